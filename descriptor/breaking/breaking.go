@@ -0,0 +1,526 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package breaking detects incompatible changes between two versions of a
+// set of .proto files, given as FileDescriptorSets such as those written by
+// protoc --descriptor_set_out --include_imports.
+//
+// Each kind of change is detected by a rule, and rules belong to one or
+// more categories:
+//
+//	WIRE  changes that break the binary wire format
+//	JSON  changes that break the JSON format of package jsonpb
+//	GO    changes that break generated Go code or its import paths
+//
+// Elements are matched by their full names, and fields also by their
+// numbers. When a file moves to another package, its elements are matched
+// with their counterparts in the new package, and the move is reported once.
+package breaking
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/descriptor/builder"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Rule categories.
+const (
+	Wire = "WIRE"
+	JSON = "JSON"
+	Go   = "GO"
+)
+
+// A Rule detects a kind of incompatible change.
+type Rule struct {
+	ID          string
+	Categories  []string
+	Description string
+}
+
+// Rules lists every rule.
+var Rules = []Rule{
+	{"FILE_NO_DELETE", []string{Go}, "files are not deleted"},
+	{"FILE_SAME_PACKAGE", []string{Wire, JSON, Go}, "files do not change package"},
+	{"FILE_SAME_GO_PACKAGE", []string{Go}, "files do not change go_package"},
+	{"MESSAGE_NO_DELETE", []string{Go}, "messages are not deleted"},
+	{"FIELD_NO_DELETE", []string{Wire, JSON}, "fields are not deleted unless their numbers are reserved"},
+	{"FIELD_SAME_NUMBER", []string{Wire}, "fields keep their numbers"},
+	{"FIELD_SAME_NAME", []string{JSON, Go}, "fields keep their names"},
+	{"FIELD_SAME_JSON_NAME", []string{JSON}, "fields keep their JSON names"},
+	{"FIELD_SAME_LABEL", []string{Wire, JSON}, "fields do not change between optional, required and repeated"},
+	{"FIELD_SAME_ONEOF", []string{Wire}, "fields do not move into, out of or between oneofs"},
+	{"FIELD_WIRE_COMPATIBLE_TYPE", []string{Wire}, "fields only change to types with the same wire encoding"},
+	{"FIELD_JSON_COMPATIBLE_TYPE", []string{JSON}, "fields only change to types with the same JSON encoding"},
+	{"ENUM_NO_DELETE", []string{Go}, "enums are not deleted"},
+	{"ENUM_VALUE_NO_DELETE", []string{Wire, JSON}, "enum values are not deleted unless their numbers are reserved"},
+	{"ENUM_VALUE_SAME_NAME", []string{JSON}, "enum values keep their names"},
+	{"SERVICE_NO_DELETE", []string{Wire, Go}, "services are not deleted"},
+	{"METHOD_NO_DELETE", []string{Wire, Go}, "methods are not deleted"},
+	{"METHOD_SAME_TYPE", []string{Wire}, "methods keep their request and response types"},
+	{"METHOD_SAME_STREAMING", []string{Wire}, "methods keep their client and server streaming"},
+}
+
+// A Change is an incompatible change found by a rule.
+type Change struct {
+	Rule    string `json:"rule"`    // ID of the rule
+	File    string `json:"file"`    // name of the file with the changed element
+	Element string `json:"element"` // full name of the changed element
+	Message string `json:"message"` // description of the change
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s: %s [%s]", c.File, c.Element, c.Message, c.Rule)
+}
+
+// A Checker compares two versions of a FileDescriptorSet.
+type Checker struct {
+	// Rules selects the rules to apply, by ID or by category. If it is
+	// empty, the rules of the WIRE and JSON categories are applied.
+	Rules []string
+
+	// Except lists rules, by ID or by category, not to apply.
+	Except []string
+}
+
+// Check returns the incompatible changes from before to after found by the
+// rules of the WIRE and JSON categories.
+func Check(before, after *descpb.FileDescriptorSet) ([]Change, error) {
+	return new(Checker).Check(before, after)
+}
+
+// Check returns the incompatible changes from before to after, sorted by
+// file and element. It returns an error if c names an unknown rule.
+func (c *Checker) Check(before, after *descpb.FileDescriptorSet) ([]Change, error) {
+	rules := c.Rules
+	if len(rules) == 0 {
+		rules = []string{Wire, JSON}
+	}
+	enabled, err := selectRules(rules)
+	if err != nil {
+		return nil, err
+	}
+	except, err := selectRules(c.Except)
+	if err != nil {
+		return nil, err
+	}
+	for id := range except {
+		delete(enabled, id)
+	}
+
+	ch := &checker{
+		enabled: enabled,
+		old:     indexSet(before),
+		new:     indexSet(after),
+		moves:   make(map[string]string),
+	}
+	ch.check()
+	sort.SliceStable(ch.changes, func(i, j int) bool {
+		a, b := ch.changes[i], ch.changes[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Element < b.Element
+	})
+	return ch.changes, nil
+}
+
+// selectRules returns the IDs of the named rules and categories.
+func selectRules(names []string) (map[string]bool, error) {
+	ids := make(map[string]bool)
+	for _, name := range names {
+		found := false
+		for _, r := range Rules {
+			if r.ID == name || hasString(r.Categories, name) {
+				ids[r.ID] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("breaking: unknown rule or category %q", name)
+		}
+	}
+	return ids, nil
+}
+
+func hasString(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+// A set indexes the elements of a FileDescriptorSet by full name.
+type set struct {
+	files    []*descpb.FileDescriptorProto
+	byName   map[string]*descpb.FileDescriptorProto // file name => file
+	messages map[string]*message
+	enums    map[string]*enum
+	services map[string]*service
+	order    []string // full names of messages and enums, in declaration order
+}
+
+type message struct {
+	file *descpb.FileDescriptorProto
+	desc *descpb.DescriptorProto
+}
+
+type enum struct {
+	file *descpb.FileDescriptorProto
+	desc *descpb.EnumDescriptorProto
+}
+
+type service struct {
+	file *descpb.FileDescriptorProto
+	desc *descpb.ServiceDescriptorProto
+}
+
+func indexSet(fds *descpb.FileDescriptorSet) *set {
+	s := &set{
+		byName:   make(map[string]*descpb.FileDescriptorProto),
+		messages: make(map[string]*message),
+		enums:    make(map[string]*enum),
+		services: make(map[string]*service),
+	}
+	for _, fd := range fds.GetFile() {
+		s.files = append(s.files, fd)
+		s.byName[fd.GetName()] = fd
+		s.indexMessages(fd, fd.GetPackage(), fd.MessageType)
+		s.indexEnums(fd, fd.GetPackage(), fd.EnumType)
+		for _, sd := range fd.Service {
+			s.services[joinName(fd.GetPackage(), sd.GetName())] = &service{fd, sd}
+		}
+	}
+	return s
+}
+
+func (s *set) indexMessages(fd *descpb.FileDescriptorProto, scope string, mds []*descpb.DescriptorProto) {
+	for _, md := range mds {
+		name := joinName(scope, md.GetName())
+		s.messages[name] = &message{fd, md}
+		s.order = append(s.order, name)
+		s.indexMessages(fd, name, md.NestedType)
+		s.indexEnums(fd, name, md.EnumType)
+	}
+}
+
+func (s *set) indexEnums(fd *descpb.FileDescriptorProto, scope string, eds []*descpb.EnumDescriptorProto) {
+	for _, ed := range eds {
+		name := joinName(scope, ed.GetName())
+		s.enums[name] = &enum{fd, ed}
+		s.order = append(s.order, name)
+	}
+}
+
+func joinName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+// A checker holds the state of a comparison.
+type checker struct {
+	enabled  map[string]bool
+	old, new *set
+	moves    map[string]string // old package => new package, for files that moved
+	changes  []Change
+}
+
+func (c *checker) report(rule string, file *descpb.FileDescriptorProto, element, format string, args ...interface{}) {
+	if !c.enabled[rule] {
+		return
+	}
+	c.changes = append(c.changes, Change{
+		Rule:    rule,
+		File:    file.GetName(),
+		Element: element,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *checker) check() {
+	for _, fd := range c.old.files {
+		nfd := c.new.byName[fd.GetName()]
+		if nfd == nil {
+			c.report("FILE_NO_DELETE", fd, fd.GetName(), "file deleted")
+			continue
+		}
+		if fd.GetPackage() != nfd.GetPackage() {
+			c.report("FILE_SAME_PACKAGE", nfd, fd.GetName(), "package changed from %q to %q", fd.GetPackage(), nfd.GetPackage())
+			c.moves[fd.GetPackage()] = nfd.GetPackage()
+		}
+		if a, b := fd.GetOptions().GetGoPackage(), nfd.GetOptions().GetGoPackage(); a != b {
+			c.report("FILE_SAME_GO_PACKAGE", nfd, fd.GetName(), "go_package changed from %q to %q", a, b)
+		}
+	}
+	for _, name := range c.old.order {
+		if m := c.old.messages[name]; m != nil {
+			c.checkMessage(name, m)
+		} else {
+			c.checkEnum(name, c.old.enums[name])
+		}
+	}
+	for _, fd := range c.old.files {
+		for _, sd := range fd.Service {
+			c.checkService(joinName(fd.GetPackage(), sd.GetName()), c.old.services[joinName(fd.GetPackage(), sd.GetName())])
+		}
+	}
+}
+
+// translate returns the full name, in the new set, of the element with the
+// given full name in the old set, which is declared in a file of package pkg.
+func (c *checker) translate(pkg, name string) string {
+	if to, ok := c.moves[pkg]; ok && (pkg == "" || strings.HasPrefix(name, pkg+".")) {
+		return joinName(to, strings.TrimPrefix(name[len(pkg):], "."))
+	}
+	return name
+}
+
+// translateType translates a type reference, such as ".foo.Bar", of the
+// old set into the corresponding reference of the new set.
+func (c *checker) translateType(ref string) string {
+	if ref == "" {
+		return ""
+	}
+	name := strings.TrimPrefix(ref, ".")
+	var fd *descpb.FileDescriptorProto
+	if m := c.old.messages[name]; m != nil {
+		fd = m.file
+	} else if e := c.old.enums[name]; e != nil {
+		fd = e.file
+	} else {
+		return ref
+	}
+	return "." + c.translate(fd.GetPackage(), name)
+}
+
+func (c *checker) checkMessage(name string, m *message) {
+	nm := c.new.messages[c.translate(m.file.GetPackage(), name)]
+	if nm == nil {
+		if !m.desc.GetOptions().GetMapEntry() {
+			c.report("MESSAGE_NO_DELETE", m.file, name, "message deleted")
+		}
+		return
+	}
+	byNumber := make(map[int32]*descpb.FieldDescriptorProto)
+	byName := make(map[string]*descpb.FieldDescriptorProto)
+	for _, f := range nm.desc.Field {
+		byNumber[f.GetNumber()] = f
+		byName[f.GetName()] = f
+	}
+	for _, f := range m.desc.Field {
+		// A field is matched by name to detect a change of its number,
+		// even if another field took the old number, and by number to
+		// detect changes in how the data under that number is decoded.
+		fname := joinName(name, f.GetName())
+		g := byName[f.GetName()]
+		if g != nil && g.GetNumber() != f.GetNumber() {
+			c.report("FIELD_SAME_NUMBER", nm.file, fname, "number changed from %d to %d", f.GetNumber(), g.GetNumber())
+		}
+		nf := byNumber[f.GetNumber()]
+		if nf == nil {
+			if g == nil && !reserved(nm.desc, f.GetNumber()) {
+				c.report("FIELD_NO_DELETE", nm.file, fname, "field %d deleted", f.GetNumber())
+			}
+			continue
+		}
+		c.checkField(nm.file, fname, m.desc, nm.desc, f, nf)
+	}
+}
+
+func reserved(md *descpb.DescriptorProto, n int32) bool {
+	for _, r := range md.ReservedRange {
+		if r.GetStart() <= n && n < r.GetEnd() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *checker) checkField(file *descpb.FileDescriptorProto, name string, md, nmd *descpb.DescriptorProto, f, nf *descpb.FieldDescriptorProto) {
+	if f.GetName() != nf.GetName() {
+		c.report("FIELD_SAME_NAME", file, name, "field %d renamed to %s", f.GetNumber(), nf.GetName())
+	}
+	if a, b := jsonName(f), jsonName(nf); a != b {
+		c.report("FIELD_SAME_JSON_NAME", file, name, "JSON name changed from %q to %q", a, b)
+	}
+	if f.GetLabel() != nf.GetLabel() {
+		c.report("FIELD_SAME_LABEL", file, name, "label changed from %s to %s", labelName(f), labelName(nf))
+	}
+	if a, b := oneofName(md, f), oneofName(nmd, nf); a != b {
+		c.report("FIELD_SAME_ONEOF", file, name, "oneof changed from %s to %s", a, b)
+	}
+
+	oldType, newType := c.translateType(f.GetTypeName()), nf.GetTypeName()
+	if wireClass(f) != wireClass(nf) || oldType != newType {
+		c.report("FIELD_WIRE_COMPATIBLE_TYPE", file, name, "type changed from %s to %s", typeName(f), typeName(nf))
+	}
+	if jsonClass(f) != jsonClass(nf) || oldType != newType {
+		c.report("FIELD_JSON_COMPATIBLE_TYPE", file, name, "type changed from %s to %s", typeName(f), typeName(nf))
+	}
+}
+
+// jsonName returns the name of f in JSON, as computed by protoc.
+func jsonName(f *descpb.FieldDescriptorProto) string {
+	if f.JsonName != nil {
+		return f.GetJsonName()
+	}
+	return builder.JSONName(f.GetName())
+}
+
+func labelName(f *descpb.FieldDescriptorProto) string {
+	return strings.ToLower(strings.TrimPrefix(f.GetLabel().String(), "LABEL_"))
+}
+
+func oneofName(md *descpb.DescriptorProto, f *descpb.FieldDescriptorProto) string {
+	if f.OneofIndex == nil || int(f.GetOneofIndex()) >= len(md.OneofDecl) {
+		return "none"
+	}
+	return md.OneofDecl[f.GetOneofIndex()].GetName()
+}
+
+func typeName(f *descpb.FieldDescriptorProto) string {
+	if f.TypeName != nil {
+		return strings.TrimPrefix(f.GetTypeName(), ".")
+	}
+	return strings.ToLower(strings.TrimPrefix(f.GetType().String(), "TYPE_"))
+}
+
+// wireClass returns the class of types that can be exchanged for the type
+// of f without changing its wire encoding.
+func wireClass(f *descpb.FieldDescriptorProto) string {
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_INT64,
+		descpb.FieldDescriptorProto_TYPE_UINT32, descpb.FieldDescriptorProto_TYPE_UINT64,
+		descpb.FieldDescriptorProto_TYPE_BOOL, descpb.FieldDescriptorProto_TYPE_ENUM:
+		return "varint"
+	case descpb.FieldDescriptorProto_TYPE_SINT32, descpb.FieldDescriptorProto_TYPE_SINT64:
+		return "zigzag"
+	case descpb.FieldDescriptorProto_TYPE_FIXED32, descpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return "fixed32"
+	case descpb.FieldDescriptorProto_TYPE_FIXED64, descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		return "fixed64"
+	case descpb.FieldDescriptorProto_TYPE_STRING, descpb.FieldDescriptorProto_TYPE_BYTES:
+		return "bytes"
+	}
+	return f.GetType().String()
+}
+
+// jsonClass returns the class of types that can be exchanged for the type
+// of f without changing its JSON encoding.
+func jsonClass(f *descpb.FieldDescriptorProto) string {
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_UINT32,
+		descpb.FieldDescriptorProto_TYPE_SINT32, descpb.FieldDescriptorProto_TYPE_FIXED32,
+		descpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return "number"
+	case descpb.FieldDescriptorProto_TYPE_INT64, descpb.FieldDescriptorProto_TYPE_UINT64,
+		descpb.FieldDescriptorProto_TYPE_SINT64, descpb.FieldDescriptorProto_TYPE_FIXED64,
+		descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		return "quoted number"
+	case descpb.FieldDescriptorProto_TYPE_FLOAT, descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return "float"
+	case descpb.FieldDescriptorProto_TYPE_GROUP, descpb.FieldDescriptorProto_TYPE_MESSAGE:
+		return "object"
+	}
+	return f.GetType().String()
+}
+
+func (c *checker) checkEnum(name string, e *enum) {
+	ne := c.new.enums[c.translate(e.file.GetPackage(), name)]
+	if ne == nil {
+		c.report("ENUM_NO_DELETE", e.file, name, "enum deleted")
+		return
+	}
+	names := make(map[int32][]string)
+	for _, v := range ne.desc.Value {
+		names[v.GetNumber()] = append(names[v.GetNumber()], v.GetName())
+	}
+	scope := name[:strings.LastIndex(name, ".")+1] // enum values are siblings of their enum
+	for _, v := range e.desc.Value {
+		vname := scope + v.GetName()
+		nn, ok := names[v.GetNumber()]
+		switch {
+		case !ok && !enumReserved(ne.desc, v.GetNumber()):
+			c.report("ENUM_VALUE_NO_DELETE", ne.file, vname, "enum value %d deleted", v.GetNumber())
+		case ok && !hasString(nn, v.GetName()):
+			c.report("ENUM_VALUE_SAME_NAME", ne.file, vname, "enum value %d renamed to %s", v.GetNumber(), strings.Join(nn, ", "))
+		}
+	}
+}
+
+func enumReserved(ed *descpb.EnumDescriptorProto, n int32) bool {
+	for _, r := range ed.ReservedRange {
+		if r.GetStart() <= n && n <= r.GetEnd() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *checker) checkService(name string, s *service) {
+	ns := c.new.services[c.translate(s.file.GetPackage(), name)]
+	if ns == nil {
+		c.report("SERVICE_NO_DELETE", s.file, name, "service deleted")
+		return
+	}
+	methods := make(map[string]*descpb.MethodDescriptorProto)
+	for _, m := range ns.desc.Method {
+		methods[m.GetName()] = m
+	}
+	for _, m := range s.desc.Method {
+		mname := joinName(name, m.GetName())
+		nm := methods[m.GetName()]
+		if nm == nil {
+			c.report("METHOD_NO_DELETE", ns.file, mname, "method deleted")
+			continue
+		}
+		if c.translateType(m.GetInputType()) != nm.GetInputType() {
+			c.report("METHOD_SAME_TYPE", ns.file, mname, "request type changed from %s to %s",
+				strings.TrimPrefix(m.GetInputType(), "."), strings.TrimPrefix(nm.GetInputType(), "."))
+		}
+		if c.translateType(m.GetOutputType()) != nm.GetOutputType() {
+			c.report("METHOD_SAME_TYPE", ns.file, mname, "response type changed from %s to %s",
+				strings.TrimPrefix(m.GetOutputType(), "."), strings.TrimPrefix(nm.GetOutputType(), "."))
+		}
+		if m.GetClientStreaming() != nm.GetClientStreaming() {
+			c.report("METHOD_SAME_STREAMING", ns.file, mname, "client streaming changed to %v", nm.GetClientStreaming())
+		}
+		if m.GetServerStreaming() != nm.GetServerStreaming() {
+			c.report("METHOD_SAME_STREAMING", ns.file, mname, "server streaming changed to %v", nm.GetServerStreaming())
+		}
+	}
+}
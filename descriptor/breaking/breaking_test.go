@@ -0,0 +1,231 @@
+package breaking_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor/breaking"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+func field(name string, number int32, label descpb.FieldDescriptorProto_Label, typ descpb.FieldDescriptorProto_Type) *descpb.FieldDescriptorProto {
+	return &descpb.FieldDescriptorProto{
+		Name:   proto.String(name),
+		Number: proto.Int32(number),
+		Label:  label.Enum(),
+		Type:   typ.Enum(),
+	}
+}
+
+func withType(f *descpb.FieldDescriptorProto, typeName string) *descpb.FieldDescriptorProto {
+	f.TypeName = proto.String(typeName)
+	return f
+}
+
+func value(name string, number int32) *descpb.EnumValueDescriptorProto {
+	return &descpb.EnumValueDescriptorProto{Name: proto.String(name), Number: proto.Int32(number)}
+}
+
+const (
+	optional = descpb.FieldDescriptorProto_LABEL_OPTIONAL
+	repeated = descpb.FieldDescriptorProto_LABEL_REPEATED
+
+	typeInt32   = descpb.FieldDescriptorProto_TYPE_INT32
+	typeInt64   = descpb.FieldDescriptorProto_TYPE_INT64
+	typeUint32  = descpb.FieldDescriptorProto_TYPE_UINT32
+	typeSint32  = descpb.FieldDescriptorProto_TYPE_SINT32
+	typeString  = descpb.FieldDescriptorProto_TYPE_STRING
+	typeBytes   = descpb.FieldDescriptorProto_TYPE_BYTES
+	typeMessage = descpb.FieldDescriptorProto_TYPE_MESSAGE
+	typeEnum    = descpb.FieldDescriptorProto_TYPE_ENUM
+)
+
+// oldSet returns the first version of a schema.
+func oldSet() *descpb.FileDescriptorSet {
+	return &descpb.FileDescriptorSet{File: []*descpb.FileDescriptorProto{{
+		Name:    proto.String("shop/order.proto"),
+		Package: proto.String("shop"),
+		Options: &descpb.FileOptions{GoPackage: proto.String("example.com/shop")},
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("Order"),
+			Field: []*descpb.FieldDescriptorProto{
+				field("id", 1, optional, typeInt32),
+				field("note", 2, optional, typeString),
+				field("count", 3, optional, typeInt32),
+				field("tags", 4, repeated, typeString),
+				field("total", 5, optional, typeInt32),
+				field("data", 6, optional, typeString),
+				field("user_name", 7, optional, typeString),
+				withType(field("state", 8, optional, typeEnum), ".shop.State"),
+				field("old", 9, optional, typeString),
+				field("card", 10, optional, typeString),
+				field("amount", 11, optional, typeInt32),
+			},
+		}, {
+			Name: proto.String("Gone"),
+		}},
+		EnumType: []*descpb.EnumDescriptorProto{{
+			Name:  proto.String("State"),
+			Value: []*descpb.EnumValueDescriptorProto{value("OPEN", 0), value("CLOSED", 1), value("HELD", 2), value("LOST", 3)},
+		}},
+		Service: []*descpb.ServiceDescriptorProto{{
+			Name: proto.String("Orders"),
+			Method: []*descpb.MethodDescriptorProto{{
+				Name:       proto.String("Get"),
+				InputType:  proto.String(".shop.Order"),
+				OutputType: proto.String(".shop.Order"),
+			}, {
+				Name:       proto.String("Delete"),
+				InputType:  proto.String(".shop.Order"),
+				OutputType: proto.String(".shop.Order"),
+			}},
+		}},
+	}, {
+		Name:    proto.String("shop/legacy.proto"),
+		Package: proto.String("shop"),
+	}}}
+}
+
+func TestCheck(t *testing.T) {
+	after := oldSet()
+	fd := after.File[0]
+	fd.Options.GoPackage = proto.String("example.com/shop/v2")
+	fd.MessageType = fd.MessageType[:1]
+	order := fd.MessageType[0]
+	order.Field = []*descpb.FieldDescriptorProto{
+		field("id", 1, optional, typeInt32),
+		// note deleted
+		field("count", 13, optional, typeInt32),
+		field("tags", 4, optional, typeString),
+		field("total", 5, optional, typeUint32),
+		field("data", 6, optional, typeBytes),
+		field("username", 7, optional, typeString),
+		withType(field("state", 8, optional, typeEnum), ".shop.State"),
+		// old deleted, with its number reserved
+		field("card", 10, optional, typeString),
+		field("amount", 11, optional, typeSint32),
+	}
+	order.Field[8].OneofIndex = proto.Int32(0)
+	order.OneofDecl = []*descpb.OneofDescriptorProto{{Name: proto.String("payment")}}
+	order.ReservedRange = []*descpb.DescriptorProto_ReservedRange{{Start: proto.Int32(9), End: proto.Int32(10)}}
+	fd.EnumType[0].Value = []*descpb.EnumValueDescriptorProto{value("OPEN", 0), value("DONE", 1), value("LOST", 3)}
+	fd.Service[0].Method = fd.Service[0].Method[:1]
+	fd.Service[0].Method[0].ServerStreaming = proto.Bool(true)
+	after.File = after.File[:1]
+
+	changes, err := (&breaking.Checker{Rules: []string{"WIRE", "JSON", "GO"}}).Check(oldSet(), after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const file = "shop/order.proto"
+	want := []breaking.Change{
+		{"FILE_NO_DELETE", "shop/legacy.proto", "shop/legacy.proto", "file deleted"},
+		{"ENUM_VALUE_SAME_NAME", file, "shop.CLOSED", "enum value 1 renamed to DONE"},
+		{"MESSAGE_NO_DELETE", file, "shop.Gone", "message deleted"},
+		{"ENUM_VALUE_NO_DELETE", file, "shop.HELD", "enum value 2 deleted"},
+		{"FIELD_SAME_ONEOF", file, "shop.Order.amount", "oneof changed from none to payment"},
+		{"FIELD_WIRE_COMPATIBLE_TYPE", file, "shop.Order.amount", "type changed from int32 to sint32"},
+		{"FIELD_SAME_NUMBER", file, "shop.Order.count", "number changed from 3 to 13"},
+		{"FIELD_JSON_COMPATIBLE_TYPE", file, "shop.Order.data", "type changed from string to bytes"},
+		{"FIELD_NO_DELETE", file, "shop.Order.note", "field 2 deleted"},
+		{"FIELD_SAME_LABEL", file, "shop.Order.tags", "label changed from repeated to optional"},
+		{"FIELD_SAME_NAME", file, "shop.Order.user_name", "field 7 renamed to username"},
+		{"FIELD_SAME_JSON_NAME", file, "shop.Order.user_name", `JSON name changed from "userName" to "username"`},
+		{"METHOD_NO_DELETE", file, "shop.Orders.Delete", "method deleted"},
+		{"METHOD_SAME_STREAMING", file, "shop.Orders.Get", "server streaming changed to true"},
+		{"FILE_SAME_GO_PACKAGE", file, "shop/order.proto", `go_package changed from "example.com/shop" to "example.com/shop/v2"`},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("got changes:")
+		for _, c := range changes {
+			t.Errorf("\t%v", c)
+		}
+		t.Errorf("want:")
+		for _, c := range want {
+			t.Errorf("\t%v", c)
+		}
+	}
+}
+
+func TestPackageMove(t *testing.T) {
+	after := oldSet()
+	fd := after.File[0]
+	fd.Package = proto.String("store")
+	order := fd.MessageType[0]
+	order.Field[7].TypeName = proto.String(".store.State")
+	order.Field[0].Type = typeInt64.Enum()
+	for _, m := range fd.Service[0].Method {
+		m.InputType = proto.String(".store.Order")
+		m.OutputType = proto.String(".store.Order")
+	}
+	fd.Service[0].Method[1].OutputType = proto.String(".store.Gone")
+
+	changes, err := breaking.Check(oldSet(), after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const file = "shop/order.proto"
+	want := []breaking.Change{
+		{"FIELD_JSON_COMPATIBLE_TYPE", file, "shop.Order.id", "type changed from int32 to int64"},
+		{"METHOD_SAME_TYPE", file, "shop.Orders.Delete", "response type changed from shop.Order to store.Gone"},
+		{"FILE_SAME_PACKAGE", file, "shop/order.proto", `package changed from "shop" to "store"`},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("got changes %v, want %v", changes, want)
+	}
+}
+
+func TestSwappedNumbers(t *testing.T) {
+	after := oldSet()
+	order := after.File[0].MessageType[0]
+	// card and data are both optional strings.
+	order.Field[5].Number = proto.Int32(10)
+	order.Field[9].Number = proto.Int32(6)
+
+	changes, err := (&breaking.Checker{Rules: []string{"WIRE"}}).Check(oldSet(), after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const file = "shop/order.proto"
+	want := []breaking.Change{
+		{"FIELD_SAME_NUMBER", file, "shop.Order.card", "number changed from 10 to 6"},
+		{"FIELD_SAME_NUMBER", file, "shop.Order.data", "number changed from 6 to 10"},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("got changes %v, want %v", changes, want)
+	}
+}
+
+func TestRuleSelection(t *testing.T) {
+	after := oldSet()
+	after.File = after.File[:1]
+	after.File[0].MessageType[0].Field[1].Name = proto.String("comment")
+
+	tests := []struct {
+		rules, except []string
+		want          []string
+	}{
+		{nil, nil, []string{"FIELD_SAME_NAME", "FIELD_SAME_JSON_NAME"}},
+		{[]string{"WIRE"}, nil, nil},
+		{[]string{"GO"}, nil, []string{"FILE_NO_DELETE", "FIELD_SAME_NAME"}},
+		{[]string{"JSON", "FILE_NO_DELETE"}, []string{"FIELD_SAME_JSON_NAME"}, []string{"FILE_NO_DELETE", "FIELD_SAME_NAME"}},
+	}
+	for _, tt := range tests {
+		changes, err := (&breaking.Checker{Rules: tt.rules, Except: tt.except}).Check(oldSet(), after)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, c := range changes {
+			got = append(got, c.Rule)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("rules %v except %v: got %v, want %v", tt.rules, tt.except, got, tt.want)
+		}
+	}
+
+	if _, err := (&breaking.Checker{Rules: []string{"WIRE", "FIELD_NO_TYPO"}}).Check(oldSet(), after); err == nil {
+		t.Error("Check with an unknown rule succeeded")
+	}
+}
@@ -0,0 +1,893 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package builder assembles FileDescriptorProtos in code, without a .proto
+// source file, and checks them as protoc would.
+//
+// A FileBuilder holds the messages, enums, services and extensions of a
+// file. Fields, enum values and extensions that are not given a number are
+// numbered when the file is built, and the files declaring the types the
+// file refers to are added to its imports:
+//
+//	f := builder.NewFile("shop/order.proto", "shop")
+//	item := f.AddMessage("Item")
+//	item.AddField("sku", builder.String)
+//	item.AddField("price", builder.NamedMessage("google.protobuf.Any"))
+//	order := f.AddMessage("Order")
+//	order.AddField("items", builder.MessageType(item)).Repeated()
+//	order.AddMapField("labels", builder.String, builder.String)
+//	fd, err := f.Build()
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/descriptor/registry"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// A Type is the type of a field, or the input or output of a method.
+type Type struct {
+	kind    descpb.FieldDescriptorProto_Type
+	message *MessageBuilder
+	enum    *EnumBuilder
+	name    string // full name of a message or enum declared by another file
+}
+
+// The scalar types.
+var (
+	Double   = Type{kind: descpb.FieldDescriptorProto_TYPE_DOUBLE}
+	Float    = Type{kind: descpb.FieldDescriptorProto_TYPE_FLOAT}
+	Int64    = Type{kind: descpb.FieldDescriptorProto_TYPE_INT64}
+	Uint64   = Type{kind: descpb.FieldDescriptorProto_TYPE_UINT64}
+	Int32    = Type{kind: descpb.FieldDescriptorProto_TYPE_INT32}
+	Fixed64  = Type{kind: descpb.FieldDescriptorProto_TYPE_FIXED64}
+	Fixed32  = Type{kind: descpb.FieldDescriptorProto_TYPE_FIXED32}
+	Bool     = Type{kind: descpb.FieldDescriptorProto_TYPE_BOOL}
+	String   = Type{kind: descpb.FieldDescriptorProto_TYPE_STRING}
+	Bytes    = Type{kind: descpb.FieldDescriptorProto_TYPE_BYTES}
+	Uint32   = Type{kind: descpb.FieldDescriptorProto_TYPE_UINT32}
+	Sfixed32 = Type{kind: descpb.FieldDescriptorProto_TYPE_SFIXED32}
+	Sfixed64 = Type{kind: descpb.FieldDescriptorProto_TYPE_SFIXED64}
+	Sint32   = Type{kind: descpb.FieldDescriptorProto_TYPE_SINT32}
+	Sint64   = Type{kind: descpb.FieldDescriptorProto_TYPE_SINT64}
+)
+
+// MessageType returns the type of a message being built.
+func MessageType(m *MessageBuilder) Type {
+	return Type{kind: descpb.FieldDescriptorProto_TYPE_MESSAGE, message: m}
+}
+
+// EnumType returns the type of an enum being built.
+func EnumType(e *EnumBuilder) Type {
+	return Type{kind: descpb.FieldDescriptorProto_TYPE_ENUM, enum: e}
+}
+
+// NamedMessage returns the type of a message declared by another file,
+// given its full name, such as "google.protobuf.Timestamp". The file is
+// looked up among the files passed to AddImport, then among the files
+// registered by generated code.
+func NamedMessage(name string) Type {
+	return Type{kind: descpb.FieldDescriptorProto_TYPE_MESSAGE, name: strings.TrimPrefix(name, ".")}
+}
+
+// NamedEnum returns the type of an enum declared by another file, given
+// its full name. It is looked up as by NamedMessage.
+func NamedEnum(name string) Type {
+	return Type{kind: descpb.FieldDescriptorProto_TYPE_ENUM, name: strings.TrimPrefix(name, ".")}
+}
+
+// A FileBuilder builds a FileDescriptorProto.
+type FileBuilder struct {
+	name, pkg, syntax string
+	options           *descpb.FileOptions
+	imports           []*descpb.FileDescriptorProto
+	messages          []*MessageBuilder
+	enums             []*EnumBuilder
+	services          []*ServiceBuilder
+	extensions        []*FieldBuilder
+	building          bool // whether Build is running, to detect import cycles
+}
+
+// NewFile returns a builder of a proto3 file with the given name and
+// package.
+func NewFile(name, pkg string) *FileBuilder {
+	return &FileBuilder{name: name, pkg: pkg, syntax: "proto3"}
+}
+
+// SetSyntax sets the syntax of the file, "proto2" or "proto3".
+func (f *FileBuilder) SetSyntax(syntax string) *FileBuilder {
+	f.syntax = syntax
+	return f
+}
+
+// SetOptions sets the options of the file.
+func (f *FileBuilder) SetOptions(opts *descpb.FileOptions) *FileBuilder {
+	f.options = opts
+	return f
+}
+
+// AddImport makes the file import dep, whether or not it uses its types,
+// and makes the types of dep available to NamedMessage and NamedEnum.
+func (f *FileBuilder) AddImport(dep *descpb.FileDescriptorProto) *FileBuilder {
+	f.imports = append(f.imports, dep)
+	return f
+}
+
+// AddMessage adds a top-level message.
+func (f *FileBuilder) AddMessage(name string) *MessageBuilder {
+	m := &MessageBuilder{file: f, name: name}
+	f.messages = append(f.messages, m)
+	return m
+}
+
+// AddEnum adds a top-level enum.
+func (f *FileBuilder) AddEnum(name string) *EnumBuilder {
+	e := &EnumBuilder{file: f, name: name}
+	f.enums = append(f.enums, e)
+	return e
+}
+
+// AddService adds a service.
+func (f *FileBuilder) AddService(name string) *ServiceBuilder {
+	s := &ServiceBuilder{file: f, name: name}
+	f.services = append(f.services, s)
+	return s
+}
+
+// AddExtension adds a top-level extension of the message extendee. If the
+// extension is not given a number, it is given the lowest free number of
+// the extension ranges of extendee.
+func (f *FileBuilder) AddExtension(name string, extendee, typ Type) *FieldBuilder {
+	x := &FieldBuilder{name: name, typ: typ, extendee: &extendee}
+	f.extensions = append(f.extensions, x)
+	return x
+}
+
+// A MessageBuilder builds a DescriptorProto.
+type MessageBuilder struct {
+	file       *FileBuilder
+	parent     *MessageBuilder
+	name       string
+	options    *descpb.MessageOptions
+	fields     []*FieldBuilder
+	oneofs     []*OneofBuilder
+	messages   []*MessageBuilder
+	enums      []*EnumBuilder
+	extensions []*FieldBuilder
+	reserved   []numberRange
+	names      []string
+	extRanges  []numberRange
+}
+
+// A numberRange is a range of numbers, both ends included.
+type numberRange struct{ start, end int32 }
+
+func (r numberRange) contains(n int32) bool { return r.start <= n && n <= r.end }
+
+// FullName returns the fully-qualified name of the message.
+func (m *MessageBuilder) FullName() string {
+	if m.parent != nil {
+		return m.parent.FullName() + "." + m.name
+	}
+	return joinName(m.file.pkg, m.name)
+}
+
+// SetOptions sets the options of the message.
+func (m *MessageBuilder) SetOptions(opts *descpb.MessageOptions) *MessageBuilder {
+	m.options = opts
+	return m
+}
+
+// AddField adds a field. A field that is not given a number is given the
+// lowest number not used by, or reserved from, the other fields.
+func (m *MessageBuilder) AddField(name string, typ Type) *FieldBuilder {
+	fb := &FieldBuilder{name: name, typ: typ}
+	m.fields = append(m.fields, fb)
+	return fb
+}
+
+// AddMapField adds a map field, along with its map entry message.
+func (m *MessageBuilder) AddMapField(name string, key, value Type) *FieldBuilder {
+	fb := m.AddField(name, Type{}).Repeated()
+	fb.mapKey, fb.mapValue = &key, &value
+	return fb
+}
+
+// AddOneof adds a oneof. Its fields are declared together, where the
+// first of them was added.
+func (m *MessageBuilder) AddOneof(name string) *OneofBuilder {
+	o := &OneofBuilder{message: m, name: name, index: len(m.oneofs)}
+	m.oneofs = append(m.oneofs, o)
+	return o
+}
+
+// AddMessage adds a nested message.
+func (m *MessageBuilder) AddMessage(name string) *MessageBuilder {
+	n := &MessageBuilder{file: m.file, parent: m, name: name}
+	m.messages = append(m.messages, n)
+	return n
+}
+
+// AddEnum adds a nested enum.
+func (m *MessageBuilder) AddEnum(name string) *EnumBuilder {
+	e := &EnumBuilder{file: m.file, parent: m, name: name}
+	m.enums = append(m.enums, e)
+	return e
+}
+
+// AddExtension adds an extension of extendee nested in the message.
+func (m *MessageBuilder) AddExtension(name string, extendee, typ Type) *FieldBuilder {
+	x := &FieldBuilder{name: name, typ: typ, extendee: &extendee}
+	m.extensions = append(m.extensions, x)
+	return x
+}
+
+// AddReservedRange reserves the field numbers start to end, inclusive.
+func (m *MessageBuilder) AddReservedRange(start, end int32) *MessageBuilder {
+	m.reserved = append(m.reserved, numberRange{start, end})
+	return m
+}
+
+// AddReservedName reserves a field name.
+func (m *MessageBuilder) AddReservedName(name string) *MessageBuilder {
+	m.names = append(m.names, name)
+	return m
+}
+
+// AddExtensionRange declares the numbers start to end, inclusive, as
+// extension numbers.
+func (m *MessageBuilder) AddExtensionRange(start, end int32) *MessageBuilder {
+	m.extRanges = append(m.extRanges, numberRange{start, end})
+	return m
+}
+
+// A OneofBuilder builds a OneofDescriptorProto.
+type OneofBuilder struct {
+	message *MessageBuilder
+	name    string
+	index   int
+	options *descpb.OneofOptions
+}
+
+// SetOptions sets the options of the oneof.
+func (o *OneofBuilder) SetOptions(opts *descpb.OneofOptions) *OneofBuilder {
+	o.options = opts
+	return o
+}
+
+// AddField adds a field to the oneof.
+func (o *OneofBuilder) AddField(name string, typ Type) *FieldBuilder {
+	fb := o.message.AddField(name, typ)
+	fb.oneof = o
+	return fb
+}
+
+// A FieldBuilder builds a FieldDescriptorProto, of a field or an
+// extension.
+type FieldBuilder struct {
+	name         string
+	number       int32
+	typ          Type
+	label        descpb.FieldDescriptorProto_Label
+	jsonName     string
+	defaultValue *string
+	options      *descpb.FieldOptions
+	oneof        *OneofBuilder
+	extendee     *Type
+	mapKey       *Type
+	mapValue     *Type
+}
+
+// SetNumber sets the number of the field.
+func (fb *FieldBuilder) SetNumber(n int32) *FieldBuilder {
+	fb.number = n
+	return fb
+}
+
+// Repeated makes the field repeated.
+func (fb *FieldBuilder) Repeated() *FieldBuilder {
+	fb.label = descpb.FieldDescriptorProto_LABEL_REPEATED
+	return fb
+}
+
+// Required makes the field required, which only proto2 allows.
+func (fb *FieldBuilder) Required() *FieldBuilder {
+	fb.label = descpb.FieldDescriptorProto_LABEL_REQUIRED
+	return fb
+}
+
+// SetJSONName sets the JSON name of the field, which defaults to its name
+// in lower camel case.
+func (fb *FieldBuilder) SetJSONName(name string) *FieldBuilder {
+	fb.jsonName = name
+	return fb
+}
+
+// SetDefault sets the default value of the field, as written in a .proto
+// file, which only proto2 allows.
+func (fb *FieldBuilder) SetDefault(value string) *FieldBuilder {
+	fb.defaultValue = &value
+	return fb
+}
+
+// SetOptions sets the options of the field.
+func (fb *FieldBuilder) SetOptions(opts *descpb.FieldOptions) *FieldBuilder {
+	fb.options = opts
+	return fb
+}
+
+// An EnumBuilder builds an EnumDescriptorProto.
+type EnumBuilder struct {
+	file     *FileBuilder
+	parent   *MessageBuilder
+	name     string
+	options  *descpb.EnumOptions
+	values   []*EnumValueBuilder
+	reserved []numberRange
+	names    []string
+}
+
+// FullName returns the fully-qualified name of the enum.
+func (e *EnumBuilder) FullName() string {
+	if e.parent != nil {
+		return e.parent.FullName() + "." + e.name
+	}
+	return joinName(e.file.pkg, e.name)
+}
+
+// SetOptions sets the options of the enum.
+func (e *EnumBuilder) SetOptions(opts *descpb.EnumOptions) *EnumBuilder {
+	e.options = opts
+	return e
+}
+
+// AddValue adds a value. A value that is not given a number is numbered
+// one more than the value before it, or zero if it is the first.
+func (e *EnumBuilder) AddValue(name string) *EnumValueBuilder {
+	vb := &EnumValueBuilder{name: name}
+	e.values = append(e.values, vb)
+	return vb
+}
+
+// AddReservedRange reserves the value numbers start to end, inclusive.
+func (e *EnumBuilder) AddReservedRange(start, end int32) *EnumBuilder {
+	e.reserved = append(e.reserved, numberRange{start, end})
+	return e
+}
+
+// AddReservedName reserves a value name.
+func (e *EnumBuilder) AddReservedName(name string) *EnumBuilder {
+	e.names = append(e.names, name)
+	return e
+}
+
+// An EnumValueBuilder builds an EnumValueDescriptorProto.
+type EnumValueBuilder struct {
+	name    string
+	number  *int32
+	options *descpb.EnumValueOptions
+}
+
+// SetNumber sets the number of the value.
+func (vb *EnumValueBuilder) SetNumber(n int32) *EnumValueBuilder {
+	vb.number = &n
+	return vb
+}
+
+// SetOptions sets the options of the value.
+func (vb *EnumValueBuilder) SetOptions(opts *descpb.EnumValueOptions) *EnumValueBuilder {
+	vb.options = opts
+	return vb
+}
+
+// A ServiceBuilder builds a ServiceDescriptorProto.
+type ServiceBuilder struct {
+	file    *FileBuilder
+	name    string
+	options *descpb.ServiceOptions
+	methods []*MethodBuilder
+}
+
+// SetOptions sets the options of the service.
+func (s *ServiceBuilder) SetOptions(opts *descpb.ServiceOptions) *ServiceBuilder {
+	s.options = opts
+	return s
+}
+
+// AddMethod adds a method, whose input and output must be messages.
+func (s *ServiceBuilder) AddMethod(name string, input, output Type) *MethodBuilder {
+	mb := &MethodBuilder{name: name, input: input, output: output}
+	s.methods = append(s.methods, mb)
+	return mb
+}
+
+// A MethodBuilder builds a MethodDescriptorProto.
+type MethodBuilder struct {
+	name            string
+	input, output   Type
+	clientStreaming bool
+	serverStreaming bool
+	options         *descpb.MethodOptions
+}
+
+// ClientStreaming makes the client send a stream of inputs.
+func (mb *MethodBuilder) ClientStreaming() *MethodBuilder {
+	mb.clientStreaming = true
+	return mb
+}
+
+// ServerStreaming makes the server send a stream of outputs.
+func (mb *MethodBuilder) ServerStreaming() *MethodBuilder {
+	mb.serverStreaming = true
+	return mb
+}
+
+// SetOptions sets the options of the method.
+func (mb *MethodBuilder) SetOptions(opts *descpb.MethodOptions) *MethodBuilder {
+	mb.options = opts
+	return mb
+}
+
+// Build returns the descriptor of the file, after checking it with
+// Validate. The files of the types it uses are added to its imports;
+// files being built by other FileBuilders are built too.
+func (f *FileBuilder) Build() (*descpb.FileDescriptorProto, error) {
+	if f.building {
+		return nil, fmt.Errorf("%s: import cycle", f.name)
+	}
+	f.building = true
+	defer func() { f.building = false }()
+
+	b := &build{file: f, files: make(map[string]*descpb.FileDescriptorProto)}
+	for _, dep := range f.imports {
+		b.use(dep)
+	}
+	fd := &descpb.FileDescriptorProto{Name: &f.name, Options: f.options}
+	if f.pkg != "" {
+		fd.Package = &f.pkg
+	}
+	if f.syntax != "proto2" {
+		fd.Syntax = &f.syntax
+	}
+	for _, m := range f.messages {
+		fd.MessageType = append(fd.MessageType, b.message(m))
+	}
+	for _, e := range f.enums {
+		fd.EnumType = append(fd.EnumType, b.enum(e))
+	}
+	fd.Extension = b.extensions(f.extensions)
+	for _, s := range f.services {
+		fd.Service = append(fd.Service, b.service(s))
+	}
+	if b.err != nil {
+		return nil, b.err
+	}
+	fd.Dependency = b.deps
+
+	var deps []*descpb.FileDescriptorProto
+	for _, name := range b.deps {
+		deps = b.addPublic(deps, b.files[name])
+	}
+	if err := Validate(fd, deps...); err != nil {
+		return nil, err
+	}
+	return fd, nil
+}
+
+// build holds the state of FileBuilder.Build.
+type build struct {
+	file       *FileBuilder
+	deps       []string                               // imports, in order of first use
+	files      map[string]*descpb.FileDescriptorProto // imported files by name
+	extNumbers map[extKey]bool                        // extension numbers used by the file
+	err        error
+}
+
+// use adds dep to the imports of the file.
+func (b *build) use(dep *descpb.FileDescriptorProto) {
+	if dep.GetName() == b.file.name {
+		return
+	}
+	if _, ok := b.files[dep.GetName()]; !ok {
+		b.files[dep.GetName()] = dep
+		b.deps = append(b.deps, dep.GetName())
+	}
+}
+
+// useBuilder adds the file built by fb to the imports of the file.
+func (b *build) useBuilder(fb *FileBuilder) {
+	if fb == b.file || b.files[fb.name] != nil || b.err != nil {
+		return
+	}
+	dep, err := fb.Build()
+	if err != nil {
+		b.err = fmt.Errorf("building import %s: %v", fb.name, err)
+		return
+	}
+	b.use(dep)
+}
+
+// addPublic appends fd and the files it imports publicly to deps.
+func (b *build) addPublic(deps []*descpb.FileDescriptorProto, fd *descpb.FileDescriptorProto) []*descpb.FileDescriptorProto {
+	for _, d := range deps {
+		if d.GetName() == fd.GetName() {
+			return deps
+		}
+	}
+	deps = append(deps, fd)
+	for _, i := range fd.PublicDependency {
+		if int(i) >= len(fd.Dependency) {
+			continue
+		}
+		name := fd.Dependency[i]
+		pub := b.files[name]
+		if pub == nil {
+			pub, _ = registry.FindFile(name)
+		}
+		if pub != nil {
+			deps = b.addPublic(deps, pub)
+		}
+	}
+	return deps
+}
+
+// lookup finds the file declaring the message or enum with the given full
+// name, along with the message, if it is one.
+func (b *build) lookup(t Type) (*descpb.FileDescriptorProto, *descpb.DescriptorProto) {
+	for _, dep := range b.file.imports {
+		if md, ok := findType(dep, t.name); ok {
+			return dep, md
+		}
+	}
+	if t.kind == descpb.FieldDescriptorProto_TYPE_ENUM {
+		fd, _, err := registry.FindEnum(t.name)
+		if err != nil {
+			return nil, nil
+		}
+		return fd, nil
+	}
+	fd, md, err := registry.FindMessage(t.name)
+	if err != nil {
+		return nil, nil
+	}
+	return fd, md
+}
+
+// findType reports whether fd declares the named message or enum, and
+// returns the message if it is one.
+func findType(fd *descpb.FileDescriptorProto, name string) (*descpb.DescriptorProto, bool) {
+	var walk func(scope string, mds []*descpb.DescriptorProto, eds []*descpb.EnumDescriptorProto) (*descpb.DescriptorProto, bool)
+	walk = func(scope string, mds []*descpb.DescriptorProto, eds []*descpb.EnumDescriptorProto) (*descpb.DescriptorProto, bool) {
+		for _, ed := range eds {
+			if joinName(scope, ed.GetName()) == name {
+				return nil, true
+			}
+		}
+		for _, md := range mds {
+			full := joinName(scope, md.GetName())
+			if full == name {
+				return md, true
+			}
+			if strings.HasPrefix(name, full+".") {
+				if nd, ok := walk(full, md.NestedType, md.EnumType); ok {
+					return nd, true
+				}
+			}
+		}
+		return nil, false
+	}
+	return walk(fd.GetPackage(), fd.MessageType, fd.EnumType)
+}
+
+// typeName returns the fully-qualified name of a message or enum type,
+// adding the file that declares it to the imports.
+func (b *build) typeName(t Type) string {
+	switch {
+	case t.message != nil:
+		b.useBuilder(t.message.file)
+		return "." + t.message.FullName()
+	case t.enum != nil:
+		b.useBuilder(t.enum.file)
+		return "." + t.enum.FullName()
+	}
+	if fd, _ := b.lookup(t); fd != nil {
+		b.use(fd)
+	}
+	// An unknown name is kept, for Validate to report.
+	return "." + t.name
+}
+
+// extensionRanges returns the extension ranges of a message type.
+func (b *build) extensionRanges(t Type) []numberRange {
+	if t.message != nil {
+		return t.message.extRanges
+	}
+	var ranges []numberRange
+	if _, md := b.lookup(t); md != nil {
+		for _, r := range md.ExtensionRange {
+			ranges = append(ranges, numberRange{r.GetStart(), r.GetEnd() - 1})
+		}
+	}
+	return ranges
+}
+
+func (b *build) message(m *MessageBuilder) *descpb.DescriptorProto {
+	md := &descpb.DescriptorProto{Name: proto.String(m.name), Options: m.options}
+
+	// Gather the fields of each oneof where its first field was added.
+	var fields []*FieldBuilder
+	done := make(map[*OneofBuilder]bool)
+	for _, fb := range m.fields {
+		switch {
+		case fb.oneof == nil:
+			fields = append(fields, fb)
+		case !done[fb.oneof]:
+			done[fb.oneof] = true
+			for _, ob := range m.fields {
+				if ob.oneof == fb.oneof {
+					fields = append(fields, ob)
+				}
+			}
+		}
+	}
+
+	// Number the fields that were not given a number.
+	used := make(map[int32]bool)
+	for _, fb := range fields {
+		used[fb.number] = true
+	}
+	next := int32(1)
+	for _, fb := range fields {
+		n := fb.number
+		if n == 0 {
+			for used[next] || inRanges(next, m.reserved) || inRanges(next, m.extRanges) ||
+				firstReservedNumber <= next && next <= lastReservedNumber {
+				next++
+			}
+			n = next
+			used[n] = true
+		}
+		fd := b.field(fb, n)
+		if fb.oneof != nil {
+			fd.OneofIndex = proto.Int32(int32(fb.oneof.index))
+		}
+		if fb.mapKey != nil {
+			entry := b.mapEntry(fb)
+			md.NestedType = append(md.NestedType, entry)
+			fd.Type = descpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			fd.TypeName = proto.String("." + m.FullName() + "." + entry.GetName())
+		}
+		md.Field = append(md.Field, fd)
+	}
+	for _, o := range m.oneofs {
+		md.OneofDecl = append(md.OneofDecl, &descpb.OneofDescriptorProto{Name: proto.String(o.name), Options: o.options})
+	}
+
+	var nested []*descpb.DescriptorProto
+	for _, n := range m.messages {
+		nested = append(nested, b.message(n))
+	}
+	md.NestedType = append(nested, md.NestedType...)
+	for _, e := range m.enums {
+		md.EnumType = append(md.EnumType, b.enum(e))
+	}
+	md.Extension = b.extensions(m.extensions)
+	for _, r := range m.extRanges {
+		md.ExtensionRange = append(md.ExtensionRange, &descpb.DescriptorProto_ExtensionRange{
+			Start: proto.Int32(r.start),
+			End:   proto.Int32(r.end + 1),
+		})
+	}
+	for _, r := range m.reserved {
+		md.ReservedRange = append(md.ReservedRange, &descpb.DescriptorProto_ReservedRange{
+			Start: proto.Int32(r.start),
+			End:   proto.Int32(r.end + 1),
+		})
+	}
+	md.ReservedName = m.names
+	return md
+}
+
+func inRanges(n int32, ranges []numberRange) bool {
+	for _, r := range ranges {
+		if r.contains(n) {
+			return true
+		}
+	}
+	return false
+}
+
+// field returns the descriptor of a field or extension with number n.
+func (b *build) field(fb *FieldBuilder, n int32) *descpb.FieldDescriptorProto {
+	label := fb.label
+	if label == 0 {
+		label = descpb.FieldDescriptorProto_LABEL_OPTIONAL
+	}
+	jsonName := fb.jsonName
+	if jsonName == "" {
+		jsonName = JSONName(fb.name)
+	}
+	fd := &descpb.FieldDescriptorProto{
+		Name:         proto.String(fb.name),
+		Number:       proto.Int32(n),
+		Label:        label.Enum(),
+		JsonName:     proto.String(jsonName),
+		DefaultValue: fb.defaultValue,
+		Options:      fb.options,
+	}
+	if fb.mapKey == nil {
+		b.setType(fd, fb.typ)
+	}
+	return fd
+}
+
+// setType sets the type and type name of a field.
+func (b *build) setType(fd *descpb.FieldDescriptorProto, t Type) {
+	if t.kind != 0 {
+		fd.Type = t.kind.Enum()
+	}
+	if t.kind == descpb.FieldDescriptorProto_TYPE_MESSAGE || t.kind == descpb.FieldDescriptorProto_TYPE_ENUM {
+		fd.TypeName = proto.String(b.typeName(t))
+	}
+}
+
+// mapEntry returns the map entry message of a map field.
+func (b *build) mapEntry(fb *FieldBuilder) *descpb.DescriptorProto {
+	opt := descpb.FieldDescriptorProto_LABEL_OPTIONAL
+	key := &descpb.FieldDescriptorProto{Name: proto.String("key"), Number: proto.Int32(1), Label: opt.Enum(), JsonName: proto.String("key")}
+	value := &descpb.FieldDescriptorProto{Name: proto.String("value"), Number: proto.Int32(2), Label: opt.Enum(), JsonName: proto.String("value")}
+	b.setType(key, *fb.mapKey)
+	b.setType(value, *fb.mapValue)
+	return &descpb.DescriptorProto{
+		Name:    proto.String(mapEntryName(fb.name)),
+		Field:   []*descpb.FieldDescriptorProto{key, value},
+		Options: &descpb.MessageOptions{MapEntry: proto.Bool(true)},
+	}
+}
+
+// extensions returns the descriptors of extensions, numbering those that
+// were not given a number.
+func (b *build) extensions(xbs []*FieldBuilder) []*descpb.FieldDescriptorProto {
+	if b.extNumbers == nil {
+		b.extNumbers = make(map[extKey]bool)
+		b.file.forEachExtension(func(xb *FieldBuilder) {
+			if xb.number != 0 {
+				b.extNumbers[extKey{b.typeName(*xb.extendee), xb.number}] = true
+			}
+		})
+	}
+	var xds []*descpb.FieldDescriptorProto
+	for _, xb := range xbs {
+		extendee := b.typeName(*xb.extendee)
+		n := xb.number
+		if n == 0 {
+		search:
+			for _, r := range b.extensionRanges(*xb.extendee) {
+				for i := r.start; i <= r.end && i > 0; i++ {
+					if !b.extNumbers[extKey{extendee, i}] {
+						n = i
+						break search
+					}
+				}
+			}
+			b.extNumbers[extKey{extendee, n}] = true
+		}
+		xd := b.field(xb, n)
+		xd.Extendee = proto.String(extendee)
+		xds = append(xds, xd)
+	}
+	return xds
+}
+
+// forEachExtension calls fn for each extension declared by the file.
+func (f *FileBuilder) forEachExtension(fn func(*FieldBuilder)) {
+	var walk func(ms []*MessageBuilder)
+	walk = func(ms []*MessageBuilder) {
+		for _, m := range ms {
+			for _, xb := range m.extensions {
+				fn(xb)
+			}
+			walk(m.messages)
+		}
+	}
+	for _, xb := range f.extensions {
+		fn(xb)
+	}
+	walk(f.messages)
+}
+
+func (b *build) enum(e *EnumBuilder) *descpb.EnumDescriptorProto {
+	ed := &descpb.EnumDescriptorProto{Name: proto.String(e.name), Options: e.options}
+	next := int32(0)
+	for _, vb := range e.values {
+		n := next
+		if vb.number != nil {
+			n = *vb.number
+		}
+		next = n + 1
+		ed.Value = append(ed.Value, &descpb.EnumValueDescriptorProto{
+			Name:    proto.String(vb.name),
+			Number:  proto.Int32(n),
+			Options: vb.options,
+		})
+	}
+	for _, r := range e.reserved {
+		ed.ReservedRange = append(ed.ReservedRange, &descpb.EnumDescriptorProto_EnumReservedRange{
+			Start: proto.Int32(r.start),
+			End:   proto.Int32(r.end),
+		})
+	}
+	ed.ReservedName = e.names
+	return ed
+}
+
+func (b *build) service(s *ServiceBuilder) *descpb.ServiceDescriptorProto {
+	sd := &descpb.ServiceDescriptorProto{Name: proto.String(s.name), Options: s.options}
+	for _, mb := range s.methods {
+		md := &descpb.MethodDescriptorProto{
+			Name:       proto.String(mb.name),
+			InputType:  proto.String(b.typeName(mb.input)),
+			OutputType: proto.String(b.typeName(mb.output)),
+			Options:    mb.options,
+		}
+		if mb.clientStreaming {
+			md.ClientStreaming = proto.Bool(true)
+		}
+		if mb.serverStreaming {
+			md.ServerStreaming = proto.Bool(true)
+		}
+		sd.Method = append(sd.Method, md)
+	}
+	return sd
+}
+
+// JSONName returns the default JSON name of a field: its name in lower
+// camel case, as protoc computes it.
+func JSONName(name string) string {
+	var b []byte
+	upper := false
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '_':
+			upper = true
+			continue
+		case upper && 'a' <= c && c <= 'z':
+			c -= 'a' - 'A'
+		}
+		upper = false
+		b = append(b, c)
+	}
+	return string(b)
+}
@@ -0,0 +1,217 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package builder
+
+import (
+	"reflect"
+	"testing"
+
+	_ "github.com/golang/protobuf/ptypes/any"
+)
+
+func TestBuild(t *testing.T) {
+	f := NewFile("shop/order.proto", "shop")
+	status := f.AddEnum("Status")
+	status.AddValue("STATUS_UNSPECIFIED")
+	status.AddValue("STATUS_OPEN")
+	status.AddValue("STATUS_CLOSED").SetNumber(5)
+	status.AddValue("STATUS_ARCHIVED")
+
+	order := f.AddMessage("Order")
+	order.AddReservedRange(2, 3).AddReservedName("total")
+	order.AddField("order_id", String)
+	order.AddField("status", EnumType(status)).SetNumber(1)
+	item := order.AddMessage("Item")
+	item.AddField("sku", String)
+	item.AddField("detail", NamedMessage("google.protobuf.Any"))
+	order.AddField("items", MessageType(item)).Repeated()
+	payment := order.AddOneof("payment")
+	payment.AddField("card", String)
+	order.AddMapField("labels", String, Int64)
+	payment.AddField("voucher", String)
+
+	f.AddService("Orders").AddMethod("Get", MessageType(order), MessageType(order)).ServerStreaming()
+
+	fd, err := f.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fd.Dependency, []string{"google/protobuf/any.proto"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Dependency = %v, want %v", got, want)
+	}
+
+	var values []int32
+	for _, vd := range fd.EnumType[0].Value {
+		values = append(values, vd.GetNumber())
+	}
+	if want := []int32{0, 1, 5, 6}; !reflect.DeepEqual(values, want) {
+		t.Errorf("enum values numbered %v, want %v", values, want)
+	}
+
+	type field struct {
+		name   string
+		number int32
+		oneof  bool
+	}
+	var fields []field
+	for _, f := range fd.MessageType[0].Field {
+		fields = append(fields, field{f.GetName(), f.GetNumber(), f.OneofIndex != nil})
+	}
+	want := []field{
+		{"order_id", 4, false},
+		{"status", 1, false},
+		{"items", 5, false},
+		{"card", 6, true},
+		{"voucher", 7, true},
+		{"labels", 8, false},
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("fields:\n got %v\nwant %v", fields, want)
+	}
+
+	md := fd.MessageType[0]
+	if got := md.Field[5].GetTypeName(); got != ".shop.Order.LabelsEntry" {
+		t.Errorf("map field type = %q, want .shop.Order.LabelsEntry", got)
+	}
+	if len(md.NestedType) != 2 || !md.NestedType[1].GetOptions().GetMapEntry() {
+		t.Errorf("nested types = %v, want Item and LabelsEntry", md.NestedType)
+	}
+	if got := md.NestedType[0].Field[1].GetTypeName(); got != ".google.protobuf.Any" {
+		t.Errorf("Item.detail type = %q, want .google.protobuf.Any", got)
+	}
+	if got := md.Field[0].GetJsonName(); got != "orderId" {
+		t.Errorf("json_name = %q, want orderId", got)
+	}
+	if got := md.ReservedRange[0]; got.GetStart() != 2 || got.GetEnd() != 4 {
+		t.Errorf("reserved range = %v, want 2 to 4 exclusive", got)
+	}
+	if m := fd.Service[0].Method[0]; m.GetInputType() != ".shop.Order" || !m.GetServerStreaming() || m.ClientStreaming != nil {
+		t.Errorf("method = %v", m)
+	}
+}
+
+func TestBuildImports(t *testing.T) {
+	common := NewFile("common.proto", "common").SetSyntax("proto2")
+	base := common.AddMessage("Base")
+	base.AddField("id", Int64)
+	base.AddExtensionRange(100, 199)
+
+	f := NewFile("app.proto", "app").SetSyntax("proto2")
+	f.AddExtension("tag", MessageType(base), String)
+	f.AddExtension("note", MessageType(base), String)
+	f.AddExtension("flag", MessageType(base), Bool).SetNumber(100)
+	fd, err := f.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fd.Dependency, []string{"common.proto"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Dependency = %v, want %v", got, want)
+	}
+	var numbers []int32
+	for _, xd := range fd.Extension {
+		numbers = append(numbers, xd.GetNumber())
+		if xd.GetExtendee() != ".common.Base" {
+			t.Errorf("extendee of %s = %q, want .common.Base", xd.GetName(), xd.GetExtendee())
+		}
+	}
+	if want := []int32{101, 102, 100}; !reflect.DeepEqual(numbers, want) {
+		t.Errorf("extensions numbered %v, want %v", numbers, want)
+	}
+	if fd.Syntax != nil {
+		t.Errorf("syntax = %q, want unset for proto2", fd.GetSyntax())
+	}
+
+	// The types of imported descriptors can be named.
+	dep, err := common.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := NewFile("other.proto", "other").AddImport(dep)
+	g.AddMessage("M").AddField("base", NamedMessage("common.Base"))
+	if _, err := g.Build(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	f := NewFile("bad.proto", "bad")
+	m := f.AddMessage("M")
+	m.AddField("a", String).SetNumber(1)
+	m.AddField("b", String).SetNumber(1)
+	m.AddField("c", NamedMessage("no.Such"))
+	m.AddField("d", String).Required()
+	_, err := f.Build()
+	errs, ok := err.(Errors)
+	if !ok {
+		t.Fatalf("Build() error = %v, want Errors", err)
+	}
+	var msgs []string
+	for _, e := range errs {
+		msgs = append(msgs, e.Error())
+	}
+	want := []string{
+		"bad.proto: bad.M.b: field number 1 is already used by a",
+		"bad.proto: bad.M.c: no.Such is not defined",
+		"bad.proto: bad.M.d: required fields are not allowed in proto3",
+	}
+	if !reflect.DeepEqual(msgs, want) {
+		t.Errorf("errors:\n got %q\nwant %q", msgs, want)
+	}
+}
+
+func TestBuildCycle(t *testing.T) {
+	a := NewFile("a.proto", "a")
+	b := NewFile("b.proto", "b")
+	ma := a.AddMessage("A")
+	mb := b.AddMessage("B")
+	ma.AddField("b", MessageType(mb))
+	mb.AddField("a", MessageType(ma))
+	if _, err := a.Build(); err == nil {
+		t.Error("Build() of files importing each other succeeded")
+	}
+}
+
+func TestJSONName(t *testing.T) {
+	for _, tt := range []struct{ in, want string }{
+		{"foo", "foo"},
+		{"foo_bar", "fooBar"},
+		{"foo_bar_2", "fooBar2"},
+		{"_foo", "Foo"},
+		{"fooBar", "fooBar"},
+		{"Country", "Country"},
+		{"o_int32", "oInt32"},
+	} {
+		if got := JSONName(tt.in); got != tt.want {
+			t.Errorf("JSONName(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,870 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package builder
+
+// This file implements the semantic checks that protoc applies to files.
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// An Error is a problem found in a file descriptor.
+type Error struct {
+	File    string  // name of the file
+	Element string  // full name of the element, or empty for the file itself
+	Path    []int32 // path of the element, as in SourceCodeInfo locations
+	Msg     string
+}
+
+func (e *Error) Error() string {
+	if e.Element == "" {
+		return e.File + ": " + e.Msg
+	}
+	return e.File + ": " + e.Element + ": " + e.Msg
+}
+
+// Errors is a list of problems, in the order of the elements of a file.
+type Errors []*Error
+
+func (errs Errors) Error() string {
+	switch len(errs) {
+	case 0:
+		return "no errors"
+	case 1:
+		return errs[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", errs[0], len(errs)-1)
+}
+
+// Field numbers of the elements of descriptor.proto that appear in the
+// paths of SourceCodeInfo locations.
+const (
+	// FileDescriptorProto
+	filePackagePath    = 2
+	fileDependencyPath = 3
+	fileMessagePath    = 4
+	fileEnumPath       = 5
+	fileServicePath    = 6
+	fileExtensionPath  = 7
+	fileSyntaxPath     = 12
+	// DescriptorProto
+	messageFieldPath          = 2
+	messageNestedPath         = 3
+	messageEnumPath           = 4
+	messageExtensionRangePath = 5
+	messageExtensionPath      = 6
+	messageOneofPath          = 8
+	messageReservedRangePath  = 9
+	messageReservedNamePath   = 10
+	// EnumDescriptorProto
+	enumValuePath         = 2
+	enumReservedRangePath = 4
+	enumReservedNamePath  = 5
+	// ServiceDescriptorProto
+	serviceMethodPath = 2
+)
+
+const (
+	maxFieldNumber      = 536870911
+	firstReservedNumber = 19000 // numbers reserved for the protobuf implementation
+	lastReservedNumber  = 19999
+)
+
+// Validate checks fd as protoc does once it has parsed a file: names must
+// be valid and unique and must resolve to declared types, field and enum
+// value numbers must not collide with each other or with reserved ranges,
+// map entries and extensions must be well formed, and so on. deps must
+// hold the files that fd imports, along with the files they import
+// publicly. Validate returns every problem found, as Errors, or nil.
+func Validate(fd *descpb.FileDescriptorProto, deps ...*descpb.FileDescriptorProto) error {
+	v := &validator{
+		fd:     fd,
+		files:  make(map[string]*descpb.FileDescriptorProto),
+		syms:   make(map[string]symbol),
+		exts:   make(map[extKey]string),
+		proto3: fd.GetSyntax() == "proto3",
+	}
+	for _, dep := range deps {
+		v.files[dep.GetName()] = dep
+	}
+	v.file()
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+type symbolKind int
+
+const (
+	symPackage symbolKind = iota
+	symMessage
+	symEnum
+	symEnumValue
+	symService
+	symMethod
+	symField
+	symOneof
+)
+
+var kindNames = [...]string{"a package", "a message", "an enum", "an enum value", "a service", "a method", "a field", "a oneof"}
+
+type symbol struct {
+	kind    symbolKind
+	file    string
+	message *descpb.DescriptorProto
+	enum    *descpb.EnumDescriptorProto
+}
+
+// isType reports whether the symbol can be the type of a field.
+func (s symbol) isType() bool {
+	return s.kind == symMessage || s.kind == symEnum
+}
+
+// isAggregate reports whether the symbol can contain other symbols.
+func (s symbol) isAggregate() bool {
+	return s.kind == symPackage || s.kind == symMessage || s.kind == symEnum || s.kind == symService
+}
+
+type extKey struct {
+	extendee string
+	number   int32
+}
+
+type validator struct {
+	fd     *descpb.FileDescriptorProto
+	files  map[string]*descpb.FileDescriptorProto // dependencies by name
+	syms   map[string]symbol                      // full name => symbol
+	exts   map[extKey]string                      // extendee and number => full name of extension
+	proto3 bool
+	errs   Errors
+}
+
+func (v *validator) errorf(path []int32, element, format string, args ...interface{}) {
+	v.errs = append(v.errs, &Error{
+		File:    v.fd.GetName(),
+		Element: element,
+		Path:    append([]int32(nil), path...),
+		Msg:     fmt.Sprintf(format, args...),
+	})
+}
+
+func appendPath(path []int32, elems ...int32) []int32 {
+	return append(append([]int32(nil), path...), elems...)
+}
+
+func joinName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+func parentScope(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[:i]
+	}
+	return ""
+}
+
+func isIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '_' && !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') && !(i > 0 && '0' <= c && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *validator) file() {
+	fd := v.fd
+	if fd.GetName() == "" {
+		v.errorf(nil, "", "missing file name")
+	}
+	switch fd.GetSyntax() {
+	case "", "proto2", "proto3":
+	default:
+		v.errorf([]int32{fileSyntaxPath}, "", "unknown syntax %q", fd.GetSyntax())
+	}
+	if fd.Package != nil {
+		for _, part := range strings.Split(fd.GetPackage(), ".") {
+			if !isIdent(part) {
+				v.errorf([]int32{filePackagePath}, "", "invalid package name %q", fd.GetPackage())
+				break
+			}
+		}
+	}
+
+	// Index the symbols visible from fd: those of the files it imports and
+	// of the files they import publicly.
+	seen := make(map[string]bool)
+	for i, name := range fd.Dependency {
+		path := []int32{fileDependencyPath, int32(i)}
+		switch {
+		case seen[name]:
+			v.errorf(path, "", "import %q was listed twice", name)
+		case v.files[name] == nil:
+			v.errorf(path, "", "import %q was not found", name)
+		default:
+			v.addVisible(v.files[name], seen)
+		}
+		seen[name] = true
+	}
+	for _, i := range append(append([]int32(nil), fd.PublicDependency...), fd.WeakDependency...) {
+		if i < 0 || int(i) >= len(fd.Dependency) {
+			v.errorf(nil, "", "invalid dependency index %d", i)
+		}
+	}
+	v.addFile(fd, true)
+
+	pkg := fd.GetPackage()
+	for i, md := range fd.MessageType {
+		v.message([]int32{fileMessagePath, int32(i)}, pkg, md)
+	}
+	for i, ed := range fd.EnumType {
+		v.enum([]int32{fileEnumPath, int32(i)}, pkg, ed)
+	}
+	for i, xd := range fd.Extension {
+		v.field(appendPath([]int32{fileExtensionPath}, int32(i)), pkg, nil, xd)
+	}
+	for i, sd := range fd.Service {
+		v.service([]int32{fileServicePath, int32(i)}, pkg, sd)
+	}
+}
+
+// addVisible adds the symbols of dep, and of the files it imports publicly.
+func (v *validator) addVisible(dep *descpb.FileDescriptorProto, seen map[string]bool) {
+	if seen[dep.GetName()] {
+		return
+	}
+	seen[dep.GetName()] = true
+	v.addFile(dep, false)
+	for _, i := range dep.PublicDependency {
+		if int(i) < len(dep.Dependency) {
+			if pub := v.files[dep.Dependency[i]]; pub != nil {
+				v.addVisible(pub, seen)
+			}
+		}
+	}
+	// Extensions of imported files may not be redeclared by fd.
+	v.addExtensions(dep)
+}
+
+func (v *validator) addExtensions(fd *descpb.FileDescriptorProto) {
+	var walk func(scope string, xds []*descpb.FieldDescriptorProto, mds []*descpb.DescriptorProto)
+	walk = func(scope string, xds []*descpb.FieldDescriptorProto, mds []*descpb.DescriptorProto) {
+		for _, xd := range xds {
+			k := extKey{strings.TrimPrefix(xd.GetExtendee(), "."), xd.GetNumber()}
+			if _, ok := v.exts[k]; !ok {
+				v.exts[k] = joinName(scope, xd.GetName())
+			}
+		}
+		for _, md := range mds {
+			walk(joinName(scope, md.GetName()), md.Extension, md.NestedType)
+		}
+	}
+	walk(fd.GetPackage(), fd.Extension, fd.MessageType)
+}
+
+// addFile adds the symbols declared in fd. Conflicts are reported only for
+// the file being validated.
+func (v *validator) addFile(fd *descpb.FileDescriptorProto, check bool) {
+	path := []int32(nil)
+	add := func(name string, sym symbol, path []int32) {
+		sym.file = fd.GetName()
+		old, ok := v.syms[name]
+		switch {
+		case !ok:
+			v.syms[name] = sym
+		case old.kind == symPackage && sym.kind == symPackage:
+		case check:
+			where := ""
+			if old.file != fd.GetName() {
+				where = " in " + old.file
+			}
+			v.errorf(path, name, "%s is already defined as %s%s", name, kindNames[old.kind], where)
+		}
+	}
+	pkg := fd.GetPackage()
+	for s := pkg; s != ""; s = parentScope(s) {
+		add(s, symbol{kind: symPackage}, []int32{filePackagePath})
+	}
+
+	var addEnum func(path []int32, scope string, ed *descpb.EnumDescriptorProto)
+	addEnum = func(path []int32, scope string, ed *descpb.EnumDescriptorProto) {
+		add(joinName(scope, ed.GetName()), symbol{kind: symEnum, enum: ed}, path)
+		for i, vd := range ed.Value {
+			// Enum values are siblings of their enum.
+			add(joinName(scope, vd.GetName()), symbol{kind: symEnumValue}, appendPath(path, enumValuePath, int32(i)))
+		}
+	}
+	var addMessage func(path []int32, scope string, md *descpb.DescriptorProto)
+	addMessage = func(path []int32, scope string, md *descpb.DescriptorProto) {
+		name := joinName(scope, md.GetName())
+		add(name, symbol{kind: symMessage, message: md}, path)
+		for i, f := range md.Field {
+			add(joinName(name, f.GetName()), symbol{kind: symField}, appendPath(path, messageFieldPath, int32(i)))
+		}
+		for i, od := range md.OneofDecl {
+			add(joinName(name, od.GetName()), symbol{kind: symOneof}, appendPath(path, messageOneofPath, int32(i)))
+		}
+		for i, xd := range md.Extension {
+			add(joinName(name, xd.GetName()), symbol{kind: symField}, appendPath(path, messageExtensionPath, int32(i)))
+		}
+		for i, nd := range md.NestedType {
+			addMessage(appendPath(path, messageNestedPath, int32(i)), name, nd)
+		}
+		for i, ed := range md.EnumType {
+			addEnum(appendPath(path, messageEnumPath, int32(i)), name, ed)
+		}
+	}
+	for i, md := range fd.MessageType {
+		addMessage(appendPath(path, fileMessagePath, int32(i)), pkg, md)
+	}
+	for i, ed := range fd.EnumType {
+		addEnum(appendPath(path, fileEnumPath, int32(i)), pkg, ed)
+	}
+	for i, xd := range fd.Extension {
+		add(joinName(pkg, xd.GetName()), symbol{kind: symField}, appendPath(path, fileExtensionPath, int32(i)))
+	}
+	for i, sd := range fd.Service {
+		spath := appendPath(path, fileServicePath, int32(i))
+		name := joinName(pkg, sd.GetName())
+		add(name, symbol{kind: symService}, spath)
+		for j, md := range sd.Method {
+			add(joinName(name, md.GetName()), symbol{kind: symMethod}, appendPath(spath, serviceMethodPath, int32(j)))
+		}
+	}
+}
+
+// resolve looks up a type name, which is either fully qualified with a
+// leading dot or relative to scope, following the scoping rules of protoc.
+func (v *validator) resolve(scope, name string) (string, symbol, bool) {
+	if strings.HasPrefix(name, ".") {
+		sym, ok := v.syms[name[1:]]
+		return name[1:], sym, ok
+	}
+	first := name
+	if i := strings.Index(name, "."); i >= 0 {
+		first = name[:i]
+	}
+	for s := scope; ; s = parentScope(s) {
+		if sym, ok := v.syms[joinName(s, first)]; ok {
+			switch {
+			case first == name && sym.isType():
+				return joinName(s, name), sym, true
+			case first != name && sym.isAggregate():
+				full := joinName(s, name)
+				sym, ok := v.syms[full]
+				return full, sym, ok
+			}
+		}
+		if s == "" {
+			return "", symbol{}, false
+		}
+	}
+}
+
+// resolveType resolves the type name of a field or method, declared in
+// scope, reporting an error if it is not declared or is not a type.
+func (v *validator) resolveType(path []int32, element, scope, name string) (string, symbol, bool) {
+	full, sym, ok := v.resolve(scope, name)
+	switch {
+	case !ok:
+		v.errorf(path, element, "%s is not defined", strings.TrimPrefix(name, "."))
+	case !sym.isType():
+		v.errorf(path, element, "%s is not a type", strings.TrimPrefix(name, "."))
+		ok = false
+	}
+	return full, sym, ok
+}
+
+func (v *validator) checkName(path []int32, element, name string) {
+	if !isIdent(name) {
+		v.errorf(path, element, "invalid name %q", name)
+	}
+}
+
+func (v *validator) message(path []int32, scope string, md *descpb.DescriptorProto) {
+	name := joinName(scope, md.GetName())
+	v.checkName(path, name, md.GetName())
+
+	numbers := make(map[int32]string)
+	jsonNames := make(map[string]string)
+	for i, f := range md.Field {
+		fpath := appendPath(path, messageFieldPath, int32(i))
+		fname := joinName(name, f.GetName())
+		v.field(fpath, name, md, f)
+		if other, ok := numbers[f.GetNumber()]; ok {
+			v.errorf(fpath, fname, "field number %d is already used by %s", f.GetNumber(), other)
+		} else {
+			numbers[f.GetNumber()] = f.GetName()
+		}
+		for _, r := range md.ReservedRange {
+			if r.GetStart() <= f.GetNumber() && f.GetNumber() < r.GetEnd() {
+				v.errorf(fpath, fname, "field number %d is reserved", f.GetNumber())
+				break
+			}
+		}
+		for _, r := range md.ExtensionRange {
+			if r.GetStart() <= f.GetNumber() && f.GetNumber() < r.GetEnd() {
+				v.errorf(fpath, fname, "field number %d is in an extension range", f.GetNumber())
+			}
+		}
+		for _, n := range md.ReservedName {
+			if n == f.GetName() {
+				v.errorf(fpath, fname, "field name %s is reserved", n)
+			}
+		}
+		if v.proto3 {
+			key := strings.ToLower(strings.Replace(f.GetName(), "_", "", -1))
+			if other, ok := jsonNames[key]; ok {
+				v.errorf(fpath, fname, "JSON name of field %s conflicts with field %s", f.GetName(), other)
+			} else {
+				jsonNames[key] = f.GetName()
+			}
+		}
+	}
+
+	v.oneofs(path, name, md)
+	v.ranges(appendPath(path, messageReservedRangePath), name, "reserved range", md.ReservedRange, nil)
+	v.ranges(appendPath(path, messageExtensionRangePath), name, "extension range", nil, md.ExtensionRange)
+	for i, a := range md.ExtensionRange {
+		for _, b := range md.ReservedRange {
+			if a.GetStart() < b.GetEnd() && b.GetStart() < a.GetEnd() {
+				v.errorf(appendPath(path, messageExtensionRangePath, int32(i)), name, "extension range %d to %d overlaps reserved range %d to %d",
+					a.GetStart(), a.GetEnd()-1, b.GetStart(), b.GetEnd()-1)
+			}
+		}
+	}
+	if v.proto3 && len(md.ExtensionRange) > 0 {
+		v.errorf(appendPath(path, messageExtensionRangePath), name, "extension ranges are not allowed in proto3")
+	}
+	reservedNames := make(map[string]bool)
+	for i, n := range md.ReservedName {
+		if reservedNames[n] {
+			v.errorf(appendPath(path, messageReservedNamePath, int32(i)), name, "name %s is reserved twice", n)
+		}
+		reservedNames[n] = true
+	}
+
+	for i, nd := range md.NestedType {
+		v.message(appendPath(path, messageNestedPath, int32(i)), name, nd)
+	}
+	for i, ed := range md.EnumType {
+		v.enum(appendPath(path, messageEnumPath, int32(i)), name, ed)
+	}
+	for i, xd := range md.Extension {
+		v.field(appendPath(path, messageExtensionPath, int32(i)), name, nil, xd)
+	}
+}
+
+// ranges checks reserved or extension ranges, whose ends are exclusive.
+func (v *validator) ranges(path []int32, element, what string, reserved []*descpb.DescriptorProto_ReservedRange, exts []*descpb.DescriptorProto_ExtensionRange) {
+	type span struct{ start, end int32 }
+	var spans []span
+	for _, r := range reserved {
+		spans = append(spans, span{r.GetStart(), r.GetEnd()})
+	}
+	for _, r := range exts {
+		spans = append(spans, span{r.GetStart(), r.GetEnd()})
+	}
+	for i, s := range spans {
+		if s.start <= 0 || s.end <= s.start || s.end > maxFieldNumber+1 && !(exts != nil && s.end == math.MaxInt32) {
+			v.errorf(appendPath(path, int32(i)), element, "invalid %s %d to %d", what, s.start, s.end-1)
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if t := spans[j]; s.start < t.end && t.start < s.end {
+				v.errorf(appendPath(path, int32(i)), element, "%s %d to %d overlaps %d to %d", what, s.start, s.end-1, t.start, t.end-1)
+			}
+		}
+	}
+}
+
+func (v *validator) oneofs(path []int32, name string, md *descpb.DescriptorProto) {
+	counts := make([]int, len(md.OneofDecl))
+	last := int32(-1)
+	done := make(map[int32]bool)
+	for i, f := range md.Field {
+		if f.OneofIndex == nil {
+			last = -1
+			continue
+		}
+		o := f.GetOneofIndex()
+		fpath := appendPath(path, messageFieldPath, int32(i))
+		if o < 0 || int(o) >= len(md.OneofDecl) {
+			v.errorf(fpath, joinName(name, f.GetName()), "invalid oneof index %d", o)
+			continue
+		}
+		if o != last && done[o] {
+			v.errorf(fpath, joinName(name, f.GetName()), "fields of oneof %s must be declared consecutively", md.OneofDecl[o].GetName())
+		}
+		if f.GetLabel() != descpb.FieldDescriptorProto_LABEL_OPTIONAL {
+			v.errorf(fpath, joinName(name, f.GetName()), "fields in oneofs must not be required or repeated")
+		}
+		counts[o]++
+		done[o] = true
+		last = o
+	}
+	for i, od := range md.OneofDecl {
+		opath := appendPath(path, messageOneofPath, int32(i))
+		v.checkName(opath, joinName(name, od.GetName()), od.GetName())
+		if counts[i] == 0 {
+			v.errorf(opath, joinName(name, od.GetName()), "oneof has no fields")
+		}
+	}
+}
+
+// field checks a field of md, or an extension declared in scope if md is
+// nil.
+func (v *validator) field(path []int32, scope string, md *descpb.DescriptorProto, f *descpb.FieldDescriptorProto) {
+	name := joinName(scope, f.GetName())
+	v.checkName(path, name, f.GetName())
+
+	n := f.GetNumber()
+	switch {
+	case n <= 0 || n > maxFieldNumber:
+		v.errorf(path, name, "field number %d is out of range", n)
+	case firstReservedNumber <= n && n <= lastReservedNumber:
+		v.errorf(path, name, "field numbers %d to %d are reserved for the protobuf implementation", firstReservedNumber, lastReservedNumber)
+	}
+
+	switch f.GetLabel() {
+	case descpb.FieldDescriptorProto_LABEL_OPTIONAL, descpb.FieldDescriptorProto_LABEL_REPEATED:
+	case descpb.FieldDescriptorProto_LABEL_REQUIRED:
+		switch {
+		case v.proto3:
+			v.errorf(path, name, "required fields are not allowed in proto3")
+		case md == nil:
+			v.errorf(path, name, "extensions cannot be required")
+		}
+	default:
+		v.errorf(path, name, "missing label")
+	}
+
+	// Resolve the type.
+	var target symbol
+	resolved := false
+	isMessage := f.Type == nil || f.GetType() == descpb.FieldDescriptorProto_TYPE_MESSAGE || f.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP
+	isEnum := f.Type == nil || f.GetType() == descpb.FieldDescriptorProto_TYPE_ENUM
+	switch {
+	case isMessage || isEnum:
+		if f.TypeName == nil {
+			v.errorf(path, name, "missing type name")
+			break
+		}
+		var full string
+		full, target, resolved = v.resolveType(path, name, scope, f.GetTypeName())
+		switch {
+		case !resolved:
+		case target.kind == symMessage && !isMessage:
+			v.errorf(path, name, "%s is not an enum type", full)
+		case target.kind == symEnum && !isEnum:
+			v.errorf(path, name, "%s is not a message type", full)
+		}
+		if resolved && target.kind == symMessage && target.message.GetOptions().GetMapEntry() {
+			v.mapField(path, name, scope, md, f, full, target.message)
+		}
+		if v.proto3 && f.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP {
+			v.errorf(path, name, "groups are not allowed in proto3")
+		}
+		if v.proto3 && resolved && target.kind == symEnum && target.file != v.fd.GetName() {
+			if dep := v.files[target.file]; dep != nil && dep.GetSyntax() != "proto3" {
+				v.errorf(path, name, "proto2 enum %s cannot be used in proto3", full)
+			}
+		}
+	case f.TypeName != nil:
+		v.errorf(path, name, "scalar field has a type name")
+	}
+
+	if f.DefaultValue != nil {
+		v.defaultValue(path, name, f, target, resolved)
+	}
+	if f.GetOptions().GetPacked() {
+		switch f.GetType() {
+		case descpb.FieldDescriptorProto_TYPE_STRING, descpb.FieldDescriptorProto_TYPE_BYTES,
+			descpb.FieldDescriptorProto_TYPE_MESSAGE, descpb.FieldDescriptorProto_TYPE_GROUP:
+			v.errorf(path, name, "only repeated fields of scalar numeric types can be packed")
+		default:
+			if f.GetLabel() != descpb.FieldDescriptorProto_LABEL_REPEATED {
+				v.errorf(path, name, "only repeated fields of scalar numeric types can be packed")
+			}
+		}
+	}
+
+	if md == nil {
+		v.extension(path, name, scope, f)
+	} else if f.Extendee != nil {
+		v.errorf(path, name, "field of message %s has an extendee", scope)
+	}
+}
+
+func (v *validator) extension(path []int32, name, scope string, f *descpb.FieldDescriptorProto) {
+	if f.Extendee == nil {
+		v.errorf(path, name, "extension has no extendee")
+		return
+	}
+	full, sym, ok := v.resolveType(path, name, scope, f.GetExtendee())
+	if !ok {
+		return
+	}
+	if sym.kind != symMessage {
+		v.errorf(path, name, "%s is not a message type", full)
+		return
+	}
+	if v.proto3 && !strings.HasPrefix(full, "google.protobuf.") {
+		v.errorf(path, name, "extensions in proto3 are only allowed for defining options")
+	}
+	inRange := false
+	for _, r := range sym.message.ExtensionRange {
+		if r.GetStart() <= f.GetNumber() && f.GetNumber() < r.GetEnd() {
+			inRange = true
+		}
+	}
+	if !inRange {
+		v.errorf(path, name, "%s does not declare %d as an extension number", full, f.GetNumber())
+	}
+	k := extKey{full, f.GetNumber()}
+	if other, ok := v.exts[k]; ok {
+		v.errorf(path, name, "extension number %d of %s is already used by %s", f.GetNumber(), full, other)
+	} else {
+		v.exts[k] = name
+	}
+	if f.OneofIndex != nil {
+		v.errorf(path, name, "extensions cannot be in oneofs")
+	}
+}
+
+// mapEntryName returns the name of the map entry message of a map field.
+func mapEntryName(field string) string {
+	var b []byte
+	upper := true
+	for i := 0; i < len(field); i++ {
+		c := field[i]
+		switch {
+		case c == '_':
+			upper = true
+			continue
+		case upper && 'a' <= c && c <= 'z':
+			c -= 'a' - 'A'
+		}
+		upper = false
+		b = append(b, c)
+	}
+	return string(b) + "Entry"
+}
+
+// mapField checks the field f, whose type is the map entry message entry.
+func (v *validator) mapField(path []int32, name, scope string, md *descpb.DescriptorProto, f *descpb.FieldDescriptorProto, full string, entry *descpb.DescriptorProto) {
+	switch {
+	case md == nil:
+		v.errorf(path, name, "map entry %s cannot be the type of an extension", full)
+		return
+	case full != joinName(scope, entry.GetName()):
+		v.errorf(path, name, "map entry %s must be nested in %s", full, scope)
+	case entry.GetName() != mapEntryName(f.GetName()):
+		v.errorf(path, name, "map entry of field %s must be named %s", f.GetName(), mapEntryName(f.GetName()))
+	}
+	if f.GetLabel() != descpb.FieldDescriptorProto_LABEL_REPEATED {
+		v.errorf(path, name, "map fields must be repeated")
+	}
+	if len(entry.Field) != 2 || entry.Field[0].GetName() != "key" || entry.Field[0].GetNumber() != 1 ||
+		entry.Field[1].GetName() != "value" || entry.Field[1].GetNumber() != 2 {
+		v.errorf(path, name, "map entry %s must have exactly the fields key = 1 and value = 2", full)
+		return
+	}
+	if len(entry.NestedType) > 0 || len(entry.EnumType) > 0 || len(entry.Extension) > 0 ||
+		len(entry.ExtensionRange) > 0 || len(entry.OneofDecl) > 0 {
+		v.errorf(path, name, "map entry %s must not declare anything but its fields", full)
+	}
+	for _, ef := range entry.Field {
+		if ef.GetLabel() != descpb.FieldDescriptorProto_LABEL_OPTIONAL {
+			v.errorf(path, name, "map entry field %s.%s must be optional", full, ef.GetName())
+		}
+	}
+	switch entry.Field[0].GetType() {
+	case descpb.FieldDescriptorProto_TYPE_FLOAT, descpb.FieldDescriptorProto_TYPE_DOUBLE,
+		descpb.FieldDescriptorProto_TYPE_BYTES, descpb.FieldDescriptorProto_TYPE_MESSAGE,
+		descpb.FieldDescriptorProto_TYPE_GROUP, descpb.FieldDescriptorProto_TYPE_ENUM:
+		v.errorf(path, name, "invalid map key type %s", strings.ToLower(strings.TrimPrefix(entry.Field[0].GetType().String(), "TYPE_")))
+	}
+}
+
+func (v *validator) defaultValue(path []int32, name string, f *descpb.FieldDescriptorProto, target symbol, resolved bool) {
+	if v.proto3 {
+		v.errorf(path, name, "default values are not allowed in proto3")
+		return
+	}
+	if f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED {
+		v.errorf(path, name, "repeated fields cannot have default values")
+		return
+	}
+	typ := f.GetType()
+	if f.Type == nil {
+		// The type name is not yet resolved to a message or enum.
+		if !resolved {
+			return
+		}
+		typ = descpb.FieldDescriptorProto_TYPE_MESSAGE
+		if target.kind == symEnum {
+			typ = descpb.FieldDescriptorProto_TYPE_ENUM
+		}
+	}
+	s := f.GetDefaultValue()
+	var err error
+	switch typ {
+	case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_SINT32, descpb.FieldDescriptorProto_TYPE_SFIXED32:
+		_, err = strconv.ParseInt(s, 10, 32)
+	case descpb.FieldDescriptorProto_TYPE_INT64, descpb.FieldDescriptorProto_TYPE_SINT64, descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		_, err = strconv.ParseInt(s, 10, 64)
+	case descpb.FieldDescriptorProto_TYPE_UINT32, descpb.FieldDescriptorProto_TYPE_FIXED32:
+		_, err = strconv.ParseUint(s, 10, 32)
+	case descpb.FieldDescriptorProto_TYPE_UINT64, descpb.FieldDescriptorProto_TYPE_FIXED64:
+		_, err = strconv.ParseUint(s, 10, 64)
+	case descpb.FieldDescriptorProto_TYPE_FLOAT, descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		switch s {
+		case "inf", "-inf", "nan":
+		default:
+			_, err = strconv.ParseFloat(s, 64)
+		}
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		if s != "true" && s != "false" {
+			err = fmt.Errorf("not a bool")
+		}
+	case descpb.FieldDescriptorProto_TYPE_MESSAGE, descpb.FieldDescriptorProto_TYPE_GROUP:
+		v.errorf(path, name, "message fields cannot have default values")
+		return
+	case descpb.FieldDescriptorProto_TYPE_ENUM:
+		if resolved && target.kind == symEnum {
+			found := false
+			for _, vd := range target.enum.Value {
+				found = found || vd.GetName() == s
+			}
+			if !found {
+				v.errorf(path, name, "default value %s is not a value of enum %s", s, strings.TrimPrefix(f.GetTypeName(), "."))
+			}
+		}
+		return
+	}
+	if err != nil {
+		v.errorf(path, name, "invalid default value %q", s)
+	}
+}
+
+func (v *validator) enum(path []int32, scope string, ed *descpb.EnumDescriptorProto) {
+	name := joinName(scope, ed.GetName())
+	v.checkName(path, name, ed.GetName())
+	if len(ed.Value) == 0 {
+		v.errorf(path, name, "enum has no values")
+		return
+	}
+	if v.proto3 && ed.Value[0].GetNumber() != 0 {
+		v.errorf(appendPath(path, enumValuePath, 0), joinName(scope, ed.Value[0].GetName()), "the first value of a proto3 enum must be zero")
+	}
+	numbers := make(map[int32]string)
+	aliased := false
+	for i, vd := range ed.Value {
+		vpath := appendPath(path, enumValuePath, int32(i))
+		vname := joinName(scope, vd.GetName())
+		v.checkName(vpath, vname, vd.GetName())
+		if other, ok := numbers[vd.GetNumber()]; ok {
+			aliased = true
+			if !ed.GetOptions().GetAllowAlias() {
+				v.errorf(vpath, vname, "enum value number %d is already used by %s; set option allow_alias to allow aliases", vd.GetNumber(), other)
+			}
+		} else {
+			numbers[vd.GetNumber()] = vd.GetName()
+		}
+		for _, r := range ed.ReservedRange {
+			if r.GetStart() <= vd.GetNumber() && vd.GetNumber() <= r.GetEnd() {
+				v.errorf(vpath, vname, "enum value number %d is reserved", vd.GetNumber())
+				break
+			}
+		}
+		for _, n := range ed.ReservedName {
+			if n == vd.GetName() {
+				v.errorf(vpath, vname, "enum value name %s is reserved", n)
+			}
+		}
+	}
+	if ed.GetOptions().GetAllowAlias() && !aliased {
+		v.errorf(path, name, "option allow_alias is set but no values are aliases")
+	}
+
+	ranges := append([]*descpb.EnumDescriptorProto_EnumReservedRange(nil), ed.ReservedRange...)
+	for i, r := range ranges {
+		if r.GetEnd() < r.GetStart() {
+			v.errorf(appendPath(path, enumReservedRangePath, int32(i)), name, "invalid reserved range %d to %d", r.GetStart(), r.GetEnd())
+		}
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].GetStart() < ranges[j].GetStart() })
+	for i := 1; i < len(ranges); i++ {
+		if ranges[i].GetStart() <= ranges[i-1].GetEnd() {
+			v.errorf(appendPath(path, enumReservedRangePath), name, "reserved range %d to %d overlaps %d to %d",
+				ranges[i].GetStart(), ranges[i].GetEnd(), ranges[i-1].GetStart(), ranges[i-1].GetEnd())
+		}
+	}
+	reservedNames := make(map[string]bool)
+	for i, n := range ed.ReservedName {
+		if reservedNames[n] {
+			v.errorf(appendPath(path, enumReservedNamePath, int32(i)), name, "name %s is reserved twice", n)
+		}
+		reservedNames[n] = true
+	}
+}
+
+func (v *validator) service(path []int32, scope string, sd *descpb.ServiceDescriptorProto) {
+	name := joinName(scope, sd.GetName())
+	v.checkName(path, name, sd.GetName())
+	for i, md := range sd.Method {
+		mpath := appendPath(path, serviceMethodPath, int32(i))
+		mname := joinName(name, md.GetName())
+		v.checkName(mpath, mname, md.GetName())
+		for _, t := range []string{md.GetInputType(), md.GetOutputType()} {
+			full, sym, ok := v.resolveType(mpath, mname, name, t)
+			if ok && sym.kind != symMessage {
+				v.errorf(mpath, mname, "%s is not a message type", full)
+			}
+		}
+	}
+}
@@ -0,0 +1,362 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package builder
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor/registry"
+	"github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/proto/test_proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	_ "github.com/golang/protobuf/ptypes/any"
+)
+
+// TestValidateRegistered checks that files compiled by protoc are valid.
+func TestValidateRegistered(t *testing.T) {
+	for _, name := range []string{
+		"google/protobuf/descriptor.proto",
+		"google/protobuf/any.proto",
+		"test_proto/test.proto",
+	} {
+		fd, err := registry.FindFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var deps []*descpb.FileDescriptorProto
+		for _, dep := range fd.Dependency {
+			d, err := registry.FindFile(dep)
+			if err != nil {
+				t.Fatal(err)
+			}
+			deps = append(deps, d)
+		}
+		if err := Validate(fd, deps...); err != nil {
+			t.Errorf("Validate(%s): %v", name, err)
+		}
+	}
+}
+
+var validateTests = []struct {
+	desc string
+	file string // FileDescriptorProto in text format
+	deps []string
+	want []string // errors as "element: message"
+}{
+	{
+		desc: "names",
+		file: `name: "a.proto" package: "a.b-c"
+			message_type { name: "1M" field { name: "x y" number: 1 label: LABEL_OPTIONAL type: TYPE_INT32 } }
+			message_type { name: "1M" }`,
+		want: []string{
+			": invalid package name \"a.b-c\"",
+			"a.b-c.1M: a.b-c.1M is already defined as a message",
+			"a.b-c.1M: invalid name \"1M\"",
+			"a.b-c.1M.x y: invalid name \"x y\"",
+			"a.b-c.1M: invalid name \"1M\"",
+		},
+	},
+	{
+		desc: "numbers",
+		file: `name: "a.proto" package: "a"
+			message_type {
+				name: "M"
+				field { name: "a" number: 1 label: LABEL_OPTIONAL type: TYPE_INT32 }
+				field { name: "b" number: 1 label: LABEL_OPTIONAL type: TYPE_INT32 }
+				field { name: "c" number: 19500 label: LABEL_OPTIONAL type: TYPE_INT32 }
+				field { name: "d" number: 5 label: LABEL_OPTIONAL type: TYPE_INT32 }
+				field { name: "e" number: 100 label: LABEL_OPTIONAL type: TYPE_INT32 }
+				field { name: "f" number: 0 label: LABEL_OPTIONAL type: TYPE_INT32 }
+				field { name: "g" number: 7 label: LABEL_OPTIONAL type: TYPE_INT32 }
+				reserved_range { start: 4 end: 6 }
+				reserved_range { start: 5 end: 8 }
+				reserved_name: "g"
+				extension_range { start: 100 end: 200 }
+				extension_range { start: 7 end: 8 }
+			}`,
+		want: []string{
+			"a.M.b: field number 1 is already used by a",
+			"a.M.c: field numbers 19000 to 19999 are reserved for the protobuf implementation",
+			"a.M.d: field number 5 is reserved",
+			"a.M.e: field number 100 is in an extension range",
+			"a.M.f: field number 0 is out of range",
+			"a.M.g: field number 7 is reserved",
+			"a.M.g: field number 7 is in an extension range",
+			"a.M.g: field name g is reserved",
+			"a.M: reserved range 5 to 7 overlaps 4 to 5",
+			"a.M: extension range 7 to 7 overlaps reserved range 5 to 7",
+		},
+	},
+	{
+		desc: "resolution",
+		file: `name: "a.proto" package: "a.b" syntax: "proto3"
+			dependency: "google/protobuf/any.proto"
+			message_type {
+				name: "M"
+				field { name: "any" number: 1 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: "google.protobuf.Any" }
+				field { name: "n" number: 2 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: "N" }
+				field { name: "self" number: 3 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: "b.M" }
+				field { name: "e" number: 4 label: LABEL_OPTIONAL type: TYPE_ENUM type_name: "M" }
+				field { name: "f" number: 5 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: "M.n" }
+				field { name: "g" number: 6 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".M" }
+				field { name: "h" number: 7 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: "Timestamp" }
+			}
+			message_type { name: "N" }`,
+		deps: []string{"google/protobuf/any.proto"},
+		want: []string{
+			"a.b.M.e: a.b.M is not an enum type",
+			"a.b.M.f: M.n is not a type",
+			"a.b.M.g: M is not defined",
+			"a.b.M.h: Timestamp is not defined",
+		},
+	},
+	{
+		desc: "imports",
+		file: `name: "a.proto" dependency: "missing.proto" dependency: "missing.proto"
+			message_type { name: "M" field { name: "t" number: 1 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".google.protobuf.Any" } }`,
+		want: []string{
+			": import \"missing.proto\" was not found",
+			": import \"missing.proto\" was listed twice",
+			"M.t: google.protobuf.Any is not defined",
+		},
+	},
+	{
+		desc: "proto3",
+		file: `name: "a.proto" package: "a" syntax: "proto3"
+			message_type {
+				name: "M"
+				field { name: "r" number: 1 label: LABEL_REQUIRED type: TYPE_INT32 }
+				field { name: "d" number: 2 label: LABEL_OPTIONAL type: TYPE_INT32 default_value: "3" }
+				field { name: "foo_bar" number: 3 label: LABEL_OPTIONAL type: TYPE_INT32 }
+				field { name: "fooBar" number: 4 label: LABEL_OPTIONAL type: TYPE_INT32 }
+				extension_range { start: 10 end: 20 }
+			}
+			enum_type { name: "E" value { name: "ONE" number: 1 } }`,
+		want: []string{
+			"a.M.r: required fields are not allowed in proto3",
+			"a.M.d: default values are not allowed in proto3",
+			"a.M.fooBar: JSON name of field fooBar conflicts with field foo_bar",
+			"a.M: extension ranges are not allowed in proto3",
+			"a.ONE: the first value of a proto3 enum must be zero",
+		},
+	},
+	{
+		desc: "maps",
+		file: `name: "a.proto" package: "a" syntax: "proto3"
+			message_type {
+				name: "M"
+				field { name: "good" number: 1 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".a.M.GoodEntry" }
+				field { name: "single" number: 2 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".a.M.SingleEntry" }
+				field { name: "misnamed" number: 3 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".a.M.GoodEntry" }
+				field { name: "float_key" number: 4 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".a.M.FloatKeyEntry" }
+				field { name: "shape" number: 5 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".a.M.ShapeEntry" }
+				nested_type { name: "GoodEntry" options { map_entry: true }
+					field { name: "key" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING }
+					field { name: "value" number: 2 label: LABEL_OPTIONAL type: TYPE_STRING } }
+				nested_type { name: "SingleEntry" options { map_entry: true }
+					field { name: "key" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING }
+					field { name: "value" number: 2 label: LABEL_OPTIONAL type: TYPE_STRING } }
+				nested_type { name: "FloatKeyEntry" options { map_entry: true }
+					field { name: "key" number: 1 label: LABEL_OPTIONAL type: TYPE_FLOAT }
+					field { name: "value" number: 2 label: LABEL_OPTIONAL type: TYPE_STRING } }
+				nested_type { name: "ShapeEntry" options { map_entry: true }
+					field { name: "key" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING } }
+			}`,
+		want: []string{
+			"a.M.single: map fields must be repeated",
+			"a.M.misnamed: map entry of field misnamed must be named MisnamedEntry",
+			"a.M.float_key: invalid map key type float",
+			"a.M.shape: map entry a.M.ShapeEntry must have exactly the fields key = 1 and value = 2",
+		},
+	},
+	{
+		desc: "oneofs",
+		file: `name: "a.proto" package: "a"
+			message_type {
+				name: "M"
+				field { name: "a" number: 1 label: LABEL_OPTIONAL type: TYPE_INT32 oneof_index: 0 }
+				field { name: "b" number: 2 label: LABEL_OPTIONAL type: TYPE_INT32 }
+				field { name: "c" number: 3 label: LABEL_REPEATED type: TYPE_INT32 oneof_index: 0 }
+				field { name: "d" number: 4 label: LABEL_OPTIONAL type: TYPE_INT32 oneof_index: 3 }
+				oneof_decl { name: "o" }
+				oneof_decl { name: "empty" }
+			}`,
+		want: []string{
+			"a.M.c: fields of oneof o must be declared consecutively",
+			"a.M.c: fields in oneofs must not be required or repeated",
+			"a.M.d: invalid oneof index 3",
+			"a.M.empty: oneof has no fields",
+		},
+	},
+	{
+		desc: "extensions",
+		file: `name: "a.proto" package: "a" dependency: "test_proto/test.proto"
+			message_type { name: "M" extension_range { start: 10 end: 20 } }
+			extension { name: "ok" number: 10 label: LABEL_OPTIONAL type: TYPE_INT32 extendee: "M" }
+			extension { name: "dup" number: 10 label: LABEL_OPTIONAL type: TYPE_INT32 extendee: ".a.M" }
+			extension { name: "out" number: 20 label: LABEL_OPTIONAL type: TYPE_INT32 extendee: "M" }
+			extension { name: "req" number: 11 label: LABEL_REQUIRED type: TYPE_INT32 extendee: "M" }
+			extension { name: "taken" number: 103 label: LABEL_OPTIONAL type: TYPE_INT32 extendee: ".test_proto.MyMessage" }
+			extension { name: "none" number: 12 label: LABEL_OPTIONAL type: TYPE_INT32 }`,
+		deps: []string{"test_proto/test.proto"},
+		want: []string{
+			"a.dup: extension number 10 of a.M is already used by a.ok",
+			"a.out: a.M does not declare 20 as an extension number",
+			"a.req: extensions cannot be required",
+			"a.taken: extension number 103 of test_proto.MyMessage is already used by test_proto.Ext.more",
+			"a.none: extension has no extendee",
+		},
+	},
+	{
+		desc: "defaults",
+		file: `name: "a.proto" package: "a"
+			message_type {
+				name: "M"
+				field { name: "i" number: 1 label: LABEL_OPTIONAL type: TYPE_INT32 default_value: "3000000000" }
+				field { name: "u" number: 2 label: LABEL_OPTIONAL type: TYPE_UINT64 default_value: "-1" }
+				field { name: "f" number: 3 label: LABEL_OPTIONAL type: TYPE_DOUBLE default_value: "-inf" }
+				field { name: "b" number: 4 label: LABEL_OPTIONAL type: TYPE_BOOL default_value: "yes" }
+				field { name: "e" number: 5 label: LABEL_OPTIONAL type: TYPE_ENUM type_name: "E" default_value: "C" }
+				field { name: "r" number: 6 label: LABEL_REPEATED type: TYPE_INT32 default_value: "1" }
+				field { name: "m" number: 7 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: "M" default_value: "1" }
+			}
+			enum_type { name: "E" value { name: "A" number: 0 } value { name: "B" number: 1 } }`,
+		want: []string{
+			"a.M.i: invalid default value \"3000000000\"",
+			"a.M.u: invalid default value \"-1\"",
+			"a.M.b: invalid default value \"yes\"",
+			"a.M.e: default value C is not a value of enum E",
+			"a.M.r: repeated fields cannot have default values",
+			"a.M.m: message fields cannot have default values",
+		},
+	},
+	{
+		desc: "enums",
+		file: `name: "a.proto" package: "a"
+			enum_type {
+				name: "E"
+				value { name: "A" number: 0 }
+				value { name: "B" number: 0 }
+				value { name: "C" number: 5 }
+				value { name: "D" number: 9 }
+				reserved_range { start: 4 end: 6 }
+				reserved_name: "D"
+			}
+			enum_type { name: "F" value { name: "A" number: 0 } options { allow_alias: true } }
+			enum_type { name: "G" }`,
+		want: []string{
+			"a.A: a.A is already defined as an enum value",
+			"a.B: enum value number 0 is already used by A; set option allow_alias to allow aliases",
+			"a.C: enum value number 5 is reserved",
+			"a.D: enum value name D is reserved",
+			"a.F: option allow_alias is set but no values are aliases",
+			"a.G: enum has no values",
+		},
+	},
+	{
+		desc: "services",
+		file: `name: "a.proto" package: "a"
+			message_type { name: "M" }
+			enum_type { name: "E" value { name: "A" number: 0 } }
+			service {
+				name: "S"
+				method { name: "Good" input_type: ".a.M" output_type: "M" }
+				method { name: "Enum" input_type: "E" output_type: "M" }
+				method { name: "Missing" input_type: "M" output_type: "X" }
+			}`,
+		want: []string{
+			"a.S.Enum: a.E is not a message type",
+			"a.S.Missing: X is not defined",
+		},
+	},
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range validateTests {
+		fd := new(descpb.FileDescriptorProto)
+		if err := proto.UnmarshalText(tt.file, fd); err != nil {
+			t.Fatalf("%s: %v", tt.desc, err)
+		}
+		var deps []*descpb.FileDescriptorProto
+		for _, name := range tt.deps {
+			dep, err := registry.FindFile(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			deps = append(deps, dep)
+		}
+		var got []string
+		err := Validate(fd, deps...)
+		if err != nil {
+			for _, e := range err.(Errors) {
+				if e.File != fd.GetName() {
+					t.Errorf("%s: error in file %q, want %q", tt.desc, e.File, fd.GetName())
+				}
+				got = append(got, e.Element+": "+e.Msg)
+			}
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: errors:\n got %q\nwant %q", tt.desc, got, tt.want)
+		}
+	}
+}
+
+func TestErrorPath(t *testing.T) {
+	fd := &descpb.FileDescriptorProto{
+		Name:    proto.String("a.proto"),
+		Package: proto.String("a"),
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("M"),
+			NestedType: []*descpb.DescriptorProto{{
+				Name: proto.String("N"),
+				Field: []*descpb.FieldDescriptorProto{{
+					Name:   proto.String("f"),
+					Number: proto.Int32(-1),
+					Label:  descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:   descpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+				}},
+			}},
+		}},
+	}
+	err := Validate(fd)
+	errs, ok := err.(Errors)
+	if !ok || len(errs) != 1 {
+		t.Fatalf("Validate() = %v, want one error", err)
+	}
+	if want := []int32{4, 0, 3, 0, 2, 0}; !reflect.DeepEqual(errs[0].Path, want) {
+		t.Errorf("Path = %v, want %v", errs[0].Path, want)
+	}
+	if got, want := err.Error(), "a.proto: a.M.N.f: field number -1 is out of range"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
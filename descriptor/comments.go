@@ -0,0 +1,233 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package descriptor
+
+// This file implements access to the comments recorded in the source code
+// info of a file descriptor. The generator only retains source code info
+// when it is run with the source_info=true parameter; otherwise all of the
+// functions below return nil.
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/golang/protobuf/internal/filedesc"
+	"github.com/golang/protobuf/proto"
+	protobuf "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Comments holds the comments attached to an element of a .proto file.
+// The comment markers are removed, but the text is otherwise unchanged;
+// in particular each comment usually ends with a newline.
+type Comments struct {
+	// Leading is the comment immediately preceding the element.
+	Leading string
+	// Trailing is the comment immediately following the element, on the
+	// same line or the next one.
+	Trailing string
+	// LeadingDetached holds the comments preceding the element that are
+	// separated from it, and from each other, by blank lines.
+	LeadingDetached []string
+}
+
+// The source code info of a file identifies each element by a path of
+// field numbers and indexes into repeated fields from the root
+// FileDescriptorProto. The constants below define the field numbers used.
+//
+// See descriptor.proto for more information about this.
+const (
+	// tag numbers in FileDescriptorProto
+	messagePath = 4 // message_type
+	enumPath    = 5 // enum_type
+	servicePath = 6 // service
+	// tag numbers in DescriptorProto
+	messageFieldPath   = 2 // field
+	messageMessagePath = 3 // nested_type
+	messageEnumPath    = 4 // enum_type
+	// tag numbers in EnumDescriptorProto
+	enumValuePath = 2 // value
+	// tag numbers in ServiceDescriptorProto
+	serviceMethodPath = 2 // method
+)
+
+// comments returns the comments of the element of fd at the given path,
+// or nil if fd has no source code info for it.
+func comments(fd *protobuf.FileDescriptorProto, path []int32) *Comments {
+	for _, loc := range fd.GetSourceCodeInfo().GetLocation() {
+		if !equalPath(loc.Path, path) {
+			continue
+		}
+		return &Comments{
+			Leading:         loc.GetLeadingComments(),
+			Trailing:        loc.GetTrailingComments(),
+			LeadingDetached: loc.LeadingDetachedComments,
+		}
+	}
+	return nil
+}
+
+func equalPath(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// messagePathOf converts the descriptor index path of a message, as returned
+// by its Descriptor method, into a source code info path.
+func messagePathOf(index []int) []int32 {
+	path := []int32{messagePath, int32(index[0])}
+	for _, i := range index[1:] {
+		path = append(path, messageMessagePath, int32(i))
+	}
+	return path
+}
+
+// MessageComments returns the comments of the message type of msg,
+// or nil if they were not retained.
+func MessageComments(msg Message) *Comments {
+	fd, _ := ForMessage(msg)
+	_, index := msg.Descriptor()
+	return comments(fd, messagePathOf(index))
+}
+
+// FieldComments returns the comments of the field of msg held in the Go
+// struct field with the given name, which is interpreted as by ForField.
+// It returns nil if there is no such field or its comments were not retained.
+func FieldComments(msg Message, name string) *Comments {
+	tag := fieldTag(msg, name)
+	if tag == 0 {
+		return nil
+	}
+	fd, md := ForMessage(msg)
+	_, index := msg.Descriptor()
+	for i, f := range md.Field {
+		if int(f.GetNumber()) == tag {
+			return comments(fd, append(messagePathOf(index), messageFieldPath, int32(i)))
+		}
+	}
+	return nil
+}
+
+// EnumComments returns the comments of the enum type of e,
+// or nil if they were not retained.
+func EnumComments(e Enum) *Comments {
+	fd, _ := ForEnum(e)
+	_, index := e.EnumDescriptor()
+	return comments(fd, enumPathOf(index))
+}
+
+// EnumValueComments returns the comments of the enum value e, which must be
+// a value of a generated enum type. If several values share its number, the
+// first is used. It returns nil if there is no such value or its comments
+// were not retained.
+func EnumValueComments(e Enum) *Comments {
+	v := reflect.ValueOf(e)
+	if v.Kind() != reflect.Int32 {
+		panic(fmt.Sprintf("descriptor: EnumValueComments of non-int32 type %T", e))
+	}
+	fd, ed := ForEnum(e)
+	_, index := e.EnumDescriptor()
+	for i, ev := range ed.Value {
+		if int64(ev.GetNumber()) == v.Int() {
+			return comments(fd, append(enumPathOf(index), enumValuePath, int32(i)))
+		}
+	}
+	return nil
+}
+
+// enumPathOf converts the descriptor index path of an enum, as returned by
+// its EnumDescriptor method, into a source code info path.
+func enumPathOf(index []int) []int32 {
+	if len(index) == 1 {
+		return []int32{enumPath, int32(index[0])}
+	}
+	path := messagePathOf(index[:len(index)-1])
+	return append(path, messageEnumPath, int32(index[len(index)-1]))
+}
+
+// ServiceComments returns the comments of the service with the given full
+// name defined in the registered file with the given name, as for ForService.
+// It returns nil if there is no such service or its comments were not retained.
+func ServiceComments(filename, name string) *Comments {
+	fd, sd := ForService(filename, name)
+	for i, s := range fd.GetService() {
+		if s == sd {
+			return comments(fd, []int32{servicePath, int32(i)})
+		}
+	}
+	return nil
+}
+
+// MethodComments returns the comments of a method of a service defined in
+// the registered file with the given name, with the method named as for
+// ForMethod. It returns nil if there is no such method or its comments were
+// not retained.
+func MethodComments(filename, method string) *Comments {
+	service, name, ok := splitMethod(method)
+	if !ok {
+		return nil
+	}
+	fd, sd := ForService(filename, service)
+	for i, s := range fd.GetService() {
+		if s != sd {
+			continue
+		}
+		for j, m := range s.Method {
+			if m.GetName() == name {
+				return comments(fd, []int32{servicePath, int32(i), serviceMethodPath, int32(j)})
+			}
+		}
+	}
+	return nil
+}
+
+// FileComments returns the source code info comments of the registered file
+// with the given name for the element at the given path, or nil if there is
+// no such file or its comments were not retained. It can be used for
+// elements not covered by the functions above, such as oneofs.
+func FileComments(filename string, path []int32) *Comments {
+	gz := proto.FileDescriptor(filename)
+	if gz == nil {
+		return nil
+	}
+	fd, err := filedesc.Extract(gz)
+	if err != nil {
+		panic(fmt.Sprintf("invalid FileDescriptorProto for %s: %v", filename, err))
+	}
+	return comments(fd, path)
+}
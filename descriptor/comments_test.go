@@ -0,0 +1,155 @@
+package descriptor_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	cpb "github.com/golang/protobuf/descriptor/descriptor_test_proto"
+	"github.com/golang/protobuf/proto"
+	tpb "github.com/golang/protobuf/proto/test_proto"
+	protobuf "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+func TestComments(t *testing.T) {
+	tests := []struct {
+		desc string
+		got  *descriptor.Comments
+		want *descriptor.Comments
+	}{{
+		desc: "message",
+		got:  descriptor.MessageComments(new(cpb.Article)),
+		want: &descriptor.Comments{
+			Leading:         " Article is a published piece of writing.\n",
+			LeadingDetached: []string{" Detached comment before Article.\n"},
+		},
+	}, {
+		desc: "leading field comment",
+		got:  descriptor.FieldComments(new(cpb.Article), "Title"),
+		want: &descriptor.Comments{Leading: " The title of the article.\n"},
+	}, {
+		desc: "trailing field comment",
+		got:  descriptor.FieldComments(new(cpb.Article), "Views"),
+		want: &descriptor.Comments{Trailing: " Number of times the article was read.\n"},
+	}, {
+		desc: "oneof field",
+		got:  descriptor.FieldComments(new(cpb.Article), "Text"),
+		want: &descriptor.Comments{Leading: " Plain text body.\n"},
+	}, {
+		desc: "uncommented field",
+		got:  descriptor.FieldComments(new(cpb.Article), "Html"),
+		want: &descriptor.Comments{},
+	}, {
+		desc: "nested message field",
+		got:  descriptor.FieldComments(new(cpb.Article_Author), "Name"),
+		want: &descriptor.Comments{Leading: " Display name.\n"},
+	}, {
+		desc: "unknown field",
+		got:  descriptor.FieldComments(new(cpb.Article), "Body"),
+		want: nil,
+	}, {
+		desc: "enum",
+		got:  descriptor.EnumComments(cpb.Format_FORMAT_TEXT),
+		want: &descriptor.Comments{Leading: " Format of an article body.\n"},
+	}, {
+		desc: "enum value",
+		got:  descriptor.EnumValueComments(cpb.Format_FORMAT_HTML),
+		want: &descriptor.Comments{Leading: " Rendered as HTML.\n"},
+	}, {
+		desc: "nested enum",
+		got:  descriptor.EnumComments(cpb.Article_DRAFT),
+		want: &descriptor.Comments{Leading: " Status of an article.\n"},
+	}, {
+		desc: "nested enum value",
+		got:  descriptor.EnumValueComments(cpb.Article_PUBLISHED),
+		want: &descriptor.Comments{Trailing: " Visible to readers.\n"},
+	}, {
+		desc: "unknown enum value",
+		got:  descriptor.EnumValueComments(cpb.Format(7)),
+		want: nil,
+	}, {
+		desc: "file without source info",
+		got:  descriptor.MessageComments(new(tpb.GoTest)),
+		want: nil,
+	}}
+	for _, tt := range tests {
+		if !reflect.DeepEqual(tt.got, tt.want) {
+			t.Errorf("%s: got %#v, want %#v", tt.desc, tt.got, tt.want)
+		}
+	}
+}
+
+func registerCommentsFile(t *testing.T) {
+	file := &protobuf.FileDescriptorProto{
+		Name:    proto.String("descriptor_test/comments.proto"),
+		Package: proto.String("descriptor.comments"),
+		Service: []*protobuf.ServiceDescriptorProto{{
+			Name: proto.String("Library"),
+			Method: []*protobuf.MethodDescriptorProto{{
+				Name:       proto.String("Lend"),
+				InputType:  proto.String(".google.protobuf.Empty"),
+				OutputType: proto.String(".google.protobuf.Empty"),
+			}, {
+				Name:       proto.String("Return"),
+				InputType:  proto.String(".google.protobuf.Empty"),
+				OutputType: proto.String(".google.protobuf.Empty"),
+			}},
+		}},
+		SourceCodeInfo: &protobuf.SourceCodeInfo{
+			Location: []*protobuf.SourceCodeInfo_Location{{
+				Path:            []int32{6, 0},
+				LeadingComments: proto.String(" Library lends books.\n"),
+			}, {
+				Path:             []int32{6, 0, 2, 1},
+				TrailingComments: proto.String(" Returns a book.\n"),
+			}},
+		},
+	}
+	b, err := proto.Marshal(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(b)
+	zw.Close()
+	proto.RegisterFile(file.GetName(), buf.Bytes())
+}
+
+func TestServiceComments(t *testing.T) {
+	registerCommentsFile(t)
+	const file = "descriptor_test/comments.proto"
+
+	want := &descriptor.Comments{Leading: " Library lends books.\n"}
+	if got := descriptor.ServiceComments(file, "descriptor.comments.Library"); !reflect.DeepEqual(got, want) {
+		t.Errorf("ServiceComments = %#v, want %#v", got, want)
+	}
+	want = &descriptor.Comments{Trailing: " Returns a book.\n"}
+	for _, method := range []string{"/descriptor.comments.Library/Return", "descriptor.comments.Library.Return"} {
+		if got := descriptor.MethodComments(file, method); !reflect.DeepEqual(got, want) {
+			t.Errorf("MethodComments(%q) = %#v, want %#v", method, got, want)
+		}
+	}
+	for _, method := range []string{"/descriptor.comments.Library/Lend", "/descriptor.comments.Library/Burn"} {
+		if got := descriptor.MethodComments(file, method); got != nil {
+			t.Errorf("MethodComments(%q) = %#v, want nil", method, got)
+		}
+	}
+	if got := descriptor.ServiceComments(file, "descriptor.comments.Archive"); got != nil {
+		t.Errorf("ServiceComments of unknown service = %#v, want nil", got)
+	}
+	if got := descriptor.FileComments(file, []int32{6, 0, 2, 1}); !reflect.DeepEqual(got, want) {
+		t.Errorf("FileComments = %#v, want %#v", got, want)
+	}
+}
+
+func ExampleFieldComments() {
+	c := descriptor.FieldComments(new(cpb.Article), "Title")
+	fmt.Printf("%q\n", c.Leading)
+
+	// Output:
+	// " The title of the article.\n"
+}
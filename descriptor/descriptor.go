@@ -37,36 +37,15 @@
 package descriptor
 
 import (
-	"bytes"
-	"compress/gzip"
 	"fmt"
-	"io/ioutil"
+	"reflect"
+	"strings"
 
+	"github.com/golang/protobuf/internal/filedesc"
 	"github.com/golang/protobuf/proto"
 	protobuf "github.com/golang/protobuf/protoc-gen-go/descriptor"
 )
 
-// extractFile extracts a FileDescriptorProto from a gzip'd buffer.
-func extractFile(gz []byte) (*protobuf.FileDescriptorProto, error) {
-	r, err := gzip.NewReader(bytes.NewReader(gz))
-	if err != nil {
-		return nil, fmt.Errorf("failed to open gzip reader: %v", err)
-	}
-	defer r.Close()
-
-	b, err := ioutil.ReadAll(r)
-	if err != nil {
-		return nil, fmt.Errorf("failed to uncompress descriptor: %v", err)
-	}
-
-	fd := new(protobuf.FileDescriptorProto)
-	if err := proto.Unmarshal(b, fd); err != nil {
-		return nil, fmt.Errorf("malformed FileDescriptorProto: %v", err)
-	}
-
-	return fd, nil
-}
-
 // Message is a proto.Message with a method to return its descriptor.
 //
 // Message types generated by the protocol compiler always satisfy
@@ -80,7 +59,7 @@ type Message interface {
 // describing the given message.
 func ForMessage(msg Message) (fd *protobuf.FileDescriptorProto, md *protobuf.DescriptorProto) {
 	gz, path := msg.Descriptor()
-	fd, err := extractFile(gz)
+	fd, err := filedesc.Extract(gz)
 	if err != nil {
 		panic(fmt.Sprintf("invalid FileDescriptorProto for %T: %v", msg, err))
 	}
@@ -91,3 +70,139 @@ func ForMessage(msg Message) (fd *protobuf.FileDescriptorProto, md *protobuf.Des
 	}
 	return fd, md
 }
+
+// Enum is an enum type with a method to return its descriptor.
+//
+// Enum types generated by the protocol compiler always satisfy
+// the Enum interface.
+type Enum interface {
+	EnumDescriptor() ([]byte, []int)
+}
+
+// ForEnum returns a FileDescriptorProto and an EnumDescriptorProto from within it
+// describing the given enum.
+func ForEnum(e Enum) (fd *protobuf.FileDescriptorProto, ed *protobuf.EnumDescriptorProto) {
+	gz, path := e.EnumDescriptor()
+	fd, err := filedesc.Extract(gz)
+	if err != nil {
+		panic(fmt.Sprintf("invalid FileDescriptorProto for %T: %v", e, err))
+	}
+
+	if len(path) == 1 {
+		return fd, fd.EnumType[path[0]]
+	}
+	md := fd.MessageType[path[0]]
+	for _, i := range path[1 : len(path)-1] {
+		md = md.NestedType[i]
+	}
+	return fd, md.EnumType[path[len(path)-1]]
+}
+
+// ForField returns the FieldDescriptorProto of the field of msg held in the
+// Go struct field with the given name, or nil if there is no such field.
+// The name may also be that of a field of a oneof wrapper type, such as
+// Number for the Communique_Number case of a oneof.
+func ForField(msg Message, name string) *protobuf.FieldDescriptorProto {
+	tag := fieldTag(msg, name)
+	if tag == 0 {
+		return nil
+	}
+	_, md := ForMessage(msg)
+	for _, f := range md.Field {
+		if int(f.GetNumber()) == tag {
+			return f
+		}
+	}
+	return nil
+}
+
+// fieldTag returns the number of the field of msg held in the Go struct
+// field with the given name, as interpreted by ForField, or 0 if there is
+// no such field.
+func fieldTag(msg Message, name string) int {
+	t := reflect.TypeOf(msg)
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return 0
+	}
+	var tag int
+	sprops := proto.GetProperties(t.Elem())
+	for _, p := range sprops.Prop {
+		if p.Name == name && p.Tag > 0 {
+			tag = p.Tag
+		}
+	}
+	for _, oop := range sprops.OneofTypes {
+		if oop.Prop.Name == name {
+			tag = oop.Prop.Tag
+		}
+	}
+	return tag
+}
+
+// ForService returns a FileDescriptorProto and a ServiceDescriptorProto from
+// within it describing the service with the given full name, such as
+// "grpc.testing.Test", defined in the registered file with the given name.
+// Generated gRPC code records the file name in the Metadata field of the
+// service's grpc.ServiceDesc. It returns nil if there is no such service.
+func ForService(filename, name string) (fd *protobuf.FileDescriptorProto, sd *protobuf.ServiceDescriptorProto) {
+	gz := proto.FileDescriptor(filename)
+	if gz == nil {
+		return nil, nil
+	}
+	fd, err := filedesc.Extract(gz)
+	if err != nil {
+		panic(fmt.Sprintf("invalid FileDescriptorProto for %s: %v", filename, err))
+	}
+
+	name = strings.TrimPrefix(name, ".")
+	for _, sd := range fd.Service {
+		if fullName(fd, sd.GetName()) == name {
+			return fd, sd
+		}
+	}
+	return nil, nil
+}
+
+// ForMethod returns a ServiceDescriptorProto and a MethodDescriptorProto from
+// within it describing a method of a service defined in the registered file
+// with the given name. The method is named as in gRPC, such as
+// "/grpc.testing.Test/UnaryCall", or by its full name, such as
+// "grpc.testing.Test.UnaryCall". It returns nil if there is no such method.
+func ForMethod(filename, method string) (sd *protobuf.ServiceDescriptorProto, md *protobuf.MethodDescriptorProto) {
+	service, name, ok := splitMethod(method)
+	if !ok {
+		return nil, nil
+	}
+	_, sd = ForService(filename, service)
+	for _, md := range sd.GetMethod() {
+		if md.GetName() == name {
+			return sd, md
+		}
+	}
+	return nil, nil
+}
+
+// splitMethod splits a method name, given in either of the forms accepted
+// by ForMethod, into the full name of its service and its simple name.
+func splitMethod(method string) (service, name string, ok bool) {
+	if strings.HasPrefix(method, "/") {
+		i := strings.LastIndex(method, "/")
+		if i == 0 {
+			return "", "", false
+		}
+		return method[1:i], method[i+1:], true
+	}
+	i := strings.LastIndex(method, ".")
+	if i < 0 {
+		return "", "", false
+	}
+	return method[:i], method[i+1:], true
+}
+
+// fullName returns the full name of a top-level element of fd.
+func fullName(fd *protobuf.FileDescriptorProto, name string) string {
+	if fd.GetPackage() == "" {
+		return name
+	}
+	return fd.GetPackage() + "." + name
+}
@@ -1,10 +1,13 @@
 package descriptor_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"fmt"
 	"testing"
 
 	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
 	tpb "github.com/golang/protobuf/proto/test_proto"
 	protobuf "github.com/golang/protobuf/protoc-gen-go/descriptor"
 )
@@ -20,6 +23,92 @@ func TestMessage(t *testing.T) {
 	}
 }
 
+func TestEnum(t *testing.T) {
+	tests := []struct {
+		e    descriptor.Enum
+		name string
+	}{
+		{tpb.FOO_FOO1, "FOO"},
+		{tpb.GoTest_FUNCTION, "KIND"},
+		{tpb.DefaultsMessage_ONE, "DefaultsEnum"},
+		{protobuf.FieldDescriptorProto_TYPE_INT32, "Type"},
+	}
+	for _, tt := range tests {
+		_, ed := descriptor.ForEnum(tt.e)
+		if ed.GetName() != tt.name {
+			t.Errorf("descriptor.ForEnum(%T).GetName() = %q; want %q", tt.e, ed.GetName(), tt.name)
+		}
+	}
+}
+
+func TestField(t *testing.T) {
+	tests := []struct {
+		msg   descriptor.Message
+		field string
+		want  string
+	}{
+		{&tpb.GoTest{}, "Kind", "Kind"},
+		{&tpb.GoTest{}, "F_Int32Defaulted", "F_Int32_defaulted"},
+		{&tpb.Communique{}, "Number", "number"},
+		{&protobuf.FieldDescriptorProto{}, "JsonName", "json_name"},
+		{&tpb.GoTest{}, "NoSuchField", ""},
+		{&tpb.GoTest{}, "XXX_unrecognized", ""},
+		{&tpb.Communique{}, "Union", ""},
+	}
+	for _, tt := range tests {
+		fd := descriptor.ForField(tt.msg, tt.field)
+		if fd.GetName() != tt.want {
+			t.Errorf("descriptor.ForField(%T, %q).GetName() = %q; want %q", tt.msg, tt.field, fd.GetName(), tt.want)
+		}
+	}
+}
+
+func TestService(t *testing.T) {
+	// No linked package defines a service, so register a file that does.
+	file := &protobuf.FileDescriptorProto{
+		Name:    proto.String("descriptor_test/service.proto"),
+		Package: proto.String("descriptor.test"),
+		Service: []*protobuf.ServiceDescriptorProto{{
+			Name: proto.String("Greeter"),
+			Method: []*protobuf.MethodDescriptorProto{{
+				Name:    proto.String("Greet"),
+				Options: &protobuf.MethodOptions{Deprecated: proto.Bool(true)},
+			}},
+		}},
+	}
+	b, err := proto.Marshal(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(b)
+	zw.Close()
+	proto.RegisterFile(file.GetName(), buf.Bytes())
+
+	fd, sd := descriptor.ForService("descriptor_test/service.proto", "descriptor.test.Greeter")
+	if fd.GetName() != file.GetName() || sd.GetName() != "Greeter" {
+		t.Errorf("descriptor.ForService = %q, %q; want %q, %q", fd.GetName(), sd.GetName(), file.GetName(), "Greeter")
+	}
+	for _, method := range []string{"/descriptor.test.Greeter/Greet", "descriptor.test.Greeter.Greet"} {
+		sd, md := descriptor.ForMethod("descriptor_test/service.proto", method)
+		if sd.GetName() != "Greeter" || !md.GetOptions().GetDeprecated() {
+			t.Errorf("descriptor.ForMethod(%q) = %v, %v", method, sd, md)
+		}
+	}
+	for _, tt := range []struct{ file, method string }{
+		{"descriptor_test/service.proto", "/descriptor.test.Greeter/Farewell"},
+		{"descriptor_test/service.proto", "/Greeter/Greet"},
+		{"descriptor_test/service.proto", "/Greet"},
+		{"descriptor_test/service.proto", "Greet"},
+		{"no/such.proto", "/descriptor.test.Greeter/Greet"},
+	} {
+		if sd, md := descriptor.ForMethod(tt.file, tt.method); sd != nil || md != nil {
+			t.Errorf("descriptor.ForMethod(%q, %q) = %v, %v; want nil", tt.file, tt.method, sd, md)
+		}
+	}
+}
+
 func Example_options() {
 	var msg *tpb.MyMessageSet
 	_, md := descriptor.ForMessage(msg)
@@ -0,0 +1,443 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: test_comments.proto
+
+package descriptor_test_proto
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Format of an article body.
+type Format int32
+
+const (
+	Format_FORMAT_TEXT Format = 0
+	// Rendered as HTML.
+	Format_FORMAT_HTML Format = 1
+)
+
+var Format_name = map[int32]string{
+	0: "FORMAT_TEXT",
+	1: "FORMAT_HTML",
+}
+
+var Format_value = map[string]int32{
+	"FORMAT_TEXT": 0,
+	"FORMAT_HTML": 1,
+}
+
+func (x Format) Enum() *Format {
+	p := new(Format)
+	*p = x
+	return p
+}
+
+func (x Format) String() string {
+	return proto.EnumName(Format_name, int32(x))
+}
+
+func (x *Format) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Format_value, data, "Format")
+	if err != nil {
+		return err
+	}
+	*x = Format(value)
+	return nil
+}
+
+func (Format) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0e6028076d13eb08, []int{0}
+}
+
+// Status of an article.
+type Article_Status int32
+
+const (
+	// Not yet published.
+	Article_DRAFT     Article_Status = 0
+	Article_PUBLISHED Article_Status = 1
+)
+
+var Article_Status_name = map[int32]string{
+	0: "DRAFT",
+	1: "PUBLISHED",
+}
+
+var Article_Status_value = map[string]int32{
+	"DRAFT":     0,
+	"PUBLISHED": 1,
+}
+
+func (x Article_Status) Enum() *Article_Status {
+	p := new(Article_Status)
+	*p = x
+	return p
+}
+
+func (x Article_Status) String() string {
+	return proto.EnumName(Article_Status_name, int32(x))
+}
+
+func (x *Article_Status) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Article_Status_value, data, "Article_Status")
+	if err != nil {
+		return err
+	}
+	*x = Article_Status(value)
+	return nil
+}
+
+func (Article_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0e6028076d13eb08, []int{0, 0}
+}
+
+// Article is a published piece of writing.
+type Article struct {
+	// The title of the article.
+	Title *string `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
+	Views *int64  `protobuf:"varint,2,opt,name=views" json:"views,omitempty"`
+	// Types that are valid to be assigned to Body:
+	//	*Article_Text
+	//	*Article_Html
+	Body                 isArticle_Body    `protobuf_oneof:"body"`
+	Status               *Article_Status   `protobuf:"varint,5,opt,name=status,enum=descriptor_test.Article_Status" json:"status,omitempty"`
+	Authors              []*Article_Author `protobuf:"bytes,6,rep,name=authors" json:"authors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Article) Reset()         { *m = Article{} }
+func (m *Article) String() string { return proto.CompactTextString(m) }
+func (*Article) ProtoMessage()    {}
+func (*Article) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e6028076d13eb08, []int{0}
+}
+
+func (m *Article) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Article.Unmarshal(m, b)
+}
+func (m *Article) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Article.Marshal(b, m, deterministic)
+}
+func (m *Article) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Article.Merge(m, src)
+}
+func (m *Article) XXX_Size() int {
+	return xxx_messageInfo_Article.Size(m)
+}
+func (m *Article) XXX_DiscardUnknown() {
+	xxx_messageInfo_Article.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Article proto.InternalMessageInfo
+
+func (m *Article) GetTitle() string {
+	if m != nil && m.Title != nil {
+		return *m.Title
+	}
+	return ""
+}
+
+func (m *Article) GetViews() int64 {
+	if m != nil && m.Views != nil {
+		return *m.Views
+	}
+	return 0
+}
+
+type isArticle_Body interface {
+	isArticle_Body()
+}
+
+type Article_Text struct {
+	Text string `protobuf:"bytes,3,opt,name=text,oneof"`
+}
+
+type Article_Html struct {
+	Html string `protobuf:"bytes,4,opt,name=html,oneof"`
+}
+
+func (*Article_Text) isArticle_Body() {}
+
+func (*Article_Html) isArticle_Body() {}
+
+func (m *Article) GetBody() isArticle_Body {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *Article) GetText() string {
+	if x, ok := m.GetBody().(*Article_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (m *Article) GetHtml() string {
+	if x, ok := m.GetBody().(*Article_Html); ok {
+		return x.Html
+	}
+	return ""
+}
+
+func (m *Article) GetStatus() Article_Status {
+	if m != nil && m.Status != nil {
+		return *m.Status
+	}
+	return Article_DRAFT
+}
+
+func (m *Article) GetAuthors() []*Article_Author {
+	if m != nil {
+		return m.Authors
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Article) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Article_OneofMarshaler, _Article_OneofUnmarshaler, _Article_OneofSizer, []interface{}{
+		(*Article_Text)(nil),
+		(*Article_Html)(nil),
+	}
+}
+
+func _Article_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Article)
+	// body
+	switch x := m.Body.(type) {
+	case *Article_Text:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Text)
+	case *Article_Html:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Html)
+	case nil:
+	default:
+		return fmt.Errorf("Article.Body has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Article_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Article)
+	switch tag {
+	case 3: // body.text
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Body = &Article_Text{x}
+		return true, err
+	case 4: // body.html
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Body = &Article_Html{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Article_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Article)
+	// body
+	switch x := m.Body.(type) {
+	case *Article_Text:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Text)))
+		n += len(x.Text)
+	case *Article_Html:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Html)))
+		n += len(x.Html)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Article_Author struct {
+	// Display name.
+	Name                 *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Article_Author) Reset()         { *m = Article_Author{} }
+func (m *Article_Author) String() string { return proto.CompactTextString(m) }
+func (*Article_Author) ProtoMessage()    {}
+func (*Article_Author) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e6028076d13eb08, []int{0, 0}
+}
+
+func (m *Article_Author) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Article_Author.Unmarshal(m, b)
+}
+func (m *Article_Author) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Article_Author.Marshal(b, m, deterministic)
+}
+func (m *Article_Author) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Article_Author.Merge(m, src)
+}
+func (m *Article_Author) XXX_Size() int {
+	return xxx_messageInfo_Article_Author.Size(m)
+}
+func (m *Article_Author) XXX_DiscardUnknown() {
+	xxx_messageInfo_Article_Author.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Article_Author proto.InternalMessageInfo
+
+func (m *Article_Author) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("descriptor_test.Format", Format_name, Format_value)
+	proto.RegisterEnum("descriptor_test.Article_Status", Article_Status_name, Article_Status_value)
+	proto.RegisterType((*Article)(nil), "descriptor_test.Article")
+	proto.RegisterType((*Article_Author)(nil), "descriptor_test.Article.Author")
+}
+
+func init() { proto.RegisterFile("test_comments.proto", fileDescriptor_0e6028076d13eb08) }
+
+var fileDescriptor_0e6028076d13eb08 = []byte{
+	// 1800 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdf, 0x72, 0x1b, 0xb7,
+	0xf5, 0xe6, 0x2e, 0x96, 0xb2, 0x0c, 0x49, 0xb6, 0x0c, 0x2b, 0xbf, 0x28, 0xb4, 0x9c, 0x9c, 0x28,
+	0xbf, 0x4c, 0x14, 0x0d, 0x4d, 0xd5, 0x6a, 0x27, 0xb5, 0xad, 0xc9, 0xb8, 0x4b, 0x12, 0x14, 0xd1,
+	0xa1, 0x76, 0x19, 0xec, 0x52, 0xb2, 0x72, 0xe3, 0x59, 0x72, 0x21, 0x11, 0x33, 0xe4, 0x82, 0xb3,
+	0x0b, 0xda, 0xd1, 0xd3, 0xf4, 0x75, 0xfa, 0x0c, 0x7d, 0x91, 0xde, 0x76, 0x80, 0xe5, 0x1f, 0xc5,
+	0x4d, 0xda, 0x8b, 0xea, 0x46, 0xf8, 0x80, 0xf3, 0xe7, 0xfb, 0xce, 0x39, 0xc4, 0x02, 0x3f, 0xd5,
+	0xa2, 0xd0, 0xef, 0x47, 0x6a, 0x3a, 0x15, 0x99, 0x2e, 0x1a, 0xb3, 0x5c, 0x69, 0x45, 0x1e, 0xa7,
+	0xa2, 0x18, 0xe5, 0x72, 0xa6, 0x55, 0xfe, 0xde, 0x9c, 0x1f, 0xfe, 0xcd, 0xc5, 0x0f, 0xfc, 0x5c,
+	0xcb, 0xd1, 0x44, 0x90, 0x3d, 0x5c, 0xd5, 0x52, 0x4f, 0xc4, 0xbe, 0x03, 0xce, 0xd1, 0x43, 0x5e,
+	0x02, 0xb3, 0xfb, 0x41, 0x8a, 0x8f, 0xc5, 0xbe, 0x0b, 0xce, 0x11, 0xe2, 0x25, 0x20, 0x7b, 0xd8,
+	0xd3, 0xe2, 0x17, 0xbd, 0x8f, 0x8c, 0x69, 0xb7, 0xc2, 0x2d, 0x32, 0xbb, 0x63, 0x3d, 0x9d, 0xec,
+	0x7b, 0xcb, 0x5d, 0x83, 0xc8, 0x9f, 0xf1, 0x46, 0xa1, 0x13, 0x3d, 0x2f, 0xf6, 0xab, 0xe0, 0x1c,
+	0x3d, 0x3a, 0xfd, 0xaa, 0xf1, 0x09, 0x8b, 0xc6, 0x82, 0x41, 0x23, 0xb2, 0x66, 0x7c, 0x61, 0x4e,
+	0x5e, 0xe3, 0x07, 0xc9, 0x5c, 0x8f, 0x55, 0x5e, 0xec, 0x6f, 0x00, 0x3a, 0xda, 0xfa, 0x0f, 0x9e,
+	0xbe, 0xb5, 0xe3, 0x4b, 0xfb, 0xda, 0x01, 0xde, 0x28, 0xb7, 0x08, 0xc1, 0x5e, 0x96, 0x4c, 0x97,
+	0xa2, 0xec, 0xfa, 0xf0, 0x10, 0x6f, 0x94, 0xa9, 0xc8, 0x43, 0x5c, 0x6d, 0x73, 0xbf, 0x13, 0xef,
+	0x56, 0xc8, 0x0e, 0x7e, 0xd8, 0x1f, 0x34, 0x7b, 0x2c, 0xea, 0xd2, 0xf6, 0xae, 0xd3, 0xdc, 0xc0,
+	0xde, 0x50, 0xa5, 0x77, 0xc7, 0xc7, 0x78, 0xa3, 0xa3, 0xf2, 0x69, 0xa2, 0xc9, 0x63, 0xbc, 0xd5,
+	0x09, 0xf9, 0x85, 0x1f, 0xbf, 0x8f, 0xe9, 0x3b, 0xe3, 0xb1, 0xde, 0xe8, 0xc6, 0x17, 0xbd, 0x5d,
+	0xa7, 0x19, 0xfd, 0xfc, 0xd3, 0xad, 0xd4, 0xe3, 0xf9, 0xb0, 0x31, 0x52, 0xd3, 0x93, 0x5b, 0x35,
+	0x49, 0xb2, 0xdb, 0x13, 0x5b, 0xf9, 0xe1, 0xfc, 0xe6, 0x64, 0xcd, 0xfd, 0xe4, 0x13, 0x19, 0xef,
+	0xad, 0xcd, 0xd9, 0x6f, 0xee, 0xfe, 0xf5, 0x1f, 0x9f, 0xe1, 0x0d, 0xe2, 0x7d, 0x5d, 0x61, 0x0e,
+	0xfe, 0xe7, 0x36, 0x76, 0xb6, 0x09, 0xfa, 0xba, 0x42, 0x4e, 0xff, 0xbe, 0x0d, 0xe7, 0x0a, 0x8a,
+	0xf9, 0x6c, 0xa6, 0x72, 0x0d, 0x37, 0x2a, 0x87, 0xbe, 0xb1, 0x1e, 0xa9, 0x09, 0x34, 0xe7, 0x37,
+	0x37, 0x22, 0x2f, 0xe0, 0x05, 0x9c, 0x2b, 0x75, 0x3b, 0x11, 0xdf, 0x15, 0x90, 0x26, 0x3a, 0x01,
+	0x99, 0x69, 0x91, 0x8f, 0xc6, 0x49, 0x76, 0x2b, 0x8c, 0xc3, 0x34, 0xd1, 0x18, 0x43, 0x4b, 0xcd,
+	0xee, 0x72, 0x79, 0x3b, 0xd6, 0x70, 0xfa, 0x87, 0x97, 0xaf, 0x20, 0x1e, 0x0b, 0x13, 0xb7, 0xac,
+	0x5d, 0xd1, 0x00, 0xf0, 0x27, 0x13, 0xb0, 0xe7, 0x05, 0xe4, 0xa2, 0x10, 0xf9, 0x07, 0x91, 0x36,
+	0x30, 0x8c, 0xb5, 0x9e, 0x15, 0x6f, 0x4e, 0x4e, 0x7e, 0x5f, 0x2d, 0xc6, 0xc0, 0x45, 0x2a, 0x0b,
+	0x9d, 0xcb, 0xe1, 0x5c, 0x4b, 0x95, 0x41, 0x92, 0xa5, 0x30, 0x2f, 0x04, 0xc8, 0x0c, 0x0a, 0x35,
+	0xcf, 0x47, 0xc2, 0xee, 0x0c, 0x65, 0x96, 0xe4, 0x77, 0x96, 0x4f, 0x51, 0x87, 0x8f, 0x52, 0x8f,
+	0x41, 0xe5, 0xf6, 0xbf, 0x9a, 0x6b, 0x0c, 0x53, 0x95, 0xca, 0x1b, 0x39, 0x4a, 0x4c, 0x84, 0x3a,
+	0x24, 0xb9, 0x80, 0x99, 0xc8, 0xa7, 0x52, 0x6b, 0x91, 0xc2, 0x2c, 0x57, 0x1f, 0x64, 0x2a, 0x52,
+	0xd0, 0xe3, 0x44, 0x83, 0x1e, 0x1b, 0x55, 0x93, 0x89, 0xfa, 0x28, 0xb3, 0x5b, 0x18, 0xa9, 0x2c,
+	0x95, 0xc6, 0xa9, 0x30, 0x4e, 0x18, 0xa6, 0x42, 0xbf, 0xc1, 0x18, 0xcc, 0xdf, 0xf1, 0x27, 0xc4,
+	0x0a, 0x50, 0x37, 0x4b, 0x46, 0x23, 0x95, 0x0a, 0x98, 0xce, 0x0b, 0x0d, 0xb9, 0xd0, 0x89, 0xcc,
+	0x6c, 0xd4, 0x64, 0xa8, 0x3e, 0x98, 0xa3, 0x45, 0xa1, 0x30, 0x64, 0x4a, 0xcb, 0x91, 0xa8, 0x83,
+	0x1e, 0xcb, 0x02, 0x26, 0xb2, 0xd0, 0x26, 0xc2, 0xfd, 0x8c, 0x59, 0xfa, 0x09, 0x9d, 0x54, 0x16,
+	0xa3, 0x49, 0x22, 0xa7, 0x22, 0x6f, 0xfc, 0x1e, 0x09, 0x99, 0xdd, 0xaf, 0xc5, 0x92, 0xc4, 0x2c,
+	0x57, 0xe9, 0x7c, 0x24, 0xd6, 0x3c, 0xf0, 0x9a, 0xc8, 0xff, 0xc4, 0x03, 0xc3, 0x42, 0x5d, 0xaa,
+	0x46, 0x73, 0x73, 0x45, 0x24, 0xcb, 0x26, 0x9d, 0xa8, 0x1c, 0x94, 0x1e, 0x8b, 0x1c, 0xa6, 0x89,
+	0x16, 0xb9, 0x4c, 0x26, 0xc5, 0xba, 0xd4, 0xb6, 0x41, 0x7a, 0x2c, 0x30, 0xdc, 0x67, 0xbf, 0x12,
+	0x15, 0x08, 0x69, 0x3d, 0x4d, 0x60, 0xf3, 0x4b, 0x33, 0x84, 0xca, 0x21, 0x04, 0x96, 0x8d, 0x1a,
+	0x90, 0xa9, 0xf5, 0x99, 0xad, 0xbb, 0xd4, 0x85, 0x51, 0x94, 0x95, 0xa1, 0x54, 0x5e, 0xc0, 0x34,
+	0xb9, 0x83, 0xa1, 0x30, 0x93, 0x92, 0x82, 0x56, 0x20, 0xb2, 0x54, 0xe5, 0x85, 0x30, 0x43, 0x31,
+	0xcb, 0xd5, 0x54, 0x69, 0x01, 0x65, 0x4d, 0x74, 0x01, 0xa9, 0xc8, 0xe5, 0x07, 0x91, 0xc2, 0x4d,
+	0xae, 0xa6, 0xb8, 0xac, 0x42, 0xa1, 0x6e, 0xf4, 0x47, 0x33, 0x26, 0x8b, 0x09, 0x82, 0x62, 0x26,
+	0x46, 0x66, 0x82, 0x60, 0x96, 0x4b, 0x33, 0x58, 0xb9, 0x99, 0x9d, 0xac, 0x9c, 0xa2, 0xa2, 0xb0,
+	0xdc, 0x31, 0xc4, 0x5d, 0x16, 0x41, 0x14, 0x76, 0xe2, 0x2b, 0x9f, 0x53, 0x60, 0x11, 0xf4, 0x79,
+	0x78, 0xc9, 0xda, 0xb4, 0x0d, 0xcd, 0x6b, 0x88, 0xbb, 0x14, 0x5a, 0x61, 0xff, 0x9a, 0xb3, 0xf3,
+	0x6e, 0x0c, 0xdd, 0xb0, 0xd7, 0xa6, 0x3c, 0x02, 0x3f, 0x68, 0x43, 0x2b, 0x0c, 0x62, 0xce, 0x9a,
+	0x83, 0x38, 0xe4, 0x11, 0x86, 0x43, 0x3f, 0x02, 0x16, 0x1d, 0xda, 0x13, 0x3f, 0xb8, 0x06, 0xfa,
+	0xae, 0xcf, 0x69, 0x14, 0x41, 0xc8, 0x81, 0x5d, 0xf4, 0x7b, 0x8c, 0xb6, 0xe1, 0xca, 0xe7, 0xdc,
+	0x0f, 0x62, 0x46, 0xa3, 0x3a, 0xb0, 0xa0, 0xd5, 0x1b, 0xb4, 0x59, 0x70, 0x5e, 0x87, 0xe6, 0x20,
+	0x86, 0x20, 0x8c, 0x31, 0xf4, 0xd8, 0x05, 0x8b, 0x69, 0x1b, 0xe2, 0xb0, 0x6e, 0xd3, 0xfe, 0xbb,
+	0x1f, 0x84, 0x1d, 0xb8, 0xa0, 0xbc, 0xd5, 0xf5, 0x83, 0xd8, 0x6f, 0xb2, 0x1e, 0x8b, 0xaf, 0x6d,
+	0xc2, 0x0e, 0x8b, 0x03, 0x93, 0xac, 0x13, 0x72, 0x0c, 0x3e, 0xf4, 0x7d, 0x1e, 0xb3, 0xd6, 0xa0,
+	0xe7, 0x73, 0xe8, 0x0f, 0x78, 0x3f, 0x8c, 0x28, 0x18, 0x65, 0x6d, 0x16, 0xb5, 0x7a, 0x3e, 0xbb,
+	0xa0, 0xed, 0x06, 0xb0, 0x00, 0x82, 0x10, 0xe8, 0x25, 0x0d, 0x62, 0x88, 0xba, 0x7e, 0xaf, 0xf7,
+	0x6b, 0xa1, 0x18, 0xc2, 0xab, 0x80, 0x72, 0xc3, 0xfe, 0xbe, 0x4c, 0x68, 0x52, 0xe8, 0x31, 0xbf,
+	0xd9, 0xa3, 0x26, 0x95, 0xd5, 0xd9, 0x66, 0x9c, 0xb6, 0x62, 0x23, 0x68, 0xbd, 0x6a, 0xb1, 0x36,
+	0x0d, 0x62, 0xbf, 0x57, 0xc7, 0x10, 0xf5, 0x69, 0x8b, 0xf9, 0xbd, 0x3a, 0xd0, 0x77, 0xf4, 0xa2,
+	0xdf, 0xf3, 0xf9, 0x75, 0x7d, 0x11, 0x34, 0xa2, 0x3f, 0x0d, 0x68, 0x10, 0x33, 0xbf, 0x07, 0x6d,
+	0xff, 0xc2, 0x3f, 0xa7, 0x11, 0x1c, 0xfd, 0xb7, 0xaa, 0xf4, 0x79, 0xd8, 0x1a, 0x70, 0x7a, 0x61,
+	0x58, 0x87, 0x1d, 0x88, 0x06, 0xcd, 0x28, 0x66, 0xf1, 0x20, 0xa6, 0x70, 0x1e, 0x86, 0x6d, 0x5b,
+	0xec, 0x88, 0xf2, 0x4b, 0xd6, 0xa2, 0xd1, 0x19, 0xf4, 0xc2, 0xc8, 0x16, 0x6c, 0x10, 0xd1, 0x3a,
+	0x86, 0xb6, 0x1f, 0xfb, 0x36, 0x75, 0x9f, 0x87, 0x1d, 0x16, 0x47, 0x67, 0x66, 0xdd, 0x1c, 0x44,
+	0xcc, 0x16, 0x8e, 0x05, 0x31, 0xe5, 0x7c, 0xd0, 0x8f, 0x59, 0x18, 0x7c, 0x0f, 0xdd, 0xf0, 0x8a,
+	0x5e, 0x52, 0x0e, 0x2d, 0x7f, 0x10, 0xd1, 0xb6, 0xad, 0x70, 0x18, 0x18, 0xb5, 0x66, 0x56, 0x68,
+	0xc8, 0xaf, 0x4d, 0xd8, 0x1e, 0x5b, 0x74, 0xa0, 0x0e, 0x57, 0x5d, 0x1a, 0x77, 0x29, 0x37, 0x45,
+	0xb5, 0xd5, 0xf2, 0x4d, 0x19, 0xa2, 0x98, 0xb3, 0x56, 0x7c, 0xdf, 0x2c, 0xe4, 0x10, 0x87, 0x3c,
+	0xc6, 0xf7, 0x74, 0x42, 0x40, 0xcf, 0x7b, 0xec, 0x9c, 0x06, 0x2d, 0x6a, 0x8e, 0x43, 0x13, 0xe6,
+	0x8a, 0x45, 0xf4, 0x7b, 0xf0, 0x39, 0x8b, 0x8c, 0x01, 0xb3, 0x89, 0xe1, 0xca, 0xbf, 0x86, 0x70,
+	0x60, 0x55, 0x9b, 0x46, 0x0d, 0x22, 0x8a, 0xcb, 0xf5, 0xbd, 0xd1, 0xad, 0xdb, 0x7e, 0x02, 0xeb,
+	0x80, 0xdf, 0xbe, 0x64, 0x86, 0xf9, 0xc2, 0xba, 0x1f, 0x46, 0x11, 0x5b, 0x8c, 0x8b, 0x2d, 0x5b,
+	0xab, 0xbb, 0xa8, 0x79, 0x03, 0x9f, 0x1e, 0x42, 0x5b, 0xe8, 0x64, 0x34, 0x16, 0x29, 0x2c, 0x9e,
+	0x0b, 0xe6, 0xaa, 0x99, 0x2f, 0xae, 0x54, 0x39, 0x11, 0x0d, 0x8c, 0x37, 0xb1, 0xe3, 0x12, 0xf4,
+	0x4d, 0x65, 0xdf, 0xac, 0x36, 0x09, 0xfa, 0xb6, 0x32, 0xc6, 0x0f, 0xb1, 0xbb, 0xb9, 0x55, 0x2e,
+	0x7f, 0xc6, 0xae, 0x57, 0x21, 0xde, 0x71, 0xa5, 0xe9, 0xd4, 0x8e, 0x61, 0xf1, 0x51, 0x06, 0x59,
+	0x40, 0x02, 0xb3, 0xf9, 0x70, 0x22, 0x0b, 0x13, 0x7c, 0x26, 0xc5, 0xc8, 0xde, 0x08, 0xe6, 0x47,
+	0x28, 0xb3, 0xdb, 0xdf, 0x4e, 0x3d, 0x14, 0x37, 0x2a, 0x17, 0xcb, 0x18, 0x0d, 0x8c, 0x31, 0x46,
+	0x5e, 0xc5, 0x21, 0xe8, 0x78, 0xf3, 0x31, 0x3e, 0xc2, 0x9e, 0x57, 0x71, 0x2b, 0x04, 0xd5, 0xdd,
+	0x83, 0xda, 0x33, 0xfb, 0xb5, 0xb2, 0xaf, 0x14, 0x13, 0xd5, 0x5e, 0x91, 0x2b, 0xaf, 0x6d, 0x5c,
+	0x35, 0x96, 0x9e, 0x31, 0x5d, 0xa1, 0x2a, 0x41, 0xf5, 0xad, 0x27, 0x4b, 0xe4, 0x10, 0x54, 0x27,
+	0x9f, 0x2f, 0x11, 0x22, 0xa8, 0x5e, 0x7b, 0x86, 0xff, 0x64, 0x33, 0x38, 0x04, 0xbd, 0x70, 0x9f,
+	0x1d, 0x7e, 0x07, 0xc1, 0x7c, 0x3a, 0x14, 0xb9, 0x0d, 0x2f, 0xcd, 0x95, 0x75, 0x2f, 0x09, 0x7c,
+	0x4c, 0xcc, 0x37, 0x31, 0x49, 0x57, 0xd9, 0x1c, 0xcf, 0xb8, 0xad, 0x50, 0x95, 0xa0, 0x17, 0x5b,
+	0xbb, 0x4b, 0x64, 0x42, 0x3e, 0xf9, 0xbf, 0x25, 0x42, 0x04, 0xbd, 0xf8, 0xa2, 0x86, 0xb7, 0x4d,
+	0xb6, 0xcd, 0x0a, 0xf1, 0x4e, 0xdc, 0x3f, 0xa2, 0xf2, 0x6c, 0xd3, 0xf0, 0x3a, 0xd9, 0xdc, 0xc6,
+	0x5f, 0x59, 0x26, 0x2e, 0x41, 0x2f, 0xbd, 0xbd, 0x1a, 0x81, 0xfe, 0xc4, 0x7e, 0x8f, 0xc4, 0x2f,
+	0x1a, 0xcc, 0xbb, 0x64, 0x95, 0xd4, 0xad, 0x1a, 0x8b, 0x15, 0x72, 0x08, 0x7a, 0xb9, 0xf5, 0x78,
+	0x89, 0x10, 0x41, 0x2f, 0xc9, 0x53, 0xbc, 0x65, 0x43, 0x21, 0x82, 0x4e, 0xbd, 0xbd, 0xc5, 0x11,
+	0xaa, 0x1a, 0xb4, 0x74, 0x43, 0x0e, 0x41, 0xa7, 0x2b, 0x37, 0x64, 0x2c, 0xc9, 0x53, 0xfc, 0xad,
+	0x71, 0x33, 0x7d, 0xfd, 0xc1, 0x7d, 0x83, 0x6a, 0x9f, 0x43, 0xf9, 0x66, 0x32, 0xc5, 0x48, 0xb2,
+	0x4f, 0x4b, 0x6d, 0x5b, 0xf4, 0xc3, 0x83, 0x1d, 0xfc, 0x0d, 0xde, 0xf0, 0x2a, 0x65, 0x93, 0x5e,
+	0x79, 0x8f, 0x6a, 0x7b, 0x10, 0x28, 0x0d, 0x77, 0x42, 0xaf, 0x07, 0xa1, 0x81, 0xf1, 0x23, 0xfc,
+	0xa0, 0x34, 0x72, 0x8c, 0xd5, 0xc3, 0x35, 0x76, 0x09, 0x7a, 0xb5, 0xbd, 0x83, 0xff, 0x7f, 0x11,
+	0xc4, 0x21, 0xe8, 0xb5, 0x47, 0x0e, 0x3f, 0x83, 0x4b, 0x59, 0xc8, 0xe1, 0x44, 0x80, 0x56, 0xb6,
+	0xe4, 0x22, 0x2f, 0xee, 0x45, 0x71, 0xac, 0xd9, 0xce, 0x1a, 0xbb, 0x04, 0xbd, 0xde, 0x7d, 0xb2,
+	0x90, 0xed, 0x11, 0x74, 0xe6, 0x3e, 0x5f, 0x48, 0xf3, 0x2c, 0x5a, 0xca, 0xf6, 0x36, 0x08, 0x3a,
+	0x5b, 0x0d, 0x84, 0xe7, 0x10, 0x74, 0x46, 0xf6, 0x97, 0x08, 0x11, 0x74, 0xf6, 0xec, 0xa0, 0x6c,
+	0x11, 0xaa, 0x10, 0xef, 0x47, 0xf7, 0x2f, 0x8b, 0x16, 0x21, 0x43, 0xfb, 0x47, 0xbc, 0x8b, 0xbf,
+	0x34, 0x34, 0x91, 0xd5, 0xfa, 0xd6, 0x7b, 0x5e, 0x7b, 0x0c, 0x6d, 0x59, 0xcc, 0x26, 0xc9, 0x9d,
+	0xfd, 0xbe, 0x2d, 0x09, 0xa2, 0x72, 0x0c, 0xdf, 0x7a, 0xdb, 0x6b, 0x5c, 0x25, 0xe8, 0xed, 0xce,
+	0xd3, 0x35, 0x76, 0x08, 0x7a, 0xbb, 0xb7, 0xbf, 0xc6, 0x88, 0xa0, 0xb7, 0xcf, 0x0e, 0x16, 0x02,
+	0xaa, 0x04, 0xf9, 0xee, 0x97, 0x0b, 0x5a, 0x55, 0xcf, 0xa0, 0xa5, 0x80, 0xea, 0x06, 0x41, 0xfe,
+	0x4a, 0x40, 0xd5, 0x21, 0xc8, 0x27, 0x5f, 0x2c, 0x11, 0x22, 0xc8, 0x3f, 0x78, 0x8e, 0x8f, 0xb0,
+	0x5b, 0xad, 0x10, 0x8f, 0x56, 0x98, 0x53, 0x3b, 0x80, 0xf2, 0x2d, 0xfb, 0xeb, 0x1e, 0x2e, 0x07,
+	0x0a, 0x63, 0x54, 0x35, 0x54, 0x68, 0x75, 0xcb, 0xa4, 0xae, 0x5a, 0x61, 0x1d, 0x97, 0x98, 0x80,
+	0xd5, 0x92, 0x65, 0xc7, 0xdd, 0x59, 0x22, 0x97, 0xa0, 0xce, 0xee, 0x13, 0x0c, 0xd6, 0xd0, 0x21,
+	0xa8, 0xeb, 0x92, 0xda, 0x53, 0xe0, 0x22, 0x4b, 0x45, 0x2e, 0x52, 0x48, 0x0a, 0x30, 0xaf, 0xe2,
+	0x72, 0x3e, 0xaa, 0x65, 0x93, 0xba, 0x2b, 0x6f, 0xd3, 0xa2, 0xee, 0xee, 0x93, 0x7f, 0x0d, 0x00,
+	0x01, 0x0e, 0xc0, 0xeb, 0xa6, 0x0c, 0x00, 0x00,
+}
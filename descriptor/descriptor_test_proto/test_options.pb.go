@@ -0,0 +1,503 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: test_options.proto
+
+package descriptor_test_proto
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	descriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Role int32
+
+const (
+	Role_ROLE_UNKNOWN Role = 0
+	Role_ROLE_ADMIN   Role = 1
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNKNOWN",
+	1: "ROLE_ADMIN",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNKNOWN": 0,
+	"ROLE_ADMIN":   1,
+}
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (x *Role) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Role_value, data, "Role")
+	if err != nil {
+		return err
+	}
+	*x = Role(value)
+	return nil
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_452d9b0191a5e976, []int{0}
+}
+
+type HttpRule struct {
+	Get                  *string  `protobuf:"bytes,1,opt,name=get" json:"get,omitempty"`
+	Post                 *string  `protobuf:"bytes,2,opt,name=post" json:"post,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HttpRule) Reset()         { *m = HttpRule{} }
+func (m *HttpRule) String() string { return proto.CompactTextString(m) }
+func (*HttpRule) ProtoMessage()    {}
+func (*HttpRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_452d9b0191a5e976, []int{0}
+}
+
+func (m *HttpRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HttpRule.Unmarshal(m, b)
+}
+func (m *HttpRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HttpRule.Marshal(b, m, deterministic)
+}
+func (m *HttpRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HttpRule.Merge(m, src)
+}
+func (m *HttpRule) XXX_Size() int {
+	return xxx_messageInfo_HttpRule.Size(m)
+}
+func (m *HttpRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_HttpRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HttpRule proto.InternalMessageInfo
+
+func (m *HttpRule) GetGet() string {
+	if m != nil && m.Get != nil {
+		return *m.Get
+	}
+	return ""
+}
+
+func (m *HttpRule) GetPost() string {
+	if m != nil && m.Post != nil {
+		return *m.Post
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+type User struct {
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Id   *int64  `protobuf:"varint,2,opt,name=id" json:"id,omitempty"`
+	Role *Role   `protobuf:"varint,3,opt,name=role,enum=descriptor_test.Role" json:"role,omitempty"`
+	// Types that are valid to be assigned to Contact:
+	//	*User_Email
+	//	*User_Phone
+	Contact              isUser_Contact `protobuf_oneof:"contact"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *User) Reset()         { *m = User{} }
+func (m *User) String() string { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()    {}
+func (*User) Descriptor() ([]byte, []int) {
+	return fileDescriptor_452d9b0191a5e976, []int{1}
+}
+
+func (m *User) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User.Unmarshal(m, b)
+}
+func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User.Marshal(b, m, deterministic)
+}
+func (m *User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User.Merge(m, src)
+}
+func (m *User) XXX_Size() int {
+	return xxx_messageInfo_User.Size(m)
+}
+func (m *User) XXX_DiscardUnknown() {
+	xxx_messageInfo_User.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User proto.InternalMessageInfo
+
+func (m *User) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *User) GetId() int64 {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return 0
+}
+
+func (m *User) GetRole() Role {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return Role_ROLE_UNKNOWN
+}
+
+type isUser_Contact interface {
+	isUser_Contact()
+}
+
+type User_Email struct {
+	Email string `protobuf:"bytes,4,opt,name=email,oneof"`
+}
+
+type User_Phone struct {
+	Phone string `protobuf:"bytes,5,opt,name=phone,oneof"`
+}
+
+func (*User_Email) isUser_Contact() {}
+
+func (*User_Phone) isUser_Contact() {}
+
+func (m *User) GetContact() isUser_Contact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (m *User) GetEmail() string {
+	if x, ok := m.GetContact().(*User_Email); ok {
+		return x.Email
+	}
+	return ""
+}
+
+func (m *User) GetPhone() string {
+	if x, ok := m.GetContact().(*User_Phone); ok {
+		return x.Phone
+	}
+	return ""
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*User) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _User_OneofMarshaler, _User_OneofUnmarshaler, _User_OneofSizer, []interface{}{
+		(*User_Email)(nil),
+		(*User_Phone)(nil),
+	}
+}
+
+func _User_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*User)
+	// contact
+	switch x := m.Contact.(type) {
+	case *User_Email:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Email)
+	case *User_Phone:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Phone)
+	case nil:
+	default:
+		return fmt.Errorf("User.Contact has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _User_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*User)
+	switch tag {
+	case 4: // contact.email
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Contact = &User_Email{x}
+		return true, err
+	case 5: // contact.phone
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Contact = &User_Phone{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _User_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*User)
+	// contact
+	switch x := m.Contact.(type) {
+	case *User_Email:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Email)))
+		n += len(x.Email)
+	case *User_Phone:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Phone)))
+		n += len(x.Phone)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+var E_Table = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MessageOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         50000,
+	Name:          "descriptor_test.table",
+	Tag:           "bytes,50000,opt,name=table",
+	Filename:      "test_options.proto",
+}
+
+var E_Version = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MessageOptions)(nil),
+	ExtensionType: (*int32)(nil),
+	Field:         50001,
+	Name:          "descriptor_test.version",
+	Tag:           "varint,50001,opt,name=version,def=1",
+	Filename:      "test_options.proto",
+}
+
+var E_Sensitive = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         50000,
+	Name:          "descriptor_test.sensitive",
+	Tag:           "varint,50000,opt,name=sensitive",
+	Filename:      "test_options.proto",
+}
+
+var E_Tags = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: ([]string)(nil),
+	Field:         50001,
+	Name:          "descriptor_test.tags",
+	Tag:           "bytes,50001,rep,name=tags",
+	Filename:      "test_options.proto",
+}
+
+var E_Prefix = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.EnumOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         50000,
+	Name:          "descriptor_test.prefix",
+	Tag:           "bytes,50000,opt,name=prefix",
+	Filename:      "test_options.proto",
+}
+
+var E_Label = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.EnumValueOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         50000,
+	Name:          "descriptor_test.label",
+	Tag:           "bytes,50000,opt,name=label",
+	Filename:      "test_options.proto",
+}
+
+var E_Host = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.ServiceOptions)(nil),
+	ExtensionType: (*string)(nil),
+	Field:         50000,
+	Name:          "descriptor_test.host",
+	Tag:           "bytes,50000,opt,name=host",
+	Filename:      "test_options.proto",
+}
+
+var E_Http = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MethodOptions)(nil),
+	ExtensionType: (*HttpRule)(nil),
+	Field:         50000,
+	Name:          "descriptor_test.http",
+	Tag:           "bytes,50000,opt,name=http",
+	Filename:      "test_options.proto",
+}
+
+func init() {
+	proto.RegisterEnum("descriptor_test.Role", Role_name, Role_value)
+	proto.RegisterType((*HttpRule)(nil), "descriptor_test.HttpRule")
+	proto.RegisterType((*User)(nil), "descriptor_test.User")
+	proto.RegisterExtension(E_Table)
+	proto.RegisterExtension(E_Version)
+	proto.RegisterExtension(E_Sensitive)
+	proto.RegisterExtension(E_Tags)
+	proto.RegisterExtension(E_Prefix)
+	proto.RegisterExtension(E_Label)
+	proto.RegisterExtension(E_Host)
+	proto.RegisterExtension(E_Http)
+}
+
+func init() { proto.RegisterFile("test_options.proto", fileDescriptor_452d9b0191a5e976) }
+
+var fileDescriptor_452d9b0191a5e976 = []byte{
+	// 2221 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x73, 0xdb, 0x46,
+	0xd2, 0x06, 0x30, 0x80, 0x48, 0x8d, 0x94, 0x58, 0x1a, 0x7f, 0x84, 0x92, 0x9d, 0xb8, 0xad, 0x37,
+	0xef, 0x5a, 0x52, 0x68, 0xca, 0xb1, 0x77, 0xe3, 0x2c, 0xb5, 0x6b, 0x07, 0x24, 0x21, 0x11, 0x2b,
+	0x12, 0xa0, 0x00, 0x50, 0x1f, 0xb9, 0xb8, 0x20, 0x72, 0x24, 0xa2, 0x96, 0xc4, 0xb0, 0x80, 0xa1,
+	0xbc, 0xb9, 0x6d, 0xe5, 0xe0, 0x43, 0xfe, 0x8f, 0xab, 0xf6, 0x27, 0xec, 0x31, 0xa9, 0xfd, 0x43,
+	0x5b, 0x33, 0x03, 0x4a, 0x8a, 0xed, 0x54, 0x0e, 0xab, 0x83, 0xd8, 0xdd, 0xd3, 0xfd, 0xf4, 0xd3,
+	0xcf, 0xf4, 0xb0, 0x88, 0x09, 0xa7, 0x39, 0x7f, 0xcd, 0xa6, 0x3c, 0x61, 0x69, 0x5e, 0x9b, 0x66,
+	0x8c, 0x33, 0x72, 0x6b, 0x48, 0xf3, 0x41, 0x96, 0x4c, 0x39, 0xcb, 0x5e, 0x8b, 0xe3, 0x75, 0xb8,
+	0x60, 0xec, 0x62, 0x4c, 0x77, 0xe4, 0xf1, 0xd9, 0xec, 0x7c, 0xe7, 0x3a, 0x41, 0x95, 0x6c, 0x3c,
+	0xc5, 0xe5, 0x36, 0xe7, 0xd3, 0x60, 0x36, 0xa6, 0x64, 0x05, 0xa3, 0x0b, 0xca, 0x2b, 0x3a, 0xe8,
+	0x9b, 0x8b, 0x81, 0x30, 0x09, 0xc1, 0xe6, 0x94, 0xe5, 0xbc, 0x62, 0xc8, 0x90, 0xb4, 0x37, 0xfe,
+	0xa5, 0x63, 0xb3, 0x9f, 0xd3, 0x8c, 0x3c, 0xc6, 0x66, 0x1a, 0x4f, 0xa8, 0xca, 0x6f, 0xdc, 0xfe,
+	0xe7, 0xbb, 0x8a, 0xfe, 0xd3, 0xbb, 0x0a, 0x9a, 0x26, 0xc9, 0x4f, 0xef, 0x2a, 0xf2, 0x28, 0x90,
+	0xff, 0xc9, 0xa7, 0xd8, 0x48, 0x86, 0x12, 0x03, 0x05, 0x46, 0x32, 0x24, 0x5b, 0xd8, 0xcc, 0xd8,
+	0x98, 0x56, 0x10, 0xe8, 0x9b, 0x9f, 0x3e, 0xbb, 0x5b, 0x7b, 0x8f, 0x75, 0x2d, 0x60, 0x63, 0x1a,
+	0xc8, 0x14, 0xf2, 0x00, 0x5b, 0x74, 0x12, 0x27, 0xe3, 0x8a, 0x29, 0x9b, 0x98, 0xa2, 0x49, 0x5b,
+	0x0b, 0x54, 0x90, 0xdc, 0xc3, 0xd6, 0x74, 0xc4, 0x52, 0x5a, 0xb1, 0xc4, 0xa9, 0x88, 0x4b, 0xb7,
+	0xbe, 0xf4, 0xe3, 0xbb, 0x8a, 0x35, 0xcb, 0x69, 0x96, 0x57, 0xf4, 0xc6, 0x22, 0x2e, 0x0d, 0x58,
+	0xca, 0xe3, 0x01, 0xdf, 0x0e, 0xb1, 0x29, 0xb0, 0xc9, 0xe7, 0x78, 0x39, 0xf0, 0x3b, 0xce, 0xeb,
+	0xbe, 0x77, 0xe0, 0xf9, 0xc7, 0xde, 0x8a, 0xb6, 0x2e, 0x2a, 0x4a, 0xfd, 0xf4, 0xef, 0x29, 0x7b,
+	0x93, 0x92, 0x47, 0x18, 0xcb, 0x63, 0xbb, 0xd5, 0x75, 0xbd, 0x15, 0x7d, 0x7d, 0xf5, 0xc7, 0x77,
+	0x95, 0x4f, 0xec, 0xe1, 0x24, 0x49, 0x93, 0x9c, 0x67, 0x31, 0x67, 0xd9, 0xfa, 0xa2, 0xe8, 0x20,
+	0x93, 0xea, 0x2f, 0xb0, 0xc5, 0xe3, 0xb3, 0x31, 0x25, 0x0f, 0x6b, 0x4a, 0xed, 0xda, 0x5c, 0xed,
+	0x5a, 0x97, 0xe6, 0x79, 0x7c, 0x41, 0x7d, 0x75, 0x49, 0x95, 0x9f, 0xdf, 0x22, 0xa9, 0xa3, 0xca,
+	0xaf, 0xbf, 0xc4, 0xa5, 0x4b, 0x9a, 0xe5, 0x09, 0x4b, 0x7f, 0xbf, 0xf4, 0x17, 0x59, 0x6a, 0xd5,
+	0xf5, 0xaf, 0x83, 0x79, 0x51, 0xfd, 0xaf, 0x78, 0x31, 0xa7, 0x69, 0x9e, 0xf0, 0xe4, 0x92, 0x92,
+	0xcf, 0x3f, 0x40, 0xd8, 0x4b, 0xe8, 0x78, 0xf8, 0xeb, 0xd6, 0xe5, 0xe0, 0xba, 0xa2, 0xfe, 0x1c,
+	0x9b, 0x3c, 0xbe, 0xc8, 0x7f, 0xaf, 0x52, 0x74, 0x46, 0xe2, 0xf2, 0x45, 0x72, 0xfd, 0x1b, 0xbc,
+	0x30, 0xcd, 0xe8, 0x79, 0xf2, 0x0f, 0xf2, 0xe0, 0x83, 0x32, 0x27, 0x9d, 0x4d, 0xde, 0x1f, 0xb5,
+	0xc8, 0xae, 0xff, 0x19, 0x5b, 0xe3, 0xf8, 0x8c, 0x8e, 0xc9, 0xa3, 0x8f, 0x96, 0x1d, 0xc5, 0xe3,
+	0xd9, 0x87, 0x32, 0xc9, 0x8a, 0xfa, 0x9f, 0xb0, 0x39, 0x62, 0x39, 0xff, 0x88, 0x46, 0x21, 0xcd,
+	0x2e, 0x93, 0xc1, 0x07, 0x75, 0x32, 0xbd, 0xee, 0x63, 0x73, 0xc4, 0xf9, 0x94, 0x7c, 0xf1, 0x11,
+	0x69, 0xf9, 0x88, 0xbd, 0xa7, 0xcc, 0xd2, 0xb3, 0xb5, 0x0f, 0xd6, 0x70, 0xfe, 0x2e, 0x02, 0x09,
+	0xd4, 0x08, 0xbf, 0x3f, 0xbc, 0x48, 0xf8, 0x68, 0x76, 0x56, 0x1b, 0xb0, 0xc9, 0xce, 0x05, 0x1b,
+	0xc7, 0xe9, 0xc5, 0xc7, 0x1e, 0xd6, 0xce, 0x7b, 0x38, 0xaf, 0x65, 0xce, 0xee, 0x47, 0xa3, 0x7f,
+	0x7b, 0xbb, 0x86, 0x17, 0x88, 0x09, 0xda, 0xf7, 0x3a, 0xfe, 0xcf, 0x32, 0xd6, 0x97, 0x09, 0x02,
+	0x8d, 0x3c, 0xfb, 0xf7, 0x32, 0xec, 0x33, 0xc8, 0x67, 0xd3, 0x29, 0xcb, 0x38, 0x9c, 0xb3, 0x0c,
+	0x7a, 0x22, 0x7b, 0xc0, 0xc6, 0xd0, 0x98, 0x9d, 0x9f, 0xd3, 0x2c, 0x87, 0x27, 0xb0, 0x2f, 0xc7,
+	0x7a, 0x9c, 0xc3, 0x30, 0xe6, 0x31, 0x24, 0x29, 0xa7, 0xd9, 0x60, 0x14, 0xa7, 0x17, 0x54, 0x14,
+	0x4c, 0x62, 0x8e, 0x31, 0x34, 0xd9, 0xf4, 0x87, 0x2c, 0xb9, 0x18, 0x71, 0x78, 0xf6, 0xf4, 0xeb,
+	0x6f, 0x21, 0x1a, 0x51, 0x81, 0x6b, 0xcf, 0xf8, 0x88, 0x65, 0x79, 0x0d, 0xc0, 0x1e, 0x8f, 0x41,
+	0x9e, 0xe7, 0x90, 0xd1, 0x9c, 0x66, 0x97, 0x74, 0x58, 0xc3, 0x20, 0xa6, 0xcd, 0xeb, 0x3b, 0x3b,
+	0xbf, 0x3d, 0x2d, 0xc6, 0x10, 0xd0, 0xa1, 0x78, 0x0c, 0xc9, 0xd9, 0x4c, 0xc8, 0x08, 0x71, 0x3a,
+	0x84, 0x59, 0x4e, 0x21, 0x49, 0x21, 0x67, 0xb3, 0x6c, 0x40, 0x65, 0xe4, 0x2c, 0x49, 0xe3, 0xec,
+	0x07, 0xc9, 0x27, 0xaf, 0xc2, 0x9b, 0x84, 0x8f, 0x80, 0x65, 0xf2, 0x93, 0xcd, 0x38, 0x86, 0x09,
+	0x1b, 0x26, 0xe7, 0xc9, 0x20, 0x16, 0x08, 0x55, 0x88, 0x33, 0x0a, 0x53, 0x9a, 0x4d, 0x12, 0xce,
+	0xe9, 0x10, 0xa6, 0x19, 0xbb, 0x4c, 0x86, 0x74, 0x08, 0x7c, 0x14, 0x73, 0xe0, 0x23, 0x31, 0xd5,
+	0x78, 0xcc, 0xde, 0x24, 0xe9, 0x05, 0x0c, 0x58, 0x3a, 0x4c, 0xe4, 0xed, 0x89, 0x22, 0x0c, 0x13,
+	0xca, 0xeb, 0x18, 0x83, 0xf8, 0xdb, 0x7e, 0x8f, 0x58, 0x0e, 0xec, 0x7c, 0xce, 0x68, 0xc0, 0x86,
+	0x14, 0x26, 0xb3, 0x9c, 0x43, 0x46, 0x79, 0x9c, 0xa4, 0x12, 0x35, 0x3e, 0x63, 0x97, 0xe2, 0xa8,
+	0x10, 0x0a, 0x43, 0xca, 0x78, 0x32, 0xa0, 0x55, 0xe0, 0xa3, 0x24, 0x87, 0x71, 0x92, 0x73, 0x81,
+	0x70, 0xb3, 0x63, 0x3a, 0x7c, 0x8f, 0xce, 0x30, 0xc9, 0x07, 0xe3, 0x38, 0x99, 0xd0, 0xac, 0xf6,
+	0x5b, 0x24, 0x92, 0xf4, 0xa6, 0x16, 0x73, 0x12, 0xd3, 0x8c, 0x0d, 0x67, 0x03, 0x7a, 0xcd, 0x03,
+	0x5f, 0x13, 0xf9, 0x9f, 0x78, 0x60, 0x28, 0xa6, 0x1b, 0xb2, 0xc1, 0x6c, 0x42, 0x53, 0x1e, 0xcf,
+	0x2f, 0x69, 0x87, 0x65, 0xc0, 0xf8, 0x88, 0x66, 0x30, 0x89, 0x39, 0xcd, 0x92, 0x78, 0x9c, 0x5f,
+	0x4b, 0x2d, 0x2f, 0x88, 0x8f, 0x28, 0x86, 0x9b, 0xec, 0xaf, 0x86, 0xf2, 0x68, 0x22, 0x2b, 0x05,
+	0xb0, 0xf8, 0x5e, 0x17, 0x84, 0xd4, 0x12, 0x82, 0x9b, 0x0e, 0x6a, 0x90, 0xb2, 0xeb, 0x33, 0xa9,
+	0x7b, 0xc2, 0x73, 0x31, 0x51, 0xaa, 0xa0, 0x58, 0x96, 0xc3, 0x24, 0xfe, 0x01, 0xce, 0xa8, 0xd8,
+	0x94, 0x21, 0x70, 0x06, 0x34, 0x1d, 0xb2, 0x2c, 0xa7, 0x62, 0x29, 0xa6, 0x19, 0x9b, 0x30, 0x4e,
+	0x41, 0x69, 0xc2, 0x73, 0x18, 0xd2, 0x2c, 0xb9, 0xa4, 0x43, 0x38, 0xcf, 0xd8, 0x04, 0x2b, 0x15,
+	0x72, 0x76, 0xce, 0xdf, 0x88, 0x35, 0x29, 0x36, 0x08, 0xf2, 0x29, 0x1d, 0x88, 0x0d, 0x82, 0x69,
+	0x96, 0x88, 0xc5, 0xca, 0xc4, 0xee, 0xa4, 0x6a, 0x8b, 0xf2, 0x5c, 0x72, 0xc7, 0x10, 0xb5, 0xdd,
+	0x10, 0x42, 0x7f, 0x2f, 0x3a, 0xb6, 0x03, 0x07, 0xdc, 0x10, 0x7a, 0x81, 0x7f, 0xe4, 0xb6, 0x9c,
+	0x16, 0x34, 0x4e, 0x21, 0x6a, 0x3b, 0xd0, 0xf4, 0x7b, 0xa7, 0x81, 0xbb, 0xdf, 0x8e, 0xa0, 0xed,
+	0x77, 0x5a, 0x4e, 0x10, 0x82, 0xed, 0xb5, 0xa0, 0xe9, 0x7b, 0x51, 0xe0, 0x36, 0xfa, 0x91, 0x1f,
+	0x84, 0x18, 0x36, 0xec, 0x10, 0xdc, 0x70, 0x43, 0x9e, 0xd8, 0xde, 0x29, 0x38, 0x27, 0xbd, 0xc0,
+	0x09, 0x43, 0xf0, 0x03, 0x70, 0xbb, 0xbd, 0x8e, 0xeb, 0xb4, 0xe0, 0xd8, 0x0e, 0x02, 0xdb, 0x8b,
+	0x5c, 0x27, 0xac, 0x82, 0xeb, 0x35, 0x3b, 0xfd, 0x96, 0xeb, 0xed, 0x57, 0xa1, 0xd1, 0x8f, 0xc0,
+	0xf3, 0x23, 0x0c, 0x1d, 0xb7, 0xeb, 0x46, 0x4e, 0x0b, 0x22, 0xbf, 0x2a, 0xdb, 0x7e, 0x58, 0x07,
+	0xfe, 0x1e, 0x74, 0x9d, 0xa0, 0xd9, 0xb6, 0xbd, 0xc8, 0x6e, 0xb8, 0x1d, 0x37, 0x3a, 0x95, 0x0d,
+	0xf7, 0xdc, 0xc8, 0x13, 0xcd, 0xf6, 0xfc, 0x00, 0x83, 0x0d, 0x3d, 0x3b, 0x88, 0xdc, 0x66, 0xbf,
+	0x63, 0x07, 0xd0, 0xeb, 0x07, 0x3d, 0x3f, 0x74, 0x40, 0x4c, 0xd6, 0x72, 0xc3, 0x66, 0xc7, 0x76,
+	0xbb, 0x4e, 0xab, 0x06, 0xae, 0x07, 0x9e, 0x0f, 0xce, 0x91, 0xe3, 0x45, 0x10, 0xb6, 0xed, 0x4e,
+	0xe7, 0xd7, 0x83, 0x62, 0xf0, 0x8f, 0x3d, 0x27, 0x10, 0xec, 0x6f, 0x8e, 0x09, 0x0d, 0x07, 0x3a,
+	0xae, 0xdd, 0xe8, 0x38, 0xa2, 0x95, 0x9c, 0xb3, 0xe5, 0x06, 0x4e, 0x33, 0x12, 0x03, 0x5d, 0x5b,
+	0x4d, 0xb7, 0xe5, 0x78, 0x91, 0xdd, 0xa9, 0x62, 0x08, 0x7b, 0x4e, 0xd3, 0xb5, 0x3b, 0x55, 0x70,
+	0x4e, 0x9c, 0x6e, 0xaf, 0x63, 0x07, 0xa7, 0xd5, 0x02, 0x34, 0x74, 0x0e, 0xfb, 0x8e, 0x17, 0xb9,
+	0x76, 0x07, 0x5a, 0x76, 0xd7, 0xde, 0x77, 0x42, 0xd8, 0xfc, 0x3d, 0x55, 0x7a, 0x81, 0xdf, 0xec,
+	0x07, 0x4e, 0x57, 0xb0, 0xf6, 0xf7, 0x20, 0xec, 0x37, 0xc2, 0xc8, 0x8d, 0xfa, 0x91, 0x03, 0xfb,
+	0xbe, 0xdf, 0x92, 0x62, 0x87, 0x4e, 0x70, 0xe4, 0x36, 0x9d, 0x70, 0x17, 0x3a, 0x7e, 0x28, 0x05,
+	0xeb, 0x87, 0x4e, 0x15, 0x43, 0xcb, 0x8e, 0x6c, 0xd9, 0xba, 0x17, 0xf8, 0x7b, 0x6e, 0x14, 0xee,
+	0x0a, 0xbb, 0xd1, 0x0f, 0x5d, 0x29, 0x9c, 0xeb, 0x45, 0x4e, 0x10, 0xf4, 0x7b, 0x91, 0xeb, 0x7b,
+	0x5b, 0xd0, 0xf6, 0x8f, 0x9d, 0x23, 0x27, 0x80, 0xa6, 0xdd, 0x0f, 0x9d, 0x96, 0x54, 0xd8, 0xf7,
+	0xc4, 0xb4, 0x62, 0x57, 0x1c, 0x3f, 0x38, 0x15, 0xb0, 0x1d, 0xb7, 0xb8, 0x81, 0x2a, 0x1c, 0xb7,
+	0x9d, 0xa8, 0xed, 0x04, 0x42, 0x54, 0xa9, 0x96, 0x2d, 0x64, 0x08, 0xa3, 0xc0, 0x6d, 0x46, 0x37,
+	0xd3, 0xfc, 0x00, 0x22, 0x3f, 0x88, 0xf0, 0x8d, 0x39, 0xc1, 0x73, 0xf6, 0x3b, 0xee, 0xbe, 0xe3,
+	0x35, 0x1d, 0x71, 0xec, 0x0b, 0x98, 0x63, 0x37, 0x74, 0xb6, 0xc0, 0x0e, 0xdc, 0x50, 0x24, 0xb8,
+	0xb2, 0x31, 0x1c, 0xdb, 0xa7, 0xe0, 0xf7, 0xe5, 0xd4, 0xe2, 0xa2, 0xfa, 0xa1, 0x83, 0x95, 0x7d,
+	0x63, 0x75, 0xab, 0xf2, 0x3e, 0xc1, 0xdd, 0x03, 0xbb, 0x75, 0xe4, 0x0a, 0xe6, 0x45, 0x76, 0xcf,
+	0x0f, 0x43, 0xb7, 0x58, 0x17, 0x29, 0x5b, 0xb3, 0x5d, 0x68, 0x5e, 0xc3, 0xb8, 0x8c, 0x75, 0x83,
+	0xa0, 0x0d, 0xad, 0x22, 0xac, 0x32, 0x41, 0x5f, 0x6a, 0x23, 0xbc, 0x88, 0x8d, 0xf2, 0xd2, 0x95,
+	0x89, 0x34, 0x82, 0xfe, 0xa0, 0x6d, 0xe3, 0x45, 0xac, 0x97, 0x88, 0xb9, 0xa9, 0x7d, 0xa5, 0x8b,
+	0x68, 0x49, 0x23, 0x68, 0xcb, 0x00, 0x8c, 0x31, 0x2a, 0x69, 0x06, 0x41, 0x9b, 0xa5, 0xff, 0x57,
+	0xb6, 0x29, 0xe2, 0x58, 0xd9, 0x16, 0x41, 0x5b, 0x4b, 0xab, 0xca, 0xd6, 0x09, 0xda, 0x22, 0x9f,
+	0x29, 0x1b, 0x11, 0xb4, 0xb5, 0xfe, 0x50, 0xc2, 0xe8, 0x04, 0x6d, 0x1b, 0x3b, 0x32, 0xac, 0xdf,
+	0x80, 0xd1, 0x4d, 0x11, 0x57, 0x30, 0xba, 0x45, 0xd0, 0xf6, 0xd2, 0x8a, 0xb2, 0x45, 0xfe, 0x6a,
+	0x45, 0xd9, 0x88, 0xa0, 0xed, 0xfb, 0x8a, 0x82, 0xa0, 0xbe, 0xfd, 0xa8, 0xa6, 0xec, 0x12, 0x41,
+	0xdb, 0x1b, 0x4f, 0x0a, 0xc2, 0x4f, 0xb4, 0xa7, 0x8a, 0xb0, 0x41, 0x50, 0xcd, 0xd8, 0x90, 0x19,
+	0x86, 0x41, 0xd0, 0x93, 0xd2, 0xff, 0x29, 0xdb, 0x14, 0x71, 0xd5, 0xc9, 0xb0, 0x08, 0xaa, 0x2d,
+	0xdd, 0x52, 0xb6, 0x4e, 0x50, 0x6d, 0x65, 0x4d, 0xd9, 0x88, 0xa0, 0xda, 0x83, 0x47, 0x12, 0x06,
+	0x11, 0xb4, 0x63, 0x3c, 0x94, 0x61, 0x74, 0x03, 0x06, 0x99, 0x22, 0xae, 0x60, 0x90, 0x45, 0xd0,
+	0x4e, 0x31, 0x37, 0xd2, 0x09, 0xda, 0x21, 0xf7, 0x94, 0x2d, 0x6a, 0xd7, 0xbe, 0x28, 0x88, 0x3d,
+	0xd3, 0xfe, 0xa8, 0x88, 0x99, 0x04, 0x3d, 0x37, 0x1e, 0xc9, 0x0c, 0xd3, 0x20, 0xe8, 0x59, 0x49,
+	0x91, 0x34, 0x65, 0x5c, 0x21, 0x9a, 0x16, 0x41, 0xcf, 0x0b, 0x44, 0x53, 0x27, 0xe8, 0x39, 0x51,
+	0x12, 0x98, 0x88, 0xa0, 0xe7, 0xf7, 0xa1, 0x40, 0xfc, 0x46, 0xfb, 0x56, 0x21, 0x5a, 0x04, 0xbd,
+	0x28, 0xee, 0xc6, 0x32, 0x08, 0xfa, 0xa6, 0xf4, 0x58, 0xd9, 0xa6, 0x88, 0x2b, 0x44, 0x4b, 0xe4,
+	0x14, 0x88, 0x96, 0x4e, 0xd0, 0x8b, 0xe2, 0x6e, 0x2c, 0x44, 0xd0, 0x8b, 0xf5, 0x87, 0x05, 0x62,
+	0x5d, 0xfb, 0x8b, 0x42, 0x5c, 0x20, 0x68, 0xb7, 0x98, 0x7a, 0xc1, 0x20, 0xa8, 0x5e, 0x5c, 0xd3,
+	0x82, 0x29, 0xe2, 0x0a, 0x71, 0xc1, 0x22, 0x68, 0xb7, 0x40, 0x5c, 0xd0, 0x09, 0xda, 0x2d, 0xa6,
+	0x5e, 0x40, 0x04, 0xed, 0x5e, 0x4d, 0xfd, 0x52, 0xfb, 0x4e, 0x21, 0x96, 0x08, 0x7a, 0x55, 0x4c,
+	0x2d, 0xae, 0xe6, 0x65, 0xe9, 0x4b, 0x65, 0x9b, 0x22, 0xae, 0x10, 0x45, 0xd7, 0x57, 0x4b, 0xb7,
+	0x95, 0xad, 0x13, 0xf4, 0xea, 0x8e, 0x9a, 0x5a, 0xdc, 0xc1, 0x2b, 0x79, 0xf1, 0x86, 0xa9, 0x11,
+	0xb3, 0xa1, 0x39, 0xba, 0x88, 0x9b, 0x62, 0xc7, 0x1a, 0xe5, 0x15, 0xbc, 0x84, 0x4d, 0x53, 0x33,
+	0x34, 0x82, 0x9a, 0xc6, 0x3a, 0x5e, 0xc6, 0x96, 0x70, 0x4c, 0xe1, 0xe1, 0xb9, 0x67, 0x11, 0xd4,
+	0x5c, 0x5a, 0x9d, 0x7b, 0x3a, 0x41, 0x4d, 0x72, 0x77, 0xee, 0x21, 0x82, 0x9a, 0x95, 0xb5, 0x02,
+	0x44, 0x27, 0xa8, 0x65, 0xdc, 0x2f, 0x8e, 0xc4, 0x52, 0xb6, 0xae, 0x40, 0xc4, 0x5a, 0xb6, 0xae,
+	0x40, 0xc4, 0x62, 0xb6, 0xc8, 0xbd, 0xb9, 0x87, 0x08, 0x6a, 0xad, 0xad, 0x4b, 0x86, 0x3a, 0x31,
+	0xf7, 0xb5, 0x50, 0x31, 0x14, 0x59, 0xfb, 0xe5, 0x65, 0x65, 0x97, 0x08, 0x6a, 0x1b, 0xf7, 0xf1,
+	0x27, 0x78, 0xc1, 0xd4, 0x4b, 0x3f, 0xbf, 0x45, 0xca, 0x9d, 0x1f, 0xb9, 0xc6, 0x7d, 0xc9, 0x41,
+	0x2f, 0xa1, 0x1b, 0x8e, 0x98, 0xea, 0xc0, 0x38, 0x94, 0x6d, 0x74, 0x39, 0xd5, 0x41, 0x41, 0x48,
+	0x97, 0x53, 0x1d, 0x14, 0x84, 0x74, 0x39, 0xd5, 0x41, 0x41, 0x48, 0x97, 0x53, 0x1d, 0xac, 0xad,
+	0xcf, 0xbd, 0x32, 0x41, 0x07, 0xf7, 0x7b, 0xf8, 0x16, 0x2e, 0x4b, 0x4f, 0x36, 0x3f, 0x78, 0x50,
+	0xc3, 0x2b, 0x78, 0x51, 0x06, 0x7e, 0x79, 0x2b, 0x5e, 0xfd, 0xc1, 0xd3, 0x97, 0x37, 0x23, 0x02,
+	0xf0, 0x3b, 0xbf, 0xe0, 0xa1, 0x13, 0xd4, 0x31, 0x2a, 0x05, 0x9e, 0x10, 0xa6, 0x73, 0xc5, 0x43,
+	0x08, 0xd3, 0x59, 0x5a, 0x99, 0x7b, 0x22, 0x73, 0xf5, 0xf6, 0xdc, 0x43, 0x04, 0x75, 0xee, 0x7d,
+	0x56, 0x80, 0x18, 0x04, 0x75, 0x8d, 0xb5, 0xe2, 0x48, 0x3c, 0xc4, 0xee, 0x15, 0x88, 0xb1, 0x40,
+	0x50, 0x77, 0xe9, 0xd6, 0xdc, 0xd3, 0x09, 0xea, 0xae, 0xdc, 0x99, 0x7b, 0x88, 0xa0, 0xee, 0x67,
+	0xa2, 0xb9, 0x69, 0xea, 0x65, 0x8d, 0x98, 0xbe, 0x11, 0x20, 0x75, 0x56, 0x16, 0x63, 0xfb, 0xe5,
+	0x5b, 0x45, 0x03, 0x44, 0x50, 0xcf, 0xdc, 0x2e, 0xca, 0xc4, 0xb3, 0xec, 0x99, 0xf3, 0x06, 0x62,
+	0x9c, 0xde, 0x15, 0x4b, 0xf1, 0x34, 0x7b, 0xb7, 0xe7, 0x0d, 0x50, 0x99, 0xa0, 0xde, 0xdd, 0xad,
+	0x42, 0x1f, 0xa4, 0xf4, 0xe9, 0xdd, 0xdb, 0x2c, 0x50, 0x4d, 0x82, 0x0e, 0xcd, 0xbb, 0x45, 0xae,
+	0x78, 0x9a, 0x87, 0x57, 0xa8, 0xe2, 0x71, 0x1e, 0x5e, 0xa1, 0x8a, 0xe7, 0x79, 0x78, 0xfb, 0x8e,
+	0x58, 0x03, 0x4b, 0x23, 0x66, 0x5f, 0xfc, 0x8c, 0xc7, 0x18, 0x59, 0x82, 0x64, 0xdf, 0x5a, 0x54,
+	0x36, 0x22, 0xe8, 0xc8, 0x78, 0x20, 0xd6, 0xc0, 0xd2, 0xd0, 0xcf, 0x6f, 0x0b, 0x77, 0x09, 0x9b,
+	0x96, 0xdc, 0xe1, 0x13, 0x63, 0x4b, 0xa0, 0x59, 0x6a, 0x4f, 0x4f, 0x8c, 0x4f, 0xe7, 0x9e, 0x41,
+	0xd0, 0xc9, 0x2a, 0x99, 0x7b, 0x88, 0xa0, 0x93, 0xdb, 0x9b, 0x82, 0xb1, 0xf4, 0x24, 0xce, 0xc9,
+	0x9d, 0xc7, 0x05, 0x8e, 0x4e, 0xd0, 0xa9, 0xf1, 0xa4, 0xc8, 0xd5, 0xa5, 0xb7, 0x3c, 0xf7, 0x0c,
+	0x82, 0x4e, 0x6f, 0xad, 0xcc, 0x3d, 0x44, 0xd0, 0xe9, 0x6a, 0xb5, 0xc0, 0xd1, 0x15, 0xce, 0x29,
+	0xf9, 0xea, 0xbf, 0x03, 0x00, 0x0a, 0x78, 0x78, 0x5f, 0x4f, 0x10, 0x00, 0x00,
+}
@@ -0,0 +1,280 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package descriptor
+
+// This file implements access to the options set on descriptors.
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	protobuf "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// getOption returns the value of the extension ext in opts, which may be
+// a nil pointer.
+func getOption(opts proto.Message, ext *proto.ExtensionDesc) (interface{}, error) {
+	if v := reflect.ValueOf(opts); v.IsNil() {
+		opts = reflect.New(v.Type().Elem()).Interface().(proto.Message)
+	}
+	return proto.GetExtension(opts, ext)
+}
+
+// MessageOption returns the value of the custom option ext, an extension
+// of google.protobuf.MessageOptions, set on the message type of msg.
+// As with proto.GetExtension, it returns the default value of ext, or
+// proto.ErrMissingExtension if it has none, when the option is not set.
+func MessageOption(msg Message, ext *proto.ExtensionDesc) (interface{}, error) {
+	_, md := ForMessage(msg)
+	return getOption(md.GetOptions(), ext)
+}
+
+// FieldOption returns the value of the custom option ext, an extension of
+// google.protobuf.FieldOptions, set on the field of msg held in the Go
+// struct field with the given name, as for ForField.
+func FieldOption(msg Message, field string, ext *proto.ExtensionDesc) (interface{}, error) {
+	fd := ForField(msg, field)
+	if fd == nil {
+		return nil, fmt.Errorf("descriptor: %T has no field %s", msg, field)
+	}
+	return getOption(fd.GetOptions(), ext)
+}
+
+// EnumOption returns the value of the custom option ext, an extension of
+// google.protobuf.EnumOptions, set on the enum type of e.
+func EnumOption(e Enum, ext *proto.ExtensionDesc) (interface{}, error) {
+	_, ed := ForEnum(e)
+	return getOption(ed.GetOptions(), ext)
+}
+
+// EnumValueOption returns the value of the custom option ext, an extension
+// of google.protobuf.EnumValueOptions, set on the value e. If several
+// values of the enum share the number of e, the first one is used.
+func EnumValueOption(e Enum, ext *proto.ExtensionDesc) (interface{}, error) {
+	vd := forEnumValue(e)
+	if vd == nil {
+		return nil, fmt.Errorf("descriptor: %T has no value %v", e, e)
+	}
+	return getOption(vd.GetOptions(), ext)
+}
+
+// forEnumValue returns the EnumValueDescriptorProto of e, or nil if e is
+// not a value of its enum.
+func forEnumValue(e Enum) *protobuf.EnumValueDescriptorProto {
+	_, ed := ForEnum(e)
+	n := reflect.ValueOf(e).Int()
+	for _, vd := range ed.Value {
+		if int64(vd.GetNumber()) == n {
+			return vd
+		}
+	}
+	return nil
+}
+
+// ServiceOption returns the value of the custom option ext, an extension
+// of google.protobuf.ServiceOptions, set on the service with the given full
+// name defined in the registered file with the given name, as for
+// ForService.
+func ServiceOption(filename, service string, ext *proto.ExtensionDesc) (interface{}, error) {
+	_, sd := ForService(filename, service)
+	if sd == nil {
+		return nil, fmt.Errorf("descriptor: no service %s in %s", service, filename)
+	}
+	return getOption(sd.GetOptions(), ext)
+}
+
+// MethodOption returns the value of the custom option ext, an extension
+// of google.protobuf.MethodOptions, set on a method of a service defined
+// in the registered file with the given name, as for ForMethod.
+func MethodOption(filename, method string, ext *proto.ExtensionDesc) (interface{}, error) {
+	_, md := ForMethod(filename, method)
+	if md == nil {
+		return nil, fmt.Errorf("descriptor: no method %s in %s", method, filename)
+	}
+	return getOption(md.GetOptions(), ext)
+}
+
+// An Option is an option set in an options message such as
+// google.protobuf.MessageOptions.
+type Option struct {
+	// Number is the field number of the option.
+	Number int32
+
+	// Name is the name of a standard option, such as "deprecated", or the
+	// full name of a custom option whose extension is linked in, such as
+	// "foo.bar.my_option". It is empty for unknown options.
+	Name string
+
+	// Extension describes a custom option whose extension is linked in,
+	// and is nil for other options.
+	Extension *proto.ExtensionDesc
+
+	// Value is the value of the option, of the type of its Go struct field
+	// or extension, with scalar pointers dereferenced. For an unknown
+	// option, it is the []byte of its wire-format encoding, including the
+	// field keys.
+	Value interface{}
+}
+
+// Options returns every option set in opts, an options message such as
+// google.protobuf.MessageOptions, sorted by field number. It includes
+// custom options whose extensions are not linked in, which are kept as
+// unknown bytes when the descriptor is decoded. opts may be nil.
+func Options(opts proto.Message) ([]Option, error) {
+	v := reflect.ValueOf(opts)
+	if opts == nil || v.IsNil() {
+		return nil, nil
+	}
+	var options []Option
+	sv := v.Elem()
+	sprops := proto.GetProperties(sv.Type())
+	for i := 0; i < sv.NumField(); i++ {
+		f := sv.Type().Field(i)
+		fv := sv.Field(i)
+		if f.Name == "XXX_unrecognized" {
+			unknown, err := unknownOptions(fv.Bytes())
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, unknown...)
+			continue
+		}
+		if strings.HasPrefix(f.Name, "XXX_") || fv.Kind() == reflect.Slice && fv.Len() == 0 {
+			continue
+		}
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				continue
+			}
+			if fv.Elem().Kind() != reflect.Struct {
+				fv = fv.Elem()
+			}
+		}
+		p := sprops.Prop[i]
+		options = append(options, Option{Number: int32(p.Tag), Name: p.OrigName, Value: fv.Interface()})
+	}
+
+	descs, err := proto.ExtensionDescs(opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, desc := range descs {
+		x, err := proto.GetExtension(opts, desc)
+		if err != nil {
+			return nil, err
+		}
+		o := Option{Number: desc.Field, Value: x}
+		if desc.ExtensionType != nil {
+			o.Name, o.Extension = desc.Name, desc
+		}
+		options = append(options, o)
+	}
+	sort.SliceStable(options, func(i, j int) bool { return options[i].Number < options[j].Number })
+	return options, nil
+}
+
+// unknownOptions splits unknown fields into an Option per field number.
+func unknownOptions(b []byte) ([]Option, error) {
+	var options []Option
+	index := make(map[int32]int)
+	for len(b) > 0 {
+		n, err := fieldLen(b)
+		if err != nil {
+			return nil, err
+		}
+		key, _ := proto.DecodeVarint(b)
+		field := int32(key >> 3)
+		if i, ok := index[field]; ok {
+			options[i].Value = append(options[i].Value.([]byte), b[:n]...)
+		} else {
+			index[field] = len(options)
+			options = append(options, Option{Number: field, Value: append([]byte(nil), b[:n]...)})
+		}
+		b = b[n:]
+	}
+	return options, nil
+}
+
+var errTruncated = errors.New("descriptor: truncated unknown option")
+
+// fieldLen returns the length of the encoded field, key included, at the
+// start of b.
+func fieldLen(b []byte) (int, error) {
+	key, n := proto.DecodeVarint(b)
+	if n == 0 {
+		return 0, errTruncated
+	}
+	switch wire := key & 7; wire {
+	case proto.WireVarint:
+		if _, m := proto.DecodeVarint(b[n:]); m > 0 {
+			return n + m, nil
+		}
+	case proto.WireFixed64:
+		if len(b) >= n+8 {
+			return n + 8, nil
+		}
+	case proto.WireBytes:
+		l, m := proto.DecodeVarint(b[n:])
+		if m > 0 && l <= uint64(len(b)-n-m) {
+			return n + m + int(l), nil
+		}
+	case proto.WireFixed32:
+		if len(b) >= n+4 {
+			return n + 4, nil
+		}
+	case proto.WireStartGroup:
+		for i := n; i < len(b); {
+			k, m := proto.DecodeVarint(b[i:])
+			if m == 0 {
+				break
+			}
+			if k&7 == proto.WireEndGroup {
+				if k>>3 != key>>3 {
+					return 0, fmt.Errorf("descriptor: mismatched end of group %d", key>>3)
+				}
+				return i + m, nil
+			}
+			l, err := fieldLen(b[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += l
+		}
+	default:
+		return 0, fmt.Errorf("descriptor: bad wire type %d in unknown option", wire)
+	}
+	return 0, errTruncated
+}
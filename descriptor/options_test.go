@@ -0,0 +1,179 @@
+package descriptor_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	optpb "github.com/golang/protobuf/descriptor/descriptor_test_proto"
+	"github.com/golang/protobuf/proto"
+	protobuf "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+func TestTypedOptions(t *testing.T) {
+	tests := []struct {
+		desc string
+		get  func() (interface{}, error)
+		want interface{}
+	}{
+		{"message", func() (interface{}, error) { return descriptor.MessageOption(&optpb.User{}, optpb.E_Table) }, proto.String("users")},
+		{"message default", func() (interface{}, error) { return descriptor.MessageOption(&optpb.User{}, optpb.E_Version) }, proto.Int32(1)},
+		{"field", func() (interface{}, error) { return descriptor.FieldOption(&optpb.User{}, "Name", optpb.E_Sensitive) }, proto.Bool(true)},
+		{"repeated", func() (interface{}, error) { return descriptor.FieldOption(&optpb.User{}, "Name", optpb.E_Tags) }, []string{"pii", "name"}},
+		{"oneof field", func() (interface{}, error) { return descriptor.FieldOption(&optpb.User{}, "Email", optpb.E_Sensitive) }, proto.Bool(true)},
+		{"enum", func() (interface{}, error) { return descriptor.EnumOption(optpb.Role_ROLE_ADMIN, optpb.E_Prefix) }, proto.String("ROLE_")},
+		{"enum value", func() (interface{}, error) { return descriptor.EnumValueOption(optpb.Role_ROLE_ADMIN, optpb.E_Label) }, proto.String("Administrator")},
+	}
+	for _, tt := range tests {
+		got, err := tt.get()
+		if err != nil {
+			t.Errorf("%s: %v", tt.desc, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.desc, got, tt.want)
+		}
+	}
+
+	if _, err := descriptor.FieldOption(&optpb.User{}, "Id", optpb.E_Sensitive); err != proto.ErrMissingExtension {
+		t.Errorf("unset field option: got error %v, want %v", err, proto.ErrMissingExtension)
+	}
+	if _, err := descriptor.FieldOption(&optpb.User{}, "Role", optpb.E_Sensitive); err != proto.ErrMissingExtension {
+		t.Errorf("field without options: got error %v, want %v", err, proto.ErrMissingExtension)
+	}
+	if _, err := descriptor.FieldOption(&optpb.User{}, "Nope", optpb.E_Sensitive); err == nil {
+		t.Errorf("option of a missing field succeeded")
+	}
+	if _, err := descriptor.MessageOption(&optpb.User{}, optpb.E_Sensitive); err == nil {
+		t.Errorf("MessageOption with a FieldOptions extension succeeded")
+	}
+	if _, err := descriptor.EnumValueOption(optpb.Role(7), optpb.E_Label); err == nil {
+		t.Errorf("EnumValueOption of an undefined value succeeded")
+	}
+}
+
+// registerOptionsFile registers a file with a service, which generated
+// Go code for test_options.proto would need gRPC for, and with an option
+// whose extension is not linked in.
+func registerOptionsFile(t *testing.T) {
+	sopts := &protobuf.ServiceOptions{Deprecated: proto.Bool(true)}
+	if err := proto.SetExtension(sopts, optpb.E_Host, proto.String("users.example.com")); err != nil {
+		t.Fatal(err)
+	}
+	// Field 50099, a string "x", is not a linked-in extension.
+	proto.SetRawExtension(sopts, 50099, []byte{0x9a, 0xbb, 0x18, 0x01, 'x'})
+	mopts := &protobuf.MethodOptions{}
+	if err := proto.SetExtension(mopts, optpb.E_Http, &optpb.HttpRule{Get: proto.String("/users/{id}")}); err != nil {
+		t.Fatal(err)
+	}
+	file := &protobuf.FileDescriptorProto{
+		Name:    proto.String("descriptor_test/options_service.proto"),
+		Package: proto.String("descriptor_test"),
+		Service: []*protobuf.ServiceDescriptorProto{{
+			Name:    proto.String("Users"),
+			Options: sopts,
+			Method: []*protobuf.MethodDescriptorProto{{
+				Name:    proto.String("Get"),
+				Options: mopts,
+			}},
+		}},
+	}
+	b, err := proto.Marshal(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(b)
+	zw.Close()
+	proto.RegisterFile(file.GetName(), buf.Bytes())
+}
+
+func TestServiceOptions(t *testing.T) {
+	registerOptionsFile(t)
+	const file = "descriptor_test/options_service.proto"
+
+	host, err := descriptor.ServiceOption(file, "descriptor_test.Users", optpb.E_Host)
+	if err != nil || *host.(*string) != "users.example.com" {
+		t.Errorf("ServiceOption = %v, %v", host, err)
+	}
+	rule, err := descriptor.MethodOption(file, "/descriptor_test.Users/Get", optpb.E_Http)
+	if err != nil || rule.(*optpb.HttpRule).GetGet() != "/users/{id}" {
+		t.Errorf("MethodOption = %v, %v", rule, err)
+	}
+	if _, err := descriptor.ServiceOption(file, "descriptor_test.Nope", optpb.E_Host); err == nil {
+		t.Errorf("option of a missing service succeeded")
+	}
+	if _, err := descriptor.MethodOption(file, "/descriptor_test.Users/Nope", optpb.E_Http); err == nil {
+		t.Errorf("option of a missing method succeeded")
+	}
+
+	_, sd := descriptor.ForService(file, "descriptor_test.Users")
+	opts, err := descriptor.Options(sd.GetOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []descriptor.Option{
+		{Number: 33, Name: "deprecated", Value: true},
+		{Number: 50000, Name: "descriptor_test.host", Extension: optpb.E_Host, Value: proto.String("users.example.com")},
+		{Number: 50099, Value: []byte{0x9a, 0xbb, 0x18, 0x01, 'x'}},
+	}
+	if !reflect.DeepEqual(opts, want) {
+		t.Errorf("Options = %+v, want %+v", opts, want)
+	}
+}
+
+func TestOptions(t *testing.T) {
+	_, md := descriptor.ForMessage(&optpb.User{})
+	opts, err := descriptor.Options(md.GetOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []descriptor.Option{
+		{Number: 3, Name: "deprecated", Value: true},
+		{Number: 50000, Name: "descriptor_test.table", Extension: optpb.E_Table, Value: proto.String("users")},
+	}
+	if !reflect.DeepEqual(opts, want) {
+		t.Errorf("Options = %+v, want %+v", opts, want)
+	}
+
+	// Unknown fields outside the extension ranges are reported too.
+	fopts := &protobuf.FieldOptions{
+		Packed:           proto.Bool(true),
+		XXX_unrecognized: []byte{0xc8, 0x3e, 0x05, 0x0b, 0x08, 0x01, 0x0c, 0xc8, 0x3e, 0x06},
+	}
+	opts, err = descriptor.Options(fopts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []descriptor.Option{
+		{Number: 1, Value: []byte{0x0b, 0x08, 0x01, 0x0c}},
+		{Number: 2, Name: "packed", Value: true},
+		{Number: 1001, Value: []byte{0xc8, 0x3e, 0x05, 0xc8, 0x3e, 0x06}},
+	}
+	if !reflect.DeepEqual(opts, want) {
+		t.Errorf("Options = %+v, want %+v", opts, want)
+	}
+
+	if opts, err := descriptor.Options((*protobuf.MessageOptions)(nil)); opts != nil || err != nil {
+		t.Errorf("Options(nil) = %v, %v", opts, err)
+	}
+	fopts.XXX_unrecognized = []byte{0x0a, 0x05}
+	if _, err := descriptor.Options(fopts); err == nil {
+		t.Errorf("Options with truncated unknown fields succeeded")
+	}
+}
+
+func ExampleFieldOption() {
+	for _, field := range []string{"Name", "Id"} {
+		if v, err := descriptor.FieldOption(&optpb.User{}, field, optpb.E_Sensitive); err == nil && *v.(*bool) {
+			fmt.Printf("%s is sensitive.\n", field)
+		}
+	}
+
+	// Output:
+	// Name is sensitive.
+}
@@ -0,0 +1,438 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package parser
+
+// This file links a parsed file to its imports, as protoc's
+// DescriptorBuilder does: it checks the file, resolves type names, fills
+// in the types of fields and their JSON names, and interprets options.
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/descriptor/builder"
+	"github.com/golang/protobuf/descriptor/registry"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+type symbolKind int
+
+const (
+	symPackage symbolKind = iota
+	symMessage
+	symEnum
+	symEnumValue
+	symField
+	symOneof
+	symService
+	symMethod
+)
+
+// A symbol is a named element of a file.
+type symbol struct {
+	kind    symbolKind
+	file    *descpb.FileDescriptorProto
+	message *descpb.DescriptorProto      // for symMessage
+	enum    *descpb.EnumDescriptorProto  // for symEnum and symEnumValue
+	field   *descpb.FieldDescriptorProto // for symField
+}
+
+func (s symbol) isType() bool {
+	return s.kind == symMessage || s.kind == symEnum
+}
+
+// isAggregate reports whether the symbol may contain other symbols.
+func (s symbol) isAggregate() bool {
+	return s.kind == symPackage || s.kind == symMessage || s.kind == symEnum || s.kind == symService
+}
+
+// symbols maps full names, without a leading dot, to symbols.
+type symbols map[string]symbol
+
+// addFile adds the symbols declared in fd. Symbols already defined are
+// kept: conflicts are reported by builder.Validate.
+func (syms symbols) addFile(fd *descpb.FileDescriptorProto) {
+	add := func(name string, sym symbol) {
+		if _, ok := syms[name]; !ok {
+			sym.file = fd
+			syms[name] = sym
+		}
+	}
+	pkg := fd.GetPackage()
+	for s := pkg; s != ""; s = parentScope(s) {
+		add(s, symbol{kind: symPackage})
+	}
+	var addEnum func(scope string, ed *descpb.EnumDescriptorProto)
+	addEnum = func(scope string, ed *descpb.EnumDescriptorProto) {
+		add(joinName(scope, ed.GetName()), symbol{kind: symEnum, enum: ed})
+		for _, vd := range ed.Value {
+			// Enum values are siblings of their enum.
+			add(joinName(scope, vd.GetName()), symbol{kind: symEnumValue, enum: ed})
+		}
+	}
+	var addMessage func(scope string, md *descpb.DescriptorProto)
+	addMessage = func(scope string, md *descpb.DescriptorProto) {
+		name := joinName(scope, md.GetName())
+		add(name, symbol{kind: symMessage, message: md})
+		for _, f := range md.Field {
+			add(joinName(name, f.GetName()), symbol{kind: symField, field: f})
+		}
+		for _, od := range md.OneofDecl {
+			add(joinName(name, od.GetName()), symbol{kind: symOneof})
+		}
+		for _, xd := range md.Extension {
+			add(joinName(name, xd.GetName()), symbol{kind: symField, field: xd})
+		}
+		for _, nd := range md.NestedType {
+			addMessage(name, nd)
+		}
+		for _, ed := range md.EnumType {
+			addEnum(name, ed)
+		}
+	}
+	for _, md := range fd.MessageType {
+		addMessage(pkg, md)
+	}
+	for _, ed := range fd.EnumType {
+		addEnum(pkg, ed)
+	}
+	for _, xd := range fd.Extension {
+		add(joinName(pkg, xd.GetName()), symbol{kind: symField, field: xd})
+	}
+	for _, sd := range fd.Service {
+		name := joinName(pkg, sd.GetName())
+		add(name, symbol{kind: symService})
+		for _, md := range sd.Method {
+			add(joinName(name, md.GetName()), symbol{kind: symMethod})
+		}
+	}
+}
+
+// resolve looks up name, as written in the element relativeTo, following
+// protoc's scoping rules: the innermost scope holding the first component
+// of name is searched for the rest of it. If typesOnly, symbols other than
+// types are skipped over when the name has a single component.
+func (syms symbols) resolve(relativeTo, name string, typesOnly bool) (string, symbol, bool) {
+	if strings.HasPrefix(name, ".") {
+		sym, ok := syms[name[1:]]
+		return name[1:], sym, ok
+	}
+	first := name
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		first = name[:i]
+	}
+	scope := relativeTo
+	for {
+		i := strings.LastIndexByte(scope, '.')
+		if i < 0 {
+			sym, ok := syms[name]
+			return name, sym, ok
+		}
+		scope = scope[:i]
+		sym, ok := syms[scope+"."+first]
+		if !ok {
+			continue
+		}
+		if first != name {
+			if sym.isAggregate() {
+				full := scope + "." + name
+				sym, ok := syms[full]
+				return full, sym, ok
+			}
+		} else if !typesOnly || sym.isType() {
+			return scope + "." + name, sym, true
+		}
+	}
+}
+
+func joinName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+func parentScope(name string) string {
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		return name[:i]
+	}
+	return ""
+}
+
+// A pool holds the symbols of every file loaded.
+type pool struct {
+	syms symbols
+}
+
+func newPool() *pool {
+	return &pool{syms: make(symbols)}
+}
+
+func (p *pool) addFile(fd *descpb.FileDescriptorProto) {
+	p.syms.addFile(fd)
+}
+
+// message returns the message with the given full name. Messages of
+// registered files, such as the options messages of descriptor.proto, are
+// found even if no file loaded imports them.
+func (p *pool) message(name string) (symbol, bool) {
+	name = strings.TrimPrefix(name, ".")
+	if sym, ok := p.syms[name]; ok {
+		return sym, sym.kind == symMessage
+	}
+	fd, _, err := registry.FindMessage(name)
+	if err != nil {
+		return symbol{}, false
+	}
+	p.addFile(fd)
+	sym, ok := p.syms[name]
+	return sym, ok && sym.kind == symMessage
+}
+
+// enum returns the enum with the given full name.
+func (p *pool) enum(name string) (symbol, bool) {
+	sym, ok := p.syms[strings.TrimPrefix(name, ".")]
+	return sym, ok && sym.kind == symEnum
+}
+
+// A linker links one file.
+type linker struct {
+	l    *loader
+	fd   *descpb.FileDescriptorProto
+	syms symbols // symbols visible in fd
+}
+
+// link links fd, whose imports are deps, reporting whether it succeeded.
+func (l *loader) link(fd *descpb.FileDescriptorProto, deps []*descpb.FileDescriptorProto) bool {
+	visible := l.visible(deps)
+	lk := &linker{l: l, fd: fd, syms: make(symbols)}
+	lk.syms.addFile(fd)
+	for _, dep := range visible {
+		lk.syms.addFile(dep)
+	}
+	l.pool.addFile(fd)
+
+	n := len(l.errs)
+	lk.resolveFile()
+	if len(l.errs) == n {
+		lk.interpretOptions()
+	}
+	if len(l.errs) > n {
+		return false
+	}
+
+	// Options, such as allow_alias and packed, must be interpreted before
+	// the file is checked.
+	if err := builder.Validate(fd, visible...); err != nil {
+		l.validationErrors(fd, err)
+		return false
+	}
+	return true
+}
+
+// visible returns deps and the files they import publicly.
+func (l *loader) visible(deps []*descpb.FileDescriptorProto) []*descpb.FileDescriptorProto {
+	var files []*descpb.FileDescriptorProto
+	seen := make(map[string]bool)
+	var add func(fd *descpb.FileDescriptorProto)
+	add = func(fd *descpb.FileDescriptorProto) {
+		if fd == nil || seen[fd.GetName()] {
+			return
+		}
+		seen[fd.GetName()] = true
+		files = append(files, fd)
+		for _, i := range fd.PublicDependency {
+			if int(i) < len(fd.Dependency) {
+				add(l.files[fd.Dependency[i]])
+			}
+		}
+	}
+	for _, dep := range deps {
+		add(dep)
+	}
+	return files
+}
+
+// validationErrors reports the errors of builder.Validate at the names of
+// the elements they concern.
+func (l *loader) validationErrors(fd *descpb.FileDescriptorProto, err error) {
+	errs, ok := err.(builder.Errors)
+	if !ok {
+		l.errs = append(l.errs, &Error{Filename: fd.GetName(), Msg: err.Error()})
+		return
+	}
+	for _, e := range errs {
+		path := e.Path
+		if name := appendPath(path, 1); findLocation(fd.SourceCodeInfo, name) != nil {
+			path = name
+		}
+		msg := e.Msg
+		if e.Element != "" {
+			msg = e.Element + ": " + msg
+		}
+		l.errorf(fd, path, "%s", msg)
+	}
+}
+
+func appendPath(path []int32, elems ...int32) []int32 {
+	return append(append([]int32(nil), path...), elems...)
+}
+
+func (lk *linker) resolveFile() {
+	pkg := lk.fd.GetPackage()
+	for i, md := range lk.fd.MessageType {
+		lk.resolveMessage([]int32{fileMessageTag, int32(i)}, joinName(pkg, md.GetName()), md)
+	}
+	for i, f := range lk.fd.Extension {
+		lk.resolveField([]int32{fileExtensionTag, int32(i)}, joinName(pkg, f.GetName()), f)
+	}
+	for i, sd := range lk.fd.Service {
+		name := joinName(pkg, sd.GetName())
+		for j, md := range sd.Method {
+			path := []int32{fileServiceTag, int32(i), serviceMethodTag, int32(j)}
+			rel := joinName(name, md.GetName())
+			if full, ok := lk.resolveType(appendPath(path, methodInputTag), rel, md.GetInputType()); ok {
+				md.InputType = proto.String("." + full)
+			}
+			if full, ok := lk.resolveType(appendPath(path, methodOutputTag), rel, md.GetOutputType()); ok {
+				md.OutputType = proto.String("." + full)
+			}
+		}
+	}
+}
+
+func (lk *linker) resolveMessage(path []int32, name string, md *descpb.DescriptorProto) {
+	for i, f := range md.Field {
+		lk.resolveField(appendPath(path, messageFieldTag, int32(i)), joinName(name, f.GetName()), f)
+	}
+	for i, f := range md.Extension {
+		lk.resolveField(appendPath(path, messageExtensionTag, int32(i)), joinName(name, f.GetName()), f)
+	}
+	for i, nd := range md.NestedType {
+		lk.resolveMessage(appendPath(path, messageNestedTag, int32(i)), joinName(name, nd.GetName()), nd)
+	}
+}
+
+func (lk *linker) resolveField(path []int32, name string, f *descpb.FieldDescriptorProto) {
+	if f.JsonName == nil {
+		f.JsonName = proto.String(builder.JSONName(f.GetName()))
+	}
+	if f.Extendee != nil {
+		if full, ok := lk.resolveType(appendPath(path, fieldExtendeeTag), name, f.GetExtendee()); ok {
+			f.Extendee = proto.String("." + full)
+		}
+	}
+	if f.TypeName != nil {
+		full, ok := lk.resolveType(appendPath(path, fieldTypeNameTag), name, f.GetTypeName())
+		if !ok {
+			return
+		}
+		f.TypeName = proto.String("." + full)
+		if f.Type == nil {
+			if lk.syms[full].kind == symMessage {
+				f.Type = descpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			} else {
+				f.Type = descpb.FieldDescriptorProto_TYPE_ENUM.Enum()
+			}
+		}
+	}
+	if f.DefaultValue != nil {
+		switch f.GetType() {
+		case descpb.FieldDescriptorProto_TYPE_FLOAT:
+			f.DefaultValue = proto.String(simpleFtoa(float32(parseDefault(f.GetDefaultValue()))))
+		case descpb.FieldDescriptorProto_TYPE_DOUBLE:
+			f.DefaultValue = proto.String(simpleDtoa(parseDefault(f.GetDefaultValue())))
+		}
+	}
+}
+
+// resolveType resolves the name of a type used by the element relativeTo.
+func (lk *linker) resolveType(path []int32, relativeTo, name string) (string, bool) {
+	full, sym, ok := lk.syms.resolve(relativeTo, name, true)
+	switch {
+	case !ok:
+		lk.l.errorf(lk.fd, path, "\"%s\" is not defined.", name)
+	case !sym.isType():
+		lk.l.errorf(lk.fd, path, "\"%s\" is not a type.", name)
+		ok = false
+	}
+	return full, ok
+}
+
+// parseDefault parses the default value of a floating-point field.
+func parseDefault(s string) float64 {
+	neg := strings.HasPrefix(s, "-")
+	switch strings.TrimPrefix(s, "-") {
+	case "inf":
+		if neg {
+			return math.Inf(-1)
+		}
+		return math.Inf(1)
+	case "nan":
+		return math.NaN()
+	}
+	v, _ := strconv.ParseFloat(s, 64)
+	return v
+}
+
+// simpleDtoa formats v as protoc does: with the fewest digits, 15 or 17,
+// that parse back to v.
+func simpleDtoa(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "inf"
+	case math.IsInf(v, -1):
+		return "-inf"
+	case math.IsNaN(v):
+		return "nan"
+	}
+	s := strconv.FormatFloat(v, 'g', 15, 64)
+	if w, _ := strconv.ParseFloat(s, 64); w != v {
+		s = strconv.FormatFloat(v, 'g', 17, 64)
+	}
+	return s
+}
+
+// simpleFtoa is simpleDtoa for floats, with 6 or 9 digits.
+func simpleFtoa(v float32) string {
+	switch {
+	case math.IsInf(float64(v), 0), math.IsNaN(float64(v)):
+		return simpleDtoa(float64(v))
+	}
+	s := strconv.FormatFloat(float64(v), 'g', 6, 64)
+	if w, _ := strconv.ParseFloat(s, 32); float32(w) != v {
+		s = strconv.FormatFloat(float64(v), 'g', 9, 64)
+	}
+	return s
+}
@@ -0,0 +1,538 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package parser
+
+// This file interprets options, as protoc's OptionInterpreter does. Each
+// option is encoded in the wire format, an extension of the options
+// message or one of its fields, and the encodings are unmarshaled into
+// the options message. Custom options thus become extensions, which
+// proto.GetExtension decodes given their Go extension descriptors.
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+type optionInterpreter struct {
+	lk *linker
+
+	set         map[string]bool    // element path and option field numbers => whether set
+	counts      map[string]int32   // interpreted path => number of repeated values
+	interpreted map[string][]int32 // uninterpreted path => interpreted path
+}
+
+func (lk *linker) interpretOptions() {
+	oi := &optionInterpreter{
+		lk:          lk,
+		set:         make(map[string]bool),
+		counts:      make(map[string]int32),
+		interpreted: make(map[string][]int32),
+	}
+	fd := lk.fd
+	pkg := fd.GetPackage()
+	if opts := fd.Options; opts != nil {
+		// File options are resolved as if declared in the package.
+		oi.interpret([]int32{fileOptionsTag}, pkg+".dummy", "google.protobuf.FileOptions", opts, &opts.UninterpretedOption)
+	}
+	for i, md := range fd.MessageType {
+		oi.message([]int32{fileMessageTag, int32(i)}, joinName(pkg, md.GetName()), md)
+	}
+	for i, ed := range fd.EnumType {
+		oi.enum([]int32{fileEnumTag, int32(i)}, pkg, ed)
+	}
+	for i, f := range fd.Extension {
+		oi.field([]int32{fileExtensionTag, int32(i)}, joinName(pkg, f.GetName()), f)
+	}
+	for i, sd := range fd.Service {
+		path := []int32{fileServiceTag, int32(i)}
+		name := joinName(pkg, sd.GetName())
+		if opts := sd.Options; opts != nil {
+			oi.interpret(appendPath(path, serviceOptionsTag), name, "google.protobuf.ServiceOptions", opts, &opts.UninterpretedOption)
+		}
+		for j, md := range sd.Method {
+			if opts := md.Options; opts != nil {
+				oi.interpret(appendPath(path, serviceMethodTag, int32(j), methodOptionsTag), joinName(name, md.GetName()), "google.protobuf.MethodOptions", opts, &opts.UninterpretedOption)
+			}
+		}
+	}
+	oi.updateSourceCodeInfo(fd.SourceCodeInfo)
+}
+
+func (oi *optionInterpreter) message(path []int32, name string, md *descpb.DescriptorProto) {
+	if opts := md.Options; opts != nil {
+		oi.interpret(appendPath(path, messageOptionsTag), name, "google.protobuf.MessageOptions", opts, &opts.UninterpretedOption)
+	}
+	for i, f := range md.Field {
+		oi.field(appendPath(path, messageFieldTag, int32(i)), joinName(name, f.GetName()), f)
+	}
+	for i, nd := range md.NestedType {
+		oi.message(appendPath(path, messageNestedTag, int32(i)), joinName(name, nd.GetName()), nd)
+	}
+	for i, ed := range md.EnumType {
+		oi.enum(appendPath(path, messageEnumTag, int32(i)), name, ed)
+	}
+	for i, r := range md.ExtensionRange {
+		if opts := r.Options; opts != nil {
+			oi.interpret(appendPath(path, messageExtensionRangeTag, int32(i), rangeOptionsTag), name, "google.protobuf.ExtensionRangeOptions", opts, &opts.UninterpretedOption)
+		}
+	}
+	for i, f := range md.Extension {
+		oi.field(appendPath(path, messageExtensionTag, int32(i)), joinName(name, f.GetName()), f)
+	}
+	for i, od := range md.OneofDecl {
+		if opts := od.Options; opts != nil {
+			oi.interpret(appendPath(path, messageOneofTag, int32(i), oneofOptionsTag), joinName(name, od.GetName()), "google.protobuf.OneofOptions", opts, &opts.UninterpretedOption)
+		}
+	}
+}
+
+func (oi *optionInterpreter) field(path []int32, name string, f *descpb.FieldDescriptorProto) {
+	if opts := f.Options; opts != nil {
+		oi.interpret(appendPath(path, fieldOptionsTag), name, "google.protobuf.FieldOptions", opts, &opts.UninterpretedOption)
+	}
+}
+
+func (oi *optionInterpreter) enum(path []int32, scope string, ed *descpb.EnumDescriptorProto) {
+	name := joinName(scope, ed.GetName())
+	if opts := ed.Options; opts != nil {
+		oi.interpret(appendPath(path, enumOptionsTag), name, "google.protobuf.EnumOptions", opts, &opts.UninterpretedOption)
+	}
+	for i, vd := range ed.Value {
+		if opts := vd.Options; opts != nil {
+			oi.interpret(appendPath(path, enumValueTag, int32(i), enumValueOptionsTag), joinName(scope, vd.GetName()), "google.protobuf.EnumValueOptions", opts, &opts.UninterpretedOption)
+		}
+	}
+}
+
+// interpret interprets the uninterpreted options of the options message
+// opts, of type typeName, at path, which belong to the element
+// relativeTo.
+func (oi *optionInterpreter) interpret(path []int32, relativeTo, typeName string, opts proto.Message, uninterpreted *[]*descpb.UninterpretedOption) {
+	list := *uninterpreted
+	if len(list) == 0 {
+		return
+	}
+	*uninterpreted = nil
+	optsType, ok := oi.lk.l.pool.message(typeName)
+	if !ok {
+		oi.errorf(path, "%s is not defined.", typeName)
+		return
+	}
+	b, err := proto.Marshal(opts)
+	if err != nil {
+		oi.errorf(path, "%v", err)
+		return
+	}
+	for i, opt := range list {
+		src := appendPath(path, uninterpretedOptionTag, int32(i))
+		enc, dest, ok := oi.interpretOne(src, path, relativeTo, typeName, optsType, opt)
+		if ok {
+			b = append(b, enc...)
+			oi.interpreted[pathKey(src)] = dest
+		}
+	}
+	if err := proto.Unmarshal(b, opts); err != nil {
+		oi.errorf(path, "%v", err)
+	}
+}
+
+func (oi *optionInterpreter) errorf(path []int32, format string, args ...interface{}) {
+	oi.lk.l.errorf(oi.lk.fd, path, format, args...)
+}
+
+func pathKey(path []int32) string {
+	return fmt.Sprint(path)
+}
+
+// optionName returns the name of an option as written, such as
+// "(google.api.http).get".
+func optionName(opt *descpb.UninterpretedOption) string {
+	var parts []string
+	for _, part := range opt.Name {
+		if part.GetIsExtension() {
+			parts = append(parts, "("+part.GetNamePart()+")")
+		} else {
+			parts = append(parts, part.GetNamePart())
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// interpretOne returns the encoding of an option of the options message
+// at optsPath, along with the path of the option once interpreted.
+func (oi *optionInterpreter) interpretOne(src, optsPath []int32, relativeTo, typeName string, optsType symbol, opt *descpb.UninterpretedOption) ([]byte, []int32, bool) {
+	if len(opt.Name) > 0 && !opt.Name[0].GetIsExtension() && opt.Name[0].GetNamePart() == "uninterpreted_option" {
+		oi.errorf(src, "Option must not use reserved name \"uninterpreted_option\".")
+		return nil, nil, false
+	}
+
+	// Find the fields named.
+	md, mdName := optsType.message, typeName
+	var fields []*descpb.FieldDescriptorProto
+	name := ""
+	for i, part := range opt.Name {
+		var f *descpb.FieldDescriptorProto
+		if i > 0 {
+			name += "."
+		}
+		if part.GetIsExtension() {
+			name += "(" + part.GetNamePart() + ")"
+			if _, sym, ok := oi.lk.syms.resolve(relativeTo, part.GetNamePart(), false); ok && sym.kind == symField {
+				f = sym.field
+			}
+			if f == nil {
+				oi.errorf(src, "Option \"%s\" unknown. Ensure that your proto definition file imports the proto which defines the option.", name)
+				return nil, nil, false
+			}
+			if strings.TrimPrefix(f.GetExtendee(), ".") != mdName {
+				oi.errorf(src, "Option field \"%s\" is not a field or extension of message \"%s\".", name, md.GetName())
+				return nil, nil, false
+			}
+		} else {
+			name += part.GetNamePart()
+			if f = findField(md, part.GetNamePart()); f == nil {
+				oi.errorf(src, "Option \"%s\" unknown.", name)
+				return nil, nil, false
+			}
+		}
+		fields = append(fields, f)
+		if i == len(opt.Name)-1 {
+			break
+		}
+		if !isMessage(f) {
+			oi.errorf(src, "Option \"%s\" is an atomic type, not a message.", name)
+			return nil, nil, false
+		}
+		if f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED {
+			oi.errorf(src, "Option field \"%s\" is a repeated message. Repeated message options must be initialized using an aggregate value.", name)
+			return nil, nil, false
+		}
+		sym, ok := oi.lk.l.pool.message(f.GetTypeName())
+		if !ok {
+			oi.errorf(src, "Option \"%s\" has unknown type %s.", name, f.GetTypeName())
+			return nil, nil, false
+		}
+		md, mdName = sym.message, strings.TrimPrefix(f.GetTypeName(), ".")
+	}
+
+	// Options may be set once, unless repeated.
+	f := fields[len(fields)-1]
+	dest := appendPath(optsPath)
+	for _, f := range fields {
+		dest = append(dest, f.GetNumber())
+	}
+	repeated := f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED
+	key := pathKey(dest)
+	if !repeated {
+		for k := range oi.set {
+			if k == key || strings.HasPrefix(k, key[:len(key)-1]+" ") {
+				oi.errorf(src, "Option \"%s\" was already set.", name)
+				return nil, nil, false
+			}
+		}
+	}
+
+	payload, ok := oi.value(src, dest, relativeTo, name, f, opt)
+	if !ok {
+		return nil, nil, false
+	}
+	oi.set[key] = true
+	enc := encodeField(f, payload)
+	for i := len(fields) - 2; i >= 0; i-- {
+		enc = encodeField(fields[i], enc)
+	}
+
+	if repeated {
+		n := oi.counts[key]
+		oi.counts[key]++
+		dest = append(dest, n)
+	}
+	return enc, dest, true
+}
+
+func findField(md *descpb.DescriptorProto, name string) *descpb.FieldDescriptorProto {
+	for _, f := range md.Field {
+		if f.GetName() == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func isMessage(f *descpb.FieldDescriptorProto) bool {
+	return f.GetType() == descpb.FieldDescriptorProto_TYPE_MESSAGE || f.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP
+}
+
+// value returns the encoded value of the option field f, at dest, without
+// its tag.
+func (oi *optionInterpreter) value(src, dest []int32, relativeTo, name string, f *descpb.FieldDescriptorProto, opt *descpb.UninterpretedOption) ([]byte, bool) {
+	typ := f.GetType()
+	typeName := strings.ToLower(strings.TrimPrefix(typ.String(), "TYPE_"))
+	switch typ {
+	case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_SINT32, descpb.FieldDescriptorProto_TYPE_SFIXED32,
+		descpb.FieldDescriptorProto_TYPE_INT64, descpb.FieldDescriptorProto_TYPE_SINT64, descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		min, max := int64(math.MinInt64), uint64(math.MaxInt64)
+		if typ == descpb.FieldDescriptorProto_TYPE_INT32 || typ == descpb.FieldDescriptorProto_TYPE_SINT32 || typ == descpb.FieldDescriptorProto_TYPE_SFIXED32 {
+			min, max = math.MinInt32, math.MaxInt32
+		}
+		var v int64
+		switch {
+		case opt.PositiveIntValue != nil:
+			if opt.GetPositiveIntValue() > max {
+				oi.errorf(src, "Value out of range for %s option \"%s\".", typeName, name)
+				return nil, false
+			}
+			v = int64(opt.GetPositiveIntValue())
+		case opt.NegativeIntValue != nil:
+			if opt.GetNegativeIntValue() < min {
+				oi.errorf(src, "Value out of range for %s option \"%s\".", typeName, name)
+				return nil, false
+			}
+			v = opt.GetNegativeIntValue()
+		default:
+			oi.errorf(src, "Value must be integer for %s option \"%s\".", typeName, name)
+			return nil, false
+		}
+		return intPayload(typ, v), true
+
+	case descpb.FieldDescriptorProto_TYPE_UINT32, descpb.FieldDescriptorProto_TYPE_FIXED32,
+		descpb.FieldDescriptorProto_TYPE_UINT64, descpb.FieldDescriptorProto_TYPE_FIXED64:
+		max := uint64(math.MaxUint64)
+		if typ == descpb.FieldDescriptorProto_TYPE_UINT32 || typ == descpb.FieldDescriptorProto_TYPE_FIXED32 {
+			max = math.MaxUint32
+		}
+		if opt.PositiveIntValue == nil {
+			oi.errorf(src, "Value must be non-negative integer for %s option \"%s\".", typeName, name)
+			return nil, false
+		}
+		if opt.GetPositiveIntValue() > max {
+			oi.errorf(src, "Value out of range for %s option \"%s\".", typeName, name)
+			return nil, false
+		}
+		return uintPayload(typ, opt.GetPositiveIntValue()), true
+
+	case descpb.FieldDescriptorProto_TYPE_FLOAT, descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		var v float64
+		switch {
+		case opt.DoubleValue != nil:
+			v = opt.GetDoubleValue()
+		case opt.PositiveIntValue != nil:
+			v = float64(opt.GetPositiveIntValue())
+		case opt.NegativeIntValue != nil:
+			v = float64(opt.GetNegativeIntValue())
+		case opt.GetIdentifierValue() == "inf":
+			v = math.Inf(1)
+		case opt.GetIdentifierValue() == "nan":
+			v = math.NaN()
+		default:
+			oi.errorf(src, "Value must be number for %s option \"%s\".", typeName, name)
+			return nil, false
+		}
+		return floatPayload(typ, v), true
+
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		switch opt.GetIdentifierValue() {
+		case "true":
+			return varintPayload(1), true
+		case "false":
+			return varintPayload(0), true
+		}
+		oi.errorf(src, "Value must be \"true\" or \"false\" for boolean option \"%s\".", name)
+		return nil, false
+
+	case descpb.FieldDescriptorProto_TYPE_ENUM:
+		if opt.IdentifierValue == nil {
+			oi.errorf(src, "Value must be identifier for enum-valued option \"%s\".", name)
+			return nil, false
+		}
+		sym, ok := oi.lk.l.pool.enum(f.GetTypeName())
+		if !ok {
+			oi.errorf(src, "Option \"%s\" has unknown type %s.", name, f.GetTypeName())
+			return nil, false
+		}
+		for _, vd := range sym.enum.Value {
+			if vd.GetName() == opt.GetIdentifierValue() {
+				return intPayload(typ, int64(vd.GetNumber())), true
+			}
+		}
+		oi.errorf(src, "Enum type \"%s\" has no value named \"%s\" for option \"%s\".", strings.TrimPrefix(f.GetTypeName(), "."), opt.GetIdentifierValue(), name)
+		return nil, false
+
+	case descpb.FieldDescriptorProto_TYPE_STRING, descpb.FieldDescriptorProto_TYPE_BYTES:
+		if opt.StringValue == nil {
+			oi.errorf(src, "Value must be quoted string for %s option \"%s\".", typeName, name)
+			return nil, false
+		}
+		return opt.StringValue, true
+
+	case descpb.FieldDescriptorProto_TYPE_MESSAGE, descpb.FieldDescriptorProto_TYPE_GROUP:
+		if opt.AggregateValue == nil {
+			oi.errorf(src, "Option \"%s\" is a message. To set the entire message, use syntax like \"%s = { <proto text format> }\". To set fields within it, use syntax like \"%s.foo = value\".", name, name, name)
+			return nil, false
+		}
+		sym, ok := oi.lk.l.pool.message(f.GetTypeName())
+		if !ok {
+			oi.errorf(src, "Option \"%s\" has unknown type %s.", name, f.GetTypeName())
+			return nil, false
+		}
+		m, err := oi.parseAggregate(relativeTo, sym, strings.TrimPrefix(f.GetTypeName(), "."), opt.GetAggregateValue())
+		if err != "" {
+			oi.errorf(src, "Error while parsing option value for \"%s\": %s", name, err)
+			return nil, false
+		}
+		if f.GetLabel() != descpb.FieldDescriptorProto_LABEL_REPEATED {
+			// Fields of the message may not be set again.
+			m.walk(dest, func(path []int32) { oi.set[pathKey(path)] = true })
+		}
+		return m.marshal(), true
+	}
+	oi.errorf(src, "Option \"%s\" has unknown type.", name)
+	return nil, false
+}
+
+// updateSourceCodeInfo moves the locations of interpreted options to the
+// paths of the fields they set, dropping the locations of their parts.
+func (oi *optionInterpreter) updateSourceCodeInfo(info *descpb.SourceCodeInfo) {
+	if info == nil || len(oi.interpreted) == 0 {
+		return
+	}
+	var locs []*descpb.SourceCodeInfo_Location
+	var matched []int32
+	for _, loc := range info.Location {
+		if matched != nil {
+			if len(loc.Path) >= len(matched) && pathKey(loc.Path[:len(matched)]) == pathKey(matched) {
+				continue
+			}
+			matched = nil
+		}
+		dest, ok := oi.interpreted[pathKey(loc.Path)]
+		if !ok {
+			locs = append(locs, loc)
+			continue
+		}
+		matched = loc.Path
+		loc.Path = dest
+		locs = append(locs, loc)
+	}
+	info.Location = locs
+}
+
+// Wire types, as in the proto package.
+const (
+	wireVarint     = 0
+	wireFixed64    = 1
+	wireBytes      = 2
+	wireStartGroup = 3
+	wireEndGroup   = 4
+	wireFixed32    = 5
+)
+
+func wireType(t descpb.FieldDescriptorProto_Type) uint64 {
+	switch t {
+	case descpb.FieldDescriptorProto_TYPE_DOUBLE, descpb.FieldDescriptorProto_TYPE_FIXED64, descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		return wireFixed64
+	case descpb.FieldDescriptorProto_TYPE_FLOAT, descpb.FieldDescriptorProto_TYPE_FIXED32, descpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return wireFixed32
+	case descpb.FieldDescriptorProto_TYPE_STRING, descpb.FieldDescriptorProto_TYPE_BYTES, descpb.FieldDescriptorProto_TYPE_MESSAGE:
+		return wireBytes
+	case descpb.FieldDescriptorProto_TYPE_GROUP:
+		return wireStartGroup
+	}
+	return wireVarint
+}
+
+// encodeField encodes a value of f, given its encoding without a tag.
+func encodeField(f *descpb.FieldDescriptorProto, payload []byte) []byte {
+	b := proto.NewBuffer(nil)
+	n := uint64(f.GetNumber()) << 3
+	switch wt := wireType(f.GetType()); wt {
+	case wireBytes:
+		b.EncodeVarint(n | wt)
+		b.EncodeRawBytes(payload)
+	case wireStartGroup:
+		b.EncodeVarint(n | wireStartGroup)
+		b.SetBuf(append(b.Bytes(), payload...))
+		b.EncodeVarint(n | wireEndGroup)
+	default:
+		b.EncodeVarint(n | wt)
+		b.SetBuf(append(b.Bytes(), payload...))
+	}
+	return b.Bytes()
+}
+
+func varintPayload(v uint64) []byte {
+	return proto.EncodeVarint(v)
+}
+
+func intPayload(t descpb.FieldDescriptorProto_Type, v int64) []byte {
+	b := proto.NewBuffer(nil)
+	switch t {
+	case descpb.FieldDescriptorProto_TYPE_SINT32:
+		b.EncodeZigzag32(uint64(v))
+	case descpb.FieldDescriptorProto_TYPE_SINT64:
+		b.EncodeZigzag64(uint64(v))
+	case descpb.FieldDescriptorProto_TYPE_SFIXED32:
+		b.EncodeFixed32(uint64(uint32(v)))
+	case descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		b.EncodeFixed64(uint64(v))
+	default:
+		b.EncodeVarint(uint64(v))
+	}
+	return b.Bytes()
+}
+
+func uintPayload(t descpb.FieldDescriptorProto_Type, v uint64) []byte {
+	b := proto.NewBuffer(nil)
+	switch t {
+	case descpb.FieldDescriptorProto_TYPE_FIXED32:
+		b.EncodeFixed32(v)
+	case descpb.FieldDescriptorProto_TYPE_FIXED64:
+		b.EncodeFixed64(v)
+	default:
+		b.EncodeVarint(v)
+	}
+	return b.Bytes()
+}
+
+func floatPayload(t descpb.FieldDescriptorProto_Type, v float64) []byte {
+	b := proto.NewBuffer(nil)
+	if t == descpb.FieldDescriptorProto_TYPE_FLOAT {
+		b.EncodeFixed32(uint64(math.Float32bits(float32(v))))
+	} else {
+		b.EncodeFixed64(math.Float64bits(v))
+	}
+	return b.Bytes()
+}
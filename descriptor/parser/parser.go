@@ -0,0 +1,305 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package parser parses .proto files into FileDescriptorProtos without
+// running protoc. The descriptors are those protoc would produce: types
+// are resolved to fully-qualified names, options, custom ones included,
+// are interpreted, and SourceCodeInfo records the location of each
+// element along with its comments.
+//
+// A Parser finds files, imports included, in its import paths, much as
+// protoc does given -I flags:
+//
+//	p := &parser.Parser{ImportPaths: []string{"proto"}}
+//	fds, err := p.ParseFiles("shop/order.proto")
+//
+// CodeGeneratorRequest builds the request protoc sends to a plugin, so
+// code generators such as protoc-gen-go's can be driven from Go.
+package parser
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+)
+
+// An Error is a problem found in a file.
+type Error struct {
+	Filename string
+	Line     int // 1-based line number, or 0 if unknown
+	Column   int // 1-based column number, or 0 if unknown
+	Msg      string
+}
+
+func (e *Error) Error() string {
+	if e.Line == 0 {
+		return e.Filename + ": " + e.Msg
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", e.Filename, e.Line, e.Column, e.Msg)
+}
+
+// newError returns an error at a 0-based line and column, as in
+// SourceCodeInfo spans.
+func newError(filename string, line, column int, format string, args ...interface{}) *Error {
+	return &Error{Filename: filename, Line: line + 1, Column: column + 1, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Errors is a list of problems, in the order they were found.
+type Errors []*Error
+
+func (errs Errors) Error() string {
+	switch len(errs) {
+	case 0:
+		return "no errors"
+	case 1:
+		return errs[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", errs[0], len(errs)-1)
+}
+
+// A Parser parses .proto files. The zero Parser reads files relative to
+// the current directory.
+type Parser struct {
+	// ImportPaths are the directories searched, in order, for the files
+	// to parse and the files they import. If empty, the current directory
+	// is searched.
+	ImportPaths []string
+
+	// Accessor, if not nil, opens files in place of os.Open. It is given
+	// the name of the file joined to an import path.
+	Accessor func(filename string) (io.ReadCloser, error)
+
+	// LookupImport, if not nil, returns the descriptors of imports not
+	// found in the import paths. registry.FindFile, for one, provides the
+	// well-known types and every other file linked into the binary.
+	LookupImport func(filename string) (*descpb.FileDescriptorProto, error)
+
+	// IncludeSourceCodeInfo keeps the SourceCodeInfo of the files parsed.
+	IncludeSourceCodeInfo bool
+}
+
+// ParseFiles parses the named files, which are relative to an import
+// path, and the files they import. It returns the descriptors of the
+// named files, or, if any file has problems, Errors.
+func (p *Parser) ParseFiles(names ...string) ([]*descpb.FileDescriptorProto, error) {
+	l, err := p.load(names)
+	if err != nil {
+		return nil, err
+	}
+	var fds []*descpb.FileDescriptorProto
+	for _, name := range names {
+		fd := l.files[name]
+		if !p.IncludeSourceCodeInfo {
+			fd.SourceCodeInfo = nil
+		}
+		fds = append(fds, fd)
+	}
+	return fds, nil
+}
+
+// CodeGeneratorRequest parses the named files, as ParseFiles does, and
+// returns the request protoc would send a plugin to generate them, given
+// parameter. The request holds every file imported, directly or not,
+// with SourceCodeInfo, whatever IncludeSourceCodeInfo says.
+func (p *Parser) CodeGeneratorRequest(parameter string, names ...string) (*plugin.CodeGeneratorRequest, error) {
+	l, err := p.load(names)
+	if err != nil {
+		return nil, err
+	}
+	req := &plugin.CodeGeneratorRequest{FileToGenerate: names, ProtoFile: l.order}
+	if parameter != "" {
+		req.Parameter = proto.String(parameter)
+	}
+	return req, nil
+}
+
+// A loader loads files and their imports.
+type loader struct {
+	p       *Parser
+	files   map[string]*descpb.FileDescriptorProto // file name => descriptor, or nil if it failed
+	order   []*descpb.FileDescriptorProto          // files, imports first
+	loading []string                               // stack of files being loaded
+	pool    *pool
+	errs    Errors
+}
+
+func (p *Parser) load(names []string) (*loader, error) {
+	l := &loader{
+		p:     p,
+		files: make(map[string]*descpb.FileDescriptorProto),
+		pool:  newPool(),
+	}
+	for _, name := range names {
+		if _, ok := l.files[name]; !ok {
+			l.load(name)
+		}
+	}
+	if len(l.errs) > 0 {
+		return nil, l.errs
+	}
+	return l, nil
+}
+
+// open reads a file from the first import path holding it.
+func (l *loader) open(name string) ([]byte, bool) {
+	dirs := l.p.ImportPaths
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+	for _, dir := range dirs {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		var r io.ReadCloser
+		var err error
+		if l.p.Accessor != nil {
+			r, err = l.p.Accessor(path)
+		} else {
+			r, err = os.Open(path)
+		}
+		if err != nil {
+			continue
+		}
+		b, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			l.errs = append(l.errs, &Error{Filename: name, Msg: err.Error()})
+			return nil, false
+		}
+		return b, true
+	}
+	return nil, false
+}
+
+// load loads a file and its imports, returning nil if any has problems.
+func (l *loader) load(name string) *descpb.FileDescriptorProto {
+	l.loading = append(l.loading, name)
+	defer func() { l.loading = l.loading[:len(l.loading)-1] }()
+	l.files[name] = nil
+
+	var fd *descpb.FileDescriptorProto
+	parsed := false
+	if src, ok := l.open(name); ok {
+		parsed = true
+		var errs Errors
+		if fd, errs = parse(name, src); len(errs) > 0 {
+			l.errs = append(l.errs, errs...)
+			return nil
+		}
+	} else if l.p.LookupImport != nil {
+		if dep, err := l.p.LookupImport(name); err == nil {
+			fd = proto.Clone(dep).(*descpb.FileDescriptorProto)
+		}
+	}
+	if fd == nil {
+		l.errs = append(l.errs, &Error{Filename: name, Msg: "File not found."})
+		return nil
+	}
+
+	// Load the imports.
+	ok := true
+	deps := make([]*descpb.FileDescriptorProto, len(fd.Dependency))
+	for i, dep := range fd.Dependency {
+		if d, loaded := l.files[dep]; loaded {
+			if d == nil && l.isLoading(dep) {
+				l.errorf(fd, []int32{fileDependencyTag, int32(i)}, "File recursively imports itself: %s -> %s", strings.Join(l.cycle(dep), " -> "), dep)
+			}
+			deps[i] = d
+		} else {
+			deps[i] = l.load(dep)
+			if deps[i] == nil {
+				l.errorf(fd, []int32{fileDependencyTag, int32(i)}, "Import \"%s\" was not found or had errors.", dep)
+			}
+		}
+		ok = ok && deps[i] != nil
+	}
+	if !ok {
+		return nil
+	}
+
+	if parsed && !l.link(fd, deps) {
+		return nil
+	}
+	l.files[name] = fd
+	l.order = append(l.order, fd)
+	l.pool.addFile(fd)
+	return fd
+}
+
+func (l *loader) isLoading(name string) bool {
+	for _, s := range l.loading {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// cycle returns the files loading since name began loading.
+func (l *loader) cycle(name string) []string {
+	for i, s := range l.loading {
+		if s == name {
+			return l.loading[i:]
+		}
+	}
+	return nil
+}
+
+// errorf reports an error at the element of fd with the given path.
+func (l *loader) errorf(fd *descpb.FileDescriptorProto, path []int32, format string, args ...interface{}) {
+	line, column := -1, -1
+	if loc := findLocation(fd.GetSourceCodeInfo(), path); loc != nil {
+		line, column = int(loc.Span[0]), int(loc.Span[1])
+	}
+	l.errs = append(l.errs, newError(fd.GetName(), line, column, format, args...))
+}
+
+// findLocation returns the location of the element with the given path.
+func findLocation(info *descpb.SourceCodeInfo, path []int32) *descpb.SourceCodeInfo_Location {
+	for _, loc := range info.GetLocation() {
+		if len(loc.Path) != len(path) {
+			continue
+		}
+		match := true
+		for i := range path {
+			match = match && loc.Path[i] == path[i]
+		}
+		if match {
+			return loc
+		}
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package parser_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor/parser"
+	"github.com/golang/protobuf/descriptor/registry"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/generator"
+
+	_ "github.com/golang/protobuf/descriptor/descriptor_test_proto"
+	_ "github.com/golang/protobuf/proto/proto3_proto"
+	_ "github.com/golang/protobuf/proto/test_proto"
+	_ "github.com/golang/protobuf/ptypes/struct"
+)
+
+// wellKnown maps the well-known types to their sources in the repository.
+var wellKnown = map[string]string{
+	"google/protobuf/descriptor.proto": "../../protoc-gen-go/descriptor/descriptor.proto",
+	"google/protobuf/struct.proto":     "../../ptypes/struct/struct.proto",
+}
+
+func openWellKnown(filename string) (io.ReadCloser, error) {
+	if path, ok := wellKnown[filename]; ok {
+		return os.Open(path)
+	}
+	return nil, os.ErrNotExist
+}
+
+// TestRegisteredFiles checks that parsing the sources of registered files
+// yields the descriptors protoc produced for them.
+func TestRegisteredFiles(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		p          *parser.Parser
+		sourceInfo bool // whether the registered descriptor has SourceCodeInfo
+	}{
+		{"test_comments.proto", &parser.Parser{ImportPaths: []string{"../descriptor_test_proto"}}, true},
+		{"test_options.proto", &parser.Parser{ImportPaths: []string{"../descriptor_test_proto"}}, true},
+		{"test_proto/test.proto", &parser.Parser{ImportPaths: []string{"../../proto"}}, false},
+		{"proto3_proto/proto3.proto", &parser.Parser{ImportPaths: []string{"../../proto"}}, false},
+		{"google/protobuf/descriptor.proto", &parser.Parser{Accessor: openWellKnown}, false},
+		{"google/protobuf/struct.proto", &parser.Parser{Accessor: openWellKnown}, false},
+	} {
+		want, err := registry.FindFile(test.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.p.IncludeSourceCodeInfo = test.sourceInfo
+		test.p.LookupImport = registry.FindFile
+		fds, err := test.p.ParseFiles(test.name)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if !proto.Equal(fds[0], want) {
+			t.Errorf("%s: got:\n%v\nwant:\n%v", test.name, proto.MarshalTextString(fds[0]), proto.MarshalTextString(want))
+		}
+	}
+}
+
+// TestGenerate drives protoc-gen-go's generator with a request built by the
+// parser, as regenerate.sh drives it with protoc.
+func TestGenerate(t *testing.T) {
+	p := &parser.Parser{ImportPaths: []string{"../descriptor_test_proto"}, LookupImport: registry.FindFile}
+	req, err := p.CodeGeneratorRequest("paths=source_relative,source_info=true", "test_comments.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(req.ProtoFile); n != 1 {
+		t.Errorf("request holds %d files, want 1", n)
+	}
+
+	g := generator.New()
+	g.Request = req
+	g.CommandLineParameters(req.GetParameter())
+	g.WrapTypes()
+	g.SetPackageNames()
+	g.BuildTypeNameMap()
+	g.GenerateAllFiles()
+	if len(g.Response.File) != 1 {
+		t.Fatalf("generated %d files, want 1", len(g.Response.File))
+	}
+	got := g.Response.File[0]
+	if got.GetName() != "test_comments.pb.go" {
+		t.Errorf("generated %s, want test_comments.pb.go", got.GetName())
+	}
+	want, err := ioutil.ReadFile("../descriptor_test_proto/test_comments.pb.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal([]byte(got.GetContent()), want) {
+		t.Errorf("generated code differs from test_comments.pb.go:\n%s", got.GetContent())
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, test := range []struct {
+		src, want string
+	}{{
+		src:  "syntax = \"proto4\";",
+		want: `a.proto:1:10: Unrecognized syntax identifier "proto4".  This parser only recognizes "proto2" and "proto3".`,
+	}, {
+		src:  "syntax = \"proto3\";\nmessage M {\n  int32 x = 1\n}",
+		want: `a.proto:4:1: Expected ";".`,
+	}, {
+		src:  "syntax = \"proto3\";\nmessage M {\n  optional int32 x = 1;\n}",
+		want: `a.proto:3:12: Explicit 'optional' labels are disallowed in the Proto3 syntax. To define 'optional' fields in Proto3, simply remove the 'optional' label, as fields are 'optional' by default.`,
+	}, {
+		src:  "syntax = \"proto3\";\npackage p;\nmessage M {\n  Foo f = 1;\n}",
+		want: `a.proto:4:3: "Foo" is not defined.`,
+	}, {
+		src:  "syntax = \"proto3\";\nmessage M {\n  int32 x = 1 [(opt) = 1];\n}",
+		want: `a.proto:3:16: Option "(opt)" unknown. Ensure that your proto definition file imports the proto which defines the option.`,
+	}, {
+		src:  "syntax = \"proto2\";\nmessage M {\n  option deprecated = true;\n  option deprecated = false;\n}",
+		want: `a.proto:4:3: Option "deprecated" was already set.`,
+	}, {
+		src:  "syntax = \"proto2\";\nmessage M {\n  optional int32 x = 1;\n  optional int32 y = 1;\n}",
+		want: `a.proto:4:18: M.y: field number 1 is already used by x`,
+	}, {
+		src:  "import \"b.proto\";\n",
+		want: `a.proto:1:1: Import "b.proto" was not found or had errors.`,
+	}} {
+		p := &parser.Parser{Accessor: func(filename string) (io.ReadCloser, error) {
+			if filename != "a.proto" {
+				return nil, os.ErrNotExist
+			}
+			return ioutil.NopCloser(strings.NewReader(test.src)), nil
+		}}
+		_, err := p.ParseFiles("a.proto")
+		errs, ok := err.(parser.Errors)
+		if !ok {
+			t.Errorf("%q: got error %v, want Errors", test.src, err)
+			continue
+		}
+		found := false
+		for _, e := range errs {
+			found = found || e.Error() == test.want
+		}
+		if !found {
+			t.Errorf("%q: got errors\n%v\nwant\n%s", test.src, errs, test.want)
+		}
+	}
+}
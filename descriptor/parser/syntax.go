@@ -0,0 +1,1736 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package parser
+
+// This file implements the grammar of .proto files. Like protoc, the
+// parser records a SourceCodeInfo location for each element as it goes,
+// starting at the element's first token and ending at its last, and leaves
+// all options uninterpreted and all type names unresolved.
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Field numbers of descriptor.proto used in SourceCodeInfo paths.
+const (
+	fileDependencyTag       = 3
+	fileMessageTag          = 4
+	fileEnumTag             = 5
+	fileServiceTag          = 6
+	fileExtensionTag        = 7
+	fileOptionsTag          = 8
+	filePackageTag          = 2
+	filePublicDependencyTag = 10
+	fileWeakDependencyTag   = 11
+	fileSyntaxTag           = 12
+
+	messageNameTag           = 1
+	messageFieldTag          = 2
+	messageNestedTag         = 3
+	messageEnumTag           = 4
+	messageExtensionRangeTag = 5
+	messageExtensionTag      = 6
+	messageOptionsTag        = 7
+	messageOneofTag          = 8
+	messageReservedRangeTag  = 9
+	messageReservedNameTag   = 10
+
+	rangeStartTag   = 1
+	rangeEndTag     = 2
+	rangeOptionsTag = 3
+
+	fieldNameTag     = 1
+	fieldExtendeeTag = 2
+	fieldNumberTag   = 3
+	fieldLabelTag    = 4
+	fieldTypeTag     = 5
+	fieldTypeNameTag = 6
+	fieldDefaultTag  = 7
+	fieldOptionsTag  = 8
+	fieldJSONNameTag = 10
+
+	oneofNameTag    = 1
+	oneofOptionsTag = 2
+
+	enumNameTag          = 1
+	enumValueTag         = 2
+	enumOptionsTag       = 3
+	enumReservedRangeTag = 4
+	enumReservedNameTag  = 5
+
+	enumValueNameTag    = 1
+	enumValueNumberTag  = 2
+	enumValueOptionsTag = 3
+
+	serviceNameTag    = 1
+	serviceMethodTag  = 2
+	serviceOptionsTag = 3
+
+	methodNameTag            = 1
+	methodInputTag           = 2
+	methodOutputTag          = 3
+	methodOptionsTag         = 4
+	methodClientStreamingTag = 5
+	methodServerStreamingTag = 6
+
+	uninterpretedOptionTag = 999
+
+	optionNameTag          = 2
+	optionIdentifierTag    = 3
+	optionPositiveIntTag   = 4
+	optionNegativeIntTag   = 5
+	optionDoubleTag        = 6
+	optionStringTag        = 7
+	optionAggregateTag     = 8
+	optionNamePartTag      = 1
+	optionNamePartNameTag  = 1
+	optionNamePartIsExtTag = 2
+)
+
+const (
+	maxFieldNumber   = 536870911
+	maxRangeSentinel = -1 // end of a range ending in "max", before adjustment
+)
+
+var scalarTypes = map[string]descpb.FieldDescriptorProto_Type{
+	"double":   descpb.FieldDescriptorProto_TYPE_DOUBLE,
+	"float":    descpb.FieldDescriptorProto_TYPE_FLOAT,
+	"int64":    descpb.FieldDescriptorProto_TYPE_INT64,
+	"uint64":   descpb.FieldDescriptorProto_TYPE_UINT64,
+	"int32":    descpb.FieldDescriptorProto_TYPE_INT32,
+	"fixed64":  descpb.FieldDescriptorProto_TYPE_FIXED64,
+	"fixed32":  descpb.FieldDescriptorProto_TYPE_FIXED32,
+	"bool":     descpb.FieldDescriptorProto_TYPE_BOOL,
+	"string":   descpb.FieldDescriptorProto_TYPE_STRING,
+	"group":    descpb.FieldDescriptorProto_TYPE_GROUP,
+	"bytes":    descpb.FieldDescriptorProto_TYPE_BYTES,
+	"uint32":   descpb.FieldDescriptorProto_TYPE_UINT32,
+	"sfixed32": descpb.FieldDescriptorProto_TYPE_SFIXED32,
+	"sfixed64": descpb.FieldDescriptorProto_TYPE_SFIXED64,
+	"sint32":   descpb.FieldDescriptorProto_TYPE_SINT32,
+	"sint64":   descpb.FieldDescriptorProto_TYPE_SINT64,
+}
+
+type parser struct {
+	filename string
+	tok      *tokenizer
+	info     *descpb.SourceCodeInfo
+	syntax   string
+	errs     Errors
+
+	// Comments read after the end of the previous declaration, which
+	// belong to the next one.
+	upcomingDoc      string
+	upcomingDetached []string
+}
+
+// parse parses a .proto file, returning its descriptor with options
+// uninterpreted and type names as written.
+func parse(filename string, src []byte) (*descpb.FileDescriptorProto, Errors) {
+	p := &parser{filename: filename, info: new(descpb.SourceCodeInfo)}
+	p.tok = newTokenizer(src, p.errorAt)
+	fd := &descpb.FileDescriptorProto{Name: proto.String(filename)}
+	p.parseFile(fd)
+	if p.syntax == "proto3" {
+		fd.Syntax = proto.String(p.syntax)
+	}
+	fd.SourceCodeInfo = p.info
+	return fd, p.errs
+}
+
+func (p *parser) errorAt(line, column int, format string, args ...interface{}) {
+	p.errs = append(p.errs, newError(p.filename, line, column, format, args...))
+}
+
+// error reports an error at the current token.
+func (p *parser) error(format string, args ...interface{}) {
+	p.errorAt(p.tok.current.line, p.tok.current.column, format, args...)
+}
+
+// A location records the SourceCodeInfo location of an element.
+type location struct {
+	p    *parser
+	info *descpb.SourceCodeInfo
+	loc  *descpb.SourceCodeInfo_Location
+}
+
+// newLocation adds a location to info, starting at the current token.
+func (p *parser) newLocation(info *descpb.SourceCodeInfo, path []int32) *location {
+	loc := &descpb.SourceCodeInfo_Location{
+		Path: path,
+		Span: []int32{int32(p.tok.current.line), int32(p.tok.current.column)},
+	}
+	info.Location = append(info.Location, loc)
+	return &location{p: p, info: info, loc: loc}
+}
+
+// child returns a new location whose path extends that of l.
+func (l *location) child(path ...int32) *location {
+	return l.childIn(l.info, path...)
+}
+
+func (l *location) childIn(info *descpb.SourceCodeInfo, path ...int32) *location {
+	return l.p.newLocation(info, append(append([]int32(nil), l.loc.Path...), path...))
+}
+
+func (l *location) addPath(n int32) {
+	l.loc.Path = append(l.loc.Path, n)
+}
+
+func (l *location) startAt(t token) {
+	l.loc.Span[0] = int32(t.line)
+	l.loc.Span[1] = int32(t.column)
+}
+
+func (l *location) endAt(t token) {
+	if int32(t.line) != l.loc.Span[0] {
+		l.loc.Span = append(l.loc.Span, int32(t.line))
+	}
+	l.loc.Span = append(l.loc.Span, int32(t.endColumn))
+}
+
+// end ends the location at the previous token, unless it has ended.
+func (l *location) end() {
+	if len(l.loc.Span) <= 2 {
+		l.endAt(l.p.tok.previous)
+	}
+}
+
+func (l *location) attachComments(leading, trailing string, detached []string) {
+	if leading != "" {
+		l.loc.LeadingComments = proto.String(leading)
+	}
+	if trailing != "" {
+		l.loc.TrailingComments = proto.String(trailing)
+	}
+	l.loc.LeadingDetachedComments = append(l.loc.LeadingDetachedComments, detached...)
+}
+
+func (p *parser) atEnd() bool                    { return p.tok.current.typ == tokenEnd }
+func (p *parser) lookingAt(text string) bool     { return p.tok.current.text == text }
+func (p *parser) lookingAtType(t tokenType) bool { return p.tok.current.typ == t }
+
+func (p *parser) tryConsume(text string) bool {
+	if p.lookingAt(text) {
+		p.tok.next()
+		return true
+	}
+	return false
+}
+
+func (p *parser) consume(text string) bool {
+	return p.consumeOr(text, "Expected \""+text+"\".")
+}
+
+func (p *parser) consumeOr(text, msg string) bool {
+	if p.tryConsume(text) {
+		return true
+	}
+	p.error("%s", msg)
+	return false
+}
+
+func (p *parser) consumeIdentifier(msg string) (string, bool) {
+	if p.lookingAtType(tokenIdent) {
+		s := p.tok.current.text
+		p.tok.next()
+		return s, true
+	}
+	p.error("%s", msg)
+	return "", false
+}
+
+// parseInteger parses an integer token, which may be octal or hex.
+func parseInteger(text string, max uint64) (uint64, bool) {
+	base := uint64(10)
+	if len(text) > 1 && text[0] == '0' {
+		if text[1] == 'x' || text[1] == 'X' {
+			base = 16
+			text = text[2:]
+		} else {
+			base = 8
+		}
+	}
+	var v uint64
+	for i := 0; i < len(text); i++ {
+		d := uint64(hexValue(text[i]))
+		if !isHexDigit(text[i]) || d >= base {
+			return 0, false
+		}
+		if d > max || v > (max-d)/base {
+			return 0, false
+		}
+		v = v*base + d
+	}
+	return v, true
+}
+
+func (p *parser) consumeInteger(msg string) (int32, bool) {
+	if !p.lookingAtType(tokenInt) {
+		p.error("%s", msg)
+		return 0, false
+	}
+	v, ok := parseInteger(p.tok.current.text, math.MaxInt32)
+	if !ok {
+		p.error("Integer out of range.")
+	}
+	p.tok.next()
+	return int32(v), true
+}
+
+func (p *parser) consumeSignedInteger(msg string) (int32, bool) {
+	max := uint64(math.MaxInt32)
+	neg := p.tryConsume("-")
+	if neg {
+		max++
+	}
+	v, ok := p.consumeInteger64(max, msg)
+	if neg {
+		return int32(-int64(v)), ok
+	}
+	return int32(v), ok
+}
+
+func (p *parser) consumeInteger64(max uint64, msg string) (uint64, bool) {
+	if !p.lookingAtType(tokenInt) {
+		p.error("%s", msg)
+		return 0, false
+	}
+	v, ok := parseInteger(p.tok.current.text, max)
+	if !ok {
+		p.error("Integer out of range.")
+		v = 0
+	}
+	p.tok.next()
+	return v, true
+}
+
+func (p *parser) consumeNumber(msg string) (float64, bool) {
+	switch {
+	case p.lookingAtType(tokenFloat):
+		v := parseFloat(p.tok.current.text)
+		p.tok.next()
+		return v, true
+	case p.lookingAtType(tokenInt):
+		v, ok := parseInteger(p.tok.current.text, math.MaxUint64)
+		if !ok {
+			p.error("Integer out of range.")
+		}
+		p.tok.next()
+		return float64(v), true
+	case p.lookingAt("inf"):
+		p.tok.next()
+		return math.Inf(1), true
+	case p.lookingAt("nan"):
+		p.tok.next()
+		return math.NaN(), true
+	}
+	p.error("%s", msg)
+	return 0, false
+}
+
+// parseFloat parses a float token, which may lack digits after the
+// decimal point or in the exponent.
+func parseFloat(text string) float64 {
+	v, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		// Trim what strtod would not accept, such as a dangling "e".
+		for len(text) > 0 {
+			text = text[:len(text)-1]
+			if v, err = strconv.ParseFloat(text, 64); err == nil {
+				break
+			}
+		}
+	}
+	return v
+}
+
+func (p *parser) consumeString(msg string) (string, bool) {
+	if !p.lookingAtType(tokenString) {
+		p.error("%s", msg)
+		return "", false
+	}
+	s := unquote(p.tok.current.text)
+	p.tok.next()
+	// Adjacent strings are concatenated, as in C.
+	for p.lookingAtType(tokenString) {
+		s += unquote(p.tok.current.text)
+		p.tok.next()
+	}
+	return s, true
+}
+
+// tryConsumeEndOfDeclaration consumes the token ending a declaration,
+// such as ";" or "{", and attaches to loc the comments read before the
+// declaration and after the token.
+func (p *parser) tryConsumeEndOfDeclaration(text string, loc *location) bool {
+	if !p.lookingAt(text) {
+		return false
+	}
+	trailing, detached, leading, _ := p.tok.nextWithComments()
+	// Save the leading comments for the next declaration, and recall
+	// those of this one.
+	leading, p.upcomingDoc = p.upcomingDoc, leading
+	switch {
+	case loc != nil:
+		detached, p.upcomingDetached = p.upcomingDetached, detached
+		loc.attachComments(leading, trailing, detached)
+	case text == "}":
+		// Pending detached comments are dropped at the end of a scope.
+		p.upcomingDetached = detached
+	default:
+		p.upcomingDetached = append(p.upcomingDetached, detached...)
+	}
+	return true
+}
+
+func (p *parser) consumeEndOfDeclaration(text string, loc *location) bool {
+	if p.tryConsumeEndOfDeclaration(text, loc) {
+		return true
+	}
+	p.error("Expected \"%s\".", text)
+	return false
+}
+
+// skipStatement skips the rest of a statement that failed to parse.
+func (p *parser) skipStatement() {
+	for !p.atEnd() {
+		if p.lookingAtType(tokenSymbol) {
+			switch {
+			case p.tryConsumeEndOfDeclaration(";", nil):
+				return
+			case p.tryConsume("{"):
+				p.skipRestOfBlock()
+				return
+			case p.lookingAt("}"):
+				return
+			}
+		}
+		p.tok.next()
+	}
+}
+
+func (p *parser) skipRestOfBlock() {
+	for !p.atEnd() {
+		if p.lookingAtType(tokenSymbol) {
+			switch {
+			case p.tryConsumeEndOfDeclaration("}", nil):
+				return
+			case p.tryConsume("{"):
+				p.skipRestOfBlock()
+			}
+		}
+		p.tok.next()
+	}
+}
+
+func (p *parser) parseFile(fd *descpb.FileDescriptorProto) {
+	_, p.upcomingDetached, p.upcomingDoc, _ = p.tok.nextWithComments()
+	root := p.newLocation(p.info, nil)
+	defer root.end()
+
+	if p.lookingAt("syntax") {
+		if !p.parseSyntax(root) {
+			return
+		}
+	} else {
+		p.syntax = "proto2"
+	}
+
+	for !p.atEnd() {
+		if !p.parseTopLevelStatement(fd, root) {
+			p.skipStatement()
+			if p.lookingAt("}") {
+				p.error("Unmatched \"}\".")
+				_, p.upcomingDetached, p.upcomingDoc, _ = p.tok.nextWithComments()
+			}
+		}
+	}
+}
+
+func (p *parser) parseSyntax(parent *location) bool {
+	loc := parent.child(fileSyntaxTag)
+	defer loc.end()
+	if !p.consumeOr("syntax", "File must begin with a syntax statement, e.g. 'syntax = \"proto2\";'.") || !p.consume("=") {
+		return false
+	}
+	tok := p.tok.current
+	syntax, ok := p.consumeString("Expected syntax identifier.")
+	if !ok || !p.consumeEndOfDeclaration(";", loc) {
+		return false
+	}
+	p.syntax = syntax
+	if syntax != "proto2" && syntax != "proto3" {
+		p.errorAt(tok.line, tok.column, "Unrecognized syntax identifier \"%s\".  This parser only recognizes \"proto2\" and \"proto3\".", syntax)
+		return false
+	}
+	return true
+}
+
+func (p *parser) parseTopLevelStatement(fd *descpb.FileDescriptorProto, root *location) bool {
+	switch {
+	case p.tryConsumeEndOfDeclaration(";", nil):
+		// An empty statement.
+		return true
+	case p.lookingAt("message"):
+		md := new(descpb.DescriptorProto)
+		loc := root.child(fileMessageTag, int32(len(fd.MessageType)))
+		fd.MessageType = append(fd.MessageType, md)
+		defer loc.end()
+		return p.parseMessageDefinition(md, loc)
+	case p.lookingAt("enum"):
+		ed := new(descpb.EnumDescriptorProto)
+		loc := root.child(fileEnumTag, int32(len(fd.EnumType)))
+		fd.EnumType = append(fd.EnumType, ed)
+		defer loc.end()
+		return p.parseEnumDefinition(ed, loc)
+	case p.lookingAt("service"):
+		sd := new(descpb.ServiceDescriptorProto)
+		loc := root.child(fileServiceTag, int32(len(fd.Service)))
+		fd.Service = append(fd.Service, sd)
+		defer loc.end()
+		return p.parseServiceDefinition(sd, loc)
+	case p.lookingAt("extend"):
+		loc := root.child(fileExtensionTag)
+		defer loc.end()
+		return p.parseExtend(&fd.Extension, &fd.MessageType, root, fileMessageTag, loc)
+	case p.lookingAt("import"):
+		return p.parseImport(fd, root)
+	case p.lookingAt("package"):
+		return p.parsePackage(fd, root)
+	case p.lookingAt("option"):
+		loc := root.child(fileOptionsTag)
+		defer loc.end()
+		if fd.Options == nil {
+			fd.Options = new(descpb.FileOptions)
+		}
+		return p.parseOption(&fd.Options.UninterpretedOption, loc, true)
+	}
+	p.error("Expected top-level statement (e.g. \"message\").")
+	return false
+}
+
+func (p *parser) parseImport(fd *descpb.FileDescriptorProto, root *location) bool {
+	loc := root.child(fileDependencyTag, int32(len(fd.Dependency)))
+	defer loc.end()
+	if !p.consume("import") {
+		return false
+	}
+	switch {
+	case p.lookingAt("public"):
+		pub := root.child(filePublicDependencyTag, int32(len(fd.PublicDependency)))
+		p.consume("public")
+		pub.end()
+		fd.PublicDependency = append(fd.PublicDependency, int32(len(fd.Dependency)))
+	case p.lookingAt("weak"):
+		weak := root.child(fileWeakDependencyTag, int32(len(fd.WeakDependency)))
+		p.consume("weak")
+		weak.end()
+		fd.WeakDependency = append(fd.WeakDependency, int32(len(fd.Dependency)))
+	}
+	name, ok := p.consumeString("Expected a string naming the file to import.")
+	if !ok {
+		return false
+	}
+	fd.Dependency = append(fd.Dependency, name)
+	return p.consumeEndOfDeclaration(";", loc)
+}
+
+func (p *parser) parsePackage(fd *descpb.FileDescriptorProto, root *location) bool {
+	if fd.Package != nil {
+		p.error("Multiple package definitions.")
+		fd.Package = nil
+	}
+	loc := root.child(filePackageTag)
+	defer loc.end()
+	if !p.consume("package") {
+		return false
+	}
+	pkg := ""
+	for {
+		ident, ok := p.consumeIdentifier("Expected identifier.")
+		if !ok {
+			return false
+		}
+		pkg += ident
+		fd.Package = proto.String(pkg)
+		if !p.tryConsume(".") {
+			break
+		}
+		pkg += "."
+	}
+	return p.consumeEndOfDeclaration(";", loc)
+}
+
+func (p *parser) parseMessageDefinition(md *descpb.DescriptorProto, loc *location) bool {
+	if !p.consume("message") {
+		return false
+	}
+	name := loc.child(messageNameTag)
+	ident, ok := p.consumeIdentifier("Expected message name.")
+	name.end()
+	if !ok {
+		return false
+	}
+	md.Name = proto.String(ident)
+	return p.parseMessageBlock(md, loc)
+}
+
+func (p *parser) parseMessageBlock(md *descpb.DescriptorProto, loc *location) bool {
+	if !p.consumeEndOfDeclaration("{", loc) {
+		return false
+	}
+	for !p.tryConsumeEndOfDeclaration("}", nil) {
+		if p.atEnd() {
+			p.error("Reached end of input in message definition (missing '}').")
+			return false
+		}
+		if !p.parseMessageStatement(md, loc) {
+			p.skipStatement()
+		}
+	}
+	adjustRanges(md)
+	return true
+}
+
+// adjustRanges sets the end of ranges ending in "max".
+func adjustRanges(md *descpb.DescriptorProto) {
+	max := int32(maxFieldNumber + 1)
+	for _, opt := range md.GetOptions().GetUninterpretedOption() {
+		if len(opt.Name) == 1 && !opt.Name[0].GetIsExtension() && opt.Name[0].GetNamePart() == "message_set_wire_format" && opt.GetIdentifierValue() == "true" {
+			max = math.MaxInt32
+		}
+	}
+	for _, r := range md.ExtensionRange {
+		if r.GetEnd() == maxRangeSentinel {
+			r.End = proto.Int32(max)
+		}
+	}
+	for _, r := range md.ReservedRange {
+		if r.GetEnd() == maxRangeSentinel {
+			r.End = proto.Int32(max)
+		}
+	}
+}
+
+func (p *parser) parseMessageStatement(md *descpb.DescriptorProto, loc *location) bool {
+	switch {
+	case p.tryConsumeEndOfDeclaration(";", nil):
+		return true
+	case p.lookingAt("message"):
+		nd := new(descpb.DescriptorProto)
+		child := loc.child(messageNestedTag, int32(len(md.NestedType)))
+		md.NestedType = append(md.NestedType, nd)
+		defer child.end()
+		return p.parseMessageDefinition(nd, child)
+	case p.lookingAt("enum"):
+		ed := new(descpb.EnumDescriptorProto)
+		child := loc.child(messageEnumTag, int32(len(md.EnumType)))
+		md.EnumType = append(md.EnumType, ed)
+		defer child.end()
+		return p.parseEnumDefinition(ed, child)
+	case p.lookingAt("extensions"):
+		child := loc.child(messageExtensionRangeTag)
+		defer child.end()
+		return p.parseExtensions(md, child)
+	case p.lookingAt("reserved"):
+		return p.parseReserved(md, loc)
+	case p.lookingAt("extend"):
+		child := loc.child(messageExtensionTag)
+		defer child.end()
+		return p.parseExtend(&md.Extension, &md.NestedType, loc, messageNestedTag, child)
+	case p.lookingAt("option"):
+		child := loc.child(messageOptionsTag)
+		defer child.end()
+		if md.Options == nil {
+			md.Options = new(descpb.MessageOptions)
+		}
+		return p.parseOption(&md.Options.UninterpretedOption, child, true)
+	case p.lookingAt("oneof"):
+		index := int32(len(md.OneofDecl))
+		od := new(descpb.OneofDescriptorProto)
+		child := loc.child(messageOneofTag, index)
+		md.OneofDecl = append(md.OneofDecl, od)
+		defer child.end()
+		return p.parseOneof(od, md, index, child, loc)
+	}
+	f := new(descpb.FieldDescriptorProto)
+	child := loc.child(messageFieldTag, int32(len(md.Field)))
+	md.Field = append(md.Field, f)
+	defer child.end()
+	return p.parseMessageField(f, &md.NestedType, loc, messageNestedTag, child)
+}
+
+func (p *parser) parseMessageField(f *descpb.FieldDescriptorProto, messages *[]*descpb.DescriptorProto, parent *location, nestedTag int32, loc *location) bool {
+	if p.lookingAt("optional") || p.lookingAt("repeated") || p.lookingAt("required") {
+		label := loc.child(fieldLabelTag)
+		switch {
+		case p.tryConsume("optional"):
+			f.Label = descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+			if p.syntax == "proto3" {
+				p.error("Explicit 'optional' labels are disallowed in the Proto3 syntax. To define 'optional' fields in Proto3, simply remove the 'optional' label, as fields are 'optional' by default.")
+			}
+		case p.tryConsume("repeated"):
+			f.Label = descpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		default:
+			p.consume("required")
+			f.Label = descpb.FieldDescriptorProto_LABEL_REQUIRED.Enum()
+		}
+		label.end()
+	}
+	return p.parseMessageFieldNoLabel(f, messages, parent, nestedTag, loc)
+}
+
+// A mapField holds the key and value types of a map field.
+type mapField struct {
+	keyType, valueType         descpb.FieldDescriptorProto_Type
+	keyTypeName, valueTypeName string
+}
+
+func (p *parser) parseMessageFieldNoLabel(f *descpb.FieldDescriptorProto, messages *[]*descpb.DescriptorProto, parent *location, nestedTag int32, loc *location) bool {
+	var m *mapField
+
+	// Parse the type.
+	typeLoc := loc.child()
+	typeParsed := false
+	var typ descpb.FieldDescriptorProto_Type
+	var typeName string
+	if p.tryConsume("map") {
+		if p.lookingAt("<") {
+			m = new(mapField)
+		} else {
+			// A message or enum named "map".
+			typeParsed = true
+			typeName = "map"
+		}
+	}
+	if m != nil {
+		switch {
+		case f.OneofIndex != nil:
+			p.error("Map fields are not allowed in oneofs.")
+			return false
+		case f.Label != nil:
+			p.error("Field labels (required/optional/repeated) are not allowed on map fields.")
+			return false
+		case f.Extendee != nil:
+			p.error("Map fields are not allowed to be extensions.")
+			return false
+		}
+		f.Label = descpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		var ok bool
+		if !p.consume("<") {
+			return false
+		}
+		if m.keyType, m.keyTypeName, ok = p.parseType(); !ok || !p.consume(",") {
+			return false
+		}
+		if m.valueType, m.valueTypeName, ok = p.parseType(); !ok || !p.consume(">") {
+			return false
+		}
+		typeLoc.addPath(fieldTypeNameTag)
+	} else {
+		if f.Label == nil && p.syntax == "proto3" {
+			f.Label = descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+		}
+		if f.Label == nil {
+			p.error("Expected \"required\", \"optional\", or \"repeated\".")
+			f.Label = descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+		}
+		if !typeParsed {
+			var ok bool
+			if typ, typeName, ok = p.parseType(); !ok {
+				return false
+			}
+		}
+		if typeName == "" {
+			typeLoc.addPath(fieldTypeTag)
+			f.Type = typ.Enum()
+		} else {
+			typeLoc.addPath(fieldTypeNameTag)
+			f.TypeName = proto.String(typeName)
+		}
+	}
+	typeLoc.end()
+
+	// Parse the name and number.
+	nameTok := p.tok.current
+	nameLoc := loc.child(fieldNameTag)
+	name, ok := p.consumeIdentifier("Expected field name.")
+	nameLoc.end()
+	if !ok {
+		return false
+	}
+	f.Name = proto.String(name)
+	if !p.consumeOr("=", "Missing field number.") {
+		return false
+	}
+	numberLoc := loc.child(fieldNumberTag)
+	number, ok := p.consumeInteger("Expected field number.")
+	numberLoc.end()
+	if !ok {
+		return false
+	}
+	f.Number = proto.Int32(number)
+
+	if !p.parseFieldOptions(f, loc) {
+		return false
+	}
+
+	if f.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP {
+		// A group declares both a field and a message, whose locations
+		// overlap.
+		groupLoc := parent.child(nestedTag, int32(len(*messages)))
+		groupLoc.loc.Span = append([]int32(nil), loc.loc.Span[:2]...)
+		defer groupLoc.end()
+		group := &descpb.DescriptorProto{Name: proto.String(name)}
+		*messages = append(*messages, group)
+
+		groupName := groupLoc.child(messageNameTag)
+		groupName.startAt(nameTok)
+		groupName.endAt(nameTok)
+		typeName := loc.child(fieldTypeNameTag)
+		typeName.startAt(nameTok)
+		typeName.endAt(nameTok)
+
+		if name[0] < 'A' || 'Z' < name[0] {
+			p.errorAt(nameTok.line, nameTok.column, "Group names must start with a capital letter.")
+		}
+		f.Name = proto.String(lower(name))
+		f.TypeName = proto.String(name)
+		if !p.lookingAt("{") {
+			p.error("Missing group body.")
+			return false
+		}
+		if !p.parseMessageBlock(group, groupLoc) {
+			return false
+		}
+	} else if !p.consumeEndOfDeclaration(";", loc) {
+		return false
+	}
+
+	if m != nil {
+		*messages = append(*messages, mapEntry(m, f))
+	}
+	return true
+}
+
+func lower(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
+	}
+	return string(b)
+}
+
+// mapEntryName returns the name of the map entry message of a map field.
+func mapEntryName(field string) string {
+	var b []byte
+	upper := true
+	for i := 0; i < len(field); i++ {
+		c := field[i]
+		switch {
+		case c == '_':
+			upper = true
+			continue
+		case upper && 'a' <= c && c <= 'z':
+			c -= 'a' - 'A'
+		}
+		upper = false
+		b = append(b, c)
+	}
+	return string(b) + "Entry"
+}
+
+// mapEntry returns the map entry message of a map field, and sets the
+// type name of the field.
+func mapEntry(m *mapField, f *descpb.FieldDescriptorProto) *descpb.DescriptorProto {
+	name := mapEntryName(f.GetName())
+	f.TypeName = proto.String(name)
+	entry := &descpb.DescriptorProto{
+		Name:    proto.String(name),
+		Options: &descpb.MessageOptions{MapEntry: proto.Bool(true)},
+	}
+	for i, kv := range []struct {
+		name     string
+		typ      descpb.FieldDescriptorProto_Type
+		typeName string
+	}{
+		{"key", m.keyType, m.keyTypeName},
+		{"value", m.valueType, m.valueTypeName},
+	} {
+		ef := &descpb.FieldDescriptorProto{
+			Name:   proto.String(kv.name),
+			Number: proto.Int32(int32(i + 1)),
+			Label:  descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		}
+		if kv.typeName == "" {
+			ef.Type = kv.typ.Enum()
+		} else {
+			ef.TypeName = proto.String(kv.typeName)
+		}
+		entry.Field = append(entry.Field, ef)
+	}
+	return entry
+}
+
+// parseType parses a scalar type, returned as typ, or the name of a
+// message or enum type.
+func (p *parser) parseType() (typ descpb.FieldDescriptorProto_Type, typeName string, ok bool) {
+	if t, ok := scalarTypes[p.tok.current.text]; ok {
+		p.tok.next()
+		return t, "", true
+	}
+	typeName, ok = p.parseUserDefinedType()
+	return 0, typeName, ok
+}
+
+func (p *parser) parseUserDefinedType() (string, bool) {
+	if _, ok := scalarTypes[p.tok.current.text]; ok {
+		p.error("Expected message type.")
+		// Pretend to accept the type, to go on parsing.
+		s := p.tok.current.text
+		p.tok.next()
+		return s, true
+	}
+	name := ""
+	if p.tryConsume(".") {
+		name = "."
+	}
+	ident, ok := p.consumeIdentifier("Expected type name.")
+	if !ok {
+		return "", false
+	}
+	name += ident
+	for p.tryConsume(".") {
+		ident, ok := p.consumeIdentifier("Expected identifier.")
+		if !ok {
+			return "", false
+		}
+		name += "." + ident
+	}
+	return name, true
+}
+
+func (p *parser) parseFieldOptions(f *descpb.FieldDescriptorProto, fieldLoc *location) bool {
+	if !p.lookingAt("[") {
+		return true
+	}
+	loc := fieldLoc.child(fieldOptionsTag)
+	defer loc.end()
+	p.consume("[")
+	for {
+		switch {
+		case p.lookingAt("default"):
+			// The default value is not an option, so its location is
+			// relative to the field.
+			if !p.parseDefaultAssignment(f, fieldLoc) {
+				return false
+			}
+		case p.lookingAt("json_name"):
+			if !p.parseJSONName(f, fieldLoc) {
+				return false
+			}
+		default:
+			if f.Options == nil {
+				f.Options = new(descpb.FieldOptions)
+			}
+			if !p.parseOption(&f.Options.UninterpretedOption, loc, false) {
+				return false
+			}
+		}
+		if !p.tryConsume(",") {
+			break
+		}
+	}
+	return p.consume("]")
+}
+
+func (p *parser) parseDefaultAssignment(f *descpb.FieldDescriptorProto, fieldLoc *location) bool {
+	if f.DefaultValue != nil {
+		p.error("Already set option \"default\".")
+		f.DefaultValue = nil
+	}
+	loc := fieldLoc.child(fieldDefaultTag)
+	defer loc.end()
+	if !p.consume("default") || !p.consume("=") {
+		return false
+	}
+
+	if f.Type == nil {
+		// The type is a message or enum, as yet unknown, so take the
+		// token as is; the linker checks it.
+		f.DefaultValue = proto.String(p.tok.current.text)
+		p.tok.next()
+		return true
+	}
+
+	var value string
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_INT64,
+		descpb.FieldDescriptorProto_TYPE_SINT32, descpb.FieldDescriptorProto_TYPE_SINT64,
+		descpb.FieldDescriptorProto_TYPE_SFIXED32, descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		max := uint64(math.MaxInt64)
+		switch f.GetType() {
+		case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_SINT32, descpb.FieldDescriptorProto_TYPE_SFIXED32:
+			max = math.MaxInt32
+		}
+		if p.tryConsume("-") {
+			value = "-"
+			max++
+		}
+		v, ok := p.consumeInteger64(max, "Expected integer for field default value.")
+		if !ok {
+			return false
+		}
+		value += strconv.FormatUint(v, 10)
+
+	case descpb.FieldDescriptorProto_TYPE_UINT32, descpb.FieldDescriptorProto_TYPE_UINT64,
+		descpb.FieldDescriptorProto_TYPE_FIXED32, descpb.FieldDescriptorProto_TYPE_FIXED64:
+		max := uint64(math.MaxUint64)
+		if f.GetType() == descpb.FieldDescriptorProto_TYPE_UINT32 || f.GetType() == descpb.FieldDescriptorProto_TYPE_FIXED32 {
+			max = math.MaxUint32
+		}
+		if p.tryConsume("-") {
+			p.error("Unsigned field can't have negative default value.")
+		}
+		v, ok := p.consumeInteger64(max, "Expected integer for field default value.")
+		if !ok {
+			return false
+		}
+		value = strconv.FormatUint(v, 10)
+
+	case descpb.FieldDescriptorProto_TYPE_FLOAT, descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		if p.tryConsume("-") {
+			value = "-"
+		}
+		v, ok := p.consumeNumber("Expected number.")
+		if !ok {
+			return false
+		}
+		value += simpleDtoa(v)
+
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		switch {
+		case p.tryConsume("true"):
+			value = "true"
+		case p.tryConsume("false"):
+			value = "false"
+		default:
+			p.error("Expected \"true\" or \"false\".")
+			return false
+		}
+
+	case descpb.FieldDescriptorProto_TYPE_STRING:
+		v, ok := p.consumeString("Expected string for field default value.")
+		if !ok {
+			return false
+		}
+		value = v
+
+	case descpb.FieldDescriptorProto_TYPE_BYTES:
+		v, ok := p.consumeString("Expected string.")
+		if !ok {
+			return false
+		}
+		value = cEscape(v)
+
+	case descpb.FieldDescriptorProto_TYPE_GROUP:
+		p.error("Messages can't have default values.")
+		return false
+	}
+	f.DefaultValue = proto.String(value)
+	return true
+}
+
+func (p *parser) parseJSONName(f *descpb.FieldDescriptorProto, fieldLoc *location) bool {
+	if f.JsonName != nil {
+		p.error("Already set option \"json_name\".")
+		f.JsonName = nil
+	}
+	loc := fieldLoc.child(fieldJSONNameTag)
+	defer loc.end()
+	if !p.consume("json_name") || !p.consume("=") {
+		return false
+	}
+	value := loc.child()
+	defer value.end()
+	name, ok := p.consumeString("Expected string for JSON name.")
+	if !ok {
+		return false
+	}
+	f.JsonName = proto.String(name)
+	return true
+}
+
+// parseOption parses an option, either a statement, such as
+// "option java_package = "x";", or an assignment within brackets. The
+// option is appended, uninterpreted, to opts.
+func (p *parser) parseOption(opts *[]*descpb.UninterpretedOption, optionsLoc *location, statement bool) bool {
+	loc := optionsLoc.child(uninterpretedOptionTag, int32(len(*opts)))
+	defer loc.end()
+	if statement && !p.consume("option") {
+		return false
+	}
+	opt := new(descpb.UninterpretedOption)
+	*opts = append(*opts, opt)
+
+	// Parse the dot-separated name.
+	nameLoc := loc.child(optionNameTag)
+	for first := true; first || p.lookingAt("."); first = false {
+		if !first {
+			p.consume(".")
+		}
+		part := nameLoc.child(optionNamePartTag, int32(len(opt.Name)))
+		ok := p.parseOptionNamePart(opt, part)
+		part.end()
+		if !ok {
+			return false
+		}
+	}
+	nameLoc.end()
+
+	if !p.consume("=") {
+		return false
+	}
+
+	value := loc.child()
+	neg := p.tryConsume("-")
+	switch p.tok.current.typ {
+	case tokenEnd:
+		p.error("Unexpected end of stream while parsing option value.")
+		return false
+
+	case tokenIdent:
+		value.addPath(optionIdentifierTag)
+		if neg {
+			switch p.tok.current.text {
+			case "inf":
+				opt.DoubleValue = proto.Float64(math.Inf(-1))
+			case "nan":
+				opt.DoubleValue = proto.Float64(math.NaN())
+			default:
+				p.error("Identifier after '-' symbol must be inf or nan.")
+				return false
+			}
+			p.tok.next()
+			break
+		}
+		ident, _ := p.consumeIdentifier("Expected identifier.")
+		opt.IdentifierValue = proto.String(ident)
+
+	case tokenInt:
+		max := uint64(math.MaxUint64)
+		if neg {
+			max = math.MaxInt64 + 1
+		}
+		v, ok := p.consumeInteger64(max, "Expected integer.")
+		if !ok {
+			return false
+		}
+		if neg {
+			value.addPath(optionNegativeIntTag)
+			opt.NegativeIntValue = proto.Int64(int64(-v))
+		} else {
+			value.addPath(optionPositiveIntTag)
+			opt.PositiveIntValue = proto.Uint64(v)
+		}
+
+	case tokenFloat:
+		value.addPath(optionDoubleTag)
+		v, _ := p.consumeNumber("Expected number.")
+		if neg {
+			v = -v
+		}
+		opt.DoubleValue = proto.Float64(v)
+
+	case tokenString:
+		value.addPath(optionStringTag)
+		if neg {
+			p.error("Invalid '-' symbol before string.")
+			return false
+		}
+		s, _ := p.consumeString("Expected string.")
+		opt.StringValue = []byte(s)
+
+	case tokenSymbol:
+		if !p.lookingAt("{") {
+			p.error("Expected option value.")
+			return false
+		}
+		value.addPath(optionAggregateTag)
+		s, ok := p.parseUninterpretedBlock()
+		if !ok {
+			return false
+		}
+		opt.AggregateValue = proto.String(s)
+	}
+	value.end()
+
+	if statement {
+		return p.consumeEndOfDeclaration(";", loc)
+	}
+	return true
+}
+
+func (p *parser) parseOptionNamePart(opt *descpb.UninterpretedOption, loc *location) bool {
+	part := new(descpb.UninterpretedOption_NamePart)
+	opt.Name = append(opt.Name, part)
+	if p.tryConsume("(") {
+		// An extension, whose name may be fully qualified.
+		partLoc := loc.child(optionNamePartNameTag)
+		name := ""
+		if p.lookingAtType(tokenIdent) {
+			ident, _ := p.consumeIdentifier("Expected identifier.")
+			name = ident
+		}
+		for p.lookingAt(".") {
+			p.consume(".")
+			ident, ok := p.consumeIdentifier("Expected identifier.")
+			if !ok {
+				return false
+			}
+			name += "." + ident
+		}
+		partLoc.end()
+		if !p.consume(")") {
+			return false
+		}
+		part.NamePart = proto.String(name)
+		part.IsExtension = proto.Bool(true)
+		return true
+	}
+	partLoc := loc.child(optionNamePartNameTag)
+	ident, ok := p.consumeIdentifier("Expected identifier.")
+	partLoc.end()
+	if !ok {
+		return false
+	}
+	part.NamePart = proto.String(ident)
+	part.IsExtension = proto.Bool(false)
+	return true
+}
+
+// parseUninterpretedBlock returns the tokens of an aggregate option value,
+// between braces, separated by spaces.
+func (p *parser) parseUninterpretedBlock() (string, bool) {
+	if !p.consume("{") {
+		return "", false
+	}
+	var s []byte
+	depth := 1
+	for !p.atEnd() {
+		switch {
+		case p.lookingAt("{"):
+			depth++
+		case p.lookingAt("}"):
+			depth--
+			if depth == 0 {
+				p.tok.next()
+				return string(s), true
+			}
+		}
+		if len(s) > 0 {
+			s = append(s, ' ')
+		}
+		s = append(s, p.tok.current.text...)
+		p.tok.next()
+	}
+	p.error("Unexpected end of stream while parsing aggregate value.")
+	return "", false
+}
+
+func (p *parser) parseExtensions(md *descpb.DescriptorProto, loc *location) bool {
+	if !p.consume("extensions") {
+		return false
+	}
+	first := len(md.ExtensionRange)
+	for {
+		rangeLoc := loc.child(int32(len(md.ExtensionRange)))
+		r := new(descpb.DescriptorProto_ExtensionRange)
+		md.ExtensionRange = append(md.ExtensionRange, r)
+		start, end, ok := p.parseRange(rangeLoc, "Expected field number range.", false)
+		rangeLoc.end()
+		if !ok {
+			return false
+		}
+		r.Start = proto.Int32(start)
+		r.End = proto.Int32(end + 1)
+		if !p.tryConsume(",") {
+			break
+		}
+	}
+
+	if p.lookingAt("[") {
+		// The options apply to every range of the statement, so their
+		// locations are recorded once, then copied for each range.
+		index := len(loc.loc.Path)
+		info := new(descpb.SourceCodeInfo)
+		opts := new(descpb.ExtensionRangeOptions)
+		indexLoc := loc.childIn(info, 0)
+		optsLoc := indexLoc.child(rangeOptionsTag)
+		p.consume("[")
+		for {
+			if !p.parseOption(&opts.UninterpretedOption, optsLoc, false) {
+				return false
+			}
+			if !p.tryConsume(",") {
+				break
+			}
+		}
+		if !p.consume("]") {
+			return false
+		}
+		optsLoc.end()
+		indexLoc.end()
+		for i := first; i < len(md.ExtensionRange); i++ {
+			md.ExtensionRange[i].Options = proto.Clone(opts).(*descpb.ExtensionRangeOptions)
+			for _, l := range info.Location {
+				if len(l.Path) == index+1 {
+					continue
+				}
+				c := proto.Clone(l).(*descpb.SourceCodeInfo_Location)
+				c.Path[index] = int32(i)
+				p.info.Location = append(p.info.Location, c)
+			}
+		}
+	}
+	return p.consumeEndOfDeclaration(";", loc)
+}
+
+// parseRange parses a range of numbers, "n" or "n to m", returning its
+// inclusive ends. Message ranges may end in "max".
+func (p *parser) parseRange(loc *location, msg string, signed bool) (start, end int32, ok bool) {
+	startLoc := loc.child(rangeStartTag)
+	startTok := p.tok.current
+	if signed {
+		start, ok = p.consumeSignedInteger(msg)
+	} else {
+		start, ok = p.consumeInteger(msg)
+	}
+	startLoc.end()
+	if !ok {
+		return 0, 0, false
+	}
+	endLoc := loc.child(rangeEndTag)
+	defer endLoc.end()
+	if !p.tryConsume("to") {
+		endLoc.startAt(startTok)
+		endLoc.endAt(startTok)
+		return start, start, true
+	}
+	endLoc.startAt(p.tok.current)
+	if p.tryConsume("max") {
+		if signed {
+			return start, math.MaxInt32, true
+		}
+		return start, maxRangeSentinel - 1, true
+	}
+	if signed {
+		end, ok = p.consumeSignedInteger("Expected integer.")
+	} else {
+		end, ok = p.consumeInteger("Expected integer.")
+	}
+	return start, end, ok
+}
+
+func (p *parser) parseReserved(md *descpb.DescriptorProto, msgLoc *location) bool {
+	startTok := p.tok.current
+	if !p.consume("reserved") {
+		return false
+	}
+	if p.lookingAtType(tokenString) {
+		loc := msgLoc.child(messageReservedNameTag)
+		loc.startAt(startTok)
+		defer loc.end()
+		return p.parseReservedNames(&md.ReservedName, loc)
+	}
+	loc := msgLoc.child(messageReservedRangeTag)
+	loc.startAt(startTok)
+	defer loc.end()
+	msg := "Expected field name or number range."
+	for {
+		rangeLoc := loc.child(int32(len(md.ReservedRange)))
+		r := new(descpb.DescriptorProto_ReservedRange)
+		md.ReservedRange = append(md.ReservedRange, r)
+		start, end, ok := p.parseRange(rangeLoc, msg, false)
+		rangeLoc.end()
+		if !ok {
+			return false
+		}
+		r.Start = proto.Int32(start)
+		r.End = proto.Int32(end + 1)
+		msg = "Expected field number range."
+		if !p.tryConsume(",") {
+			break
+		}
+	}
+	return p.consumeEndOfDeclaration(";", loc)
+}
+
+func (p *parser) parseReservedNames(names *[]string, loc *location) bool {
+	for {
+		nameLoc := loc.child(int32(len(*names)))
+		name, ok := p.consumeString("Expected field name.")
+		nameLoc.end()
+		if !ok {
+			return false
+		}
+		*names = append(*names, name)
+		if !p.tryConsume(",") {
+			break
+		}
+	}
+	return p.consumeEndOfDeclaration(";", loc)
+}
+
+func (p *parser) parseExtend(exts *[]*descpb.FieldDescriptorProto, messages *[]*descpb.DescriptorProto, parent *location, nestedTag int32, loc *location) bool {
+	if !p.consume("extend") {
+		return false
+	}
+	extendeeStart := p.tok.current
+	extendee, ok := p.parseUserDefinedType()
+	if !ok {
+		return false
+	}
+	extendeeEnd := p.tok.previous
+	if !p.consumeEndOfDeclaration("{", loc) {
+		return false
+	}
+	for {
+		if p.atEnd() {
+			p.error("Reached end of input in extend definition (missing '}').")
+			return false
+		}
+		fieldLoc := loc.child(int32(len(*exts)))
+		f := &descpb.FieldDescriptorProto{Extendee: proto.String(extendee)}
+		*exts = append(*exts, f)
+		extendeeLoc := fieldLoc.child(fieldExtendeeTag)
+		extendeeLoc.startAt(extendeeStart)
+		extendeeLoc.endAt(extendeeEnd)
+		if !p.parseMessageField(f, messages, parent, nestedTag, fieldLoc) {
+			p.skipStatement()
+		}
+		fieldLoc.end()
+		if p.tryConsumeEndOfDeclaration("}", nil) {
+			return true
+		}
+	}
+}
+
+func (p *parser) parseOneof(od *descpb.OneofDescriptorProto, md *descpb.DescriptorProto, index int32, loc, msgLoc *location) bool {
+	if !p.consume("oneof") {
+		return false
+	}
+	nameLoc := loc.child(oneofNameTag)
+	name, ok := p.consumeIdentifier("Expected oneof name.")
+	nameLoc.end()
+	if !ok {
+		return false
+	}
+	od.Name = proto.String(name)
+	if !p.consumeEndOfDeclaration("{", loc) {
+		return false
+	}
+	for {
+		if p.atEnd() {
+			p.error("Reached end of input in oneof definition (missing '}').")
+			return false
+		}
+		if p.lookingAt("option") {
+			optLoc := loc.child(oneofOptionsTag)
+			if od.Options == nil {
+				od.Options = new(descpb.OneofOptions)
+			}
+			ok := p.parseOption(&od.Options.UninterpretedOption, optLoc, true)
+			optLoc.end()
+			if !ok {
+				return false
+			}
+		} else {
+			if p.lookingAt("required") || p.lookingAt("optional") || p.lookingAt("repeated") {
+				p.error("Fields in oneofs must not have labels (required / optional / repeated).")
+				p.tok.next()
+			}
+			fieldLoc := msgLoc.child(messageFieldTag, int32(len(md.Field)))
+			f := &descpb.FieldDescriptorProto{
+				Label:      descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				OneofIndex: proto.Int32(index),
+			}
+			md.Field = append(md.Field, f)
+			if !p.parseMessageFieldNoLabel(f, &md.NestedType, msgLoc, messageNestedTag, fieldLoc) {
+				p.skipStatement()
+			}
+			fieldLoc.end()
+		}
+		if p.tryConsumeEndOfDeclaration("}", nil) {
+			return true
+		}
+	}
+}
+
+func (p *parser) parseEnumDefinition(ed *descpb.EnumDescriptorProto, loc *location) bool {
+	if !p.consume("enum") {
+		return false
+	}
+	nameLoc := loc.child(enumNameTag)
+	name, ok := p.consumeIdentifier("Expected enum name.")
+	nameLoc.end()
+	if !ok {
+		return false
+	}
+	ed.Name = proto.String(name)
+	if !p.consumeEndOfDeclaration("{", loc) {
+		return false
+	}
+	for !p.tryConsumeEndOfDeclaration("}", nil) {
+		if p.atEnd() {
+			p.error("Reached end of input in enum definition (missing '}').")
+			return false
+		}
+		if !p.parseEnumStatement(ed, loc) {
+			p.skipStatement()
+		}
+	}
+	return true
+}
+
+func (p *parser) parseEnumStatement(ed *descpb.EnumDescriptorProto, loc *location) bool {
+	switch {
+	case p.tryConsumeEndOfDeclaration(";", nil):
+		return true
+	case p.lookingAt("option"):
+		child := loc.child(enumOptionsTag)
+		defer child.end()
+		if ed.Options == nil {
+			ed.Options = new(descpb.EnumOptions)
+		}
+		return p.parseOption(&ed.Options.UninterpretedOption, child, true)
+	case p.lookingAt("reserved"):
+		return p.parseEnumReserved(ed, loc)
+	}
+	vd := new(descpb.EnumValueDescriptorProto)
+	child := loc.child(enumValueTag, int32(len(ed.Value)))
+	ed.Value = append(ed.Value, vd)
+	defer child.end()
+	return p.parseEnumConstant(vd, child)
+}
+
+func (p *parser) parseEnumConstant(vd *descpb.EnumValueDescriptorProto, loc *location) bool {
+	nameLoc := loc.child(enumValueNameTag)
+	name, ok := p.consumeIdentifier("Expected enum constant name.")
+	nameLoc.end()
+	if !ok {
+		return false
+	}
+	vd.Name = proto.String(name)
+	if !p.consumeOr("=", "Missing numeric value for enum constant.") {
+		return false
+	}
+	numberLoc := loc.child(enumValueNumberTag)
+	number, ok := p.consumeSignedInteger("Expected integer.")
+	numberLoc.end()
+	if !ok {
+		return false
+	}
+	vd.Number = proto.Int32(number)
+
+	if p.lookingAt("[") {
+		optsLoc := loc.child(enumValueOptionsTag)
+		p.consume("[")
+		vd.Options = new(descpb.EnumValueOptions)
+		for {
+			if !p.parseOption(&vd.Options.UninterpretedOption, optsLoc, false) {
+				return false
+			}
+			if !p.tryConsume(",") {
+				break
+			}
+		}
+		if !p.consume("]") {
+			return false
+		}
+		optsLoc.end()
+	}
+	return p.consumeEndOfDeclaration(";", loc)
+}
+
+func (p *parser) parseEnumReserved(ed *descpb.EnumDescriptorProto, enumLoc *location) bool {
+	startTok := p.tok.current
+	if !p.consume("reserved") {
+		return false
+	}
+	if p.lookingAtType(tokenString) {
+		loc := enumLoc.child(enumReservedNameTag)
+		loc.startAt(startTok)
+		defer loc.end()
+		return p.parseReservedNames(&ed.ReservedName, loc)
+	}
+	loc := enumLoc.child(enumReservedRangeTag)
+	loc.startAt(startTok)
+	defer loc.end()
+	msg := "Expected enum value or number range."
+	for {
+		rangeLoc := loc.child(int32(len(ed.ReservedRange)))
+		r := new(descpb.EnumDescriptorProto_EnumReservedRange)
+		ed.ReservedRange = append(ed.ReservedRange, r)
+		start, end, ok := p.parseRange(rangeLoc, msg, true)
+		rangeLoc.end()
+		if !ok {
+			return false
+		}
+		r.Start = proto.Int32(start)
+		r.End = proto.Int32(end)
+		msg = "Expected enum number range."
+		if !p.tryConsume(",") {
+			break
+		}
+	}
+	return p.consumeEndOfDeclaration(";", loc)
+}
+
+func (p *parser) parseServiceDefinition(sd *descpb.ServiceDescriptorProto, loc *location) bool {
+	if !p.consume("service") {
+		return false
+	}
+	nameLoc := loc.child(serviceNameTag)
+	name, ok := p.consumeIdentifier("Expected service name.")
+	nameLoc.end()
+	if !ok {
+		return false
+	}
+	sd.Name = proto.String(name)
+	if !p.consumeEndOfDeclaration("{", loc) {
+		return false
+	}
+	for !p.tryConsumeEndOfDeclaration("}", nil) {
+		if p.atEnd() {
+			p.error("Reached end of input in service definition (missing '}').")
+			return false
+		}
+		if !p.parseServiceStatement(sd, loc) {
+			p.skipStatement()
+		}
+	}
+	return true
+}
+
+func (p *parser) parseServiceStatement(sd *descpb.ServiceDescriptorProto, loc *location) bool {
+	switch {
+	case p.tryConsumeEndOfDeclaration(";", nil):
+		return true
+	case p.lookingAt("option"):
+		child := loc.child(serviceOptionsTag)
+		defer child.end()
+		if sd.Options == nil {
+			sd.Options = new(descpb.ServiceOptions)
+		}
+		return p.parseOption(&sd.Options.UninterpretedOption, child, true)
+	}
+	md := new(descpb.MethodDescriptorProto)
+	child := loc.child(serviceMethodTag, int32(len(sd.Method)))
+	sd.Method = append(sd.Method, md)
+	defer child.end()
+	return p.parseServiceMethod(md, child)
+}
+
+func (p *parser) parseServiceMethod(md *descpb.MethodDescriptorProto, loc *location) bool {
+	if !p.consume("rpc") {
+		return false
+	}
+	nameLoc := loc.child(methodNameTag)
+	name, ok := p.consumeIdentifier("Expected method name.")
+	nameLoc.end()
+	if !ok {
+		return false
+	}
+	md.Name = proto.String(name)
+
+	for _, dir := range []struct {
+		streamingTag, typeTag int32
+		streaming             **bool
+		typeName              **string
+	}{
+		{methodClientStreamingTag, methodInputTag, &md.ClientStreaming, &md.InputType},
+		{methodServerStreamingTag, methodOutputTag, &md.ServerStreaming, &md.OutputType},
+	} {
+		if dir.typeTag == methodOutputTag && !p.consume("returns") {
+			return false
+		}
+		if !p.consume("(") {
+			return false
+		}
+		if p.lookingAt("stream") {
+			streamLoc := loc.child(dir.streamingTag)
+			p.consume("stream")
+			streamLoc.end()
+			*dir.streaming = proto.Bool(true)
+		}
+		typeLoc := loc.child(dir.typeTag)
+		typeName, ok := p.parseUserDefinedType()
+		typeLoc.end()
+		if !ok {
+			return false
+		}
+		*dir.typeName = proto.String(typeName)
+		if !p.consume(")") {
+			return false
+		}
+	}
+
+	if !p.lookingAt("{") {
+		return p.consumeEndOfDeclaration(";", loc)
+	}
+	// A block of options, which may be empty.
+	md.Options = new(descpb.MethodOptions)
+	p.consumeEndOfDeclaration("{", loc)
+	for !p.tryConsumeEndOfDeclaration("}", nil) {
+		if p.atEnd() {
+			p.error("Reached end of input in method options (missing '}').")
+			return false
+		}
+		if p.tryConsumeEndOfDeclaration(";", nil) {
+			continue
+		}
+		optLoc := loc.child(methodOptionsTag)
+		if !p.parseOption(&md.Options.UninterpretedOption, optLoc, true) {
+			p.skipStatement()
+		}
+		optLoc.end()
+	}
+	return true
+}
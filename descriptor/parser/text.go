@@ -0,0 +1,458 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package parser
+
+// This file parses the values of aggregate options, which are messages in
+// the text format, such as
+//
+//	option (google.api.http) = { get: "/v1/users" additional_bindings { post: "/v1/users" } };
+//
+// and encodes them, as protoc does, in field-number order.
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// A textMessage holds the fields of a message being parsed.
+type textMessage struct {
+	sym    symbol // the message type
+	name   string // full name of the message type
+	fields map[int32]*textField
+}
+
+type textField struct {
+	f      *descpb.FieldDescriptorProto
+	values [][]byte       // encoded without tags
+	subs   []*textMessage // the values of message fields
+}
+
+type textParser struct {
+	oi         *optionInterpreter
+	relativeTo string // element whose option is parsed, to resolve extension names
+	tok        *tokenizer
+	err        string
+}
+
+// parseAggregate parses text, a message of the type sym, or returns a
+// description of why it could not be parsed.
+func (oi *optionInterpreter) parseAggregate(relativeTo string, sym symbol, name, text string) (*textMessage, string) {
+	tp := &textParser{oi: oi, relativeTo: relativeTo}
+	tp.tok = newTokenizer([]byte(text), func(line, column int, format string, args ...interface{}) {
+		tp.errorf(format, args...)
+	})
+	tp.tok.next()
+	m := newTextMessage(sym, name)
+	if !tp.parseFields(m, "") || tp.err != "" {
+		return nil, tp.err
+	}
+	return m, ""
+}
+
+func newTextMessage(sym symbol, name string) *textMessage {
+	return &textMessage{sym: sym, name: name, fields: make(map[int32]*textField)}
+}
+
+func (tp *textParser) errorf(format string, args ...interface{}) {
+	if tp.err == "" {
+		tp.err = fmt.Sprintf(format, args...)
+	}
+}
+
+func (tp *textParser) lookingAt(text string) bool {
+	return tp.tok.current.typ != tokenString && tp.tok.current.text == text
+}
+
+func (tp *textParser) tryConsume(text string) bool {
+	if tp.lookingAt(text) {
+		tp.tok.next()
+		return true
+	}
+	return false
+}
+
+func (tp *textParser) consume(text string) bool {
+	if tp.tryConsume(text) {
+		return true
+	}
+	tp.errorf("Expected \"%s\", found \"%s\".", text, tp.tok.current.text)
+	return false
+}
+
+func (tp *textParser) consumeIdentifier() (string, bool) {
+	if tp.tok.current.typ != tokenIdent {
+		tp.errorf("Expected identifier, got: %s", tp.tok.current.text)
+		return "", false
+	}
+	s := tp.tok.current.text
+	tp.tok.next()
+	return s, true
+}
+
+// parseFields parses fields into m until the token end, or the end of
+// input if end is empty.
+func (tp *textParser) parseFields(m *textMessage, end string) bool {
+	for {
+		if tp.tok.current.typ == tokenEnd {
+			if end != "" {
+				tp.errorf("Expected \"%s\".", end)
+				return false
+			}
+			return true
+		}
+		if end != "" && tp.tryConsume(end) {
+			return true
+		}
+		if !tp.parseField(m) {
+			return false
+		}
+	}
+}
+
+func (tp *textParser) parseField(m *textMessage) bool {
+	var f *descpb.FieldDescriptorProto
+	if tp.tryConsume("[") {
+		name, ok := tp.consumeIdentifier()
+		if !ok {
+			return false
+		}
+		for tp.tryConsume(".") {
+			ident, ok := tp.consumeIdentifier()
+			if !ok {
+				return false
+			}
+			name += "." + ident
+		}
+		if !tp.consume("]") {
+			return false
+		}
+		if _, sym, ok := tp.oi.lk.syms.resolve(tp.relativeTo, name, false); ok && sym.kind == symField && strings.TrimPrefix(sym.field.GetExtendee(), ".") == m.name {
+			f = sym.field
+		}
+		if f == nil {
+			tp.errorf("Extension \"%s\" is not defined or is not an extension of \"%s\".", name, m.name)
+			return false
+		}
+	} else {
+		name, ok := tp.consumeIdentifier()
+		if !ok {
+			return false
+		}
+		f = findField(m.sym.message, name)
+		if f == nil {
+			// Groups are named by their type.
+			if g := findField(m.sym.message, strings.ToLower(name)); g != nil && g.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP {
+				f = g
+			}
+		}
+		if f != nil && f.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP && !strings.HasSuffix(f.GetTypeName(), "."+name) {
+			f = nil
+		}
+		if f == nil {
+			tp.errorf("Message type \"%s\" has no field named \"%s\".", m.name, name)
+			return false
+		}
+	}
+
+	if isMessage(f) {
+		tp.tryConsume(":")
+	} else if !tp.consume(":") {
+		return false
+	}
+	if f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED && tp.tryConsume("[") {
+		if !tp.tryConsume("]") {
+			for {
+				if !tp.parseValue(m, f) {
+					return false
+				}
+				if tp.tryConsume("]") {
+					break
+				}
+				if !tp.consume(",") {
+					return false
+				}
+			}
+		}
+	} else if !tp.parseValue(m, f) {
+		return false
+	}
+	if !tp.tryConsume(";") {
+		tp.tryConsume(",")
+	}
+	return true
+}
+
+func (tp *textParser) parseValue(m *textMessage, f *descpb.FieldDescriptorProto) bool {
+	var v []byte
+	var sub *textMessage
+	if isMessage(f) {
+		end := "}"
+		if tp.tryConsume("<") {
+			end = ">"
+		} else if !tp.consume("{") {
+			return false
+		}
+		sym, ok := tp.oi.lk.l.pool.message(f.GetTypeName())
+		if !ok {
+			tp.errorf("Message type \"%s\" is not defined.", strings.TrimPrefix(f.GetTypeName(), "."))
+			return false
+		}
+		sub = newTextMessage(sym, strings.TrimPrefix(f.GetTypeName(), "."))
+		if !tp.parseFields(sub, end) {
+			return false
+		}
+		v = sub.marshal()
+	} else {
+		var ok bool
+		if v, ok = tp.parseScalar(f); !ok {
+			return false
+		}
+	}
+
+	tf := m.fields[f.GetNumber()]
+	if tf == nil {
+		tf = &textField{f: f}
+		m.fields[f.GetNumber()] = tf
+	} else if f.GetLabel() != descpb.FieldDescriptorProto_LABEL_REPEATED {
+		tp.errorf("Non-repeated field \"%s\" is specified multiple times.", f.GetName())
+		return false
+	}
+	tf.values = append(tf.values, v)
+	if sub != nil {
+		tf.subs = append(tf.subs, sub)
+	}
+	return true
+}
+
+func (tp *textParser) parseScalar(f *descpb.FieldDescriptorProto) ([]byte, bool) {
+	typ := f.GetType()
+	cur := tp.tok.current
+	switch typ {
+	case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_SINT32, descpb.FieldDescriptorProto_TYPE_SFIXED32,
+		descpb.FieldDescriptorProto_TYPE_INT64, descpb.FieldDescriptorProto_TYPE_SINT64, descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		max := uint64(math.MaxInt64)
+		if typ == descpb.FieldDescriptorProto_TYPE_INT32 || typ == descpb.FieldDescriptorProto_TYPE_SINT32 || typ == descpb.FieldDescriptorProto_TYPE_SFIXED32 {
+			max = math.MaxInt32
+		}
+		neg := tp.tryConsume("-")
+		if neg {
+			max++
+		}
+		v, ok := tp.consumeInteger(max)
+		if !ok {
+			return nil, false
+		}
+		if neg {
+			return intPayload(typ, -int64(v)), true
+		}
+		return intPayload(typ, int64(v)), true
+
+	case descpb.FieldDescriptorProto_TYPE_UINT32, descpb.FieldDescriptorProto_TYPE_FIXED32,
+		descpb.FieldDescriptorProto_TYPE_UINT64, descpb.FieldDescriptorProto_TYPE_FIXED64:
+		max := uint64(math.MaxUint64)
+		if typ == descpb.FieldDescriptorProto_TYPE_UINT32 || typ == descpb.FieldDescriptorProto_TYPE_FIXED32 {
+			max = math.MaxUint32
+		}
+		v, ok := tp.consumeInteger(max)
+		if !ok {
+			return nil, false
+		}
+		return uintPayload(typ, v), true
+
+	case descpb.FieldDescriptorProto_TYPE_FLOAT, descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		neg := tp.tryConsume("-")
+		var v float64
+		cur = tp.tok.current
+		switch cur.typ {
+		case tokenInt:
+			n, ok := parseInteger(cur.text, math.MaxUint64)
+			if !ok {
+				tp.errorf("Integer out of range (%s)", cur.text)
+				return nil, false
+			}
+			v = float64(n)
+		case tokenFloat:
+			v = parseFloat(strings.TrimRight(cur.text, "fF"))
+		case tokenIdent:
+			switch strings.ToLower(cur.text) {
+			case "inf", "infinity":
+				v = math.Inf(1)
+			case "nan":
+				v = math.NaN()
+			default:
+				tp.errorf("Invalid float number: %s", cur.text)
+				return nil, false
+			}
+		default:
+			tp.errorf("Expected double, got: %s", cur.text)
+			return nil, false
+		}
+		tp.tok.next()
+		if neg {
+			v = -v
+		}
+		return floatPayload(typ, v), true
+
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		switch cur.text {
+		case "true", "True", "t", "1":
+			tp.tok.next()
+			return varintPayload(1), true
+		case "false", "False", "f", "0":
+			tp.tok.next()
+			return varintPayload(0), true
+		}
+		tp.errorf("Invalid value for boolean field \"%s\". Value: \"%s\".", f.GetName(), cur.text)
+		return nil, false
+
+	case descpb.FieldDescriptorProto_TYPE_ENUM:
+		sym, ok := tp.oi.lk.l.pool.enum(f.GetTypeName())
+		if !ok {
+			tp.errorf("Enum type \"%s\" is not defined.", strings.TrimPrefix(f.GetTypeName(), "."))
+			return nil, false
+		}
+		if cur.typ == tokenIdent {
+			tp.tok.next()
+			for _, vd := range sym.enum.Value {
+				if vd.GetName() == cur.text {
+					return intPayload(typ, int64(vd.GetNumber())), true
+				}
+			}
+		} else {
+			neg := tp.tryConsume("-")
+			n, ok := tp.consumeInteger(math.MaxInt32 + 1)
+			if !ok {
+				return nil, false
+			}
+			v := int64(n)
+			if neg {
+				v = -v
+			}
+			for _, vd := range sym.enum.Value {
+				if int64(vd.GetNumber()) == v {
+					return intPayload(typ, v), true
+				}
+			}
+		}
+		tp.errorf("Unknown enumeration value of \"%s\" for field \"%s\".", cur.text, f.GetName())
+		return nil, false
+
+	case descpb.FieldDescriptorProto_TYPE_STRING, descpb.FieldDescriptorProto_TYPE_BYTES:
+		if cur.typ != tokenString {
+			tp.errorf("Expected string, got: %s", cur.text)
+			return nil, false
+		}
+		var s string
+		for tp.tok.current.typ == tokenString {
+			s += unquote(tp.tok.current.text)
+			tp.tok.next()
+		}
+		return []byte(s), true
+	}
+	tp.errorf("Unsupported field type %v.", typ)
+	return nil, false
+}
+
+func (tp *textParser) consumeInteger(max uint64) (uint64, bool) {
+	cur := tp.tok.current
+	if cur.typ != tokenInt {
+		tp.errorf("Expected integer, got: %s", cur.text)
+		return 0, false
+	}
+	v, ok := parseInteger(cur.text, max)
+	if !ok {
+		tp.errorf("Integer out of range (%s)", cur.text)
+		return 0, false
+	}
+	tp.tok.next()
+	return v, true
+}
+
+// walk calls fn with the path of each non-repeated field set in m, or in
+// the messages it holds, which is at path.
+func (m *textMessage) walk(path []int32, fn func([]int32)) {
+	for n, tf := range m.fields {
+		if tf.f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED {
+			continue
+		}
+		p := appendPath(path, n)
+		fn(p)
+		for _, sub := range tf.subs {
+			sub.walk(p, fn)
+		}
+	}
+}
+
+// marshal encodes the fields of m in field-number order. Repeated fields
+// of scalar numeric types are packed if so declared, or by default in
+// proto3.
+func (m *textMessage) marshal() []byte {
+	var nums []int
+	for n := range m.fields {
+		nums = append(nums, int(n))
+	}
+	sort.Ints(nums)
+	var b []byte
+	for _, n := range nums {
+		tf := m.fields[int32(n)]
+		if isPacked(tf.f, m.sym.file) {
+			var packed []byte
+			for _, v := range tf.values {
+				packed = append(packed, v...)
+			}
+			b = append(b, encodeField(&descpb.FieldDescriptorProto{Number: tf.f.Number, Type: descpb.FieldDescriptorProto_TYPE_BYTES.Enum()}, packed)...)
+			continue
+		}
+		for _, v := range tf.values {
+			b = append(b, encodeField(tf.f, v)...)
+		}
+	}
+	return b
+}
+
+func isPacked(f *descpb.FieldDescriptorProto, fd *descpb.FileDescriptorProto) bool {
+	if f.GetLabel() != descpb.FieldDescriptorProto_LABEL_REPEATED {
+		return false
+	}
+	switch wireType(f.GetType()) {
+	case wireBytes, wireStartGroup:
+		return false
+	}
+	if f.GetOptions() != nil && f.Options.Packed != nil {
+		return f.Options.GetPacked()
+	}
+	return fd.GetSyntax() == "proto3"
+}
@@ -0,0 +1,678 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package parser
+
+// This file implements the tokenizer of .proto files. It follows the
+// tokenizer of protoc closely, as the positions of tokens and the text and
+// placement of comments end up in SourceCodeInfo.
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+type tokenType int
+
+const (
+	tokenStart tokenType = iota // before the first token
+	tokenEnd                    // end of input
+	tokenIdent
+	tokenInt
+	tokenFloat
+	tokenString
+	tokenSymbol
+)
+
+// A token is a token of a .proto file. Lines and columns are zero-based,
+// and tabs advance the column to the next multiple of 8, as in protoc.
+type token struct {
+	typ       tokenType
+	text      string
+	line      int
+	column    int
+	endColumn int
+}
+
+const tabWidth = 8
+
+type tokenizer struct {
+	src    []byte
+	pos    int  // offset of ch
+	ch     byte // current character, or 0 at the end of input
+	line   int  // position of ch
+	column int
+
+	current  token
+	previous token
+
+	recordStart int // offset where recording of a comment started
+
+	errorf func(line, column int, format string, args ...interface{})
+}
+
+func newTokenizer(src []byte, errorf func(line, column int, format string, args ...interface{})) *tokenizer {
+	t := &tokenizer{src: src, errorf: errorf}
+	if len(src) > 0 {
+		t.ch = src[0]
+	}
+	return t
+}
+
+func (t *tokenizer) atEOF() bool {
+	return t.pos >= len(t.src)
+}
+
+func (t *tokenizer) nextChar() {
+	switch t.ch {
+	case '\n':
+		t.line++
+		t.column = 0
+	case '\t':
+		t.column += tabWidth - t.column%tabWidth
+	default:
+		t.column++
+	}
+	t.pos++
+	t.ch = 0
+	if t.pos < len(t.src) {
+		t.ch = t.src[t.pos]
+	}
+}
+
+func (t *tokenizer) startRecording() {
+	t.recordStart = t.pos
+}
+
+func (t *tokenizer) stopRecording() string {
+	return string(t.src[t.recordStart:t.pos])
+}
+
+func (t *tokenizer) tryConsume(c byte) bool {
+	if t.ch == c && !t.atEOF() {
+		t.nextChar()
+		return true
+	}
+	return false
+}
+
+func (t *tokenizer) tryConsumeOne(class func(byte) bool) bool {
+	if !t.atEOF() && class(t.ch) {
+		t.nextChar()
+		return true
+	}
+	return false
+}
+
+func (t *tokenizer) consumeZeroOrMore(class func(byte) bool) {
+	for t.tryConsumeOne(class) {
+	}
+}
+
+func (t *tokenizer) consumeOneOrMore(class func(byte) bool, msg string) {
+	if !t.tryConsumeOne(class) {
+		t.errorf(t.line, t.column, "%s", msg)
+		return
+	}
+	t.consumeZeroOrMore(class)
+}
+
+func isWhitespace(c byte) bool {
+	return c == ' ' || c == '\n' || c == '\t' || c == '\r' || c == '\v' || c == '\f'
+}
+
+func isWhitespaceNoNewline(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\v' || c == '\f'
+}
+
+func isUnprintable(c byte) bool { return c < ' ' && c > 0 }
+func isDigit(c byte) bool       { return '0' <= c && c <= '9' }
+func isOctalDigit(c byte) bool  { return '0' <= c && c <= '7' }
+func isHexDigit(c byte) bool {
+	return isDigit(c) || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_'
+}
+func isAlphanumeric(c byte) bool { return isLetter(c) || isDigit(c) }
+func isEscape(c byte) bool       { return strings.IndexByte(`abfnrtv\?'"`, c) >= 0 }
+
+type commentStatus int
+
+const (
+	lineComment commentStatus = iota
+	blockComment
+	slashNotComment
+	noComment
+)
+
+// tryConsumeCommentStart consumes the start of a comment. If it finds a
+// lone slash, it makes it the current token.
+func (t *tokenizer) tryConsumeCommentStart() commentStatus {
+	if !t.tryConsume('/') {
+		return noComment
+	}
+	switch {
+	case t.tryConsume('/'):
+		return lineComment
+	case t.tryConsume('*'):
+		return blockComment
+	}
+	t.current = token{typ: tokenSymbol, text: "/", line: t.line, column: t.column - 1, endColumn: t.column}
+	return slashNotComment
+}
+
+// consumeLineComment consumes the rest of a line comment, returning its
+// text, including the final newline.
+func (t *tokenizer) consumeLineComment() string {
+	t.startRecording()
+	for !t.atEOF() && t.ch != '\n' {
+		t.nextChar()
+	}
+	t.tryConsume('\n')
+	return t.stopRecording()
+}
+
+// consumeBlockComment consumes the rest of a block comment, returning its
+// text without the leading whitespace and asterisk of each line.
+func (t *tokenizer) consumeBlockComment() string {
+	startLine, startColumn := t.line, t.column-2
+	var b bytes.Buffer
+	t.startRecording()
+	for {
+		for !t.atEOF() && t.ch != '*' && t.ch != '/' && t.ch != '\n' {
+			t.nextChar()
+		}
+		switch {
+		case t.tryConsume('\n'):
+			b.WriteString(t.stopRecording())
+			t.consumeZeroOrMore(isWhitespaceNoNewline)
+			if t.tryConsume('*') && t.tryConsume('/') {
+				return b.String()
+			}
+			t.startRecording()
+		case t.tryConsume('*') && t.tryConsume('/'):
+			s := t.stopRecording()
+			b.WriteString(s[:len(s)-2])
+			return b.String()
+		case t.tryConsume('/') && t.ch == '*':
+			t.errorf(t.line, t.column, "\"/*\" inside block comment.  Block comments cannot be nested.")
+		case t.atEOF():
+			t.errorf(t.line, t.column, "End-of-file inside block comment.")
+			t.errorf(startLine, startColumn, "  Comment started here.")
+			b.WriteString(t.stopRecording())
+			return b.String()
+		}
+	}
+}
+
+// next reads the next token, skipping comments. It returns false at the
+// end of input.
+func (t *tokenizer) next() bool {
+	t.previous = t.current
+	for !t.atEOF() {
+		t.consumeZeroOrMore(isWhitespace)
+		switch t.tryConsumeCommentStart() {
+		case lineComment:
+			t.consumeLineComment()
+			continue
+		case blockComment:
+			t.consumeBlockComment()
+			continue
+		case slashNotComment:
+			return true
+		}
+		if t.atEOF() {
+			break
+		}
+		if isUnprintable(t.ch) || t.ch == 0 {
+			t.errorf(t.line, t.column, "Invalid control characters encountered in text.")
+			t.nextChar()
+			for t.tryConsumeOne(isUnprintable) || !t.atEOF() && t.tryConsume(0) {
+			}
+			continue
+		}
+
+		start, line, column := t.pos, t.line, t.column
+		var typ tokenType
+		switch {
+		case t.tryConsumeOne(isLetter):
+			t.consumeZeroOrMore(isAlphanumeric)
+			typ = tokenIdent
+		case t.tryConsume('0'):
+			typ = t.consumeNumber(true, false)
+		case t.tryConsume('.'):
+			if t.tryConsumeOne(isDigit) {
+				if t.previous.typ == tokenIdent && line == t.previous.line && column == t.previous.endColumn {
+					t.errorf(t.line, t.column-2, "Need space between identifier and decimal point.")
+				}
+				typ = t.consumeNumber(false, true)
+			} else {
+				typ = tokenSymbol
+			}
+		case t.tryConsumeOne(isDigit):
+			typ = t.consumeNumber(false, false)
+		case t.tryConsume('"'):
+			t.consumeString('"')
+			typ = tokenString
+		case t.tryConsume('\''):
+			t.consumeString('\'')
+			typ = tokenString
+		default:
+			if t.ch&0x80 != 0 {
+				t.errorf(t.line, t.column, "Interpreting non ascii codepoint %d.", t.ch)
+			}
+			t.nextChar()
+			typ = tokenSymbol
+		}
+		t.current = token{
+			typ:       typ,
+			text:      string(t.src[start:t.pos]),
+			line:      line,
+			column:    column,
+			endColumn: t.column,
+		}
+		return true
+	}
+	t.current = token{typ: tokenEnd, line: t.line, column: t.column, endColumn: t.column}
+	return false
+}
+
+func (t *tokenizer) consumeNumber(startedWithZero, startedWithDot bool) tokenType {
+	isFloat := false
+	switch {
+	case startedWithZero && (t.tryConsume('x') || t.tryConsume('X')):
+		t.consumeOneOrMore(isHexDigit, "\"0x\" must be followed by hex digits.")
+	case startedWithZero && !t.atEOF() && isDigit(t.ch):
+		t.consumeZeroOrMore(isOctalDigit)
+		if !t.atEOF() && isDigit(t.ch) {
+			t.errorf(t.line, t.column, "Numbers starting with leading zero must be in octal.")
+			t.consumeZeroOrMore(isDigit)
+		}
+	default:
+		if startedWithDot {
+			isFloat = true
+			t.consumeZeroOrMore(isDigit)
+		} else {
+			t.consumeZeroOrMore(isDigit)
+			if t.tryConsume('.') {
+				isFloat = true
+				t.consumeZeroOrMore(isDigit)
+			}
+		}
+		if t.tryConsume('e') || t.tryConsume('E') {
+			isFloat = true
+			if !t.tryConsume('-') {
+				t.tryConsume('+')
+			}
+			t.consumeOneOrMore(isDigit, "\"e\" must be followed by exponent.")
+		}
+	}
+	switch {
+	case !t.atEOF() && isLetter(t.ch):
+		t.errorf(t.line, t.column, "Need space between number and identifier.")
+	case t.ch == '.' && !t.atEOF():
+		if isFloat {
+			t.errorf(t.line, t.column, "Already saw decimal point or exponent; can't have another one.")
+		} else {
+			t.errorf(t.line, t.column, "Hex and octal numbers must be integers.")
+		}
+	}
+	if isFloat {
+		return tokenFloat
+	}
+	return tokenInt
+}
+
+func (t *tokenizer) consumeString(delim byte) {
+	for {
+		switch {
+		case t.atEOF():
+			t.errorf(t.line, t.column, "Unexpected end of string.")
+			return
+		case t.ch == '\n':
+			t.errorf(t.line, t.column, "String literals cannot cross line boundaries.")
+			return
+		case t.ch == '\\':
+			t.nextChar()
+			switch {
+			case t.tryConsumeOne(isEscape):
+			case t.tryConsumeOne(isOctalDigit):
+			case t.tryConsume('x'):
+				if !t.tryConsumeOne(isHexDigit) {
+					t.errorf(t.line, t.column, "Expected hex digits for escape sequence.")
+				}
+			case t.tryConsume('u'):
+				for i := 0; i < 4; i++ {
+					if !t.tryConsumeOne(isHexDigit) {
+						t.errorf(t.line, t.column, "Expected four hex digits for \\u escape sequence.")
+						break
+					}
+				}
+			case t.tryConsume('U'):
+				for i := 0; i < 8; i++ {
+					if !t.tryConsumeOne(isHexDigit) {
+						t.errorf(t.line, t.column, "Expected eight hex digits up to 10ffff for \\U escape sequence")
+						break
+					}
+				}
+			default:
+				t.errorf(t.line, t.column, "Invalid escape sequence in string literal.")
+			}
+		case t.ch == delim:
+			t.nextChar()
+			return
+		default:
+			t.nextChar()
+		}
+	}
+}
+
+// A commentCollector sorts the comments between two tokens into the
+// trailing comment of the first, the leading comment of the second, and
+// the detached comments in between.
+type commentCollector struct {
+	prevTrailing string
+	detached     []string
+	nextLeading  string
+
+	buf                string
+	hasComment         bool
+	isLineComment      bool
+	canAttachToPrev    bool
+	hasTrailingComment bool
+	numComments        int
+}
+
+func (c *commentCollector) bufferForLineComment() {
+	// Consecutive line comments are combined, but not block comments.
+	if c.hasComment && !c.isLineComment {
+		c.flush()
+	}
+	c.hasComment = true
+	c.isLineComment = true
+}
+
+func (c *commentCollector) bufferForBlockComment() {
+	if c.hasComment {
+		c.flush()
+	}
+	c.hasComment = true
+	c.isLineComment = false
+}
+
+func (c *commentCollector) clearBuffer() {
+	c.buf = ""
+	c.hasComment = false
+}
+
+// flush is called once the buffered comment is known not to be attached
+// to the next token.
+func (c *commentCollector) flush() {
+	if !c.hasComment {
+		return
+	}
+	if c.canAttachToPrev {
+		c.prevTrailing += c.buf
+		c.hasTrailingComment = true
+		c.canAttachToPrev = false
+	} else {
+		c.detached = append(c.detached, c.buf)
+	}
+	c.clearBuffer()
+	c.numComments++
+}
+
+// maybeDetachComment is called when the next token is on the line where
+// the comments ended, so that it is unclear which token a lone comment
+// belongs to.
+func (c *commentCollector) maybeDetachComment() {
+	count := c.numComments
+	if c.hasComment {
+		count++
+	}
+	if count != 1 {
+		return
+	}
+	if c.hasTrailingComment {
+		c.detached = append([]string{c.prevTrailing}, c.detached...)
+		c.prevTrailing = ""
+	}
+	c.canAttachToPrev = false
+	c.flush()
+}
+
+// finish returns the comments; whatever is in the buffer is a leading
+// comment.
+func (c *commentCollector) finish() (trailing string, detached []string, leading string) {
+	if c.hasComment {
+		c.nextLeading = c.buf
+	}
+	return c.prevTrailing, c.detached, c.nextLeading
+}
+
+// nextWithComments reads the next token like next, and returns the
+// comments between the previous token and the new one.
+func (t *tokenizer) nextWithComments() (trailing string, detached []string, leading string, ok bool) {
+	c := &commentCollector{canAttachToPrev: true}
+	ok = t.nextWithCollector(c)
+	trailing, detached, leading = c.finish()
+	return trailing, detached, leading, ok
+}
+
+func (t *tokenizer) nextWithCollector(c *commentCollector) bool {
+	prevLine := t.line
+	trailingCommentEndLine := -1
+
+	if t.current.typ == tokenStart {
+		// Skip a UTF-8 byte order mark.
+		if t.tryConsume(0xEF) {
+			if !t.tryConsume(0xBB) || !t.tryConsume(0xBF) {
+				t.errorf(t.line, t.column, "Proto file starts with 0xEF but not UTF-8 BOM. Only UTF-8 is accepted for proto file.")
+				return false
+			}
+		}
+		c.canAttachToPrev = false
+	} else {
+		// A comment on the same line as the previous token is attached
+		// to it.
+		t.consumeZeroOrMore(isWhitespaceNoNewline)
+		switch t.tryConsumeCommentStart() {
+		case lineComment:
+			trailingCommentEndLine = t.line
+			c.bufferForLineComment()
+			c.buf += t.consumeLineComment()
+			c.flush()
+		case blockComment:
+			c.bufferForBlockComment()
+			c.buf += t.consumeBlockComment()
+			trailingCommentEndLine = t.line
+			t.consumeZeroOrMore(isWhitespaceNoNewline)
+			if !t.tryConsume('\n') {
+				// The next token is on the same line, so the comment
+				// cannot be attributed to either.
+				c.clearBuffer()
+				return t.next()
+			}
+			c.flush()
+		case slashNotComment:
+			return true
+		case noComment:
+			if !t.tryConsume('\n') {
+				return t.next()
+			}
+		}
+	}
+
+	// We are now on the line after the previous token.
+	for {
+		t.consumeZeroOrMore(isWhitespaceNoNewline)
+		switch t.tryConsumeCommentStart() {
+		case lineComment:
+			c.bufferForLineComment()
+			c.buf += t.consumeLineComment()
+		case blockComment:
+			c.bufferForBlockComment()
+			c.buf += t.consumeBlockComment()
+			// Consume the rest of the line, so that it is not taken
+			// for a blank line.
+			t.consumeZeroOrMore(isWhitespaceNoNewline)
+			t.tryConsume('\n')
+		case slashNotComment:
+			return true
+		case noComment:
+			if t.tryConsume('\n') {
+				// A blank line.
+				c.flush()
+				c.canAttachToPrev = false
+				continue
+			}
+			ok := t.next()
+			if !ok || t.current.text == "}" || t.current.text == "]" || t.current.text == ")" {
+				// At the end of a scope, comments belong to no token.
+				c.flush()
+			}
+			if ok && (prevLine == t.line || trailingCommentEndLine == t.line) {
+				c.maybeDetachComment()
+			}
+			return ok
+		}
+	}
+}
+
+// unquote returns the value of a string token, as in protoc.
+func unquote(text string) string {
+	if len(text) < 2 {
+		return ""
+	}
+	var b []byte
+	s := text[1 : len(text)-1]
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 >= len(s) {
+			b = append(b, c)
+			continue
+		}
+		i++
+		c = s[i]
+		switch {
+		case isOctalDigit(c):
+			v := int(c - '0')
+			for j := 0; j < 2 && i+1 < len(s) && isOctalDigit(s[i+1]); j++ {
+				i++
+				v = v*8 + int(s[i]-'0')
+			}
+			b = append(b, byte(v))
+		case c == 'x' && i+1 < len(s) && isHexDigit(s[i+1]):
+			v := 0
+			for j := 0; j < 2 && i+1 < len(s) && isHexDigit(s[i+1]); j++ {
+				i++
+				v = v*16 + hexValue(s[i])
+			}
+			b = append(b, byte(v))
+		case (c == 'u' || c == 'U') && i+1 < len(s) && isHexDigit(s[i+1]):
+			n := 4
+			if c == 'U' {
+				n = 8
+			}
+			v := 0
+			for j := 0; j < n && i+1 < len(s) && isHexDigit(s[i+1]); j++ {
+				i++
+				v = v*16 + hexValue(s[i])
+			}
+			b = append(b, string(rune(v))...)
+		default:
+			b = append(b, translateEscape(c))
+		}
+	}
+	return string(b)
+}
+
+func hexValue(c byte) int {
+	switch {
+	case '0' <= c && c <= '9':
+		return int(c - '0')
+	case 'a' <= c && c <= 'f':
+		return int(c-'a') + 10
+	}
+	return int(c-'A') + 10
+}
+
+func translateEscape(c byte) byte {
+	switch c {
+	case 'a':
+		return '\a'
+	case 'b':
+		return '\b'
+	case 'f':
+		return '\f'
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case 'v':
+		return '\v'
+	case '\\', '?', '\'', '"':
+		return c
+	}
+	return '?'
+}
+
+// cEscape escapes a bytes value as protoc does for default values.
+func cEscape(s string) string {
+	var b bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\'':
+			b.WriteString(`\'`)
+		case '\\':
+			b.WriteString(`\\`)
+		default:
+			if c < 0x20 || c >= 0x7f {
+				fmt.Fprintf(&b, "\\%03o", c)
+			} else {
+				b.WriteByte(c)
+			}
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,1257 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package printer renders file descriptors as .proto source text.
+//
+// It can reconstruct schemas from descriptors obtained at run time, such as
+// those of the files registered by generated code or those in a
+// FileDescriptorSet, in a form that protoc compiles back into an equivalent
+// descriptor. Map fields, groups and oneofs are written in their original
+// syntax, and comments are reproduced when the descriptor retains its
+// SourceCodeInfo.
+package printer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/descriptor/builder"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Printer renders FileDescriptorProtos as .proto source text.
+type Printer struct {
+	// Indent is the string written for each level of indentation.
+	// It defaults to two spaces.
+	Indent string
+
+	// OmitComments causes comments recorded in the SourceCodeInfo of a
+	// file to be left out.
+	OmitComments bool
+
+	// Files holds other files, such as the rest of a FileDescriptorSet.
+	// Their declarations are used to print custom options whose extensions
+	// are not linked into the binary, and to keep the names of referenced
+	// types short without making them ambiguous.
+	Files []*descpb.FileDescriptorProto
+}
+
+// Print writes the .proto source of fd to w.
+func (p *Printer) Print(w io.Writer, fd *descpb.FileDescriptorProto) error {
+	s, err := p.PrintToString(fd)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, s)
+	return err
+}
+
+// PrintToString returns the .proto source of fd.
+func (p *Printer) PrintToString(fd *descpb.FileDescriptorProto) (string, error) {
+	fp := newFilePrinter(p, fd)
+	fp.file()
+	if fp.err != nil {
+		return "", fp.err
+	}
+	return fp.buf.String(), nil
+}
+
+// Field numbers of the elements of descriptor.proto that appear in the
+// paths of SourceCodeInfo locations.
+const (
+	// FileDescriptorProto
+	filePackagePath    = 2
+	fileDependencyPath = 3
+	fileMessagePath    = 4
+	fileEnumPath       = 5
+	fileServicePath    = 6
+	fileExtensionPath  = 7
+	fileOptionsPath    = 8
+	fileSyntaxPath     = 12
+	// DescriptorProto
+	messageFieldPath          = 2
+	messageNestedPath         = 3
+	messageEnumPath           = 4
+	messageExtensionRangePath = 5
+	messageExtensionPath      = 6
+	messageOptionsPath        = 7
+	messageOneofPath          = 8
+	messageReservedRangePath  = 9
+	messageReservedNamePath   = 10
+	// EnumDescriptorProto
+	enumValuePath         = 2
+	enumOptionsPath       = 3
+	enumReservedRangePath = 4
+	enumReservedNamePath  = 5
+	// ServiceDescriptorProto
+	serviceMethodPath  = 2
+	serviceOptionsPath = 3
+	// MethodDescriptorProto
+	methodOptionsPath = 4
+	// OneofDescriptorProto
+	oneofOptionsPath = 2
+)
+
+const (
+	maxFieldNumber = 536870911
+	maxEnumNumber  = math.MaxInt32
+)
+
+type extKey struct {
+	extendee string // full name, without the leading dot
+	number   int32
+}
+
+type extension struct {
+	name  string // full name
+	field *descpb.FieldDescriptorProto
+}
+
+// A filePrinter holds the state of printing a single file.
+type filePrinter struct {
+	*Printer
+	fd     *descpb.FileDescriptorProto
+	proto3 bool
+	indent string
+
+	buf       bytes.Buffer
+	depth     int
+	blankNext bool // whether to write a blank line before the next line
+	err       error
+
+	locs     map[string]*descpb.SourceCodeInfo_Location // path => location
+	types    map[string]bool                            // full names of packages, messages, enums and services
+	messages map[string]*descpb.DescriptorProto         // full name => message
+	enums    map[string]*descpb.EnumDescriptorProto     // full name => enum
+	exts     map[extKey]extension
+}
+
+func newFilePrinter(p *Printer, fd *descpb.FileDescriptorProto) *filePrinter {
+	fp := &filePrinter{
+		Printer:  p,
+		fd:       fd,
+		proto3:   fd.GetSyntax() == "proto3",
+		indent:   p.Indent,
+		locs:     make(map[string]*descpb.SourceCodeInfo_Location),
+		types:    make(map[string]bool),
+		messages: make(map[string]*descpb.DescriptorProto),
+		enums:    make(map[string]*descpb.EnumDescriptorProto),
+		exts:     make(map[extKey]extension),
+	}
+	if fp.indent == "" {
+		fp.indent = "  "
+	}
+	for _, loc := range fd.GetSourceCodeInfo().GetLocation() {
+		if k := pathKey(loc.Path); fp.locs[k] == nil {
+			fp.locs[k] = loc
+		}
+	}
+	fp.index(fd)
+	for _, f := range p.Files {
+		fp.index(f)
+	}
+	return fp
+}
+
+// index records the declarations of fd.
+func (fp *filePrinter) index(fd *descpb.FileDescriptorProto) {
+	pkg := fd.GetPackage()
+	for s := pkg; s != ""; s = parentScope(s) {
+		fp.types[s] = true
+	}
+	fp.indexExtensions(pkg, fd.Extension)
+	fp.indexMessages(pkg, fd.MessageType)
+	fp.indexEnums(pkg, fd.EnumType)
+	for _, sd := range fd.Service {
+		fp.types[joinName(pkg, sd.GetName())] = true
+	}
+}
+
+func (fp *filePrinter) indexMessages(scope string, mds []*descpb.DescriptorProto) {
+	for _, md := range mds {
+		name := joinName(scope, md.GetName())
+		fp.types[name] = true
+		fp.messages[name] = md
+		fp.indexExtensions(name, md.Extension)
+		fp.indexMessages(name, md.NestedType)
+		fp.indexEnums(name, md.EnumType)
+	}
+}
+
+func (fp *filePrinter) indexEnums(scope string, eds []*descpb.EnumDescriptorProto) {
+	for _, ed := range eds {
+		name := joinName(scope, ed.GetName())
+		fp.types[name] = true
+		fp.enums[name] = ed
+	}
+}
+
+func (fp *filePrinter) indexExtensions(scope string, fields []*descpb.FieldDescriptorProto) {
+	for _, f := range fields {
+		k := extKey{strings.TrimPrefix(f.GetExtendee(), "."), f.GetNumber()}
+		if _, ok := fp.exts[k]; !ok {
+			fp.exts[k] = extension{joinName(scope, f.GetName()), f}
+		}
+	}
+}
+
+func joinName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+// parentScope returns the scope enclosing the named element, such as "a.b"
+// for "a.b.c", or "" for a top-level name.
+func parentScope(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[:i]
+	}
+	return ""
+}
+
+// simpleName returns the last component of a full name.
+func simpleName(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// typeName returns the shortest name by which the type with the given
+// fully-qualified name, such as ".foo.Bar", can be referred to from scope.
+func (fp *filePrinter) typeName(scope, name string) string {
+	if !strings.HasPrefix(name, ".") {
+		return name
+	}
+	full := name[1:]
+	parts := strings.Split(full, ".")
+	for k := len(parts) - 1; k >= 0; k-- {
+		if fp.resolve(scope, parts[k:], full) == full {
+			return strings.Join(parts[k:], ".")
+		}
+	}
+	return name
+}
+
+// resolve returns the full name that a relative reference from scope
+// resolves to, given that the referenced type has the full name target.
+// As in protoc, the innermost scope declaring the first component of
+// the reference is searched for the rest.
+func (fp *filePrinter) resolve(scope string, parts []string, target string) string {
+	for {
+		first := joinName(scope, parts[0])
+		if fp.types[first] || first == target || strings.HasPrefix(target, first+".") {
+			return joinName(scope, strings.Join(parts, "."))
+		}
+		if scope == "" {
+			return ""
+		}
+		scope = parentScope(scope)
+	}
+}
+
+// Output.
+
+func (fp *filePrinter) blank() {
+	fp.blankNext = true
+}
+
+// printLine writes a line at the current indentation.
+func (fp *filePrinter) printLine(s string) {
+	if fp.blankNext && s != "}" && fp.buf.Len() > 0 {
+		fp.buf.WriteByte('\n')
+	}
+	fp.blankNext = false
+	for i := 0; i < fp.depth; i++ {
+		fp.buf.WriteString(fp.indent)
+	}
+	fp.buf.WriteString(s)
+	fp.buf.WriteByte('\n')
+}
+
+// line writes a statement along with the comments of the element at path.
+func (fp *filePrinter) line(path []int32, s string) {
+	fp.leadingComments(path)
+	fp.endLine(path, s)
+}
+
+// open starts a block, such as a message, for the element at path.
+func (fp *filePrinter) open(path []int32, s string) {
+	fp.leadingComments(path)
+	fp.endLine(path, s+" {")
+	fp.depth++
+}
+
+func (fp *filePrinter) close() {
+	fp.depth--
+	fp.printLine("}")
+}
+
+func (fp *filePrinter) location(path []int32) *descpb.SourceCodeInfo_Location {
+	if path == nil {
+		return nil
+	}
+	return fp.locs[pathKey(path)]
+}
+
+func pathKey(path []int32) string {
+	return fmt.Sprint(path)
+}
+
+func (fp *filePrinter) leadingComments(path []int32) {
+	loc := fp.location(path)
+	if loc == nil || fp.OmitComments {
+		return
+	}
+	for _, c := range loc.LeadingDetachedComments {
+		fp.comment(c)
+		fp.blank()
+	}
+	if loc.LeadingComments != nil {
+		fp.comment(loc.GetLeadingComments())
+	}
+}
+
+// endLine writes the last line of a statement, or the first line of a
+// block, followed by the trailing comment of the element at path. A
+// trailing comment of several lines goes on the lines below, followed by
+// a blank line so that it is not taken for a comment on what follows.
+func (fp *filePrinter) endLine(path []int32, s string) {
+	loc := fp.location(path)
+	if loc == nil || loc.TrailingComments == nil || fp.OmitComments {
+		fp.printLine(s)
+		return
+	}
+	lines := commentLines(loc.GetTrailingComments())
+	if len(lines) == 1 {
+		fp.printLine(s + " //" + lines[0])
+		return
+	}
+	fp.printLine(s)
+	if strings.HasSuffix(s, "{") {
+		fp.depth++
+		defer func() { fp.depth-- }()
+	}
+	for _, l := range lines {
+		fp.printLine("//" + l)
+	}
+	fp.blank()
+}
+
+func (fp *filePrinter) comment(c string) {
+	for _, l := range commentLines(c) {
+		fp.printLine("//" + l)
+	}
+}
+
+func commentLines(c string) []string {
+	lines := strings.Split(strings.TrimSuffix(c, "\n"), "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " \t")
+	}
+	return lines
+}
+
+// An item is an element printed in the body of a file or a block.
+type item struct {
+	path  []int32 // path of the element, used to order items by source position
+	block bool    // whether the item spans several lines
+	print func()
+}
+
+// printItems prints items separated by blank lines around blocks. If the
+// file records the source position of every item, they are printed in
+// their original order; otherwise they are printed in the given order.
+func (fp *filePrinter) printItems(items []item) {
+	positioned := true
+	for _, it := range items {
+		if loc := fp.location(it.path); loc == nil || len(loc.Span) < 2 {
+			positioned = false
+		}
+	}
+	if positioned {
+		sort.SliceStable(items, func(i, j int) bool {
+			a, b := fp.location(items[i].path).Span, fp.location(items[j].path).Span
+			if a[0] != b[0] {
+				return a[0] < b[0]
+			}
+			return a[1] < b[1]
+		})
+	}
+	for i, it := range items {
+		if i > 0 && (it.block || items[i-1].block) {
+			fp.blank()
+		}
+		it.print()
+	}
+}
+
+// A declarer locates the item among those of a queue that declares a map
+// entry or group message implicitly.
+type declarer struct {
+	q *itemQueue
+	i int
+}
+
+// An itemQueue hands out a sequence of items in order.
+type itemQueue struct {
+	items []item
+	next  int // index of the first item not yet handed out
+}
+
+// through returns the items not yet handed out up to and including the
+// i'th.
+func (q *itemQueue) through(i int) []item {
+	if i < q.next {
+		return nil
+	}
+	its := q.items[q.next : i+1]
+	q.next = i + 1
+	return its
+}
+
+func appendPath(path []int32, elems ...int32) []int32 {
+	return append(append([]int32(nil), path...), elems...)
+}
+
+// Elements.
+
+func (fp *filePrinter) file() {
+	fd := fp.fd
+	syntax := fd.GetSyntax()
+	if syntax == "" {
+		syntax = "proto2"
+	}
+	fp.line([]int32{fileSyntaxPath}, "syntax = "+quoteString(syntax)+";")
+
+	if fd.Package != nil {
+		fp.blank()
+		fp.line([]int32{filePackagePath}, "package "+fd.GetPackage()+";")
+	}
+
+	if len(fd.Dependency) > 0 {
+		fp.blank()
+	}
+	public := make(map[int32]bool)
+	for _, i := range fd.PublicDependency {
+		public[i] = true
+	}
+	weak := make(map[int32]bool)
+	for _, i := range fd.WeakDependency {
+		weak[i] = true
+	}
+	for i, dep := range fd.Dependency {
+		kind := ""
+		switch {
+		case public[int32(i)]:
+			kind = "public "
+		case weak[int32(i)]:
+			kind = "weak "
+		}
+		fp.line([]int32{fileDependencyPath, int32(i)}, "import "+kind+quoteString(dep)+";")
+	}
+
+	fp.blank()
+	fp.options([]int32{fileOptionsPath}, "google.protobuf.FileOptions", fd.Options)
+
+	pkg := fd.GetPackage()
+	hidden := fp.groupMessages(pkg, fd.MessageType, fd.Extension)
+	messages := new(itemQueue)
+	for i, md := range fd.MessageType {
+		if hidden[md.GetName()] {
+			continue
+		}
+		path, md := []int32{fileMessagePath, int32(i)}, md
+		messages.items = append(messages.items, item{path, true, func() { fp.message(path, pkg, md) }})
+	}
+	exts := &itemQueue{items: fp.extends([]int32{fileExtensionPath}, pkg, fd.Extension, fd.MessageType)}
+
+	// As in messageBody, the extend blocks declaring groups are placed
+	// among the messages to keep every message at its index.
+	declaredBy := extendGroups(pkg, fd.MessageType, fd.Extension, hidden, exts)
+	var items []item
+	for i, md := range fd.MessageType {
+		if d, ok := declaredBy[i]; ok {
+			items = append(items, d.q.through(d.i)...)
+		} else if !hidden[md.GetName()] {
+			items = append(items, messages.through(messages.next)...)
+		}
+	}
+	for i, ed := range fd.EnumType {
+		path, ed := []int32{fileEnumPath, int32(i)}, ed
+		items = append(items, item{path, true, func() { fp.enum(path, pkg, ed) }})
+	}
+	for i, sd := range fd.Service {
+		path, sd := []int32{fileServicePath, int32(i)}, sd
+		items = append(items, item{path, true, func() { fp.service(path, pkg, sd) }})
+	}
+	items = append(items, exts.through(len(exts.items)-1)...)
+	fp.blank()
+	fp.printItems(items)
+}
+
+// groupMessages returns the names of the messages among mds that hold the
+// contents of the groups among fields, which are printed along with them.
+func (fp *filePrinter) groupMessages(scope string, mds []*descpb.DescriptorProto, fields ...[]*descpb.FieldDescriptorProto) map[string]bool {
+	hidden := make(map[string]bool)
+	for _, fs := range fields {
+		for _, f := range fs {
+			if _, md := findNested(scope, mds, f); md != nil && f.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP {
+				hidden[md.GetName()] = true
+			}
+		}
+	}
+	return hidden
+}
+
+// extendGroups locates, among exts, the extend blocks printed by extends
+// for the extensions fields, those declaring the group messages among mds.
+// It returns their declarers keyed by the index of the group message.
+func extendGroups(scope string, mds []*descpb.DescriptorProto, fields []*descpb.FieldDescriptorProto, hidden map[string]bool, exts *itemQueue) map[int]declarer {
+	declaredBy := make(map[int]declarer)
+	for k, it := range exts.items {
+		start, end := it.path[len(it.path)-1], int32(len(fields))
+		if k+1 < len(exts.items) {
+			next := exts.items[k+1].path
+			end = next[len(next)-1]
+		}
+		for _, f := range fields[start:end] {
+			if j, md := findNested(scope, mds, f); md != nil && hidden[md.GetName()] {
+				declaredBy[j] = declarer{exts, k}
+			}
+		}
+	}
+	return declaredBy
+}
+
+// findNested returns the message among mds, declared in scope, that is
+// the type of f, along with its index.
+func findNested(scope string, mds []*descpb.DescriptorProto, f *descpb.FieldDescriptorProto) (int, *descpb.DescriptorProto) {
+	for i, md := range mds {
+		if f.GetTypeName() == "."+joinName(scope, md.GetName()) {
+			return i, md
+		}
+	}
+	return 0, nil
+}
+
+// mapEntry returns the map entry message that is the type of f, a field of
+// the message md with full name scope, if f is a map field.
+func mapEntry(scope string, md *descpb.DescriptorProto, f *descpb.FieldDescriptorProto) *descpb.DescriptorProto {
+	if md == nil || f.GetLabel() != descpb.FieldDescriptorProto_LABEL_REPEATED || f.GetType() != descpb.FieldDescriptorProto_TYPE_MESSAGE {
+		return nil
+	}
+	_, entry := findNested(scope, md.NestedType, f)
+	if entry == nil || !entry.GetOptions().GetMapEntry() || len(entry.Field) != 2 {
+		return nil
+	}
+	return entry
+}
+
+func (fp *filePrinter) message(path []int32, scope string, md *descpb.DescriptorProto) {
+	fp.open(path, "message "+md.GetName())
+	fp.messageBody(path, joinName(scope, md.GetName()), md)
+	fp.close()
+}
+
+// messageBody prints the declarations of the message md with full name
+// name, whose element is at path.
+func (fp *filePrinter) messageBody(path []int32, name string, md *descpb.DescriptorProto) {
+	fp.options(appendPath(path, messageOptionsPath), "google.protobuf.MessageOptions", md.Options)
+
+	hidden := fp.groupMessages(name, md.NestedType, md.Field, md.Extension)
+	for _, f := range md.Field {
+		if entry := mapEntry(name, md, f); entry != nil {
+			hidden[entry.GetName()] = true
+		}
+	}
+
+	var enums []item
+	nested, fields := new(itemQueue), new(itemQueue)
+	for i, nmd := range md.NestedType {
+		if hidden[nmd.GetName()] {
+			continue
+		}
+		path, nmd := appendPath(path, messageNestedPath, int32(i)), nmd
+		nested.items = append(nested.items, item{path, true, func() { fp.message(path, name, nmd) }})
+	}
+	for i, ed := range md.EnumType {
+		path, ed := appendPath(path, messageEnumPath, int32(i)), ed
+		enums = append(enums, item{path, true, func() { fp.enum(path, name, ed) }})
+	}
+	fieldItem := make([]int, len(md.Field)) // index in fields of the item printing each field
+	oneofs := make(map[int32]int)
+	for i, f := range md.Field {
+		if f.OneofIndex != nil {
+			o := f.GetOneofIndex()
+			if int(o) >= len(md.OneofDecl) {
+				continue
+			}
+			if k, ok := oneofs[o]; ok {
+				fieldItem[i] = k
+				continue
+			}
+			oneofs[o], fieldItem[i] = len(fields.items), len(fields.items)
+			path := appendPath(path, messageOneofPath, o)
+			fields.items = append(fields.items, item{path, true, func() { fp.oneof(path, name, md, o) }})
+			continue
+		}
+		fieldItem[i] = len(fields.items)
+		path, f := appendPath(path, messageFieldPath, int32(i)), f
+		fields.items = append(fields.items, item{path, fp.isGroup(f), func() { fp.field(path, name, md, f, false) }})
+	}
+	exts := &itemQueue{items: fp.extends(appendPath(path, messageExtensionPath), name, md.Extension, md.NestedType)}
+
+	// Map entry and group messages are declared implicitly by their fields.
+	// Without source positions, items are printed in the order built here,
+	// so the fields and extend blocks declaring them are interleaved with
+	// the explicit nested messages to keep every nested type at its index.
+	declaredBy := extendGroups(name, md.NestedType, md.Extension, hidden, exts)
+	for i, f := range md.Field {
+		if j, nmd := findNested(name, md.NestedType, f); nmd != nil && hidden[nmd.GetName()] {
+			declaredBy[j] = declarer{fields, fieldItem[i]}
+		}
+	}
+
+	var items []item
+	take := func(q *itemQueue, i int) {
+		if q == fields && fields.next == 0 {
+			// Enums are printed before the fields.
+			items, enums = append(items, enums...), nil
+		}
+		items = append(items, q.through(i)...)
+	}
+	for i, nmd := range md.NestedType {
+		if d, ok := declaredBy[i]; ok {
+			take(d.q, d.i)
+		} else if !hidden[nmd.GetName()] {
+			take(nested, nested.next)
+		}
+	}
+	take(fields, len(fields.items)-1)
+	for i, r := range md.ExtensionRange {
+		path, r := appendPath(path, messageExtensionRangePath, int32(i)), r
+		items = append(items, item{path, false, func() {
+			text := "extensions " + rangeText(r.GetStart(), r.GetEnd()-1, maxFieldNumber)
+			fp.line(path, text+fp.bracketOptions(nil, "google.protobuf.ExtensionRangeOptions", r.Options)+";")
+		}})
+	}
+	items = append(items, exts.through(len(exts.items)-1)...)
+	if len(md.ReservedRange) > 0 {
+		var ranges []string
+		for _, r := range md.ReservedRange {
+			ranges = append(ranges, rangeText(r.GetStart(), r.GetEnd()-1, maxFieldNumber))
+		}
+		path := appendPath(path, messageReservedRangePath)
+		items = append(items, item{path, false, func() { fp.line(path, "reserved "+strings.Join(ranges, ", ")+";") }})
+	}
+	if len(md.ReservedName) > 0 {
+		path := appendPath(path, messageReservedNamePath)
+		items = append(items, item{path, false, func() { fp.line(path, "reserved "+quoteNames(md.ReservedName)+";") }})
+	}
+	fp.printItems(items)
+}
+
+func (fp *filePrinter) isGroup(f *descpb.FieldDescriptorProto) bool {
+	return f.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP
+}
+
+func (fp *filePrinter) oneof(path []int32, scope string, md *descpb.DescriptorProto, index int32) {
+	od := md.OneofDecl[index]
+	fp.open(path, "oneof "+od.GetName())
+	fp.options(appendPath(path, oneofOptionsPath), "google.protobuf.OneofOptions", od.Options)
+	var items []item
+	for i, f := range md.Field {
+		if f.OneofIndex == nil || f.GetOneofIndex() != index {
+			continue
+		}
+		path, f := appendPath(path[:len(path)-2], messageFieldPath, int32(i)), f
+		items = append(items, item{path, fp.isGroup(f), func() { fp.field(path, scope, md, f, true) }})
+	}
+	fp.printItems(items)
+	fp.close()
+}
+
+// extends returns items for extend blocks declaring the extensions fields,
+// declared in scope alongside the messages mds, with one block for each
+// run of extensions of the same message.
+func (fp *filePrinter) extends(path []int32, scope string, fields []*descpb.FieldDescriptorProto, mds []*descpb.DescriptorProto) []item {
+	var items []item
+	for i := 0; i < len(fields); {
+		j := i + 1
+		for j < len(fields) && fields[j].GetExtendee() == fields[i].GetExtendee() {
+			j++
+		}
+		start, exts := i, fields[i:j]
+		items = append(items, item{appendPath(path, int32(i)), true, func() {
+			fp.open(nil, "extend "+fp.typeName(scope, exts[0].GetExtendee()))
+			for k, f := range exts {
+				fp.extension(appendPath(path, int32(start+k)), scope, mds, f)
+			}
+			fp.close()
+		}})
+		i = j
+	}
+	return items
+}
+
+// extension prints an extension field declared in scope, alongside the
+// messages mds.
+func (fp *filePrinter) extension(path []int32, scope string, mds []*descpb.DescriptorProto, f *descpb.FieldDescriptorProto) {
+	fp.field(path, scope, &descpb.DescriptorProto{NestedType: mds}, f, false)
+}
+
+var scalarTypes = map[descpb.FieldDescriptorProto_Type]string{
+	descpb.FieldDescriptorProto_TYPE_DOUBLE:   "double",
+	descpb.FieldDescriptorProto_TYPE_FLOAT:    "float",
+	descpb.FieldDescriptorProto_TYPE_INT64:    "int64",
+	descpb.FieldDescriptorProto_TYPE_UINT64:   "uint64",
+	descpb.FieldDescriptorProto_TYPE_INT32:    "int32",
+	descpb.FieldDescriptorProto_TYPE_FIXED64:  "fixed64",
+	descpb.FieldDescriptorProto_TYPE_FIXED32:  "fixed32",
+	descpb.FieldDescriptorProto_TYPE_BOOL:     "bool",
+	descpb.FieldDescriptorProto_TYPE_STRING:   "string",
+	descpb.FieldDescriptorProto_TYPE_BYTES:    "bytes",
+	descpb.FieldDescriptorProto_TYPE_UINT32:   "uint32",
+	descpb.FieldDescriptorProto_TYPE_SFIXED32: "sfixed32",
+	descpb.FieldDescriptorProto_TYPE_SFIXED64: "sfixed64",
+	descpb.FieldDescriptorProto_TYPE_SINT32:   "sint32",
+	descpb.FieldDescriptorProto_TYPE_SINT64:   "sint64",
+}
+
+// fieldType returns the type of f as written in a field declared in scope.
+func (fp *filePrinter) fieldType(scope string, f *descpb.FieldDescriptorProto) string {
+	if t, ok := scalarTypes[f.GetType()]; ok {
+		return t
+	}
+	return fp.typeName(scope, f.GetTypeName())
+}
+
+// field prints the field f of the message md with full name scope, or an
+// extension declared in scope.
+func (fp *filePrinter) field(path []int32, scope string, md *descpb.DescriptorProto, f *descpb.FieldDescriptorProto, inOneof bool) {
+	var label string
+	switch {
+	case inOneof:
+	case f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED:
+		label = "repeated "
+	case f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REQUIRED:
+		label = "required "
+	case !fp.proto3:
+		label = "optional "
+	}
+
+	name, typ := f.GetName(), ""
+	var group *descpb.DescriptorProto
+	var groupIndex int
+	if entry := mapEntry(scope, md, f); entry != nil {
+		label = ""
+		typ = "map<" + fp.fieldType(scope, entry.Field[0]) + ", " + fp.fieldType(scope, entry.Field[1]) + ">"
+	} else if fp.isGroup(f) {
+		groupIndex, group = findNested(scope, md.NestedType, f)
+		if group == nil {
+			fp.err = fmt.Errorf("printer: no message declared for group %s", f.GetName())
+			return
+		}
+		typ, name = "group", group.GetName()
+	} else {
+		typ = fp.fieldType(scope, f)
+	}
+
+	var opts []string
+	if f.DefaultValue != nil {
+		opts = append(opts, "default = "+defaultValue(f))
+	}
+	if f.JsonName != nil && f.GetJsonName() != builder.JSONName(f.GetName()) {
+		opts = append(opts, "json_name = "+quoteString(f.GetJsonName()))
+	}
+	text := fmt.Sprintf("%s%s %s = %d%s", label, typ, name, f.GetNumber(), fp.bracketOptions(opts, "google.protobuf.FieldOptions", f.Options))
+	if group == nil {
+		fp.line(path, text+";")
+		return
+	}
+	// The path of a group's message depends on where it is declared.
+	groupPath := appendPath(path[:len(path)-2], messageNestedPath, int32(groupIndex))
+	if len(path) == 2 {
+		groupPath = []int32{fileMessagePath, int32(groupIndex)}
+	}
+	fp.open(path, text)
+	fp.messageBody(groupPath, joinName(scope, group.GetName()), group)
+	fp.close()
+}
+
+func defaultValue(f *descpb.FieldDescriptorProto) string {
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_STRING:
+		return quoteString(f.GetDefaultValue())
+	case descpb.FieldDescriptorProto_TYPE_BYTES:
+		// protoc stores default bytes values already escaped.
+		return `"` + f.GetDefaultValue() + `"`
+	}
+	return f.GetDefaultValue()
+}
+
+func (fp *filePrinter) enum(path []int32, scope string, ed *descpb.EnumDescriptorProto) {
+	fp.open(path, "enum "+ed.GetName())
+	fp.options(appendPath(path, enumOptionsPath), "google.protobuf.EnumOptions", ed.Options)
+	var items []item
+	for i, vd := range ed.Value {
+		path, vd := appendPath(path, enumValuePath, int32(i)), vd
+		items = append(items, item{path, false, func() {
+			opts := fp.bracketOptions(nil, "google.protobuf.EnumValueOptions", vd.Options)
+			fp.line(path, fmt.Sprintf("%s = %d%s;", vd.GetName(), vd.GetNumber(), opts))
+		}})
+	}
+	if len(ed.ReservedRange) > 0 {
+		var ranges []string
+		for _, r := range ed.ReservedRange {
+			ranges = append(ranges, rangeText(r.GetStart(), r.GetEnd(), maxEnumNumber))
+		}
+		path := appendPath(path, enumReservedRangePath)
+		items = append(items, item{path, false, func() { fp.line(path, "reserved "+strings.Join(ranges, ", ")+";") }})
+	}
+	if len(ed.ReservedName) > 0 {
+		path := appendPath(path, enumReservedNamePath)
+		items = append(items, item{path, false, func() { fp.line(path, "reserved "+quoteNames(ed.ReservedName)+";") }})
+	}
+	fp.printItems(items)
+	fp.close()
+}
+
+func (fp *filePrinter) service(path []int32, scope string, sd *descpb.ServiceDescriptorProto) {
+	name := joinName(scope, sd.GetName())
+	fp.open(path, "service "+sd.GetName())
+	fp.options(appendPath(path, serviceOptionsPath), "google.protobuf.ServiceOptions", sd.Options)
+	var items []item
+	for i, md := range sd.Method {
+		path, md := appendPath(path, serviceMethodPath, int32(i)), md
+		items = append(items, item{path, false, func() { fp.method(path, name, md) }})
+	}
+	fp.printItems(items)
+	fp.close()
+}
+
+func (fp *filePrinter) method(path []int32, scope string, md *descpb.MethodDescriptorProto) {
+	var in, out string
+	if md.GetClientStreaming() {
+		in = "stream "
+	}
+	if md.GetServerStreaming() {
+		out = "stream "
+	}
+	text := fmt.Sprintf("rpc %s(%s%s) returns (%s%s)", md.GetName(),
+		in, fp.typeName(scope, md.GetInputType()), out, fp.typeName(scope, md.GetOutputType()))
+	if opts, _ := descriptor.Options(md.Options); len(opts) == 0 {
+		fp.line(path, text+";")
+		return
+	}
+	fp.open(path, text)
+	fp.options(appendPath(path, methodOptionsPath), "google.protobuf.MethodOptions", md.Options)
+	fp.close()
+}
+
+// rangeText formats the inclusive range of numbers from start to end.
+func rangeText(start, end, max int32) string {
+	switch {
+	case end >= max:
+		return fmt.Sprintf("%d to max", start)
+	case start == end:
+		return strconv.Itoa(int(start))
+	}
+	return fmt.Sprintf("%d to %d", start, end)
+}
+
+func quoteNames(names []string) string {
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = quoteString(n)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// Options.
+
+// An option is an option to print, as a name and a value in .proto syntax.
+type option struct {
+	number int32
+	name   string
+	value  string
+}
+
+// options prints the options in opts, an options message extending the
+// message extendee, as option statements, followed by a blank line.
+func (fp *filePrinter) options(path []int32, extendee string, opts proto.Message) {
+	list := fp.optionList(extendee, opts)
+	for _, o := range list {
+		if o.name == "" {
+			fp.printLine("// " + o.value)
+			continue
+		}
+		fp.line(appendPath(path, o.number), "option "+o.name+" = "+o.value+";")
+	}
+	if len(list) > 0 {
+		fp.blank()
+	}
+}
+
+// bracketOptions returns the options in opts, following the pseudo-options
+// in pre, in the bracketed form used by fields and enum values.
+func (fp *filePrinter) bracketOptions(pre []string, extendee string, opts proto.Message) string {
+	entries := pre
+	var omitted []string
+	for _, o := range fp.optionList(extendee, opts) {
+		if o.name == "" {
+			omitted = append(omitted, o.value)
+			continue
+		}
+		entries = append(entries, o.name+" = "+o.value)
+	}
+	for _, s := range omitted {
+		fp.printLine("// " + s)
+	}
+	if len(entries) == 0 {
+		return ""
+	}
+	return " [" + strings.Join(entries, ", ") + "]"
+}
+
+// optionList returns the options set in opts. An option that cannot be
+// printed, because its extension is unknown, is returned with an empty
+// name and an explanation as its value.
+func (fp *filePrinter) optionList(extendee string, opts proto.Message) []option {
+	set, err := descriptor.Options(opts)
+	if err != nil {
+		fp.err = err
+		return nil
+	}
+	var list []option
+	for _, o := range set {
+		if o.Number == 999 { // uninterpreted_option
+			continue
+		}
+		var name string
+		var values []string
+		switch {
+		case o.Extension != nil:
+			name, values = "("+o.Name+")", goValues(o.Value)
+		case o.Name != "":
+			name, values = o.Name, goValues(o.Value)
+		default:
+			ext, ok := fp.exts[extKey{extendee, o.Number}]
+			if ok {
+				values, ok = fp.decodeValues(ext.field, o.Value.([]byte))
+			}
+			if !ok {
+				list = append(list, option{o.Number, "", fmt.Sprintf("option %d of %s omitted: its definition is unknown", o.Number, extendee)})
+				continue
+			}
+			name = "(" + ext.name + ")"
+		}
+		for _, v := range values {
+			list = append(list, option{o.Number, name, v})
+		}
+	}
+	return list
+}
+
+// goValues formats the value of a linked-in option, one element at a time
+// for a repeated option.
+func goValues(x interface{}) []string {
+	v := reflect.ValueOf(x)
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+		values := make([]string, v.Len())
+		for i := range values {
+			values[i] = goValue(v.Index(i))
+		}
+		return values
+	}
+	return []string{goValue(v)}
+}
+
+func goValue(v reflect.Value) string {
+	if m, ok := v.Interface().(proto.Message); ok {
+		return aggregate(strings.TrimSpace(proto.CompactTextString(m)))
+	}
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if s, ok := v.Interface().(fmt.Stringer); ok && v.Kind() == reflect.Int32 {
+		return s.String() // an enum
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32:
+		return formatFloat(v.Float(), 32)
+	case reflect.Float64:
+		return formatFloat(v.Float(), 64)
+	case reflect.String:
+		return quoteString(v.String())
+	case reflect.Slice:
+		return quoteBytes(v.Bytes())
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+func aggregate(fields string) string {
+	if fields == "" {
+		return "{}"
+	}
+	return "{ " + fields + " }"
+}
+
+func formatFloat(f float64, bits int) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	case math.IsNaN(f):
+		return "nan"
+	}
+	return strconv.FormatFloat(f, 'g', -1, bits)
+}
+
+// decodeValues decodes the wire-format encoding b of the option f, whose
+// extension is not linked in, into values in .proto syntax.
+func (fp *filePrinter) decodeValues(f *descpb.FieldDescriptorProto, b []byte) ([]string, bool) {
+	var values []string
+	for len(b) > 0 {
+		key, n := proto.DecodeVarint(b)
+		if n == 0 {
+			return nil, false
+		}
+		b = b[n:]
+		v, m, ok := fp.decodeField(f, key&7, b)
+		if !ok {
+			return nil, false
+		}
+		values = append(values, v...)
+		b = b[m:]
+	}
+	return values, true
+}
+
+// decodeField decodes the value, with wire type wire, at the start of b,
+// returning the values it holds and its length.
+func (fp *filePrinter) decodeField(f *descpb.FieldDescriptorProto, wire uint64, b []byte) ([]string, int, bool) {
+	switch wire {
+	case proto.WireVarint:
+		x, n := proto.DecodeVarint(b)
+		if n == 0 {
+			return nil, 0, false
+		}
+		return []string{fp.scalar(f, x)}, n, true
+	case proto.WireFixed32:
+		if len(b) < 4 {
+			return nil, 0, false
+		}
+		return []string{fp.scalar(f, uint64(binary.LittleEndian.Uint32(b)))}, 4, true
+	case proto.WireFixed64:
+		if len(b) < 8 {
+			return nil, 0, false
+		}
+		return []string{fp.scalar(f, binary.LittleEndian.Uint64(b))}, 8, true
+	case proto.WireBytes:
+		l, n := proto.DecodeVarint(b)
+		if n == 0 || l > uint64(len(b)-n) {
+			return nil, 0, false
+		}
+		data := b[n : n+int(l)]
+		switch f.GetType() {
+		case descpb.FieldDescriptorProto_TYPE_STRING:
+			return []string{quoteString(string(data))}, n + int(l), true
+		case descpb.FieldDescriptorProto_TYPE_BYTES:
+			return []string{quoteBytes(data)}, n + int(l), true
+		case descpb.FieldDescriptorProto_TYPE_MESSAGE:
+			s, _, ok := fp.decodeMessage(f.GetTypeName(), data, false)
+			return []string{s}, n + int(l), ok
+		}
+		// A packed repeated scalar.
+		var values []string
+		for len(data) > 0 {
+			w := uint64(proto.WireVarint)
+			switch f.GetType() {
+			case descpb.FieldDescriptorProto_TYPE_FIXED32, descpb.FieldDescriptorProto_TYPE_SFIXED32, descpb.FieldDescriptorProto_TYPE_FLOAT:
+				w = proto.WireFixed32
+			case descpb.FieldDescriptorProto_TYPE_FIXED64, descpb.FieldDescriptorProto_TYPE_SFIXED64, descpb.FieldDescriptorProto_TYPE_DOUBLE:
+				w = proto.WireFixed64
+			}
+			v, m, ok := fp.decodeField(f, w, data)
+			if !ok {
+				return nil, 0, false
+			}
+			values = append(values, v...)
+			data = data[m:]
+		}
+		return values, n + int(l), true
+	case proto.WireStartGroup:
+		if f.GetType() != descpb.FieldDescriptorProto_TYPE_GROUP {
+			return nil, 0, false
+		}
+		s, n, ok := fp.decodeMessage(f.GetTypeName(), b, true)
+		return []string{s}, n, ok
+	}
+	return nil, 0, false
+}
+
+// decodeMessage decodes the encoding b of a message of the named type into
+// an aggregate value. If group is set, b continues past the end of the
+// group, whose length is returned.
+func (fp *filePrinter) decodeMessage(typeName string, b []byte, group bool) (string, int, bool) {
+	md := fp.messages[strings.TrimPrefix(typeName, ".")]
+	if md == nil {
+		return "", 0, false
+	}
+	var fields []string
+	i := 0
+	for i < len(b) {
+		key, n := proto.DecodeVarint(b[i:])
+		if n == 0 {
+			return "", 0, false
+		}
+		i += n
+		if key&7 == proto.WireEndGroup {
+			if !group {
+				return "", 0, false
+			}
+			return aggregate(strings.Join(fields, " ")), i, true
+		}
+		var f *descpb.FieldDescriptorProto
+		for _, fd := range md.Field {
+			if uint64(fd.GetNumber()) == key>>3 {
+				f = fd
+			}
+		}
+		if f == nil {
+			return "", 0, false
+		}
+		values, m, ok := fp.decodeField(f, key&7, b[i:])
+		if !ok {
+			return "", 0, false
+		}
+		i += m
+		name := f.GetName()
+		if fp.isGroup(f) {
+			name = simpleName(f.GetTypeName())
+		}
+		for _, v := range values {
+			fields = append(fields, name+": "+v)
+		}
+	}
+	if group {
+		return "", 0, false
+	}
+	return aggregate(strings.Join(fields, " ")), i, true
+}
+
+// scalar formats the scalar value x of the field f.
+func (fp *filePrinter) scalar(f *descpb.FieldDescriptorProto, x uint64) string {
+	switch f.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return strconv.FormatInt(int64(int32(x)), 10)
+	case descpb.FieldDescriptorProto_TYPE_INT64, descpb.FieldDescriptorProto_TYPE_SFIXED64:
+		return strconv.FormatInt(int64(x), 10)
+	case descpb.FieldDescriptorProto_TYPE_SINT32:
+		return strconv.FormatInt(int64(int32(uint32(x)>>1)^-int32(x&1)), 10)
+	case descpb.FieldDescriptorProto_TYPE_SINT64:
+		return strconv.FormatInt(int64(x>>1)^-int64(x&1), 10)
+	case descpb.FieldDescriptorProto_TYPE_UINT32, descpb.FieldDescriptorProto_TYPE_FIXED32:
+		return strconv.FormatUint(uint64(uint32(x)), 10)
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		return strconv.FormatBool(x != 0)
+	case descpb.FieldDescriptorProto_TYPE_FLOAT:
+		return formatFloat(float64(math.Float32frombits(uint32(x))), 32)
+	case descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return formatFloat(math.Float64frombits(x), 64)
+	case descpb.FieldDescriptorProto_TYPE_ENUM:
+		if ed := fp.enums[strings.TrimPrefix(f.GetTypeName(), ".")]; ed != nil {
+			for _, vd := range ed.Value {
+				if vd.GetNumber() == int32(x) {
+					return vd.GetName()
+				}
+			}
+		}
+		return strconv.FormatInt(int64(int32(x)), 10)
+	}
+	return strconv.FormatUint(x, 10)
+}
+
+// quoteString quotes s as a string literal. Valid UTF-8 is kept as is.
+func quoteString(s string) string {
+	return quote([]byte(s), utf8.ValidString(s))
+}
+
+// quoteBytes quotes b as a string literal, escaping every byte that is not
+// printable ASCII.
+func quoteBytes(b []byte) string {
+	return quote(b, false)
+}
+
+func quote(b []byte, keepUTF8 bool) string {
+	var buf bytes.Buffer
+	buf.WriteByte('"')
+	for _, c := range b {
+		switch c {
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		default:
+			if 0x20 <= c && c < 0x7f || keepUTF8 && c >= 0x80 {
+				buf.WriteByte(c)
+			} else {
+				fmt.Fprintf(&buf, `\%03o`, c)
+			}
+		}
+	}
+	buf.WriteByte('"')
+	return buf.String()
+}
@@ -0,0 +1,408 @@
+package printer_test
+
+import (
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	cpb "github.com/golang/protobuf/descriptor/descriptor_test_proto"
+	"github.com/golang/protobuf/descriptor/parser"
+	"github.com/golang/protobuf/descriptor/printer"
+	"github.com/golang/protobuf/descriptor/registry"
+	"github.com/golang/protobuf/proto"
+	tpb "github.com/golang/protobuf/proto/test_proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+	_ "github.com/golang/protobuf/validate/rules"
+)
+
+func TestComments(t *testing.T) {
+	fd, _ := descriptor.ForMessage(new(cpb.Article))
+	got, err := new(printer.Printer).PrintToString(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Skip the license, which protoc records as a detached comment.
+	got = got[strings.Index(got, "// Detached comment about the file."):]
+	want := `// Detached comment about the file.
+
+syntax = "proto2";
+
+package descriptor_test;
+
+option go_package = "github.com/golang/protobuf/descriptor/descriptor_test_proto;descriptor_test_proto";
+
+// Detached comment before Article.
+
+// Article is a published piece of writing.
+message Article {
+  // The title of the article.
+  optional string title = 1;
+  optional int64 views = 2; // Number of times the article was read.
+
+  oneof body {
+    // Plain text body.
+    string text = 3;
+    string html = 4;
+  }
+
+  // Status of an article.
+  enum Status {
+    // Not yet published.
+    DRAFT = 0;
+    PUBLISHED = 1; // Visible to readers.
+  }
+
+  optional Status status = 5;
+
+  message Author {
+    // Display name.
+    optional string name = 1;
+  }
+
+  repeated Author authors = 6;
+}
+
+// Format of an article body.
+enum Format {
+  FORMAT_TEXT = 0;
+  // Rendered as HTML.
+  FORMAT_HTML = 1;
+}
+`
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	got, err = (&printer.Printer{OmitComments: true, Indent: "\t"}).PrintToString(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(got, "//") || !strings.Contains(got, "\n\toptional string title = 1;\n") {
+		t.Errorf("printed with OmitComments and a tab indent:\n%s", got)
+	}
+}
+
+func field(name string, number int32, label descpb.FieldDescriptorProto_Label, typ descpb.FieldDescriptorProto_Type, typeName string) *descpb.FieldDescriptorProto {
+	f := &descpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		Number:   proto.Int32(number),
+		Label:    label.Enum(),
+		Type:     typ.Enum(),
+		JsonName: proto.String(name),
+	}
+	if typeName != "" {
+		f.TypeName = proto.String(typeName)
+	}
+	return f
+}
+
+const (
+	optional = descpb.FieldDescriptorProto_LABEL_OPTIONAL
+	repeated = descpb.FieldDescriptorProto_LABEL_REPEATED
+
+	typeInt32   = descpb.FieldDescriptorProto_TYPE_INT32
+	typeString  = descpb.FieldDescriptorProto_TYPE_STRING
+	typeMessage = descpb.FieldDescriptorProto_TYPE_MESSAGE
+	typeEnum    = descpb.FieldDescriptorProto_TYPE_ENUM
+)
+
+func TestProto3(t *testing.T) {
+	nameField := field("display_name", 5, optional, typeString, "")
+	nameField.JsonName = proto.String("name")
+	fd := &descpb.FileDescriptorProto{
+		Name:             proto.String("shop/order.proto"),
+		Package:          proto.String("shop.v1"),
+		Dependency:       []string{"google/protobuf/timestamp.proto", "shop/item.proto", "shop/legacy.proto"},
+		PublicDependency: []int32{1},
+		WeakDependency:   []int32{2},
+		Syntax:           proto.String("proto3"),
+		Options: &descpb.FileOptions{
+			GoPackage:   proto.String("example.com/shop/v1;shop"),
+			OptimizeFor: descpb.FileOptions_CODE_SIZE.Enum(),
+		},
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("Order"),
+			Field: []*descpb.FieldDescriptorProto{
+				field("id", 1, optional, typeString, ""),
+				field("items", 2, repeated, typeMessage, ".shop.v1.Order.ItemsEntry"),
+				field("created", 3, optional, typeMessage, ".google.protobuf.Timestamp"),
+				field("state", 4, optional, typeEnum, ".shop.v1.Order.State"),
+				nameField,
+				field("card", 6, optional, typeString, ""),
+				field("voucher", 7, optional, typeMessage, ".shop.v1.Voucher"),
+				field("ids", 8, repeated, typeInt32, ""),
+			},
+			NestedType: []*descpb.DescriptorProto{{
+				Name: proto.String("ItemsEntry"),
+				Field: []*descpb.FieldDescriptorProto{
+					field("key", 1, optional, typeString, ""),
+					field("value", 2, optional, typeMessage, ".shop.v1.Item"),
+				},
+				Options: &descpb.MessageOptions{MapEntry: proto.Bool(true)},
+			}, {
+				Name: proto.String("Voucher"),
+			}},
+			EnumType: []*descpb.EnumDescriptorProto{{
+				Name: proto.String("State"),
+				Value: []*descpb.EnumValueDescriptorProto{
+					{Name: proto.String("STATE_UNSPECIFIED"), Number: proto.Int32(0)},
+					{Name: proto.String("STATE_OPEN"), Number: proto.Int32(1)},
+					{Name: proto.String("STATE_ACTIVE"), Number: proto.Int32(1), Options: &descpb.EnumValueOptions{Deprecated: proto.Bool(true)}},
+				},
+				Options: &descpb.EnumOptions{AllowAlias: proto.Bool(true)},
+				ReservedRange: []*descpb.EnumDescriptorProto_EnumReservedRange{
+					{Start: proto.Int32(5), End: proto.Int32(5)},
+					{Start: proto.Int32(10), End: proto.Int32(2147483647)},
+				},
+				ReservedName: []string{"STATE_CLOSED"},
+			}},
+			OneofDecl: []*descpb.OneofDescriptorProto{{Name: proto.String("payment")}},
+			ReservedRange: []*descpb.DescriptorProto_ReservedRange{
+				{Start: proto.Int32(9), End: proto.Int32(10)},
+				{Start: proto.Int32(15), End: proto.Int32(20)},
+			},
+			ReservedName: []string{"total", "tax"},
+		}, {
+			Name: proto.String("Voucher"),
+		}},
+		Service: []*descpb.ServiceDescriptorProto{{
+			Name: proto.String("Orders"),
+			Method: []*descpb.MethodDescriptorProto{{
+				Name:       proto.String("Get"),
+				InputType:  proto.String(".shop.v1.Order"),
+				OutputType: proto.String(".shop.v1.Order"),
+			}, {
+				Name:            proto.String("Watch"),
+				InputType:       proto.String(".shop.v1.Order"),
+				OutputType:      proto.String(".shop.v1.Order"),
+				ServerStreaming: proto.Bool(true),
+				ClientStreaming: proto.Bool(true),
+				Options: &descpb.MethodOptions{
+					Deprecated:       proto.Bool(true),
+					IdempotencyLevel: descpb.MethodOptions_NO_SIDE_EFFECTS.Enum(),
+				},
+			}},
+		}},
+	}
+	o := fd.MessageType[0]
+	o.Field[5].OneofIndex = proto.Int32(0)
+	o.Field[6].OneofIndex = proto.Int32(0)
+	o.Field[7].Options = &descpb.FieldOptions{Packed: proto.Bool(false)}
+
+	got, err := new(printer.Printer).PrintToString(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `syntax = "proto3";
+
+package shop.v1;
+
+import "google/protobuf/timestamp.proto";
+import public "shop/item.proto";
+import weak "shop/legacy.proto";
+
+option optimize_for = CODE_SIZE;
+option go_package = "example.com/shop/v1;shop";
+
+message Order {
+  enum State {
+    option allow_alias = true;
+
+    STATE_UNSPECIFIED = 0;
+    STATE_OPEN = 1;
+    STATE_ACTIVE = 1 [deprecated = true];
+    reserved 5, 10 to max;
+    reserved "STATE_CLOSED";
+  }
+
+  string id = 1;
+  map<string, Item> items = 2;
+
+  message Voucher {
+  }
+
+  google.protobuf.Timestamp created = 3;
+  State state = 4;
+  string display_name = 5 [json_name = "name"];
+
+  oneof payment {
+    string card = 6;
+    v1.Voucher voucher = 7;
+  }
+
+  repeated int32 ids = 8 [packed = false];
+  reserved 9, 15 to 19;
+  reserved "total", "tax";
+}
+
+message Voucher {
+}
+
+service Orders {
+  rpc Get(Order) returns (Order);
+  rpc Watch(stream Order) returns (stream Order) {
+    option deprecated = true;
+    option idempotency_level = NO_SIDE_EFFECTS;
+  }
+}
+`
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestGroupsAndExtensions(t *testing.T) {
+	fd, _ := descriptor.ForMessage(new(tpb.GoTest))
+	got, err := new(printer.Printer).PrintToString(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"\n  repeated group RepeatedGroup = 80 {\n    required string RequiredField = 81;\n  }\n",
+		"\n  map<sint64, FloatingPoint> msg_mapping = 2;\n",
+		"\n  extensions 100 to max;\n",
+		"\n  oneof union {\n",
+		"\nextend MyMessage {\n",
+		"\n  optional float F_Pinf = 15 [default = inf];\n",
+		"\n  optional string F_String = 10 [default = \"hello, \\\"world!\\\"\\n\"];\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("printed test.proto without %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestUnlinkedOptions(t *testing.T) {
+	// The options are declared in a file whose extensions are not linked in.
+	opts := &descpb.FileDescriptorProto{
+		Name:       proto.String("acme/options.proto"),
+		Package:    proto.String("acme"),
+		Dependency: []string{"google/protobuf/descriptor.proto"},
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("Route"),
+			Field: []*descpb.FieldDescriptorProto{
+				field("path", 1, optional, typeString, ""),
+				field("methods", 2, repeated, typeEnum, ".acme.Method"),
+			},
+		}},
+		EnumType: []*descpb.EnumDescriptorProto{{
+			Name: proto.String("Method"),
+			Value: []*descpb.EnumValueDescriptorProto{
+				{Name: proto.String("GET"), Number: proto.Int32(0)},
+				{Name: proto.String("POST"), Number: proto.Int32(1)},
+			},
+		}},
+		Extension: []*descpb.FieldDescriptorProto{
+			field("route", 51000, optional, typeMessage, ".acme.Route"),
+			field("weight", 51001, optional, descpb.FieldDescriptorProto_TYPE_SINT32, ""),
+			field("alias", 51000, repeated, typeString, ""),
+		},
+	}
+	opts.Extension[0].Extendee = proto.String(".google.protobuf.MessageOptions")
+	opts.Extension[1].Extendee = proto.String(".google.protobuf.MessageOptions")
+	opts.Extension[2].Extendee = proto.String(".google.protobuf.FieldOptions")
+
+	var route, msgOpts, fieldOpts proto.Buffer
+	route.EncodeVarint(1<<3 | proto.WireBytes)
+	route.EncodeStringBytes("/users")
+	route.EncodeVarint(2<<3 | proto.WireVarint)
+	route.EncodeVarint(1)
+	msgOpts.EncodeVarint(51000<<3 | proto.WireBytes)
+	msgOpts.EncodeRawBytes(route.Bytes())
+	msgOpts.EncodeVarint(51001<<3 | proto.WireVarint)
+	msgOpts.EncodeZigzag32(uint64(-3 & 0xffffffff))
+	msgOpts.EncodeVarint(51999<<3 | proto.WireVarint)
+	msgOpts.EncodeVarint(1)
+	fieldOpts.EncodeVarint(51000<<3 | proto.WireBytes)
+	fieldOpts.EncodeStringBytes("login")
+	fieldOpts.EncodeVarint(51000<<3 | proto.WireBytes)
+	fieldOpts.EncodeStringBytes("user")
+
+	user := &descpb.DescriptorProto{
+		Name:    proto.String("User"),
+		Field:   []*descpb.FieldDescriptorProto{field("name", 1, optional, typeString, "")},
+		Options: &descpb.MessageOptions{XXX_unrecognized: msgOpts.Bytes()},
+	}
+	user.Field[0].Options = &descpb.FieldOptions{XXX_unrecognized: fieldOpts.Bytes()}
+	fd := &descpb.FileDescriptorProto{
+		Name:        proto.String("acme/user.proto"),
+		Package:     proto.String("acme"),
+		Dependency:  []string{"acme/options.proto"},
+		MessageType: []*descpb.DescriptorProto{user},
+	}
+
+	got, err := (&printer.Printer{Files: []*descpb.FileDescriptorProto{opts}}).PrintToString(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `syntax = "proto2";
+
+package acme;
+
+import "acme/options.proto";
+
+message User {
+  option (acme.route) = { path: "/users" methods: POST };
+  option (acme.weight) = -3;
+  // option 51999 of google.protobuf.MessageOptions omitted: its definition is unknown
+
+  optional string name = 1 [(acme.alias) = "login", (acme.alias) = "user"];
+}
+`
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+// TestRoundTrip checks that parsing a printed file gives back the file
+// printed, when it has no source positions to order its elements by.
+func TestRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		dir, name string
+	}{
+		{"../../proto", "test_proto/test.proto"},
+		{"../../protoc-gen-go/testdata", "my_test/test.proto"},
+		{"../..", "validate/validate_test_proto/proto3.proto"},
+	} {
+		p := &parser.Parser{ImportPaths: []string{test.dir}, LookupImport: registry.FindFile}
+		fds, err := p.ParseFiles(test.name)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		text, err := new(printer.Printer).PrintToString(fds[0])
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		path := filepath.Join(test.dir, filepath.FromSlash(test.name))
+		p.Accessor = func(filename string) (io.ReadCloser, error) {
+			if filename == path {
+				return ioutil.NopCloser(strings.NewReader(text)), nil
+			}
+			return os.Open(filename)
+		}
+		got, err := p.ParseFiles(test.name)
+		if err != nil {
+			t.Errorf("%s: parsing the printed file: %v\n%s", test.name, err, text)
+			continue
+		}
+		if !proto.Equal(got[0], fds[0]) {
+			t.Errorf("%s: got:\n%v\nwant:\n%v", test.name, proto.MarshalTextString(got[0]), proto.MarshalTextString(fds[0]))
+		}
+	}
+}
+
+func ExamplePrinter() {
+	fd, _ := descriptor.ForMessage(new(cpb.Article))
+	p := printer.Printer{OmitComments: true}
+	if err := p.Print(os.Stdout, fd); err != nil {
+		log.Fatal(err)
+	}
+}
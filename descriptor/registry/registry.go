@@ -0,0 +1,303 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package registry indexes the descriptors of the .proto files linked into
+// the binary, which generated code registers with proto.RegisterFile, and
+// looks up their messages, enums, services, methods and extensions by
+// fully-qualified name, such as "google.protobuf.Timestamp".
+//
+// Registered files are decompressed and indexed on first use, and files
+// registered later are indexed on the next lookup. The descriptors returned
+// are shared by all callers and must not be modified.
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/internal/filedesc"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// A symbol is a named element of a file.
+type symbol struct {
+	file    *descpb.FileDescriptorProto
+	message *descpb.DescriptorProto
+	enum    *descpb.EnumDescriptorProto
+	service *descpb.ServiceDescriptorProto
+	method  *descpb.MethodDescriptorProto
+	ext     *descpb.FieldDescriptorProto
+}
+
+var (
+	mu       sync.Mutex
+	files    = make(map[string]*descpb.FileDescriptorProto) // file name => decompressed descriptor
+	indexed  = make(map[string]bool)                        // file name => whether its symbols are indexed
+	symbols  = make(map[string]symbol)                      // full name => symbol
+	services []string                                       // full names of indexed services
+)
+
+// loadFile returns the decompressed descriptor of a registered file.
+// mu must be held.
+func loadFile(name string) (*descpb.FileDescriptorProto, error) {
+	if fd := files[name]; fd != nil {
+		return fd, nil
+	}
+	gz := proto.FileDescriptor(name)
+	if gz == nil {
+		return nil, fmt.Errorf("registry: file %q is not registered", name)
+	}
+	fd, err := filedesc.Extract(gz)
+	if err != nil {
+		return nil, fmt.Errorf("registry: file %q: %v", name, err)
+	}
+	files[name] = fd
+	return fd, nil
+}
+
+// indexAll indexes every registered file not yet indexed. Files that fail
+// to decompress are skipped; FindFile reports their errors. mu must be held.
+func indexAll() {
+	if len(indexed) == proto.NumRegisteredFiles() {
+		return
+	}
+	for _, name := range proto.RegisteredFiles() {
+		if indexed[name] {
+			continue
+		}
+		indexed[name] = true
+		fd, err := loadFile(name)
+		if err != nil {
+			continue
+		}
+		indexFile(fd)
+	}
+}
+
+// add records sym under name, unless an earlier file defined name.
+func add(name string, sym symbol) {
+	if _, ok := symbols[name]; !ok {
+		symbols[name] = sym
+	}
+}
+
+func indexFile(fd *descpb.FileDescriptorProto) {
+	prefix := fd.GetPackage()
+	for _, md := range fd.MessageType {
+		indexMessage(fd, prefix, md)
+	}
+	for _, ed := range fd.EnumType {
+		add(join(prefix, ed.GetName()), symbol{file: fd, enum: ed})
+	}
+	for _, xd := range fd.Extension {
+		add(join(prefix, xd.GetName()), symbol{file: fd, ext: xd})
+	}
+	for _, sd := range fd.Service {
+		name := join(prefix, sd.GetName())
+		if _, ok := symbols[name]; !ok {
+			services = append(services, name)
+		}
+		add(name, symbol{file: fd, service: sd})
+		for _, m := range sd.Method {
+			add(join(name, m.GetName()), symbol{file: fd, service: sd, method: m})
+		}
+	}
+}
+
+func indexMessage(fd *descpb.FileDescriptorProto, prefix string, md *descpb.DescriptorProto) {
+	name := join(prefix, md.GetName())
+	add(name, symbol{file: fd, message: md})
+	for _, nd := range md.NestedType {
+		indexMessage(fd, name, nd)
+	}
+	for _, ed := range md.EnumType {
+		add(join(name, ed.GetName()), symbol{file: fd, enum: ed})
+	}
+	for _, xd := range md.Extension {
+		add(join(name, xd.GetName()), symbol{file: fd, ext: xd})
+	}
+}
+
+func join(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// lookup returns the symbol with the given full name, which may have a
+// leading dot.
+func lookup(name string) (symbol, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	indexAll()
+	sym, ok := symbols[strings.TrimPrefix(name, ".")]
+	return sym, ok
+}
+
+// Files returns the names of all registered files, sorted.
+func Files() []string {
+	return proto.RegisteredFiles()
+}
+
+// FindFile returns the descriptor of the registered file with the given
+// name, such as "google/protobuf/timestamp.proto".
+func FindFile(name string) (*descpb.FileDescriptorProto, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	return loadFile(name)
+}
+
+// FindMessage returns the descriptor of the message with the given full
+// name and of the file that defines it.
+func FindMessage(name string) (*descpb.FileDescriptorProto, *descpb.DescriptorProto, error) {
+	sym, ok := lookup(name)
+	if !ok || sym.message == nil {
+		return nil, nil, fmt.Errorf("registry: message %q not found", name)
+	}
+	return sym.file, sym.message, nil
+}
+
+// FindEnum returns the descriptor of the enum with the given full name and
+// of the file that defines it.
+func FindEnum(name string) (*descpb.FileDescriptorProto, *descpb.EnumDescriptorProto, error) {
+	sym, ok := lookup(name)
+	if !ok || sym.enum == nil {
+		return nil, nil, fmt.Errorf("registry: enum %q not found", name)
+	}
+	return sym.file, sym.enum, nil
+}
+
+// FindService returns the descriptor of the service with the given full
+// name and of the file that defines it.
+func FindService(name string) (*descpb.FileDescriptorProto, *descpb.ServiceDescriptorProto, error) {
+	sym, ok := lookup(name)
+	if !ok || sym.service == nil || sym.method != nil {
+		return nil, nil, fmt.Errorf("registry: service %q not found", name)
+	}
+	return sym.file, sym.service, nil
+}
+
+// FindMethod returns the descriptor of the method with the given full
+// name, such as "pkg.Service.Method", and of its service.
+func FindMethod(name string) (*descpb.ServiceDescriptorProto, *descpb.MethodDescriptorProto, error) {
+	sym, ok := lookup(name)
+	if !ok || sym.method == nil {
+		return nil, nil, fmt.Errorf("registry: method %q not found", name)
+	}
+	return sym.service, sym.method, nil
+}
+
+// FindExtension returns the descriptor of the extension field with the
+// given full name and of the file that defines it.
+func FindExtension(name string) (*descpb.FileDescriptorProto, *descpb.FieldDescriptorProto, error) {
+	sym, ok := lookup(name)
+	if !ok || sym.ext == nil {
+		return nil, nil, fmt.Errorf("registry: extension %q not found", name)
+	}
+	return sym.file, sym.ext, nil
+}
+
+// FindExtensionByNumber returns the descriptor of the extension of the
+// message with full name extendee that has the given field number.
+func FindExtensionByNumber(extendee string, field int32) (*descpb.FileDescriptorProto, *descpb.FieldDescriptorProto, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	indexAll()
+	extendee = "." + strings.TrimPrefix(extendee, ".")
+	var names []string
+	for name, sym := range symbols {
+		if sym.ext != nil && sym.ext.GetExtendee() == extendee && sym.ext.GetNumber() == field {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil, fmt.Errorf("registry: extension %d of %q not found", field, extendee[1:])
+	}
+	sort.Strings(names)
+	sym := symbols[names[0]]
+	return sym.file, sym.ext, nil
+}
+
+// Services returns the full names of all services in registered files,
+// sorted.
+func Services() []string {
+	mu.Lock()
+	defer mu.Unlock()
+	indexAll()
+	names := append([]string(nil), services...)
+	sort.Strings(names)
+	return names
+}
+
+// FileDescriptorSet returns the descriptors of the named files and of all
+// the files they depend on, directly or indirectly, with every file listed
+// after its dependencies. With no names, it returns every registered file.
+// It returns an error if a file or dependency is not registered.
+func FileDescriptorSet(names ...string) (*descpb.FileDescriptorSet, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if len(names) == 0 {
+		names = proto.RegisteredFiles()
+	}
+	set := new(descpb.FileDescriptorSet)
+	done := make(map[string]bool)
+	var visit func(name, from string) error
+	visit = func(name, from string) error {
+		if done[name] {
+			return nil
+		}
+		done[name] = true
+		fd, err := loadFile(name)
+		if err != nil {
+			if from != "" {
+				return fmt.Errorf("%v (imported by %q)", err, from)
+			}
+			return err
+		}
+		for _, dep := range fd.Dependency {
+			if err := visit(dep, name); err != nil {
+				return err
+			}
+		}
+		set.File = append(set.File, fd)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name, ""); err != nil {
+			return nil, err
+		}
+	}
+	return set, nil
+}
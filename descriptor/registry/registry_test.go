@@ -0,0 +1,179 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package registry
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	_ "github.com/golang/protobuf/proto/test_proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// registerTestFile registers a file defining a service, which no linked
+// package does, that imports test_proto/test.proto.
+func registerTestFile(t *testing.T) {
+	fd := &descpb.FileDescriptorProto{
+		Name:       proto.String("registry_test/service.proto"),
+		Package:    proto.String("registry.test"),
+		Dependency: []string{"test_proto/test.proto", "google/protobuf/timestamp.proto"},
+		Service: []*descpb.ServiceDescriptorProto{{
+			Name: proto.String("Greeter"),
+			Method: []*descpb.MethodDescriptorProto{{
+				Name:       proto.String("Greet"),
+				InputType:  proto.String(".test_proto.GoTest"),
+				OutputType: proto.String(".google.protobuf.Timestamp"),
+			}},
+		}},
+	}
+	b, err := proto.Marshal(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(b)
+	zw.Close()
+	proto.RegisterFile(fd.GetName(), buf.Bytes())
+}
+
+func TestFind(t *testing.T) {
+	fd, md, err := FindMessage("test_proto.GoTest")
+	if err != nil || md.GetName() != "GoTest" || fd.GetName() != "test_proto/test.proto" {
+		t.Errorf("FindMessage(test_proto.GoTest) = %v, %v, %v", fd.GetName(), md.GetName(), err)
+	}
+	if _, md, err := FindMessage(".test_proto.Ext"); err != nil || md.GetName() != "Ext" {
+		t.Errorf("FindMessage(.test_proto.Ext) = %v, %v", md.GetName(), err)
+	}
+	if _, md, err := FindMessage("google.protobuf.Timestamp"); err != nil || md.GetName() != "Timestamp" {
+		t.Errorf("FindMessage(google.protobuf.Timestamp) = %v, %v", md.GetName(), err)
+	}
+	if _, ed, err := FindEnum("test_proto.DefaultsMessage.DefaultsEnum"); err != nil || len(ed.GetValue()) != 3 {
+		t.Errorf("FindEnum(test_proto.DefaultsMessage.DefaultsEnum) = %v, %v", ed, err)
+	}
+	if _, xd, err := FindExtension("test_proto.Ext.more"); err != nil || xd.GetNumber() != 103 {
+		t.Errorf("FindExtension(test_proto.Ext.more) = %v, %v", xd, err)
+	}
+	if _, xd, err := FindExtensionByNumber("test_proto.MyMessage", 106); err != nil || xd.GetName() != "greeting" {
+		t.Errorf("FindExtensionByNumber(test_proto.MyMessage, 106) = %v, %v", xd, err)
+	}
+
+	for _, name := range []string{"test_proto.NoSuchMessage", "test_proto.FOO", "test_proto"} {
+		if _, _, err := FindMessage(name); err == nil {
+			t.Errorf("FindMessage(%q) succeeded", name)
+		}
+	}
+	if _, _, err := FindEnum("test_proto.GoTest"); err == nil {
+		t.Errorf("FindEnum of a message succeeded")
+	}
+	if _, _, err := FindExtensionByNumber("test_proto.MyMessage", 200); err == nil {
+		t.Errorf("FindExtensionByNumber of an unregistered extension succeeded")
+	}
+	if _, err := FindFile("no/such.proto"); err == nil {
+		t.Errorf("FindFile of an unregistered file succeeded")
+	}
+}
+
+func TestFindAllocs(t *testing.T) {
+	FindMessage("test_proto.GoTest")
+	// Nothing is rescanned or copied when no file was registered since the
+	// last lookup.
+	if n := testing.AllocsPerRun(100, func() { FindMessage("test_proto.GoTest") }); n != 0 {
+		t.Errorf("FindMessage of an indexed message made %v allocations, want 0", n)
+	}
+}
+
+func TestServices(t *testing.T) {
+	registerTestFile(t)
+
+	// The file registered after the first lookups is indexed too.
+	if got := Services(); len(got) != 1 || got[0] != "registry.test.Greeter" {
+		t.Errorf("Services() = %v, want [registry.test.Greeter]", got)
+	}
+	fd, sd, err := FindService("registry.test.Greeter")
+	if err != nil || sd.GetName() != "Greeter" || fd.GetName() != "registry_test/service.proto" {
+		t.Errorf("FindService(registry.test.Greeter) = %v, %v, %v", fd.GetName(), sd.GetName(), err)
+	}
+	sd, m, err := FindMethod("registry.test.Greeter.Greet")
+	if err != nil || sd.GetName() != "Greeter" || m.GetOutputType() != ".google.protobuf.Timestamp" {
+		t.Errorf("FindMethod(registry.test.Greeter.Greet) = %v, %v, %v", sd, m, err)
+	}
+	if _, _, err := FindService("registry.test.Greeter.Greet"); err == nil {
+		t.Errorf("FindService of a method succeeded")
+	}
+
+	set, err := FileDescriptorSet("registry_test/service.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, fd := range set.File {
+		names = append(names, fd.GetName())
+	}
+	// Dependencies come before the files that import them.
+	want := []string{"google/protobuf/duration.proto", "google/protobuf/timestamp.proto", "test_proto/test.proto", "registry_test/service.proto"}
+	if len(names) != len(want) {
+		t.Fatalf("FileDescriptorSet files = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("FileDescriptorSet files = %v, want %v", names, want)
+			break
+		}
+	}
+}
+
+func TestFileDescriptorSet(t *testing.T) {
+	set, err := FileDescriptorSet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(set.File) != len(Files()) {
+		t.Errorf("FileDescriptorSet() has %d files, want %d", len(set.File), len(Files()))
+	}
+	seen := make(map[string]bool)
+	for _, fd := range set.File {
+		for _, dep := range fd.Dependency {
+			if !seen[dep] {
+				t.Errorf("%s is listed before its dependency %s", fd.GetName(), dep)
+			}
+		}
+		seen[fd.GetName()] = true
+	}
+
+	if _, err := FileDescriptorSet("no/such.proto"); err == nil {
+		t.Errorf("FileDescriptorSet of an unregistered file succeeded")
+	}
+}
@@ -4,4 +4,5 @@ require (
 	golang.org/x/net v0.0.0-20180906233101-161cd47e91fd // indirect
 	golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f
 	google.golang.org/genproto v0.0.0-20180831171423-11092d34479b
+	gopkg.in/yaml.v3 v3.0.1
 )
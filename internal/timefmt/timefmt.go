@@ -0,0 +1,156 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package timefmt formats and parses the proto3 JSON string forms of
+// google.protobuf.Timestamp and Duration. It works on their seconds and
+// nanos, so that both the proto package and ptypes, which imports proto,
+// can share it.
+package timefmt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Seconds field of the earliest valid Timestamp.
+	// This is time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC).Unix().
+	MinTimestampSeconds = -62135596800
+	// Seconds field just after the latest valid Timestamp.
+	// This is time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC).Unix().
+	MaxTimestampSeconds = 253402300800
+
+	// Range of a Duration in seconds, as specified in
+	// google/protobuf/duration.proto. This is about 10,000 years in seconds.
+	MaxDurationSeconds = int64(10000 * 365.25 * 24 * 60 * 60)
+	MinDurationSeconds = -MaxDurationSeconds
+)
+
+// ValidTimestamp reports whether secs and nanos form a valid Timestamp,
+// one in the range [0001-01-01, 10000-01-01) with nanos in [0, 1e9).
+func ValidTimestamp(secs int64, nanos int32) bool {
+	return secs >= MinTimestampSeconds && secs < MaxTimestampSeconds && nanos >= 0 && nanos < 1e9
+}
+
+// ValidDuration reports whether secs and nanos form a valid Duration:
+// both within range, and of the same sign unless nanos is zero.
+func ValidDuration(secs int64, nanos int32) bool {
+	return secs >= MinDurationSeconds && secs <= MaxDurationSeconds &&
+		nanos > -1e9 && nanos < 1e9 &&
+		!(secs < 0 && nanos > 0) && !(secs > 0 && nanos < 0)
+}
+
+// FormatTimestamp returns the valid Timestamp secs, nanos as an RFC 3339
+// string in UTC with 0, 3, 6 or 9 fractional digits.
+func FormatTimestamp(secs int64, nanos int32) string {
+	x := time.Unix(secs, int64(nanos)).UTC().Format("2006-01-02T15:04:05.000000000")
+	return trimNanos(x) + "Z"
+}
+
+// FormatDuration returns the valid Duration secs, nanos as a number of
+// seconds with 0, 3, 6 or 9 fractional digits followed by "s".
+func FormatDuration(secs int64, nanos int32) string {
+	sign := ""
+	if secs < 0 || nanos < 0 {
+		sign, secs, nanos = "-", -secs, -nanos
+	}
+	return trimNanos(fmt.Sprintf("%s%d.%09d", sign, secs, nanos)) + "s"
+}
+
+// trimNanos removes trailing groups of three zeros from the nine
+// fractional digits of x, and the decimal point if none remain.
+func trimNanos(x string) string {
+	x = strings.TrimSuffix(x, "000")
+	x = strings.TrimSuffix(x, "000")
+	return strings.TrimSuffix(x, ".000")
+}
+
+// ParseTimestamp parses an RFC 3339 string with any UTC offset and up to
+// nine fractional digits. It returns an error if the time is out of the
+// range of a Timestamp.
+func ParseTimestamp(s string) (secs int64, nanos int32, err error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return 0, 0, err
+	}
+	secs, nanos = t.Unix(), int32(t.Nanosecond())
+	if !ValidTimestamp(secs, nanos) {
+		return 0, 0, fmt.Errorf("%q out of range", s)
+	}
+	return secs, nanos, nil
+}
+
+// ParseDuration parses a number of seconds with up to nine fractional
+// digits followed by "s", such as "1.5s" or "-0.000000001s".
+func ParseDuration(s string) (secs int64, nanos int32, err error) {
+	if !strings.HasSuffix(s, "s") {
+		return 0, 0, fmt.Errorf("%q does not end in \"s\"", s)
+	}
+	x := s[:len(s)-1]
+	neg := strings.HasPrefix(x, "-")
+	if neg {
+		x = x[1:]
+	}
+	whole, frac := x, ""
+	if i := strings.IndexByte(x, '.'); i >= 0 {
+		whole, frac = x[:i], x[i+1:]
+		if frac == "" {
+			return 0, 0, fmt.Errorf("invalid %q", s)
+		}
+	}
+	if whole == "" || !isDigits(whole) || !isDigits(frac) || len(frac) > 9 {
+		return 0, 0, fmt.Errorf("invalid %q", s)
+	}
+	secs, err = strconv.ParseInt(whole, 10, 64)
+	if err != nil || secs > MaxDurationSeconds {
+		return 0, 0, fmt.Errorf("%q out of range", s)
+	}
+	if frac != "" {
+		n, _ := strconv.ParseInt(frac+strings.Repeat("0", 9-len(frac)), 10, 32)
+		nanos = int32(n)
+	}
+	if neg {
+		secs, nanos = -secs, -nanos
+	}
+	return secs, nanos, nil
+}
+
+// isDigits reports whether s consists of ASCII digits only.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -40,6 +40,7 @@ package jsonpb
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -49,10 +50,14 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/golang/protobuf/descriptor"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
 	stpb "github.com/golang/protobuf/ptypes/struct"
 )
 
@@ -67,6 +72,15 @@ type Marshaler struct {
 	// Whether to render fields with zero values.
 	EmitDefaults bool
 
+	// A custom policy for choosing which fields with zero values to render.
+	// If set, it takes precedence over EmitDefaults.
+	DefaultsPolicy DefaultsPolicy
+
+	// Whether unset proto2 scalar fields that are rendered, as chosen by
+	// EmitDefaults or DefaultsPolicy, use their declared default value
+	// (e.g. [default = 5]) or the zero value of their type instead of null.
+	Proto2Defaults bool
+
 	// A string to indent each level by. The presence of this field will
 	// also cause a space to appear between the field separator and
 	// value, and for newlines to be appear between fields and array
@@ -76,6 +90,10 @@ type Marshaler struct {
 	// Whether to use the original (.proto) name for fields.
 	OrigName bool
 
+	// A custom strategy for naming fields in the JSON output.
+	// If set, it takes precedence over OrigName.
+	FieldNamer FieldNamer
+
 	// A custom URL resolver to use when marshaling Any messages to JSON.
 	// If unset, the default resolution strategy is to extract the
 	// fully-qualified type name from the type URL and pass that to
@@ -85,9 +103,10 @@ type Marshaler struct {
 
 // AnyResolver takes a type URL, present in an Any message, and resolves it into
 // an instance of the associated message.
-type AnyResolver interface {
-	Resolve(typeUrl string) (proto.Message, error)
-}
+//
+// It is the same interface as ptypes.Resolver, so a ptypes.Registry or any
+// other Resolver can be used for both the binary and JSON forms of Any.
+type AnyResolver = ptypes.Resolver
 
 func defaultResolveAny(typeUrl string) (proto.Message, error) {
 	// Only the part of typeUrl after the last slash is relevant.
@@ -102,6 +121,214 @@ func defaultResolveAny(typeUrl string) (proto.Message, error) {
 	return reflect.New(mt.Elem()).Interface().(proto.Message), nil
 }
 
+// resolveAny resolves typeUrl with r, or with the default resolution
+// strategy if r is nil.
+func resolveAny(r AnyResolver, typeUrl string) (proto.Message, error) {
+	if r != nil {
+		return r.Resolve(typeUrl)
+	}
+	return defaultResolveAny(typeUrl)
+}
+
+// FieldNamer chooses the JSON object key used for a message field.
+//
+// Extension fields are not subject to a FieldNamer; they are always
+// named by their fully-qualified name in brackets, e.g. "[pkg.ext]".
+type FieldNamer interface {
+	// JSONFieldName returns the JSON key for the field described by prop,
+	// which belongs to the message with the fully-qualified name msgName.
+	// The JSONName of prop is the json_name from the field descriptor,
+	// or empty if it is the same as OrigName.
+	JSONFieldName(msgName string, prop *proto.Properties) string
+}
+
+// FieldNamerFunc is an adapter to allow the use of an ordinary function
+// as a FieldNamer.
+type FieldNamerFunc func(msgName string, prop *proto.Properties) string
+
+// JSONFieldName calls f(msgName, prop).
+func (f FieldNamerFunc) JSONFieldName(msgName string, prop *proto.Properties) string {
+	return f(msgName, prop)
+}
+
+var (
+	// JSONFieldNames names fields by their json_name, which protoc
+	// defaults to the lowerCamelCase form of the original name.
+	// This is the default behavior of Marshaler.
+	JSONFieldNames FieldNamer = FieldNamerFunc(jsonFieldName)
+
+	// OrigFieldNames names fields by their original (.proto) name.
+	OrigFieldNames FieldNamer = FieldNamerFunc(origFieldName)
+
+	// SnakeCaseFieldNames names fields by the snake_case form of their
+	// original (.proto) name.
+	SnakeCaseFieldNames FieldNamer = FieldNamerFunc(snakeCaseFieldName)
+)
+
+func jsonFieldName(_ string, prop *proto.Properties) string {
+	if prop.JSONName != "" {
+		return prop.JSONName
+	}
+	return prop.OrigName
+}
+
+func origFieldName(_ string, prop *proto.Properties) string {
+	return prop.OrigName
+}
+
+func snakeCaseFieldName(_ string, prop *proto.Properties) string {
+	return snakeCase(prop.OrigName)
+}
+
+// snakeCase converts a lowerCamelCase or CamelCase identifier to snake_case.
+// Identifiers that are already in snake_case are returned unchanged.
+func snakeCase(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' {
+			if i > 0 && s[i-1] != '_' {
+				prev := s[i-1]
+				prevUpper := 'A' <= prev && prev <= 'Z'
+				nextLower := i+1 < len(s) && 'a' <= s[i+1] && s[i+1] <= 'z'
+				// Break before "Bar" in "fooBar" and "HTTPServer",
+				// but keep runs of capitals such as "ID" together.
+				if !prevUpper || nextLower {
+					b = append(b, '_')
+				}
+			}
+			c += 'a' - 'A'
+		}
+		b = append(b, c)
+	}
+	return string(b)
+}
+
+// OverrideFieldNames returns a FieldNamer that uses the names in overrides,
+// which is keyed by fully-qualified field name (e.g. "pkg.Message.field_name"),
+// and defers to fallback for all other fields.
+// If fallback is nil, JSONFieldNames is used.
+func OverrideFieldNames(overrides map[string]string, fallback FieldNamer) FieldNamer {
+	if fallback == nil {
+		fallback = JSONFieldNames
+	}
+	return FieldNamerFunc(func(msgName string, prop *proto.Properties) string {
+		if name, ok := overrides[msgName+"."+prop.OrigName]; ok {
+			return name
+		}
+		return fallback.JSONFieldName(msgName, prop)
+	})
+}
+
+// DefaultsPolicy decides whether a field that is unset or holds the zero
+// value of its type is rendered by a Marshaler.
+type DefaultsPolicy interface {
+	// EmitDefault reports whether to render the field described by prop,
+	// which belongs to the message with the fully-qualified name msgName.
+	EmitDefault(msgName string, prop *proto.Properties) bool
+}
+
+// DefaultsPolicyFunc is an adapter to allow the use of an ordinary function
+// as a DefaultsPolicy.
+type DefaultsPolicyFunc func(msgName string, prop *proto.Properties) bool
+
+// EmitDefault calls f(msgName, prop).
+func (f DefaultsPolicyFunc) EmitDefault(msgName string, prop *proto.Properties) bool {
+	return f(msgName, prop)
+}
+
+var (
+	// EmitAllDefaults renders every field; it is equivalent to EmitDefaults.
+	EmitAllDefaults DefaultsPolicy = DefaultsPolicyFunc(func(string, *proto.Properties) bool {
+		return true
+	})
+
+	// EmitCollectionDefaults renders empty repeated and map fields,
+	// but omits singular fields with zero values.
+	EmitCollectionDefaults DefaultsPolicy = DefaultsPolicyFunc(func(_ string, prop *proto.Properties) bool {
+		return prop.Repeated || prop.MapKeyProp != nil
+	})
+)
+
+// EmitFieldDefaults returns a DefaultsPolicy that renders only the listed
+// fields, given by fully-qualified name (e.g. "pkg.Message.field_name").
+func EmitFieldDefaults(names ...string) DefaultsPolicy {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return DefaultsPolicyFunc(func(msgName string, prop *proto.Properties) bool {
+		return set[msgName+"."+prop.OrigName]
+	})
+}
+
+// EmitDefaultsWhere returns a DefaultsPolicy that renders the fields whose
+// descriptor satisfies f. Descriptors are looked up through the message
+// type registered under the message name; fields of unregistered messages
+// are never rendered. The result of f is cached for each field.
+func EmitDefaultsWhere(f func(*descpb.FieldDescriptorProto) bool) DefaultsPolicy {
+	var (
+		mu    sync.Mutex
+		cache = make(map[string]bool)
+	)
+	return DefaultsPolicyFunc(func(msgName string, prop *proto.Properties) bool {
+		key := msgName + "." + prop.OrigName
+		mu.Lock()
+		emit, ok := cache[key]
+		mu.Unlock()
+		if ok {
+			return emit
+		}
+		if fd := fieldDescriptor(msgName, int32(prop.Tag)); fd != nil {
+			emit = f(fd)
+		}
+		mu.Lock()
+		cache[key] = emit
+		mu.Unlock()
+		return emit
+	})
+}
+
+// EmitDefaultsForOption returns a DefaultsPolicy that renders the fields
+// whose options set the boolean extension ext to true, e.g.
+//
+//	extend google.protobuf.FieldOptions {
+//	  optional bool emit_default = 50000;
+//	}
+func EmitDefaultsForOption(ext *proto.ExtensionDesc) DefaultsPolicy {
+	return EmitDefaultsWhere(func(fd *descpb.FieldDescriptorProto) bool {
+		if fd.Options == nil || !proto.HasExtension(fd.Options, ext) {
+			return false
+		}
+		v, err := proto.GetExtension(fd.Options, ext)
+		if err != nil {
+			return false
+		}
+		b, ok := v.(*bool)
+		return ok && b != nil && *b
+	})
+}
+
+// fieldDescriptor returns the descriptor of the field numbered tag in the
+// registered message named msgName, or nil if there is none.
+func fieldDescriptor(msgName string, tag int32) *descpb.FieldDescriptorProto {
+	mt := proto.MessageType(msgName)
+	if mt == nil {
+		return nil
+	}
+	msg, ok := reflect.Zero(mt).Interface().(descriptor.Message)
+	if !ok {
+		return nil
+	}
+	_, md := descriptor.ForMessage(msg)
+	for _, fd := range md.Field {
+		if fd.GetNumber() == tag {
+			return fd
+		}
+	}
+	return nil
+}
+
 // JSONPBMarshaler is implemented by protobuf messages that customize the
 // way they are marshaled to JSON. Messages that implement this should
 // also implement JSONPBUnmarshaler so that the custom format can be
@@ -194,70 +421,24 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 	s := reflect.ValueOf(v).Elem()
 
 	// Handle well-known types.
-	if wkt, ok := v.(wkt); ok {
-		switch wkt.XXX_WellKnownType() {
-		case "DoubleValue", "FloatValue", "Int64Value", "UInt64Value",
-			"Int32Value", "UInt32Value", "BoolValue", "StringValue", "BytesValue":
-			// "Wrappers use the same representation in JSON
-			//  as the wrapped primitive type, ..."
-			sprop := proto.GetProperties(s.Type())
-			return m.marshalValue(out, sprop.Prop[0], s.Field(0), indent)
-		case "Any":
-			// Any is a bit more involved.
-			return m.marshalAny(out, v, indent)
-		case "Duration":
-			// "Generated output always contains 0, 3, 6, or 9 fractional digits,
-			//  depending on required precision."
-			s, ns := s.Field(0).Int(), s.Field(1).Int()
-			if ns <= -secondInNanos || ns >= secondInNanos {
-				return fmt.Errorf("ns out of range (%v, %v)", -secondInNanos, secondInNanos)
-			}
-			if (s > 0 && ns < 0) || (s < 0 && ns > 0) {
-				return errors.New("signs of seconds and nanos do not match")
-			}
-			if s < 0 {
-				ns = -ns
-			}
-			x := fmt.Sprintf("%d.%09d", s, ns)
-			x = strings.TrimSuffix(x, "000")
-			x = strings.TrimSuffix(x, "000")
-			x = strings.TrimSuffix(x, ".000")
-			out.write(`"`)
-			out.write(x)
-			out.write(`s"`)
-			return out.err
-		case "Struct", "ListValue":
-			// Let marshalValue handle the `Struct.fields` map or the `ListValue.values` slice.
-			// TODO: pass the correct Properties if needed.
-			return m.marshalValue(out, &proto.Properties{}, s.Field(0), indent)
-		case "Timestamp":
-			// "RFC 3339, where generated output will always be Z-normalized
-			//  and uses 0, 3, 6 or 9 fractional digits."
-			s, ns := s.Field(0).Int(), s.Field(1).Int()
-			if ns < 0 || ns >= secondInNanos {
-				return fmt.Errorf("ns out of range [0, %v)", secondInNanos)
+	if name := wellKnownType(v); name == "Any" {
+		// Any is a bit more involved.
+		return m.marshalAny(out, v, indent)
+	} else if name != "" {
+		form, err := wellKnownValue(s, name)
+		if err != nil {
+			return err
+		}
+		switch form := form.(type) {
+		case messageField:
+			return m.marshalValue(out, form.prop, form.value, indent)
+		case string:
+			b, err := json.Marshal(form)
+			if err != nil {
+				return err
 			}
-			t := time.Unix(s, ns).UTC()
-			// time.RFC3339Nano isn't exactly right (we need to get 3/6/9 fractional digits).
-			x := t.Format("2006-01-02T15:04:05.000000000")
-			x = strings.TrimSuffix(x, "000")
-			x = strings.TrimSuffix(x, "000")
-			x = strings.TrimSuffix(x, ".000")
-			out.write(`"`)
-			out.write(x)
-			out.write(`Z"`)
+			out.write(string(b))
 			return out.err
-		case "Value":
-			// Value has a single oneof.
-			kind := s.Field(0)
-			if kind.IsNil() {
-				// "absence of any variant indicates an error"
-				return errors.New("nil Value")
-			}
-			// oneof -> *T -> T -> T.F
-			x := kind.Elem().Elem().Field(0)
-			// TODO: pass the correct Properties if needed.
-			return m.marshalValue(out, &proto.Properties{}, x, indent)
 		}
 	}
 
@@ -275,6 +456,41 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 		firstField = false
 	}
 
+	fields, err := m.messageFields(v)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		if !firstField {
+			m.writeSep(out)
+		}
+		if err := m.marshalField(out, f.prop, f.value, indent); err != nil {
+			return err
+		}
+		firstField = false
+	}
+
+	if m.Indent != "" {
+		out.write("\n")
+		out.write(indent)
+	}
+	out.write("}")
+	return out.err
+}
+
+// messageField is a field of a message to be rendered.
+type messageField struct {
+	prop  *proto.Properties // JSONName is the key to render the field under
+	value reflect.Value
+}
+
+// messageFields returns the fields of the message struct v to be rendered,
+// in order, followed by its extensions.
+func (m *Marshaler) messageFields(v proto.Message) ([]messageField, error) {
+	s := reflect.ValueOf(v).Elem()
+	msgName := proto.MessageName(v)
+
+	var fields []messageField
 	for i := 0; i < s.NumField(); i++ {
 		value := s.Field(i)
 		valueField := s.Type().Field(i)
@@ -290,95 +506,462 @@ func (m *Marshaler) marshalObject(out *errWriter, v proto.Message, indent, typeU
 			}
 		}
 
-		if !m.EmitDefaults {
-			switch value.Kind() {
-			case reflect.Bool:
-				if !value.Bool() {
-					continue
-				}
-			case reflect.Int32, reflect.Int64:
-				if value.Int() == 0 {
-					continue
-				}
-			case reflect.Uint32, reflect.Uint64:
-				if value.Uint() == 0 {
-					continue
-				}
-			case reflect.Float32, reflect.Float64:
-				if value.Float() == 0 {
-					continue
-				}
-			case reflect.String:
-				if value.Len() == 0 {
-					continue
-				}
-			case reflect.Map, reflect.Ptr, reflect.Slice:
-				if value.IsNil() {
-					continue
-				}
-			}
-		}
-
 		// Oneof fields need special handling.
 		if valueField.Tag.Get("protobuf_oneof") != "" {
 			// value is an interface containing &T{real_value}.
 			sv := value.Elem().Elem() // interface -> *T -> T
 			value = sv.Field(0)
 			valueField = sv.Type().Field(0)
+		} else if isZeroValue(value) {
+			if !m.emitDefault(msgName, valueField) {
+				continue
+			}
+			if m.Proto2Defaults {
+				value = proto2Default(s, i)
+			}
 		}
-		prop := jsonProperties(valueField, m.OrigName)
-		if !firstField {
-			m.writeSep(out)
+		fields = append(fields, messageField{m.jsonProperties(msgName, valueField), value})
+	}
+
+	// Handle proto2 extensions.
+	extensions := proto.RegisteredExtensions(v)
+	// Sort extensions for stable output.
+	ids := make([]int32, 0, len(extensions))
+	for id, desc := range extensions {
+		if !proto.HasExtension(v, desc) {
+			continue
 		}
-		if err := m.marshalField(out, prop, value, indent); err != nil {
-			return err
+		ids = append(ids, id)
+	}
+	sort.Sort(int32Slice(ids))
+	for _, id := range ids {
+		desc := extensions[id]
+		if desc == nil {
+			// unknown extension
+			continue
 		}
-		firstField = false
+		ext, err := proto.GetExtension(v, desc)
+		if err != nil {
+			return nil, err
+		}
+		prop := new(proto.Properties)
+		prop.Parse(desc.Tag)
+		prop.JSONName = extensionName(desc.Name)
+		fields = append(fields, messageField{prop, reflect.ValueOf(ext)})
+	}
+	return fields, nil
+}
+
+// isZeroValue reports whether v, the value of a message field, is unset
+// or holds the zero value of its type.
+func isZeroValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint32, reflect.Uint64:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.String:
+		return v.Len() == 0
+	case reflect.Map, reflect.Ptr, reflect.Slice:
+		return v.IsNil()
 	}
+	return false
+}
 
-	// Handle proto2 extensions.
-	if ep, ok := v.(proto.Message); ok {
-		extensions := proto.RegisteredExtensions(v)
-		// Sort extensions for stable output.
-		ids := make([]int32, 0, len(extensions))
-		for id, desc := range extensions {
-			if !proto.HasExtension(ep, desc) {
-				continue
+// emitDefault reports whether the field f of the message named msgName
+// should be rendered even though it holds its zero value.
+func (m *Marshaler) emitDefault(msgName string, f reflect.StructField) bool {
+	if m.DefaultsPolicy == nil {
+		return m.EmitDefaults
+	}
+	var prop proto.Properties
+	prop.Init(f.Type, f.Name, f.Tag.Get("protobuf"), &f)
+	return m.DefaultsPolicy.EmitDefault(msgName, &prop)
+}
+
+// proto2Default returns the value to render for the unset field i of the
+// message struct s. Scalar and bytes fields yield their declared default
+// if there is one, or else the zero value of their type; other fields
+// are returned unchanged.
+func proto2Default(s reflect.Value, i int) reflect.Value {
+	v := s.Field(i)
+	t := v.Type()
+	isScalar := t.Kind() == reflect.Ptr && t.Elem().Kind() != reflect.Struct
+	isBytes := t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+	if !isScalar && !isBytes {
+		return v
+	}
+	if d := defaultsOf(s.Type()).Field(i); !d.IsNil() {
+		return d
+	}
+	if isBytes {
+		return reflect.ValueOf([]byte{})
+	}
+	return reflect.Zero(t.Elem())
+}
+
+var (
+	defaultsMu sync.Mutex
+	defaults   = make(map[reflect.Type]reflect.Value)
+)
+
+// defaultsOf returns a message struct of type t with its declared defaults
+// set. It is computed once per type and shared, so it must not be modified.
+func defaultsOf(t reflect.Type) reflect.Value {
+	defaultsMu.Lock()
+	defer defaultsMu.Unlock()
+	d, ok := defaults[t]
+	if !ok {
+		dm := reflect.New(t)
+		proto.SetDefaults(dm.Interface().(proto.Message))
+		d = dm.Elem()
+		defaults[t] = d
+	}
+	return d
+}
+
+// formatDuration returns the JSON string form of a Duration.
+func formatDuration(s, ns int64) (string, error) {
+	// "Generated output always contains 0, 3, 6, or 9 fractional digits,
+	//  depending on required precision."
+	if ns <= -secondInNanos || ns >= secondInNanos {
+		return "", fmt.Errorf("ns out of range (%v, %v)", -secondInNanos, secondInNanos)
+	}
+	if (s > 0 && ns < 0) || (s < 0 && ns > 0) {
+		return "", errors.New("signs of seconds and nanos do not match")
+	}
+	if s < 0 {
+		ns = -ns
+	}
+	x := fmt.Sprintf("%d.%09d", s, ns)
+	x = strings.TrimSuffix(x, "000")
+	x = strings.TrimSuffix(x, "000")
+	x = strings.TrimSuffix(x, ".000")
+	return x + "s", nil
+}
+
+// formatTimestamp returns the JSON string form of a Timestamp.
+func formatTimestamp(s, ns int64) (string, error) {
+	// "RFC 3339, where generated output will always be Z-normalized
+	//  and uses 0, 3, 6 or 9 fractional digits."
+	if ns < 0 || ns >= secondInNanos {
+		return "", fmt.Errorf("ns out of range [0, %v)", secondInNanos)
+	}
+	t := time.Unix(s, ns).UTC()
+	// time.RFC3339Nano isn't exactly right (we need to get 3/6/9 fractional digits).
+	x := t.Format("2006-01-02T15:04:05.000000000")
+	x = strings.TrimSuffix(x, "000")
+	x = strings.TrimSuffix(x, "000")
+	x = strings.TrimSuffix(x, ".000")
+	return x + "Z", nil
+}
+
+// isWellKnown reports whether m is a well-known type with a special JSON
+// form, which an Any holds under "value".
+func isWellKnown(m proto.Message) bool {
+	return wellKnownType(m) != ""
+}
+
+// wellKnownType returns the name of the well-known type v is, or "" if it
+// is none. Both Marshal and Unmarshal dispatch on it, as do their
+// interface counterparts.
+func wellKnownType(v interface{}) string {
+	if w, ok := v.(wkt); ok {
+		return w.XXX_WellKnownType()
+	}
+	if isFieldMask(v) {
+		return "FieldMask"
+	}
+	return ""
+}
+
+// wrapperTypes is the set of well-known wrapper types, which use the same
+// representation in JSON as the primitive type they wrap.
+var wrapperTypes = map[string]bool{
+	"DoubleValue": true,
+	"FloatValue":  true,
+	"Int64Value":  true,
+	"UInt64Value": true,
+	"Int32Value":  true,
+	"UInt32Value": true,
+	"BoolValue":   true,
+	"StringValue": true,
+	"BytesValue":  true,
+}
+
+// wellKnownValue returns the JSON form of s, the struct of a well-known
+// type called name: either a string, or the field whose value stands for
+// the whole message. It returns nil for Any and for types without a
+// special form.
+func wellKnownValue(s reflect.Value, name string) (interface{}, error) {
+	switch {
+	case wrapperTypes[name]:
+		// "Wrappers use the same representation in JSON
+		//  as the wrapped primitive type, ..."
+		sprop := proto.GetProperties(s.Type())
+		return messageField{sprop.Prop[0], s.Field(0)}, nil
+	case name == "Struct" || name == "ListValue":
+		// The `Struct.fields` map or the `ListValue.values` slice.
+		// TODO: pass the correct Properties if needed.
+		return messageField{&proto.Properties{}, s.Field(0)}, nil
+	case name == "Value":
+		// Value has a single oneof.
+		kind := s.Field(0)
+		if kind.IsNil() {
+			// "absence of any variant indicates an error"
+			return nil, errors.New("nil Value")
+		}
+		// oneof -> *T -> T -> T.F
+		return messageField{&proto.Properties{}, kind.Elem().Elem().Field(0)}, nil
+	case name == "Duration":
+		return formatDuration(s.Field(0).Int(), s.Field(1).Int())
+	case name == "Timestamp":
+		return formatTimestamp(s.Field(0).Int(), s.Field(1).Int())
+	case name == "FieldMask":
+		return formatFieldMask(s.Field(0).Interface().([]string))
+	}
+	return nil, nil
+}
+
+// unpackAny returns the type URL of any and the message it holds.
+func unpackAny(r AnyResolver, any proto.Message) (string, proto.Message, error) {
+	v := reflect.ValueOf(any).Elem()
+	turl := v.Field(0).String()
+	msg, err := resolveAny(r, turl)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := proto.Unmarshal(v.Field(1).Bytes(), msg); err != nil {
+		return "", nil, err
+	}
+	return turl, msg, nil
+}
+
+// packAny stores m as the value of target, an Any struct whose type URL
+// is already set.
+func packAny(target reflect.Value, m proto.Message) error {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("can't marshal proto %T into Any.Value: %v", m, err)
+	}
+	target.Field(1).SetBytes(b)
+	return nil
+}
+
+// scalarValue returns the JSON form of v, a field value other than a
+// message, list or map: nil for NullValue; the name of a known enum value
+// or the json.Number of an unknown one; a string for 64-bit integers,
+// bytes and non-finite floats; a json.Number for other numbers; or a
+// bool or string.
+func (m *Marshaler) scalarValue(prop *proto.Properties, v reflect.Value) (interface{}, error) {
+	if wellKnownType(v.Interface()) == "NullValue" {
+		return nil, nil
+	}
+
+	// Handle enumerations.
+	if !m.EnumsAsInts && prop.Enum != "" {
+		// Unknown enum values are stringified by the proto library as their
+		// value. Such values should _not_ be quoted or they will be
+		// interpreted as an enum string instead of their value.
+		enumStr := v.Interface().(fmt.Stringer).String()
+		valStr := strconv.Itoa(int(v.Int()))
+		if enumStr == valStr {
+			return json.Number(valStr), nil
+		}
+		return enumStr, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		switch {
+		case math.IsInf(f, 1):
+			return "Infinity", nil
+		case math.IsInf(f, -1):
+			return "-Infinity", nil
+		case math.IsNaN(f):
+			return "NaN", nil
+		}
+		// Format the number the same way as encoding/json.
+		b, err := json.Marshal(v.Interface())
+		if err != nil {
+			return nil, err
+		}
+		return json.Number(b), nil
+	case reflect.Int32:
+		return json.Number(strconv.FormatInt(v.Int(), 10)), nil
+	case reflect.Uint32:
+		return json.Number(strconv.FormatUint(v.Uint(), 10)), nil
+	case reflect.Int64:
+		// 64-bit integers are written as strings.
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Slice:
+		return base64.StdEncoding.EncodeToString(v.Bytes()), nil
+	}
+	return nil, fmt.Errorf("unsupported value of type %v", v.Type())
+}
+
+// mapKeyString returns the JSON object key for the map key k.
+func mapKeyString(k reflect.Value) string {
+	return fmt.Sprint(k.Interface())
+}
+
+// enumNumber returns the number of the value called name of the enum
+// described by prop.
+func enumNumber(prop *proto.Properties, name string) (int32, error) {
+	n, ok := proto.EnumValueMap(prop.Enum)[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown value %q for enum %s", name, prop.Enum)
+	}
+	return n, nil
+}
+
+// extensionName returns the JSON name of the extension called name.
+func extensionName(name string) string {
+	return "[" + name + "]"
+}
+
+// A fieldTarget is where Unmarshal stores the value found under one key of
+// an object.
+type fieldTarget struct {
+	key   string
+	prop  *proto.Properties
+	value reflect.Value
+	ext   *proto.ExtensionDesc // set when value holds a new extension value
+}
+
+// fieldTargets matches keys, the keys of an object, against the fields of
+// the message struct target: its regular fields, under any of the names
+// accepted for them, the members of its oneofs, whose wrappers it
+// allocates, and its registered extensions. It removes the matched keys;
+// any left over are unknown fields.
+func (u *Unmarshaler) fieldTargets(target reflect.Value, keys map[string]bool) []fieldTarget {
+	var msgName string
+	if u.FieldNamer != nil {
+		if pm, ok := target.Addr().Interface().(proto.Message); ok {
+			msgName = proto.MessageName(pm)
+		}
+	}
+
+	consumeField := func(prop *proto.Properties) (string, bool) {
+		// Be liberal in what names we accept; both orig_name and camelName are okay,
+		// as is the name chosen by a custom FieldNamer.
+		var key string
+		found := false
+		// If, for some reason, several are present in the data, favour the last.
+		for _, name := range u.acceptedFieldNames(msgName, prop) {
+			if keys[name] {
+				key = name
+				found = true
+				delete(keys, name)
 			}
-			ids = append(ids, id)
 		}
-		sort.Sort(int32Slice(ids))
-		for _, id := range ids {
-			desc := extensions[id]
-			if desc == nil {
-				// unknown extension
+		return key, found
+	}
+
+	var targets []fieldTarget
+	targetType := target.Type()
+	sprops := proto.GetProperties(targetType)
+	for i := 0; i < target.NumField(); i++ {
+		if strings.HasPrefix(targetType.Field(i).Name, "XXX_") {
+			continue
+		}
+		if key, ok := consumeField(sprops.Prop[i]); ok {
+			targets = append(targets, fieldTarget{key: key, prop: sprops.Prop[i], value: target.Field(i)})
+		}
+	}
+	// Check for any oneof fields.
+	if len(keys) > 0 {
+		for _, oop := range sprops.OneofTypes {
+			key, ok := consumeField(oop.Prop)
+			if !ok {
 				continue
 			}
-			ext, extErr := proto.GetExtension(ep, desc)
-			if extErr != nil {
-				return extErr
-			}
-			value := reflect.ValueOf(ext)
-			var prop proto.Properties
-			prop.Parse(desc.Tag)
-			prop.JSONName = fmt.Sprintf("[%s]", desc.Name)
-			if !firstField {
-				m.writeSep(out)
-			}
-			if err := m.marshalField(out, &prop, value, indent); err != nil {
-				return err
+			nv := reflect.New(oop.Type.Elem())
+			target.Field(oop.Field).Set(nv)
+			targets = append(targets, fieldTarget{key: key, prop: oop.Prop, value: nv.Elem().Field(0)})
+		}
+	}
+	// Handle proto2 extensions.
+	if len(keys) > 0 {
+		if ep, ok := target.Addr().Interface().(proto.Message); ok {
+			for _, ext := range proto.RegisteredExtensions(ep) {
+				name := extensionName(ext.Name)
+				if !keys[name] {
+					continue
+				}
+				delete(keys, name)
+				nv := reflect.New(reflect.TypeOf(ext.ExtensionType).Elem())
+				targets = append(targets, fieldTarget{key: name, value: nv.Elem(), ext: ext})
 			}
-			firstField = false
 		}
+	}
+	return targets
+}
+
+// setExtension sets the extension value t was unmarshaled into on the
+// message struct target. It does nothing if t is not an extension.
+func (t fieldTarget) setExtension(target reflect.Value) error {
+	if t.ext == nil {
+		return nil
+	}
+	return proto.SetExtension(target.Addr().Interface().(proto.Message), t.ext, t.value.Addr().Interface())
+}
 
+// anyKey returns any one of keys, to report as an unknown field.
+func anyKey(keys map[string]bool) string {
+	for k := range keys {
+		return k
 	}
+	return ""
+}
 
-	if m.Indent != "" {
-		out.write("\n")
-		out.write(indent)
+// isFieldMask reports whether v is a google.protobuf.FieldMask. Its Go
+// type lives in genproto, which does not generate XXX_WellKnownType, so
+// it is recognized by its message name instead.
+func isFieldMask(v interface{}) bool {
+	m, ok := v.(proto.Message)
+	return ok && proto.MessageName(m) == "google.protobuf.FieldMask"
+}
+
+// formatFieldMask returns the JSON string form of a FieldMask: its paths
+// converted to lowerCamelCase and joined by commas.
+func formatFieldMask(paths []string) (string, error) {
+	camel := make([]string, len(paths))
+	for i, p := range paths {
+		// "Field names ... that cannot be round-tripped are rejected."
+		for j := 0; j < len(p); j++ {
+			if c := p[j]; c >= 'A' && c <= 'Z' || c == '_' && (j+1 == len(p) || p[j+1] < 'a' || p[j+1] > 'z') {
+				return "", fmt.Errorf("bad FieldMask path %q", p)
+			}
+		}
+		camel[i] = camelCase(p)
 	}
-	out.write("}")
-	return out.err
+	return strings.Join(camel, ","), nil
+}
+
+// camelCase converts a snake_case name to lowerCamelCase.
+func camelCase(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		if s[i] == '_' && i+1 < len(s) && s[i+1] >= 'a' && s[i+1] <= 'z' {
+			b = append(b, s[i+1]-'a'+'A')
+			i++
+			continue
+		}
+		b = append(b, s[i])
+	}
+	return string(b)
 }
 
 func (m *Marshaler) writeSep(out *errWriter) {
@@ -394,26 +977,12 @@ func (m *Marshaler) marshalAny(out *errWriter, any proto.Message, indent string)
 	//  it will be converted as follows: {"@type": xxx, "value": yyy}.
 	//  Otherwise, the value will be converted into a JSON object,
 	//  and the "@type" field will be inserted to indicate the actual data type."
-	v := reflect.ValueOf(any).Elem()
-	turl := v.Field(0).String()
-	val := v.Field(1).Bytes()
-
-	var msg proto.Message
-	var err error
-	if m.AnyResolver != nil {
-		msg, err = m.AnyResolver.Resolve(turl)
-	} else {
-		msg, err = defaultResolveAny(turl)
-	}
+	turl, msg, err := unpackAny(m.AnyResolver, any)
 	if err != nil {
 		return err
 	}
 
-	if err := proto.Unmarshal(val, msg); err != nil {
-		return err
-	}
-
-	if _, ok := msg.(wkt); ok {
+	if isWellKnown(msg) {
 		out.write("{")
 		if m.Indent != "" {
 			out.write("\n")
@@ -516,39 +1085,6 @@ func (m *Marshaler) marshalValue(out *errWriter, prop *proto.Properties, v refle
 		return out.err
 	}
 
-	// Handle well-known types.
-	// Most are handled up in marshalObject (because 99% are messages).
-	if wkt, ok := v.Interface().(wkt); ok {
-		switch wkt.XXX_WellKnownType() {
-		case "NullValue":
-			out.write("null")
-			return out.err
-		}
-	}
-
-	// Handle enumerations.
-	if !m.EnumsAsInts && prop.Enum != "" {
-		// Unknown enum values will are stringified by the proto library as their
-		// value. Such values should _not_ be quoted or they will be interpreted
-		// as an enum string instead of their value.
-		enumStr := v.Interface().(fmt.Stringer).String()
-		var valStr string
-		if v.Kind() == reflect.Ptr {
-			valStr = strconv.Itoa(int(v.Elem().Int()))
-		} else {
-			valStr = strconv.Itoa(int(v.Int()))
-		}
-		isKnownEnum := enumStr != valStr
-		if isKnownEnum {
-			out.write(`"`)
-		}
-		out.write(enumStr)
-		if isKnownEnum {
-			out.write(`"`)
-		}
-		return out.err
-	}
-
 	// Handle nested messages.
 	if v.Kind() == reflect.Struct {
 		return m.marshalObject(out, v.Addr().Interface().(proto.Message), indent+m.Indent, "")
@@ -572,22 +1108,11 @@ func (m *Marshaler) marshalValue(out *errWriter, prop *proto.Properties, v refle
 			}
 
 			// TODO handle map key prop properly
-			b, err := json.Marshal(k.Interface())
+			b, err := json.Marshal(mapKeyString(k))
 			if err != nil {
 				return err
 			}
-			s := string(b)
-
-			// If the JSON is not a string value, encode it again to make it one.
-			if !strings.HasPrefix(s, `"`) {
-				b, err := json.Marshal(s)
-				if err != nil {
-					return err
-				}
-				s = string(b)
-			}
-
-			out.write(s)
+			out.write(string(b))
 			out.write(`:`)
 			if m.Indent != "" {
 				out.write(` `)
@@ -610,37 +1135,15 @@ func (m *Marshaler) marshalValue(out *errWriter, prop *proto.Properties, v refle
 		return out.err
 	}
 
-	// Handle non-finite floats, e.g. NaN, Infinity and -Infinity.
-	if v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
-		f := v.Float()
-		var sval string
-		switch {
-		case math.IsInf(f, 1):
-			sval = `"Infinity"`
-		case math.IsInf(f, -1):
-			sval = `"-Infinity"`
-		case math.IsNaN(f):
-			sval = `"NaN"`
-		}
-		if sval != "" {
-			out.write(sval)
-			return out.err
-		}
-	}
-
-	// Default handling defers to the encoding/json library.
-	b, err := json.Marshal(v.Interface())
+	x, err := m.scalarValue(prop, v)
 	if err != nil {
 		return err
 	}
-	needToQuote := string(b[0]) != `"` && (v.Kind() == reflect.Int64 || v.Kind() == reflect.Uint64)
-	if needToQuote {
-		out.write(`"`)
+	b, err := json.Marshal(x)
+	if err != nil {
+		return err
 	}
 	out.write(string(b))
-	if needToQuote {
-		out.write(`"`)
-	}
 	return out.err
 }
 
@@ -651,6 +1154,10 @@ type Unmarshaler struct {
 	// failing to unmarshal.
 	AllowUnknownFields bool
 
+	// A custom strategy for naming fields. If set, the names it produces
+	// are accepted in addition to the original and json_name forms.
+	FieldNamer FieldNamer
+
 	// A custom URL resolver to use when unmarshaling Any messages from JSON.
 	// If unset, the default resolution strategy is to extract the
 	// fully-qualified type name from the type URL and pass that to
@@ -724,152 +1231,123 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 	}
 
 	// Handle well-known types that are not pointers.
-	if w, ok := target.Addr().Interface().(wkt); ok {
-		switch w.XXX_WellKnownType() {
-		case "DoubleValue", "FloatValue", "Int64Value", "UInt64Value",
-			"Int32Value", "UInt32Value", "BoolValue", "StringValue", "BytesValue":
-			return u.unmarshalValue(target.Field(0), inputValue, prop)
-		case "Any":
-			// Use json.RawMessage pointer type instead of value to support pre-1.8 version.
-			// 1.8 changed RawMessage.MarshalJSON from pointer type to value type, see
-			// https://github.com/golang/go/issues/14493
-			var jsonFields map[string]*json.RawMessage
-			if err := json.Unmarshal(inputValue, &jsonFields); err != nil {
-				return err
-			}
-
-			val, ok := jsonFields["@type"]
-			if !ok || val == nil {
-				return errors.New("Any JSON doesn't have '@type'")
-			}
-
-			var turl string
-			if err := json.Unmarshal([]byte(*val), &turl); err != nil {
-				return fmt.Errorf("can't unmarshal Any's '@type': %q", *val)
-			}
-			target.Field(0).SetString(turl)
-
-			var m proto.Message
-			var err error
-			if u.AnyResolver != nil {
-				m, err = u.AnyResolver.Resolve(turl)
-			} else {
-				m, err = defaultResolveAny(turl)
-			}
-			if err != nil {
-				return err
-			}
-
-			if _, ok := m.(wkt); ok {
-				val, ok := jsonFields["value"]
-				if !ok {
-					return errors.New("Any JSON doesn't have 'value'")
-				}
-
-				if err := u.unmarshalValue(reflect.ValueOf(m).Elem(), *val, nil); err != nil {
-					return fmt.Errorf("can't unmarshal Any nested proto %T: %v", m, err)
-				}
-			} else {
-				delete(jsonFields, "@type")
-				nestedProto, err := json.Marshal(jsonFields)
-				if err != nil {
-					return fmt.Errorf("can't generate JSON for Any's nested proto to be unmarshaled: %v", err)
-				}
+	switch name := wellKnownType(target.Addr().Interface()); {
+	case wrapperTypes[name]:
+		return u.unmarshalValue(target.Field(0), inputValue, prop)
+	case name == "Any":
+		// Use json.RawMessage pointer type instead of value to support pre-1.8 version.
+		// 1.8 changed RawMessage.MarshalJSON from pointer type to value type, see
+		// https://github.com/golang/go/issues/14493
+		var jsonFields map[string]*json.RawMessage
+		if err := json.Unmarshal(inputValue, &jsonFields); err != nil {
+			return err
+		}
 
-				if err = u.unmarshalValue(reflect.ValueOf(m).Elem(), nestedProto, nil); err != nil {
-					return fmt.Errorf("can't unmarshal Any nested proto %T: %v", m, err)
-				}
-			}
+		val, ok := jsonFields["@type"]
+		if !ok || val == nil {
+			return errors.New("Any JSON doesn't have '@type'")
+		}
 
-			b, err := proto.Marshal(m)
-			if err != nil {
-				return fmt.Errorf("can't marshal proto %T into Any.Value: %v", m, err)
-			}
-			target.Field(1).SetBytes(b)
+		var turl string
+		if err := json.Unmarshal([]byte(*val), &turl); err != nil {
+			return fmt.Errorf("can't unmarshal Any's '@type': %q", *val)
+		}
+		target.Field(0).SetString(turl)
 
-			return nil
-		case "Duration":
-			unq, err := unquote(string(inputValue))
-			if err != nil {
-				return err
-			}
+		m, err := resolveAny(u.AnyResolver, turl)
+		if err != nil {
+			return err
+		}
 
-			d, err := time.ParseDuration(unq)
-			if err != nil {
-				return fmt.Errorf("bad Duration: %v", err)
+		if isWellKnown(m) {
+			val, ok := jsonFields["value"]
+			if !ok {
+				return errors.New("Any JSON doesn't have 'value'")
 			}
 
-			ns := d.Nanoseconds()
-			s := ns / 1e9
-			ns %= 1e9
-			target.Field(0).SetInt(s)
-			target.Field(1).SetInt(ns)
-			return nil
-		case "Timestamp":
-			unq, err := unquote(string(inputValue))
-			if err != nil {
-				return err
+			if err := u.unmarshalValue(reflect.ValueOf(m).Elem(), *val, nil); err != nil {
+				return fmt.Errorf("can't unmarshal Any nested proto %T: %v", m, err)
 			}
-
-			t, err := time.Parse(time.RFC3339Nano, unq)
+		} else {
+			delete(jsonFields, "@type")
+			nestedProto, err := json.Marshal(jsonFields)
 			if err != nil {
-				return fmt.Errorf("bad Timestamp: %v", err)
+				return fmt.Errorf("can't generate JSON for Any's nested proto to be unmarshaled: %v", err)
 			}
 
-			target.Field(0).SetInt(t.Unix())
-			target.Field(1).SetInt(int64(t.Nanosecond()))
-			return nil
-		case "Struct":
-			var m map[string]json.RawMessage
-			if err := json.Unmarshal(inputValue, &m); err != nil {
-				return fmt.Errorf("bad StructValue: %v", err)
+			if err = u.unmarshalValue(reflect.ValueOf(m).Elem(), nestedProto, nil); err != nil {
+				return fmt.Errorf("can't unmarshal Any nested proto %T: %v", m, err)
 			}
+		}
+		return packAny(target, m)
+	case name == "FieldMask":
+		unq, err := unquote(string(inputValue))
+		if err != nil {
+			return err
+		}
+		return setFieldMask(target, unq)
+	case name == "Duration":
+		unq, err := unquote(string(inputValue))
+		if err != nil {
+			return err
+		}
+		return setDuration(target, unq)
+	case name == "Timestamp":
+		unq, err := unquote(string(inputValue))
+		if err != nil {
+			return err
+		}
+		return setTimestamp(target, unq)
+	case name == "Struct":
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(inputValue, &m); err != nil {
+			return fmt.Errorf("bad StructValue: %v", err)
+		}
 
-			target.Field(0).Set(reflect.ValueOf(map[string]*stpb.Value{}))
-			for k, jv := range m {
-				pv := &stpb.Value{}
-				if err := u.unmarshalValue(reflect.ValueOf(pv).Elem(), jv, prop); err != nil {
-					return fmt.Errorf("bad value in StructValue for key %q: %v", k, err)
-				}
-				target.Field(0).SetMapIndex(reflect.ValueOf(k), reflect.ValueOf(pv))
-			}
-			return nil
-		case "ListValue":
-			var s []json.RawMessage
-			if err := json.Unmarshal(inputValue, &s); err != nil {
-				return fmt.Errorf("bad ListValue: %v", err)
+		target.Field(0).Set(reflect.ValueOf(map[string]*stpb.Value{}))
+		for k, jv := range m {
+			pv := &stpb.Value{}
+			if err := u.unmarshalValue(reflect.ValueOf(pv).Elem(), jv, prop); err != nil {
+				return fmt.Errorf("bad value in StructValue for key %q: %v", k, err)
 			}
+			target.Field(0).SetMapIndex(reflect.ValueOf(k), reflect.ValueOf(pv))
+		}
+		return nil
+	case name == "ListValue":
+		var s []json.RawMessage
+		if err := json.Unmarshal(inputValue, &s); err != nil {
+			return fmt.Errorf("bad ListValue: %v", err)
+		}
 
-			target.Field(0).Set(reflect.ValueOf(make([]*stpb.Value, len(s))))
-			for i, sv := range s {
-				if err := u.unmarshalValue(target.Field(0).Index(i), sv, prop); err != nil {
-					return err
-				}
-			}
-			return nil
-		case "Value":
-			ivStr := string(inputValue)
-			if ivStr == "null" {
-				target.Field(0).Set(reflect.ValueOf(&stpb.Value_NullValue{}))
-			} else if v, err := strconv.ParseFloat(ivStr, 0); err == nil {
-				target.Field(0).Set(reflect.ValueOf(&stpb.Value_NumberValue{v}))
-			} else if v, err := unquote(ivStr); err == nil {
-				target.Field(0).Set(reflect.ValueOf(&stpb.Value_StringValue{v}))
-			} else if v, err := strconv.ParseBool(ivStr); err == nil {
-				target.Field(0).Set(reflect.ValueOf(&stpb.Value_BoolValue{v}))
-			} else if err := json.Unmarshal(inputValue, &[]json.RawMessage{}); err == nil {
-				lv := &stpb.ListValue{}
-				target.Field(0).Set(reflect.ValueOf(&stpb.Value_ListValue{lv}))
-				return u.unmarshalValue(reflect.ValueOf(lv).Elem(), inputValue, prop)
-			} else if err := json.Unmarshal(inputValue, &map[string]json.RawMessage{}); err == nil {
-				sv := &stpb.Struct{}
-				target.Field(0).Set(reflect.ValueOf(&stpb.Value_StructValue{sv}))
-				return u.unmarshalValue(reflect.ValueOf(sv).Elem(), inputValue, prop)
-			} else {
-				return fmt.Errorf("unrecognized type for Value %q", ivStr)
+		target.Field(0).Set(reflect.ValueOf(make([]*stpb.Value, len(s))))
+		for i, sv := range s {
+			if err := u.unmarshalValue(target.Field(0).Index(i), sv, prop); err != nil {
+				return err
 			}
-			return nil
 		}
+		return nil
+	case name == "Value":
+		ivStr := string(inputValue)
+		if ivStr == "null" {
+			target.Field(0).Set(reflect.ValueOf(&stpb.Value_NullValue{}))
+		} else if v, err := strconv.ParseFloat(ivStr, 0); err == nil {
+			target.Field(0).Set(reflect.ValueOf(&stpb.Value_NumberValue{v}))
+		} else if v, err := unquote(ivStr); err == nil {
+			target.Field(0).Set(reflect.ValueOf(&stpb.Value_StringValue{v}))
+		} else if v, err := strconv.ParseBool(ivStr); err == nil {
+			target.Field(0).Set(reflect.ValueOf(&stpb.Value_BoolValue{v}))
+		} else if err := json.Unmarshal(inputValue, &[]json.RawMessage{}); err == nil {
+			lv := &stpb.ListValue{}
+			target.Field(0).Set(reflect.ValueOf(&stpb.Value_ListValue{lv}))
+			return u.unmarshalValue(reflect.ValueOf(lv).Elem(), inputValue, prop)
+		} else if err := json.Unmarshal(inputValue, &map[string]json.RawMessage{}); err == nil {
+			sv := &stpb.Struct{}
+			target.Field(0).Set(reflect.ValueOf(&stpb.Value_StructValue{sv}))
+			return u.unmarshalValue(reflect.ValueOf(sv).Elem(), inputValue, prop)
+		} else {
+			return fmt.Errorf("unrecognized type for Value %q", ivStr)
+		}
+		return nil
 	}
 
 	// Handle enums, which have an underlying type of int32,
@@ -877,13 +1355,11 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 	// The case of an enum appearing as a number is handled
 	// at the bottom of this function.
 	if inputValue[0] == '"' && prop != nil && prop.Enum != "" {
-		vmap := proto.EnumValueMap(prop.Enum)
 		// Don't need to do unquoting; valid enum names
 		// are from a limited character set.
-		s := inputValue[1 : len(inputValue)-1]
-		n, ok := vmap[string(s)]
-		if !ok {
-			return fmt.Errorf("unknown value %q for enum %s", s, prop.Enum)
+		n, err := enumNumber(prop, string(inputValue[1:len(inputValue)-1]))
+		if err != nil {
+			return err
 		}
 		if target.Kind() == reflect.Ptr { // proto2
 			target.Set(reflect.New(targetType.Elem()))
@@ -903,86 +1379,21 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 			return err
 		}
 
-		consumeField := func(prop *proto.Properties) (json.RawMessage, bool) {
-			// Be liberal in what names we accept; both orig_name and camelName are okay.
-			fieldNames := acceptedJSONFieldNames(prop)
-
-			vOrig, okOrig := jsonFields[fieldNames.orig]
-			vCamel, okCamel := jsonFields[fieldNames.camel]
-			if !okOrig && !okCamel {
-				return nil, false
-			}
-			// If, for some reason, both are present in the data, favour the camelName.
-			var raw json.RawMessage
-			if okOrig {
-				raw = vOrig
-				delete(jsonFields, fieldNames.orig)
-			}
-			if okCamel {
-				raw = vCamel
-				delete(jsonFields, fieldNames.camel)
-			}
-			return raw, true
+		keys := make(map[string]bool, len(jsonFields))
+		for k := range jsonFields {
+			keys[k] = true
 		}
-
-		sprops := proto.GetProperties(targetType)
-		for i := 0; i < target.NumField(); i++ {
-			ft := target.Type().Field(i)
-			if strings.HasPrefix(ft.Name, "XXX_") {
-				continue
-			}
-
-			valueForField, ok := consumeField(sprops.Prop[i])
-			if !ok {
-				continue
-			}
-
-			if err := u.unmarshalValue(target.Field(i), valueForField, sprops.Prop[i]); err != nil {
+		for _, t := range u.fieldTargets(target, keys) {
+			if err := u.unmarshalValue(t.value, jsonFields[t.key], t.prop); err != nil {
 				return err
 			}
-		}
-		// Check for any oneof fields.
-		if len(jsonFields) > 0 {
-			for _, oop := range sprops.OneofTypes {
-				raw, ok := consumeField(oop.Prop)
-				if !ok {
-					continue
-				}
-				nv := reflect.New(oop.Type.Elem())
-				target.Field(oop.Field).Set(nv)
-				if err := u.unmarshalValue(nv.Elem().Field(0), raw, oop.Prop); err != nil {
-					return err
-				}
-			}
-		}
-		// Handle proto2 extensions.
-		if len(jsonFields) > 0 {
-			if ep, ok := target.Addr().Interface().(proto.Message); ok {
-				for _, ext := range proto.RegisteredExtensions(ep) {
-					name := fmt.Sprintf("[%s]", ext.Name)
-					raw, ok := jsonFields[name]
-					if !ok {
-						continue
-					}
-					delete(jsonFields, name)
-					nv := reflect.New(reflect.TypeOf(ext.ExtensionType).Elem())
-					if err := u.unmarshalValue(nv.Elem(), raw, nil); err != nil {
-						return err
-					}
-					if err := proto.SetExtension(ep, ext, nv.Interface()); err != nil {
-						return err
-					}
-				}
+			if err := t.setExtension(target); err != nil {
+				return err
 			}
 		}
-		if !u.AllowUnknownFields && len(jsonFields) > 0 {
+		if !u.AllowUnknownFields && len(keys) > 0 {
 			// Pick any field to be the scapegoat.
-			var f string
-			for fname := range jsonFields {
-				f = fname
-				break
-			}
-			return fmt.Errorf("unknown field %q in %v", f, targetType)
+			return fmt.Errorf("unknown field %q in %v", anyKey(keys), targetType)
 		}
 		return nil
 	}
@@ -1067,32 +1478,93 @@ func (u *Unmarshaler) unmarshalValue(target reflect.Value, inputValue json.RawMe
 	return json.Unmarshal(inputValue, target.Addr().Interface())
 }
 
+// setDuration parses the JSON string form of a Duration into target.
+func setDuration(target reflect.Value, str string) error {
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		return fmt.Errorf("bad Duration: %v", err)
+	}
+
+	ns := d.Nanoseconds()
+	s := ns / 1e9
+	ns %= 1e9
+	target.Field(0).SetInt(s)
+	target.Field(1).SetInt(ns)
+	return nil
+}
+
+// setTimestamp parses the JSON string form of a Timestamp into target.
+func setTimestamp(target reflect.Value, str string) error {
+	t, err := time.Parse(time.RFC3339Nano, str)
+	if err != nil {
+		return fmt.Errorf("bad Timestamp: %v", err)
+	}
+
+	target.Field(0).SetInt(t.Unix())
+	target.Field(1).SetInt(int64(t.Nanosecond()))
+	return nil
+}
+
+// setFieldMask parses the JSON string form of a FieldMask into target.
+func setFieldMask(target reflect.Value, str string) error {
+	var paths []string
+	if str != "" {
+		for _, p := range strings.Split(str, ",") {
+			if strings.Contains(p, "_") {
+				return fmt.Errorf("bad FieldMask path %q", p)
+			}
+			// Unlike snakeCase, every capital letter starts a new word,
+			// so that formatFieldMask gives back the same path.
+			var b []byte
+			for i := 0; i < len(p); i++ {
+				if c := p[i]; 'A' <= c && c <= 'Z' {
+					b = append(b, '_', c+'a'-'A')
+				} else {
+					b = append(b, c)
+				}
+			}
+			paths = append(paths, string(b))
+		}
+	}
+	target.Field(0).Set(reflect.ValueOf(paths))
+	return nil
+}
+
 func unquote(s string) (string, error) {
 	var ret string
 	err := json.Unmarshal([]byte(s), &ret)
 	return ret, err
 }
 
-// jsonProperties returns parsed proto.Properties for the field and corrects JSONName attribute.
-func jsonProperties(f reflect.StructField, origName bool) *proto.Properties {
+// jsonProperties returns parsed proto.Properties for the field of the message
+// named msgName and corrects JSONName attribute.
+func (m *Marshaler) jsonProperties(msgName string, f reflect.StructField) *proto.Properties {
 	var prop proto.Properties
 	prop.Init(f.Type, f.Name, f.Tag.Get("protobuf"), &f)
-	if origName || prop.JSONName == "" {
+	switch {
+	case m.FieldNamer != nil:
+		prop.JSONName = m.FieldNamer.JSONFieldName(msgName, &prop)
+	case m.OrigName || prop.JSONName == "":
 		prop.JSONName = prop.OrigName
 	}
 	return &prop
 }
 
-type fieldNames struct {
-	orig, camel string
-}
-
-func acceptedJSONFieldNames(prop *proto.Properties) fieldNames {
-	opts := fieldNames{orig: prop.OrigName, camel: prop.OrigName}
-	if prop.JSONName != "" {
-		opts.camel = prop.JSONName
+// acceptedFieldNames returns the names under which the field described by
+// prop, of the message named msgName, may appear, in increasing order of
+// preference: the original name, the camelName and the name chosen by the
+// FieldNamer, if any.
+func (u *Unmarshaler) acceptedFieldNames(msgName string, prop *proto.Properties) []string {
+	names := []string{prop.OrigName}
+	if prop.JSONName != "" && prop.JSONName != prop.OrigName {
+		names = append(names, prop.JSONName)
+	}
+	if u.FieldNamer != nil {
+		if custom := u.FieldNamer.JSONFieldName(msgName, prop); custom != "" {
+			names = append(names, custom)
+		}
 	}
-	return opts
+	return names
 }
 
 // Writer wrapper inspired by https://blog.golang.org/errors-are-values
@@ -44,9 +44,12 @@ import (
 
 	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
 	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
+	tpb "github.com/golang/protobuf/proto/test_proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
 	"github.com/golang/protobuf/ptypes"
 	anypb "github.com/golang/protobuf/ptypes/any"
 	durpb "github.com/golang/protobuf/ptypes/duration"
+	fmpb "github.com/golang/protobuf/ptypes/field_mask"
 	stpb "github.com/golang/protobuf/ptypes/struct"
 	tspb "github.com/golang/protobuf/ptypes/timestamp"
 	wpb "github.com/golang/protobuf/ptypes/wrappers"
@@ -441,6 +444,25 @@ var marshalingTests = []struct {
 	{"empty repeated emitted", Marshaler{EmitDefaults: true}, &pb.SimpleSlice3{}, `{"slices":[]}`},
 	{"empty map emitted", Marshaler{EmitDefaults: true}, &pb.SimpleMap3{}, `{"stringy":{}}`},
 	{"nested struct null", Marshaler{EmitDefaults: true}, &pb.SimpleNull3{}, `{"simple":null}`},
+	{"empty value emitted by policy", Marshaler{DefaultsPolicy: EmitAllDefaults}, &pb.Simple3{}, `{"dub":0}`},
+	{"policy overrides EmitDefaults", Marshaler{EmitDefaults: true, DefaultsPolicy: EmitCollectionDefaults}, &pb.Simple3{}, `{}`},
+	{"empty repeated emitted by collection policy", Marshaler{DefaultsPolicy: EmitCollectionDefaults}, &pb.SimpleSlice3{}, `{"slices":[]}`},
+	{"empty map emitted by collection policy", Marshaler{DefaultsPolicy: EmitCollectionDefaults}, &pb.SimpleMap3{}, `{"stringy":{}}`},
+	{"listed field emitted", Marshaler{DefaultsPolicy: EmitFieldDefaults("jsonpb.Simple.o_int32", "jsonpb.Simple.o_string")},
+		&pb.Simple{OBool: proto.Bool(true)}, `{"oBool":true,"oInt32":null,"oString":null}`},
+	{"field emitted by descriptor", Marshaler{DefaultsPolicy: EmitDefaultsWhere(func(fd *descpb.FieldDescriptorProto) bool {
+		return fd.GetType() == descpb.FieldDescriptorProto_TYPE_STRING
+	})}, &pb.Simple{}, `{"oString":null}`},
+	{"field option not set", Marshaler{DefaultsPolicy: EmitDefaultsForOption(pb.E_EmitDefault)}, &pb.Simple{}, `{}`},
+	{"field option set", Marshaler{DefaultsPolicy: EmitDefaultsForOption(pb.E_EmitDefault)}, &pb.EmitDefaultOption{}, `{"marked":null}`},
+	{"field option set with proto2 defaults", Marshaler{DefaultsPolicy: EmitDefaultsForOption(pb.E_EmitDefault), Proto2Defaults: true},
+		&pb.EmitDefaultOption{}, `{"marked":5}`},
+	{"proto2 zero values", Marshaler{DefaultsPolicy: EmitFieldDefaults("jsonpb.Simple.o_int64", "jsonpb.Simple.o_bytes"), Proto2Defaults: true},
+		&pb.Simple{}, `{"oInt64":"0","oBytes":""}`},
+	{"proto2 zero enum value", Marshaler{DefaultsPolicy: EmitFieldDefaults("jsonpb.Widget.color", "jsonpb.Widget.simple"), Proto2Defaults: true},
+		&pb.Widget{}, `{"color":"RED","simple":null}`},
+	{"proto2 declared defaults", Marshaler{DefaultsPolicy: EmitFieldDefaults("test_proto.Defaults.F_Int32", "test_proto.Defaults.F_Bytes", "test_proto.Defaults.F_Enum"), Proto2Defaults: true},
+		&tpb.Defaults{F_Int32: proto.Int32(7)}, `{"FInt32":7,"FBytes":"Qmlnbm9zZQ==","FEnum":"GREEN"}`},
 	{"map<int64, int32>", marshaler, &pb.Mappy{Nummy: map[int64]int32{1: 2, 3: 4}}, `{"nummy":{"1":2,"3":4}}`},
 	{"map<int64, int32>", marshalerAllOptions, &pb.Mappy{Nummy: map[int64]int32{1: 2, 3: 4}}, nummyPrettyJSON},
 	{"map<string, string>", marshaler,
@@ -468,6 +490,18 @@ var marshalingTests = []struct {
 	{"oneof, set", marshaler, &pb.MsgWithOneof{Union: &pb.MsgWithOneof_Title{"Grand Poobah"}}, `{"title":"Grand Poobah"}`},
 	{"force orig_name", Marshaler{OrigName: true}, &pb.Simple{OInt32: proto.Int32(4)},
 		`{"o_int32":4}`},
+	{"orig field namer", Marshaler{FieldNamer: OrigFieldNames}, &pb.Simple{OInt32: proto.Int32(4)},
+		`{"o_int32":4}`},
+	{"field namer overrides OrigName", Marshaler{OrigName: true, FieldNamer: JSONFieldNames}, &pb.Simple{OInt32: proto.Int32(4)},
+		`{"oInt32":4}`},
+	{"snake_case field namer", Marshaler{FieldNamer: SnakeCaseFieldNames},
+		&pb.MsgWithOneof{Union: &pb.MsgWithOneof_Country{Country: "Australia"}}, `{"country":"Australia"}`},
+	{"overridden field name", Marshaler{FieldNamer: OverrideFieldNames(map[string]string{"jsonpb.Simple.o_int32": "legacyInt"}, OrigFieldNames)},
+		&pb.Simple{OInt32: proto.Int32(4), OBool: proto.Bool(true)}, `{"o_bool":true,"legacyInt":4}`},
+	{"overridden field name in map value", Marshaler{FieldNamer: OverrideFieldNames(map[string]string{"jsonpb.Simple3.dub": "double"}, nil)},
+		&pb.Mappy{Objjy: map[int32]*pb.Simple3{1: {Dub: 1}}}, `{"objjy":{"1":{"double":1}}}`},
+	{"overridden field name in extension value", Marshaler{FieldNamer: OverrideFieldNames(map[string]string{"jsonpb.Complex.imaginary": "im"}, nil)},
+		realNumber, `{"value":3.14159265359,"[jsonpb.Complex.real_extension]":{"im":0.5772156649},"[jsonpb.name]":"Pi"}`},
 	{"proto2 extension", marshaler, realNumber, realNumberJSON},
 	{"Any with message", marshaler, anySimple, anySimpleJSON},
 	{"Any with message and indent", marshalerAllOptions, anySimple, anySimplePrettyJSON},
@@ -492,6 +526,13 @@ var marshalingTests = []struct {
 	}}}, `{"lv":["x",null,3,true]}`},
 	{"Timestamp", marshaler, &pb.KnownTypes{Ts: &tspb.Timestamp{Seconds: 14e8, Nanos: 21e6}}, `{"ts":"2014-05-13T16:53:20.021Z"}`},
 	{"Timestamp", marshaler, &pb.KnownTypes{Ts: &tspb.Timestamp{Seconds: 14e8, Nanos: 0}}, `{"ts":"2014-05-13T16:53:20Z"}`},
+	{"Timestamp after year 9999", marshaler, &pb.KnownTypes{Ts: &tspb.Timestamp{Seconds: 253402300800}}, `{"ts":"10000-01-01T00:00:00Z"}`},
+	{"FieldMask", marshaler, &fmpb.FieldMask{Paths: []string{"foo_bar", "baz.qux_quux", "x2"}}, `"fooBar,baz.quxQuux,x2"`},
+	{"empty FieldMask", marshaler, &fmpb.FieldMask{}, `""`},
+	{"Any with FieldMask", marshaler, &anypb.Any{
+		TypeUrl: "type.googleapis.com/google.protobuf.FieldMask",
+		Value:   []byte{0x0a, 0x07, 'f', 'o', 'o', '_', 'b', 'a', 'r'},
+	}, `{"@type":"type.googleapis.com/google.protobuf.FieldMask","value":"fooBar"}`},
 	{"number Value", marshaler, &pb.KnownTypes{Val: &stpb.Value{Kind: &stpb.Value_NumberValue{1}}}, `{"val":1}`},
 	{"null Value", marshaler, &pb.KnownTypes{Val: &stpb.Value{Kind: &stpb.Value_NullValue{stpb.NullValue_NULL_VALUE}}}, `{"val":null}`},
 	{"string number value", marshaler, &pb.KnownTypes{Val: &stpb.Value{Kind: &stpb.Value_StringValue{"9223372036854775807"}}}, `{"val":"9223372036854775807"}`},
@@ -756,9 +797,17 @@ var unmarshalingTests = []struct {
 	{"oneof orig_name2", Unmarshaler{}, `{"home_address":"Australia"}`, &pb.MsgWithOneof{Union: &pb.MsgWithOneof_HomeAddress{"Australia"}}},
 	{"orig_name input", Unmarshaler{}, `{"o_bool":true}`, &pb.Simple{OBool: proto.Bool(true)}},
 	{"camelName input", Unmarshaler{}, `{"oBool":true}`, &pb.Simple{OBool: proto.Bool(true)}},
+	{"snake_case field namer input", Unmarshaler{FieldNamer: SnakeCaseFieldNames}, `{"country":"Australia"}`, &pb.MsgWithOneof{Union: &pb.MsgWithOneof_Country{Country: "Australia"}}},
+	{"snake_case field namer camelName input", Unmarshaler{FieldNamer: SnakeCaseFieldNames}, `{"homeAddress":"Australia"}`, &pb.MsgWithOneof{Union: &pb.MsgWithOneof_HomeAddress{HomeAddress: "Australia"}}},
+	{"overridden field name input", Unmarshaler{FieldNamer: OverrideFieldNames(map[string]string{"jsonpb.Simple3.dub": "double"}, nil)},
+		`{"objjy":{"1":{"double":1}}}`, &pb.Mappy{Objjy: map[int32]*pb.Simple3{1: {Dub: 1}}}},
 
 	{"Duration", Unmarshaler{}, `{"dur":"3.000s"}`, &pb.KnownTypes{Dur: &durpb.Duration{Seconds: 3}}},
 	{"Duration", Unmarshaler{}, `{"dur":"4s"}`, &pb.KnownTypes{Dur: &durpb.Duration{Seconds: 4}}},
+	{"FieldMask", Unmarshaler{}, `"fooBar,baz.quxQuux,x2"`, &fmpb.FieldMask{Paths: []string{"foo_bar", "baz.qux_quux", "x2"}}},
+	{"empty FieldMask", Unmarshaler{}, `""`, &fmpb.FieldMask{}},
+	{"Duration in minutes", Unmarshaler{}, `{"dur":"1m"}`, &pb.KnownTypes{Dur: &durpb.Duration{Seconds: 60}}},
+	{"Duration in milliseconds", Unmarshaler{}, `{"dur":"100ms"}`, &pb.KnownTypes{Dur: &durpb.Duration{Nanos: 1e8}}},
 	{"Duration with unicode", Unmarshaler{}, `{"dur": "3\u0073"}`, &pb.KnownTypes{Dur: &durpb.Duration{Seconds: 3}}},
 	{"null Duration", Unmarshaler{}, `{"dur":null}`, &pb.KnownTypes{Dur: nil}},
 	{"Timestamp", Unmarshaler{}, `{"ts":"2014-05-13T16:53:20.021Z"}`, &pb.KnownTypes{Ts: &tspb.Timestamp{Seconds: 14e8, Nanos: 21e6}}},
@@ -865,6 +914,28 @@ func TestUnmarshaling(t *testing.T) {
 	}
 }
 
+func TestSnakeCase(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"foo", "foo"},
+		{"foo_bar", "foo_bar"},
+		{"fooBar", "foo_bar"},
+		{"FooBar", "foo_bar"},
+		{"Country", "country"},
+		{"userID", "user_id"},
+		{"HTTPServer", "http_server"},
+		{"foo_Bar", "foo_bar"},
+		{"field2Name", "field2_name"},
+	}
+	for _, tt := range tests {
+		if got := snakeCase(tt.in); got != tt.want {
+			t.Errorf("snakeCase(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
 func TestUnmarshalNullArray(t *testing.T) {
 	var repeats pb.Repeats
 	if err := UnmarshalString(`{"rBool":null}`, &repeats); err != nil {
@@ -937,6 +1008,18 @@ var unmarshalingShouldError = []struct {
 	{"repeated proto3 enum with non array input", `{"rFunny":"PUNS"}`, &proto3pb.Message{RFunny: []proto3pb.Message_Humour{}}},
 }
 
+func TestFieldMaskErrors(t *testing.T) {
+	// Paths that do not survive the round trip through lowerCamelCase.
+	for _, path := range []string{"fooBar", "foo__bar", "foo_", "foo_1"} {
+		if _, err := new(Marshaler).MarshalToString(&fmpb.FieldMask{Paths: []string{path}}); err == nil {
+			t.Errorf("marshaling FieldMask path %q succeeded, want error", path)
+		}
+	}
+	if err := UnmarshalString(`"foo_bar"`, &fmpb.FieldMask{}); err == nil {
+		t.Errorf("unmarshaling FieldMask path foo_bar succeeded, want error")
+	}
+}
+
 func TestUnmarshalingBadInput(t *testing.T) {
 	for _, tt := range unmarshalingShouldError {
 		err := UnmarshalString(tt.in, tt.pb)
@@ -946,6 +1029,33 @@ func TestUnmarshalingBadInput(t *testing.T) {
 	}
 }
 
+func TestAnyWithRegistry(t *testing.T) {
+	msg := &pb.Simple{OBool: proto.Bool(true)}
+	any, err := ptypes.MarshalAnyWithPrefix(msg, "types.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := ptypes.NewRegistry(&pb.Simple{})
+	js, err := (&Marshaler{AnyResolver: r}).MarshalToString(any)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"@type":"types.example.com/jsonpb.Simple","oBool":true}`; js != want {
+		t.Errorf("got %s, want %s", js, want)
+	}
+	got := &anypb.Any{}
+	if err := (&Unmarshaler{AnyResolver: r}).Unmarshal(strings.NewReader(js), got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, any) {
+		t.Errorf("got %v, want %v", got, any)
+	}
+	// The registry does not fall back to the global registry.
+	if err := (&Unmarshaler{AnyResolver: r}).Unmarshal(strings.NewReader(`{"@type":"types.example.com/jsonpb.Widget"}`), got); err == nil {
+		t.Errorf("unmarshaling an Any of a type not in the registry succeeded")
+	}
+}
+
 type funcResolver func(turl string) (proto.Message, error)
 
 func (fn funcResolver) Resolve(turl string) (proto.Message, error) {
@@ -6,6 +6,7 @@ package jsonpb
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	descriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
 	any "github.com/golang/protobuf/ptypes/any"
 	duration "github.com/golang/protobuf/ptypes/duration"
 	_struct "github.com/golang/protobuf/ptypes/struct"
@@ -1236,6 +1237,56 @@ func (m *MsgWithRequiredWKT) GetStr() *wrappers.StringValue {
 	return nil
 }
 
+type EmitDefaultOption struct {
+	Marked               *int32   `protobuf:"varint,1,opt,name=marked,def=5" json:"marked,omitempty"`
+	Unmarked             *int32   `protobuf:"varint,2,opt,name=unmarked,def=6" json:"unmarked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmitDefaultOption) Reset()         { *m = EmitDefaultOption{} }
+func (m *EmitDefaultOption) String() string { return proto.CompactTextString(m) }
+func (*EmitDefaultOption) ProtoMessage()    {}
+func (*EmitDefaultOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e97c739a0ce14cc6, []int{13}
+}
+
+func (m *EmitDefaultOption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmitDefaultOption.Unmarshal(m, b)
+}
+func (m *EmitDefaultOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmitDefaultOption.Marshal(b, m, deterministic)
+}
+func (m *EmitDefaultOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmitDefaultOption.Merge(m, src)
+}
+func (m *EmitDefaultOption) XXX_Size() int {
+	return xxx_messageInfo_EmitDefaultOption.Size(m)
+}
+func (m *EmitDefaultOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmitDefaultOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmitDefaultOption proto.InternalMessageInfo
+
+const Default_EmitDefaultOption_Marked int32 = 5
+const Default_EmitDefaultOption_Unmarked int32 = 6
+
+func (m *EmitDefaultOption) GetMarked() int32 {
+	if m != nil && m.Marked != nil {
+		return *m.Marked
+	}
+	return Default_EmitDefaultOption_Marked
+}
+
+func (m *EmitDefaultOption) GetUnmarked() int32 {
+	if m != nil && m.Unmarked != nil {
+		return *m.Unmarked
+	}
+	return Default_EmitDefaultOption_Unmarked
+}
+
 var E_Name = &proto.ExtensionDesc{
 	ExtendedType:  (*Real)(nil),
 	ExtensionType: (*string)(nil),
@@ -1254,6 +1305,15 @@ var E_Extm = &proto.ExtensionDesc{
 	Filename:      "test_objects.proto",
 }
 
+var E_EmitDefault = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.FieldOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         50000,
+	Name:          "jsonpb.emit_default",
+	Tag:           "varint,50000,opt,name=emit_default",
+	Filename:      "test_objects.proto",
+}
+
 func init() {
 	proto.RegisterEnum("jsonpb.Widget_Color", Widget_Color_name, Widget_Color_value)
 	proto.RegisterType((*Simple)(nil), "jsonpb.Simple")
@@ -1273,104 +1333,111 @@ func init() {
 	proto.RegisterMapType((map[string]*MsgWithRequired)(nil), "jsonpb.MsgWithIndirectRequired.MapFieldEntry")
 	proto.RegisterType((*MsgWithRequiredBytes)(nil), "jsonpb.MsgWithRequiredBytes")
 	proto.RegisterType((*MsgWithRequiredWKT)(nil), "jsonpb.MsgWithRequiredWKT")
+	proto.RegisterType((*EmitDefaultOption)(nil), "jsonpb.EmitDefaultOption")
 	proto.RegisterExtension(E_Name)
 	proto.RegisterExtension(E_Extm)
+	proto.RegisterExtension(E_EmitDefault)
 }
 
 func init() { proto.RegisterFile("test_objects.proto", fileDescriptor_e97c739a0ce14cc6) }
 
 var fileDescriptor_e97c739a0ce14cc6 = []byte{
-	// 1460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x72, 0xdb, 0x44,
-	0x14, 0x8e, 0x24, 0xcb, 0xb6, 0x8e, 0xf3, 0xd7, 0x6d, 0xda, 0x2a, 0xa1, 0x14, 0x8d, 0x5b, 0x8a,
-	0x69, 0x89, 0x3b, 0x38, 0x1e, 0x4f, 0x29, 0xdc, 0x34, 0x4d, 0x4a, 0x4b, 0xdb, 0xc0, 0x6c, 0x52,
-	0x7a, 0xe9, 0x91, 0x23, 0x39, 0x55, 0x91, 0xb4, 0x66, 0x77, 0x9d, 0xd4, 0x03, 0xcc, 0xe4, 0x19,
-	0x18, 0x9e, 0x80, 0x0b, 0x6e, 0xb9, 0xe3, 0x82, 0xb7, 0xe0, 0x8d, 0x98, 0x3d, 0xbb, 0xf2, 0x5f,
-	0xe2, 0x81, 0x2b, 0x7b, 0xf7, 0xfb, 0xd9, 0xd5, 0x9e, 0x4f, 0x67, 0x05, 0x44, 0xc6, 0x42, 0x76,
-	0x59, 0xef, 0x5d, 0x7c, 0x2c, 0x45, 0x73, 0xc0, 0x99, 0x64, 0xa4, 0xfc, 0x4e, 0xb0, 0x7c, 0xd0,
-	0xdb, 0xda, 0x3c, 0x61, 0xec, 0x24, 0x8d, 0x1f, 0xe0, 0x6c, 0x6f, 0xd8, 0x7f, 0x10, 0xe6, 0x23,
-	0x4d, 0xd9, 0xba, 0x35, 0x0f, 0x45, 0x43, 0x1e, 0xca, 0x84, 0xe5, 0x06, 0xbf, 0x39, 0x8f, 0x0b,
-	0xc9, 0x87, 0xc7, 0xd2, 0xa0, 0x1f, 0xcd, 0xa3, 0x32, 0xc9, 0x62, 0x21, 0xc3, 0x6c, 0xb0, 0xc8,
-	0xfe, 0x8c, 0x87, 0x83, 0x41, 0xcc, 0xcd, 0x0e, 0xeb, 0x7f, 0x96, 0xa0, 0x7c, 0x98, 0x64, 0x83,
-	0x34, 0x26, 0xd7, 0xa0, 0xcc, 0xba, 0x3d, 0xc6, 0x52, 0xdf, 0x0a, 0xac, 0x46, 0x95, 0xba, 0x6c,
-	0x97, 0xb1, 0x94, 0xdc, 0x80, 0x0a, 0xeb, 0x26, 0xb9, 0xdc, 0x69, 0xf9, 0x76, 0x60, 0x35, 0x5c,
-	0x5a, 0x66, 0xcf, 0xd5, 0x88, 0xdc, 0x82, 0x9a, 0x01, 0xba, 0x42, 0x72, 0xdf, 0x41, 0xd0, 0xd3,
-	0xe0, 0xa1, 0xe4, 0x63, 0x61, 0xa7, 0xed, 0x97, 0x02, 0xab, 0xe1, 0x68, 0x61, 0xa7, 0x3d, 0x16,
-	0x76, 0xda, 0x28, 0x74, 0x11, 0xf4, 0x34, 0xa8, 0x84, 0x9b, 0x50, 0x65, 0xdd, 0xa1, 0x5e, 0xb2,
-	0x1c, 0x58, 0x8d, 0x15, 0x5a, 0x61, 0xaf, 0x71, 0x48, 0x02, 0x58, 0x2e, 0x20, 0xd4, 0x56, 0x10,
-	0x06, 0x03, 0xcf, 0x88, 0x3b, 0x6d, 0xbf, 0x1a, 0x58, 0x8d, 0x92, 0x11, 0x77, 0xda, 0x13, 0xb1,
-	0x59, 0xd8, 0x43, 0x18, 0x0c, 0x3c, 0x16, 0x0b, 0xbd, 0x32, 0x04, 0x56, 0xe3, 0x0a, 0xad, 0xb0,
-	0xc3, 0xa9, 0x95, 0xc5, 0x64, 0xe5, 0x1a, 0xc2, 0x60, 0xe0, 0x19, 0x71, 0xa7, 0xed, 0x2f, 0x07,
-	0x56, 0x83, 0x18, 0x71, 0xb1, 0xb2, 0x98, 0xac, 0xbc, 0x82, 0x30, 0x18, 0x78, 0x7c, 0x58, 0xfd,
-	0x94, 0x85, 0xd2, 0x5f, 0x0d, 0xac, 0x86, 0x4d, 0xcb, 0xec, 0xa9, 0x1a, 0xe9, 0xc3, 0x42, 0x00,
-	0x95, 0x6b, 0x08, 0x7a, 0x1a, 0x1c, 0xaf, 0x1a, 0xb1, 0x61, 0x2f, 0x8d, 0xfd, 0xf5, 0xc0, 0x6a,
-	0x58, 0xb4, 0xc2, 0xf6, 0x70, 0xa8, 0x57, 0xd5, 0x10, 0x6a, 0xaf, 0x20, 0x0c, 0x06, 0x9e, 0x6c,
-	0x59, 0xf2, 0x24, 0x3f, 0xf1, 0x49, 0x60, 0x35, 0x3c, 0xb5, 0x65, 0x1c, 0xea, 0x0d, 0xf5, 0x46,
-	0x32, 0x16, 0xfe, 0xd5, 0xc0, 0x6a, 0x2c, 0xd3, 0x32, 0xdb, 0x55, 0xa3, 0xfa, 0xaf, 0x16, 0xc0,
-	0x01, 0xcb, 0x9f, 0x26, 0x79, 0x22, 0x63, 0x41, 0xae, 0x82, 0xdb, 0xef, 0xe6, 0x61, 0x8e, 0xa1,
-	0xb1, 0x69, 0xa9, 0x7f, 0x10, 0xe6, 0x2a, 0x4a, 0xfd, 0xee, 0x20, 0xc9, 0xfb, 0x18, 0x19, 0x9b,
-	0xba, 0xfd, 0xef, 0x92, 0xbc, 0xaf, 0xa7, 0x73, 0x35, 0xed, 0x98, 0xe9, 0x03, 0x35, 0x7d, 0x15,
-	0xdc, 0x08, 0x2d, 0x4a, 0xb8, 0xc1, 0x52, 0x64, 0x2c, 0x22, 0x6d, 0xe1, 0xe2, 0xac, 0x1b, 0x15,
-	0x16, 0x91, 0xb6, 0x28, 0x9b, 0x69, 0x65, 0x51, 0xff, 0xc3, 0x86, 0x0a, 0x8d, 0x07, 0x71, 0x28,
-	0x85, 0xa2, 0xf0, 0x22, 0xc7, 0x8e, 0xca, 0x31, 0x2f, 0x72, 0xcc, 0xc7, 0x39, 0x76, 0x54, 0x8e,
-	0xb9, 0xce, 0x71, 0x01, 0x74, 0xda, 0xbe, 0x13, 0x38, 0x2a, 0xa7, 0x5c, 0xe7, 0x74, 0x13, 0xaa,
-	0xbc, 0xc8, 0x61, 0x29, 0x70, 0x54, 0x0e, 0xb9, 0xc9, 0xe1, 0x18, 0xea, 0xb4, 0x7d, 0x37, 0x70,
-	0x54, 0xca, 0xb8, 0x49, 0x19, 0x42, 0xa2, 0x48, 0xaf, 0xa3, 0x32, 0xc4, 0x0f, 0xa7, 0x54, 0x26,
-	0x21, 0x95, 0xc0, 0x51, 0x09, 0xe1, 0x26, 0x21, 0xb8, 0x09, 0x5d, 0xff, 0x6a, 0xe0, 0xa8, 0xfa,
-	0x73, 0x5d, 0x7f, 0xd4, 0x98, 0xfa, 0x7a, 0x81, 0xa3, 0xea, 0xcb, 0x4d, 0x7d, 0xb5, 0x9d, 0xae,
-	0x1e, 0x04, 0x8e, 0xaa, 0x1e, 0x9f, 0x54, 0x8f, 0x9b, 0xea, 0xd5, 0x02, 0x47, 0x55, 0x8f, 0xeb,
-	0xea, 0xfd, 0x65, 0x43, 0xf9, 0x4d, 0x12, 0x9d, 0xc4, 0x92, 0xdc, 0x03, 0xf7, 0x98, 0xa5, 0x8c,
-	0x63, 0xe5, 0x56, 0x5b, 0x1b, 0x4d, 0xdd, 0xac, 0x9a, 0x1a, 0x6e, 0x3e, 0x51, 0x18, 0xd5, 0x14,
-	0xb2, 0xad, 0xfc, 0x34, 0x5b, 0x1d, 0xde, 0x22, 0x76, 0x99, 0xe3, 0x2f, 0xb9, 0x0b, 0x65, 0x81,
-	0x4d, 0x05, 0xdf, 0xa2, 0x5a, 0x6b, 0xb5, 0x60, 0xeb, 0x56, 0x43, 0x0d, 0x4a, 0x3e, 0xd5, 0x07,
-	0x82, 0x4c, 0xb5, 0xcf, 0x8b, 0x4c, 0x75, 0x40, 0x86, 0x5a, 0xe1, 0xba, 0xc0, 0xfe, 0x06, 0x7a,
-	0xae, 0x15, 0x4c, 0x53, 0x77, 0x5a, 0xe0, 0xe4, 0x33, 0xf0, 0x78, 0xb7, 0x20, 0x5f, 0x43, 0xdb,
-	0x0b, 0xe4, 0x2a, 0x37, 0xff, 0xea, 0x1f, 0x83, 0xab, 0x37, 0x5d, 0x01, 0x87, 0xee, 0xef, 0xad,
-	0x2f, 0x11, 0x0f, 0xdc, 0xaf, 0xe9, 0xfe, 0xfe, 0xc1, 0xba, 0x45, 0xaa, 0x50, 0xda, 0x7d, 0xf9,
-	0x7a, 0x7f, 0xdd, 0xae, 0xff, 0x66, 0x43, 0xe9, 0x55, 0x38, 0x10, 0xe4, 0x4b, 0xa8, 0x65, 0x53,
-	0xdd, 0xcb, 0x42, 0xff, 0x0f, 0x0a, 0x7f, 0x45, 0x69, 0xbe, 0x2a, 0x5a, 0xd9, 0x7e, 0x2e, 0xf9,
-	0x88, 0x7a, 0xd9, 0xb8, 0xb5, 0x3d, 0x86, 0x95, 0x0c, 0xb3, 0x59, 0x3c, 0xb5, 0x8d, 0xf2, 0x0f,
-	0x67, 0xe5, 0x2a, 0xaf, 0xfa, 0xb1, 0xb5, 0x41, 0x2d, 0x9b, 0xcc, 0x6c, 0x7d, 0x05, 0xab, 0xb3,
-	0xfe, 0x64, 0x1d, 0x9c, 0x1f, 0xe2, 0x11, 0x96, 0xd1, 0xa1, 0xea, 0x2f, 0xd9, 0x00, 0xf7, 0x34,
-	0x4c, 0x87, 0x31, 0xbe, 0x7e, 0x1e, 0xd5, 0x83, 0x47, 0xf6, 0x43, 0x6b, 0xeb, 0x00, 0xd6, 0xe7,
-	0xed, 0xa7, 0xf5, 0x55, 0xad, 0xbf, 0x33, 0xad, 0xbf, 0x58, 0x94, 0x89, 0x5f, 0xfd, 0x1f, 0x0b,
-	0x96, 0x5f, 0x89, 0x93, 0x37, 0x89, 0x7c, 0xfb, 0x6d, 0x1e, 0xb3, 0x3e, 0xb9, 0x0e, 0xae, 0x4c,
-	0x64, 0x1a, 0xa3, 0x9d, 0xf7, 0x6c, 0x89, 0xea, 0x21, 0xf1, 0xa1, 0x2c, 0xc2, 0x34, 0xe4, 0x23,
-	0xf4, 0x74, 0x9e, 0x2d, 0x51, 0x33, 0x26, 0x5b, 0x50, 0x79, 0xc2, 0x86, 0x6a, 0x27, 0xd8, 0x16,
-	0x94, 0xa6, 0x98, 0x20, 0xb7, 0x61, 0xf9, 0x2d, 0xcb, 0xe2, 0x6e, 0x18, 0x45, 0x3c, 0x16, 0x02,
-	0x3b, 0x84, 0x22, 0xd4, 0xd4, 0xec, 0x63, 0x3d, 0x49, 0xf6, 0xe1, 0x4a, 0x26, 0x4e, 0xba, 0x67,
-	0x89, 0x7c, 0xdb, 0xe5, 0xf1, 0x8f, 0xc3, 0x84, 0xc7, 0x11, 0x76, 0x8d, 0x5a, 0xeb, 0xc6, 0xf8,
-	0x60, 0xf5, 0x1e, 0xa9, 0x81, 0x9f, 0x2d, 0xd1, 0xb5, 0x6c, 0x76, 0x6a, 0xb7, 0x02, 0xee, 0x30,
-	0x4f, 0x58, 0x5e, 0xbf, 0x0b, 0x25, 0x1a, 0x87, 0xe9, 0xe4, 0x14, 0x2d, 0xdd, 0x6a, 0x70, 0x70,
-	0xaf, 0x5a, 0x8d, 0xd6, 0xcf, 0xcf, 0xcf, 0xcf, 0xed, 0xfa, 0x99, 0xda, 0xb8, 0x3a, 0x90, 0xf7,
-	0xe4, 0x26, 0x78, 0x49, 0x16, 0x9e, 0x24, 0xb9, 0x7a, 0x40, 0x4d, 0x9f, 0x4c, 0x4c, 0x24, 0xad,
-	0x3d, 0x58, 0xe5, 0x71, 0x98, 0x76, 0xe3, 0xf7, 0x32, 0xce, 0x45, 0xc2, 0x72, 0xb2, 0x3c, 0x49,
-	0x66, 0x98, 0xfa, 0x3f, 0xcd, 0x46, 0xdb, 0xd8, 0xd3, 0x15, 0x25, 0xda, 0x2f, 0x34, 0xf5, 0xbf,
-	0x5d, 0x80, 0x17, 0x39, 0x3b, 0xcb, 0x8f, 0x46, 0x83, 0x58, 0x90, 0x3b, 0x60, 0x87, 0x39, 0x5e,
-	0x1b, 0xb5, 0xd6, 0x46, 0x53, 0x5f, 0xf8, 0xcd, 0xe2, 0xc2, 0x6f, 0x3e, 0xce, 0x47, 0xd4, 0x0e,
-	0x73, 0x72, 0x1f, 0x9c, 0x68, 0xa8, 0x5f, 0xf6, 0x5a, 0x6b, 0xf3, 0x02, 0x6d, 0xcf, 0x7c, 0x76,
-	0x50, 0xc5, 0x22, 0x9f, 0x80, 0x2d, 0x24, 0xde, 0x62, 0xea, 0x0c, 0xe7, 0xb9, 0x87, 0xf8, 0x09,
-	0x42, 0x6d, 0xa1, 0x9a, 0x88, 0x2d, 0x85, 0x89, 0xc9, 0xd6, 0x05, 0xe2, 0x51, 0xf1, 0x35, 0x42,
-	0x6d, 0x29, 0x14, 0x37, 0x3d, 0xc5, 0x1b, 0xec, 0x32, 0xee, 0xcb, 0x44, 0xc8, 0xef, 0xd5, 0x09,
-	0x53, 0x3b, 0x3d, 0x25, 0x0d, 0x70, 0x4e, 0xc3, 0x14, 0x6f, 0xb4, 0x5a, 0xeb, 0xfa, 0x05, 0xb2,
-	0x26, 0x2a, 0x0a, 0x69, 0x82, 0x13, 0xf5, 0x52, 0x8c, 0x4e, 0xad, 0x75, 0xf3, 0xe2, 0x73, 0x61,
-	0xaf, 0x34, 0xfc, 0xa8, 0x97, 0x92, 0x6d, 0x70, 0xfa, 0xa9, 0xc4, 0x24, 0xa9, 0xf7, 0x76, 0x9e,
-	0x8f, 0x5d, 0xd7, 0xd0, 0xfb, 0xa9, 0x54, 0xf4, 0x04, 0x9b, 0xfc, 0xe5, 0x74, 0x7c, 0x13, 0x0d,
-	0x3d, 0xe9, 0xb4, 0xd5, 0x6e, 0x86, 0x9d, 0x36, 0x5e, 0x4e, 0x97, 0xed, 0xe6, 0xf5, 0x34, 0x7f,
-	0xd8, 0x69, 0xa3, 0xfd, 0x4e, 0x0b, 0xbf, 0x63, 0x16, 0xd8, 0xef, 0xb4, 0x0a, 0xfb, 0x9d, 0x16,
-	0xda, 0xef, 0xb4, 0xf0, 0xc3, 0x66, 0x91, 0xfd, 0x98, 0x3f, 0x44, 0x7e, 0x09, 0x6f, 0x42, 0x6f,
-	0xc1, 0xa1, 0xab, 0x56, 0xa0, 0xe9, 0xc8, 0x53, 0xfe, 0xaa, 0xa9, 0xc1, 0x02, 0x7f, 0x7d, 0xbb,
-	0x18, 0x7f, 0x21, 0x39, 0xf9, 0x1c, 0xdc, 0xe2, 0x96, 0xb9, 0xfc, 0x01, 0xf0, 0xd6, 0xd1, 0x02,
-	0xcd, 0xac, 0xdf, 0x86, 0xb5, 0xb9, 0x97, 0x51, 0x35, 0x20, 0xdd, 0x4a, 0xed, 0x86, 0x87, 0xbe,
-	0xf5, 0xdf, 0x6d, 0xb8, 0x61, 0x58, 0xcf, 0xf3, 0x28, 0xe1, 0xf1, 0xb1, 0x1c, 0xb3, 0xef, 0x43,
-	0x49, 0x0c, 0x7b, 0x99, 0x49, 0xf2, 0xa2, 0x37, 0x9c, 0x22, 0x89, 0x7c, 0x03, 0x5e, 0x16, 0x0e,
-	0xba, 0xfd, 0x24, 0x4e, 0x23, 0xd3, 0x6c, 0xb7, 0xe7, 0x14, 0xf3, 0x0b, 0xa8, 0x26, 0xfc, 0x54,
-	0xf1, 0x75, 0xf3, 0xad, 0x66, 0x66, 0x48, 0x1e, 0x42, 0x4d, 0xa4, 0xc9, 0x71, 0x6c, 0xdc, 0x1c,
-	0x74, 0x5b, 0xb8, 0x3e, 0x20, 0x17, 0x95, 0x5b, 0x47, 0xb0, 0x32, 0x63, 0x3a, 0xdd, 0x72, 0x3d,
-	0xdd, 0x72, 0xb7, 0x67, 0x5b, 0xee, 0x42, 0xdb, 0xa9, 0xde, 0x7b, 0x0f, 0x36, 0xe6, 0x50, 0x3c,
-	0x6d, 0x42, 0xa0, 0xd4, 0x1b, 0x49, 0x81, 0xe7, 0xb9, 0x4c, 0xf1, 0x7f, 0x7d, 0x0f, 0xc8, 0x1c,
-	0xf7, 0xcd, 0x8b, 0xa3, 0xa2, 0xdc, 0x8a, 0xf8, 0x7f, 0xca, 0xfd, 0x28, 0x80, 0x52, 0x1e, 0x66,
-	0xf1, 0x5c, 0xd3, 0xfa, 0x19, 0x9f, 0x02, 0x91, 0x47, 0x5f, 0x40, 0x29, 0x7e, 0x2f, 0xb3, 0x39,
-	0xc6, 0x2f, 0xff, 0x51, 0x2a, 0x25, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xe9, 0xd4, 0xfd, 0x2f,
-	0x41, 0x0d, 0x00, 0x00,
+	// 1550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x72, 0xdb, 0xc6,
+	0x15, 0x36, 0xfe, 0x48, 0xe2, 0x90, 0xfa, 0xf1, 0x5a, 0x89, 0x61, 0xd5, 0x4e, 0x30, 0x4c, 0x9a,
+	0x22, 0x4e, 0xcd, 0x4c, 0x29, 0x96, 0x93, 0xaa, 0xbd, 0xb1, 0x22, 0xb9, 0x4e, 0x13, 0x2b, 0x9d,
+	0x95, 0x5d, 0x5f, 0x72, 0x40, 0x61, 0x29, 0x23, 0x01, 0xb0, 0xec, 0xee, 0xc2, 0xb2, 0xa6, 0xed,
+	0x8c, 0xae, 0xfa, 0x00, 0x9d, 0x3e, 0x41, 0x2f, 0x7a, 0xdb, 0xab, 0xf6, 0xa2, 0x4f, 0xd1, 0xbe,
+	0x51, 0x67, 0xcf, 0x2e, 0x48, 0x89, 0x32, 0xdd, 0x5c, 0x11, 0x7b, 0xbe, 0x9f, 0xfd, 0x39, 0x67,
+	0xcf, 0x12, 0x88, 0x62, 0x52, 0x4d, 0xf8, 0xf4, 0x3b, 0x76, 0xaa, 0xe4, 0x60, 0x2e, 0xb8, 0xe2,
+	0xa4, 0xf5, 0x9d, 0xe4, 0xd5, 0x7c, 0xba, 0x7b, 0xef, 0x8c, 0xf3, 0xb3, 0x82, 0x7d, 0x8e, 0xd1,
+	0x69, 0x3d, 0xfb, 0x3c, 0xad, 0x2e, 0x0c, 0x65, 0x37, 0x5e, 0x85, 0x32, 0x26, 0x4f, 0x45, 0x3e,
+	0x57, 0x5c, 0x58, 0xc6, 0x07, 0x37, 0x18, 0xb5, 0x48, 0x55, 0xce, 0x2b, 0x8b, 0xdf, 0x5f, 0xc5,
+	0xa5, 0x12, 0xf5, 0xa9, 0xb2, 0xe8, 0x87, 0xab, 0xa8, 0xca, 0x4b, 0x26, 0x55, 0x5a, 0xce, 0xd7,
+	0xd9, 0x9f, 0x8b, 0x74, 0x3e, 0x67, 0xc2, 0xee, 0xa1, 0xff, 0x0f, 0x1f, 0x5a, 0x27, 0x79, 0x39,
+	0x2f, 0x18, 0x79, 0x0f, 0x5a, 0x7c, 0x32, 0xe5, 0xbc, 0x88, 0x9c, 0xd8, 0x49, 0x3a, 0x34, 0xe0,
+	0x07, 0x9c, 0x17, 0xe4, 0x2e, 0xb4, 0xf9, 0x24, 0xaf, 0xd4, 0xde, 0x30, 0x72, 0x63, 0x27, 0x09,
+	0x68, 0x8b, 0x7f, 0xa5, 0x47, 0xe4, 0x03, 0xe8, 0x5a, 0x60, 0x22, 0x95, 0x88, 0x3c, 0x04, 0x43,
+	0x03, 0x9e, 0x28, 0xb1, 0x10, 0x8e, 0x47, 0x91, 0x1f, 0x3b, 0x89, 0x67, 0x84, 0xe3, 0xd1, 0x42,
+	0x38, 0x1e, 0xa1, 0x30, 0x40, 0x30, 0x34, 0xa0, 0x16, 0xde, 0x83, 0x0e, 0x9f, 0xd4, 0x66, 0xca,
+	0x56, 0xec, 0x24, 0x1b, 0xb4, 0xcd, 0x5f, 0xe0, 0x90, 0xc4, 0xd0, 0x6b, 0x20, 0xd4, 0xb6, 0x11,
+	0x06, 0x0b, 0x5f, 0x13, 0x8f, 0x47, 0x51, 0x27, 0x76, 0x12, 0xdf, 0x8a, 0xc7, 0xa3, 0xa5, 0xd8,
+	0x4e, 0x1c, 0x22, 0x0c, 0x16, 0x5e, 0x88, 0xa5, 0x99, 0x19, 0x62, 0x27, 0xb9, 0x4d, 0xdb, 0xfc,
+	0xe4, 0xca, 0xcc, 0x72, 0x39, 0x73, 0x17, 0x61, 0xb0, 0xf0, 0x35, 0xf1, 0x78, 0x14, 0xf5, 0x62,
+	0x27, 0x21, 0x56, 0xdc, 0xcc, 0x2c, 0x97, 0x33, 0x6f, 0x20, 0x0c, 0x16, 0x5e, 0x1c, 0xd6, 0xac,
+	0xe0, 0xa9, 0x8a, 0x36, 0x63, 0x27, 0x71, 0x69, 0x8b, 0x3f, 0xd1, 0x23, 0x73, 0x58, 0x08, 0xa0,
+	0x72, 0x0b, 0xc1, 0xd0, 0x80, 0x8b, 0x59, 0x33, 0x5e, 0x4f, 0x0b, 0x16, 0x6d, 0xc7, 0x4e, 0xe2,
+	0xd0, 0x36, 0x3f, 0xc4, 0xa1, 0x99, 0xd5, 0x40, 0xa8, 0xbd, 0x8d, 0x30, 0x58, 0x78, 0xb9, 0x64,
+	0x25, 0xf2, 0xea, 0x2c, 0x22, 0xb1, 0x93, 0x84, 0x7a, 0xc9, 0x38, 0x34, 0x0b, 0x9a, 0x5e, 0x28,
+	0x26, 0xa3, 0x3b, 0xb1, 0x93, 0xf4, 0x68, 0x8b, 0x1f, 0xe8, 0x51, 0xff, 0x2f, 0x0e, 0xc0, 0x31,
+	0xaf, 0x9e, 0xe4, 0x55, 0xae, 0x98, 0x24, 0x77, 0x20, 0x98, 0x4d, 0xaa, 0xb4, 0xc2, 0xa2, 0x71,
+	0xa9, 0x3f, 0x3b, 0x4e, 0x2b, 0x5d, 0x4a, 0xb3, 0xc9, 0x3c, 0xaf, 0x66, 0x58, 0x32, 0x2e, 0x0d,
+	0x66, 0xbf, 0xcd, 0xab, 0x99, 0x09, 0x57, 0x3a, 0xec, 0xd9, 0xf0, 0xb1, 0x0e, 0xdf, 0x81, 0x20,
+	0x43, 0x0b, 0x1f, 0x17, 0xe8, 0x67, 0xd6, 0x22, 0x33, 0x16, 0x01, 0x46, 0x83, 0xac, 0xb1, 0xc8,
+	0x8c, 0x45, 0xcb, 0x86, 0xb5, 0x45, 0xff, 0xef, 0x2e, 0xb4, 0x29, 0x9b, 0xb3, 0x54, 0x49, 0x4d,
+	0x11, 0x4d, 0x1d, 0x7b, 0xba, 0x8e, 0x45, 0x53, 0xc7, 0x62, 0x51, 0xc7, 0x9e, 0xae, 0x63, 0x61,
+	0xea, 0xb8, 0x01, 0xc6, 0xa3, 0xc8, 0x8b, 0xbd, 0xc4, 0x33, 0xc0, 0x78, 0xa4, 0x4f, 0x47, 0x34,
+	0x75, 0xe8, 0xc7, 0x9e, 0xae, 0x43, 0x61, 0xeb, 0x70, 0x01, 0x8d, 0x47, 0x51, 0x10, 0x7b, 0x89,
+	0x6f, 0xa1, 0x46, 0x25, 0x9b, 0xea, 0xf5, 0x74, 0x0d, 0x89, 0x93, 0x2b, 0x2a, 0x5b, 0x21, 0xed,
+	0xd8, 0x4b, 0x88, 0x85, 0xc6, 0x23, 0xb3, 0x08, 0x93, 0xff, 0x4e, 0xec, 0xe9, 0xfc, 0x0b, 0x93,
+	0x7f, 0xd4, 0xd8, 0xfc, 0x86, 0xb1, 0xa7, 0xf3, 0x2b, 0x6c, 0x7e, 0x8d, 0x9d, 0xc9, 0x1e, 0xc4,
+	0x9e, 0xce, 0x9e, 0x58, 0x66, 0x4f, 0xd8, 0xec, 0x75, 0x63, 0x4f, 0x67, 0x4f, 0x98, 0xec, 0xfd,
+	0xcb, 0x85, 0xd6, 0xcb, 0x3c, 0x3b, 0x63, 0x8a, 0x3c, 0x84, 0xe0, 0x94, 0x17, 0x5c, 0x60, 0xe6,
+	0x36, 0x87, 0x3b, 0x03, 0xd3, 0xce, 0x06, 0x06, 0x1e, 0x7c, 0xa9, 0x31, 0x6a, 0x28, 0xe4, 0x91,
+	0xf6, 0x33, 0x6c, 0x7d, 0x78, 0xeb, 0xd8, 0x2d, 0x81, 0xbf, 0xe4, 0x13, 0x68, 0x49, 0x6c, 0x2a,
+	0x78, 0x8b, 0xba, 0xc3, 0xcd, 0x86, 0x6d, 0x5a, 0x0d, 0xb5, 0x28, 0xf9, 0xd4, 0x1c, 0x08, 0x32,
+	0xf5, 0x3a, 0x6f, 0x32, 0xdb, 0xc2, 0x7c, 0x90, 0x4f, 0xa1, 0x2d, 0x4c, 0x82, 0xa3, 0x1d, 0xf4,
+	0xdc, 0x6a, 0x98, 0x36, 0xef, 0xb4, 0xc1, 0xc9, 0x4f, 0x21, 0x14, 0x93, 0x86, 0xfc, 0x5e, 0xec,
+	0xbd, 0x8d, 0xdc, 0x11, 0xf6, 0xab, 0xff, 0x63, 0x08, 0xcc, 0xa2, 0xdb, 0xe0, 0xd1, 0xa3, 0xc3,
+	0xed, 0x5b, 0x24, 0x84, 0xe0, 0xd7, 0xf4, 0xe8, 0xe8, 0x78, 0xdb, 0x21, 0x1d, 0xf0, 0x0f, 0xbe,
+	0x79, 0x71, 0xb4, 0xed, 0xf6, 0xff, 0xea, 0x82, 0xff, 0x2c, 0x9d, 0x4b, 0xf2, 0x4b, 0xe8, 0x96,
+	0x57, 0xba, 0x97, 0x83, 0xfe, 0x3f, 0x6a, 0xfc, 0x35, 0x65, 0xf0, 0xac, 0x69, 0x65, 0x47, 0x95,
+	0x12, 0x17, 0x34, 0x2c, 0x9b, 0x31, 0x79, 0x0c, 0x1b, 0x25, 0xd6, 0x66, 0xb3, 0x6b, 0x17, 0xe5,
+	0x0f, 0xae, 0xcb, 0x75, 0xbd, 0x9a, 0x6d, 0x1b, 0x83, 0x6e, 0xb9, 0x8c, 0xec, 0xfe, 0x0a, 0x36,
+	0xaf, 0xfb, 0x93, 0x6d, 0xf0, 0xbe, 0x67, 0x17, 0x98, 0x46, 0x8f, 0xea, 0x4f, 0xb2, 0x03, 0xc1,
+	0xeb, 0xb4, 0xa8, 0x19, 0x5e, 0xbf, 0x90, 0x9a, 0xc1, 0xbe, 0xfb, 0x85, 0xb3, 0x7b, 0x0c, 0xdb,
+	0xab, 0xf6, 0x57, 0xf5, 0x1d, 0xa3, 0xff, 0xf8, 0xaa, 0xfe, 0x66, 0x52, 0x96, 0x7e, 0xfd, 0xff,
+	0x3a, 0xd0, 0x7b, 0x26, 0xcf, 0x5e, 0xe6, 0xea, 0xd5, 0xb7, 0x15, 0xe3, 0x33, 0xf2, 0x3e, 0x04,
+	0x2a, 0x57, 0x05, 0x43, 0xbb, 0xf0, 0xe9, 0x2d, 0x6a, 0x86, 0x24, 0x82, 0x96, 0x4c, 0x8b, 0x54,
+	0x5c, 0xa0, 0xa7, 0xf7, 0xf4, 0x16, 0xb5, 0x63, 0xb2, 0x0b, 0xed, 0x2f, 0x79, 0xad, 0x57, 0x12,
+	0x79, 0x56, 0xd3, 0x04, 0xc8, 0x47, 0xd0, 0x7b, 0xc5, 0x4b, 0x36, 0x49, 0xb3, 0x4c, 0x30, 0x29,
+	0x23, 0xdf, 0x12, 0xba, 0x3a, 0xfa, 0xd8, 0x04, 0xc9, 0x11, 0xdc, 0x2e, 0xe5, 0xd9, 0xe4, 0x3c,
+	0x57, 0xaf, 0x26, 0x82, 0xfd, 0xbe, 0xce, 0x05, 0xcb, 0xb0, 0x6b, 0x74, 0x87, 0x77, 0x17, 0x07,
+	0x6b, 0xd6, 0x48, 0x2d, 0xfc, 0xf4, 0x16, 0xdd, 0x2a, 0xaf, 0x87, 0x0e, 0xda, 0x10, 0xd4, 0x55,
+	0xce, 0xab, 0xfe, 0x27, 0xe0, 0x53, 0x96, 0x16, 0xcb, 0x53, 0x74, 0x4c, 0xab, 0xc1, 0xc1, 0xc3,
+	0x4e, 0x27, 0xdb, 0xbe, 0xbc, 0xbc, 0xbc, 0x74, 0xfb, 0xe7, 0x7a, 0xe1, 0xfa, 0x40, 0xde, 0x90,
+	0xfb, 0x10, 0xe6, 0x65, 0x7a, 0x96, 0x57, 0x7a, 0x83, 0x86, 0xbe, 0x0c, 0x2c, 0x25, 0xc3, 0x43,
+	0xd8, 0x14, 0x2c, 0x2d, 0x26, 0xec, 0x8d, 0x62, 0x95, 0xcc, 0x79, 0x45, 0x7a, 0xcb, 0xca, 0x4c,
+	0x8b, 0xe8, 0x0f, 0xd7, 0x4b, 0xdb, 0xda, 0xd3, 0x0d, 0x2d, 0x3a, 0x6a, 0x34, 0xfd, 0x7f, 0x07,
+	0x00, 0x5f, 0x57, 0xfc, 0xbc, 0x7a, 0x7e, 0x31, 0x67, 0x92, 0x7c, 0x0c, 0x6e, 0x5a, 0xe1, 0xb3,
+	0xd1, 0x1d, 0xee, 0x0c, 0xcc, 0x83, 0x3f, 0x68, 0x1e, 0xfc, 0xc1, 0xe3, 0xea, 0x82, 0xba, 0x69,
+	0x45, 0x3e, 0x03, 0x2f, 0xab, 0xcd, 0x65, 0xef, 0x0e, 0xef, 0xdd, 0xa0, 0x1d, 0xda, 0xbf, 0x1d,
+	0x54, 0xb3, 0xc8, 0x4f, 0xc0, 0x95, 0x2a, 0xea, 0xd9, 0x33, 0x5c, 0xe5, 0x9e, 0xe0, 0x5f, 0x10,
+	0xea, 0x4a, 0xdd, 0x44, 0x5c, 0x25, 0x6d, 0x99, 0xec, 0xde, 0x20, 0x3e, 0x6f, 0xfe, 0x8d, 0x50,
+	0x57, 0x49, 0xcd, 0x2d, 0x5e, 0x47, 0x5b, 0x6b, 0xb8, 0xdf, 0xe4, 0x52, 0xfd, 0x4e, 0x9f, 0x30,
+	0x75, 0x8b, 0xd7, 0x24, 0x01, 0xef, 0x75, 0x5a, 0xe0, 0x8b, 0xd6, 0x1d, 0xbe, 0x7f, 0x83, 0x6c,
+	0x88, 0x9a, 0x42, 0x06, 0xe0, 0x65, 0xd3, 0x02, 0x4b, 0xa7, 0x3b, 0xbc, 0x7f, 0x73, 0x5f, 0xd8,
+	0x2b, 0x2d, 0x3f, 0x9b, 0x16, 0xe4, 0x11, 0x78, 0xb3, 0x42, 0x61, 0x25, 0xe9, 0x7b, 0xbb, 0xca,
+	0xc7, 0xae, 0x6b, 0xe9, 0xb3, 0x42, 0x69, 0x7a, 0x8e, 0x4d, 0xfe, 0xed, 0x74, 0xbc, 0x89, 0x96,
+	0x9e, 0x8f, 0x47, 0x7a, 0x35, 0xf5, 0x78, 0x14, 0xb5, 0xd6, 0xac, 0xe6, 0xc5, 0x55, 0x7e, 0x3d,
+	0x1e, 0xa1, 0xfd, 0xde, 0x30, 0x6a, 0xaf, 0xb7, 0xdf, 0x1b, 0x36, 0xf6, 0x7b, 0x43, 0xb4, 0xdf,
+	0x1b, 0x46, 0x9d, 0x77, 0xd8, 0x2f, 0xf8, 0x35, 0xf2, 0x7d, 0x7c, 0x09, 0xc3, 0x35, 0x87, 0xae,
+	0x5b, 0x81, 0xa1, 0x23, 0x4f, 0xfb, 0xeb, 0xa6, 0x06, 0x6b, 0xfc, 0xcd, 0xeb, 0x62, 0xfd, 0xa5,
+	0x12, 0xe4, 0x67, 0x10, 0x34, 0xaf, 0xcc, 0xdb, 0x37, 0x80, 0xaf, 0x8e, 0x11, 0x18, 0x66, 0xff,
+	0x23, 0xd8, 0x5a, 0xb9, 0x8c, 0x64, 0xdb, 0xcc, 0xea, 0xc4, 0x6e, 0x12, 0xa2, 0x6f, 0xff, 0x6f,
+	0x2e, 0xdc, 0xb5, 0xac, 0xaf, 0xaa, 0x2c, 0x17, 0xec, 0x54, 0x2d, 0xd8, 0x9f, 0x81, 0x2f, 0xeb,
+	0x69, 0x19, 0x39, 0xef, 0xbc, 0xe1, 0x14, 0x49, 0xe4, 0x37, 0x10, 0x96, 0xe9, 0x7c, 0x32, 0xcb,
+	0x59, 0x91, 0xd9, 0x66, 0xfb, 0x68, 0x45, 0xb1, 0x3a, 0x81, 0x6e, 0xc2, 0x4f, 0x34, 0xdf, 0x34,
+	0xdf, 0x4e, 0x69, 0x87, 0xe4, 0x0b, 0xe8, 0xca, 0x22, 0x3f, 0x65, 0xd6, 0xcd, 0x8b, 0xbd, 0x77,
+	0xcd, 0x0f, 0xc8, 0x45, 0xe5, 0xee, 0x73, 0xd8, 0xb8, 0x66, 0x7a, 0xb5, 0xe5, 0x86, 0xa6, 0xe5,
+	0x3e, 0xba, 0xde, 0x72, 0xd7, 0xda, 0x5e, 0xe9, 0xbd, 0x0f, 0x61, 0x67, 0x05, 0xc5, 0xd3, 0x26,
+	0x04, 0xfc, 0xe9, 0x85, 0x92, 0x78, 0x9e, 0x3d, 0x8a, 0xdf, 0xfd, 0x43, 0x20, 0x2b, 0xdc, 0x97,
+	0x5f, 0x3f, 0x6f, 0xd2, 0xad, 0x89, 0x3f, 0x24, 0xdd, 0xfd, 0x13, 0xb8, 0x7d, 0x54, 0xe6, 0xea,
+	0x90, 0xcd, 0xd2, 0xba, 0x50, 0xdf, 0xce, 0x75, 0xc3, 0x20, 0x1f, 0x42, 0xab, 0x4c, 0xc5, 0xf7,
+	0x2c, 0xc3, 0xed, 0x04, 0xfb, 0xce, 0xcf, 0x0f, 0xfc, 0xcb, 0x7f, 0x46, 0x0e, 0xb5, 0x61, 0xf2,
+	0x00, 0x3a, 0x75, 0x65, 0x29, 0xae, 0xa1, 0x8c, 0xe9, 0x22, 0xb4, 0x1f, 0x83, 0x5f, 0xa5, 0x25,
+	0x5b, 0xe9, 0x84, 0x7f, 0xc4, 0xa3, 0x41, 0x64, 0xff, 0x17, 0xe0, 0xb3, 0x37, 0xaa, 0x5c, 0x61,
+	0xfc, 0xe9, 0xff, 0xe4, 0x5f, 0x4b, 0xf6, 0x0f, 0xa0, 0xc7, 0xca, 0x5c, 0x4d, 0x32, 0xb3, 0x64,
+	0xf2, 0xe0, 0xe6, 0x85, 0xd7, 0x59, 0x31, 0x5b, 0x91, 0xd1, 0x7f, 0xfe, 0xec, 0xe1, 0x2b, 0xd8,
+	0x65, 0xcb, 0x6d, 0xfe, 0x6f, 0x00, 0xb1, 0x88, 0xb1, 0xab, 0xfc, 0x0d, 0x00, 0x00,
 }
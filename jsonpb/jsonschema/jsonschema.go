@@ -0,0 +1,546 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package jsonschema generates JSON Schema documents describing the JSON
+representation of protocol buffer messages used by package jsonpb.
+
+The schemas follow JSON Schema draft-07. By default they describe the
+output of a jsonpb.Marshaler with the same options: fields are named by
+their json_name, 64-bit integers are strings, enums are names and
+well-known types use their special JSON forms. With Lenient set, they
+instead describe every input accepted by a jsonpb.Unmarshaler.
+*/
+package jsonschema
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/descriptor/builder"
+	"github.com/golang/protobuf/descriptor/registry"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Draft is the URI of the JSON Schema version used by generated schemas.
+const Draft = "http://json-schema.org/draft-07/schema#"
+
+// Schema is a JSON Schema document or subschema.
+// Only the keywords used by this package are represented.
+type Schema struct {
+	Schema          string        `json:"$schema,omitempty"`
+	Ref             string        `json:"$ref,omitempty"`
+	Title           string        `json:"title,omitempty"`
+	Type            string        `json:"type,omitempty"`
+	Format          string        `json:"format,omitempty"`
+	Pattern         string        `json:"pattern,omitempty"`
+	ContentEncoding string        `json:"contentEncoding,omitempty"`
+	Enum            []interface{} `json:"enum,omitempty"`
+	Minimum         *float64      `json:"minimum,omitempty"`
+	Maximum         *float64      `json:"maximum,omitempty"`
+
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	PropertyNames        *Schema            `json:"propertyNames,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"` // bool or *Schema
+
+	AllOf []*Schema `json:"allOf,omitempty"`
+	AnyOf []*Schema `json:"anyOf,omitempty"`
+	OneOf []*Schema `json:"oneOf,omitempty"`
+	Not   *Schema   `json:"not,omitempty"`
+
+	Definitions map[string]*Schema `json:"definitions,omitempty"`
+}
+
+// Generator is a configurable object for generating JSON Schemas.
+// Its options mirror those of jsonpb.Marshaler and jsonpb.Unmarshaler.
+type Generator struct {
+	// Whether enum values are rendered as integers, as opposed to string values.
+	EnumsAsInts bool
+
+	// Whether fields are named by their original (.proto) name.
+	OrigName bool
+
+	// Whether messages may contain unknown fields.
+	AllowUnknownFields bool
+
+	// Whether to describe every form accepted by jsonpb.Unmarshaler,
+	// such as quoted numbers, enum numbers, original field names and
+	// null values, rather than only the form produced by jsonpb.Marshaler.
+	Lenient bool
+}
+
+// ForMessage returns a schema for the JSON form of msg using the default options.
+func ForMessage(msg descriptor.Message) (*Schema, error) {
+	return new(Generator).ForMessage(msg)
+}
+
+// ForMessage returns a schema for the JSON form of msg. The descriptors of
+// the files msg depends on are looked up with proto.FileDescriptor.
+func (g *Generator) ForMessage(msg descriptor.Message) (*Schema, error) {
+	fd, _ := descriptor.ForMessage(msg)
+	set := new(descpb.FileDescriptorSet)
+	seen := make(map[string]bool)
+	var add func(*descpb.FileDescriptorProto) error
+	add = func(fd *descpb.FileDescriptorProto) error {
+		if seen[fd.GetName()] {
+			return nil
+		}
+		seen[fd.GetName()] = true
+		for _, dep := range fd.Dependency {
+			dfd, err := registry.FindFile(dep)
+			if err != nil {
+				return fmt.Errorf("jsonschema: dependency of %q: %v", fd.GetName(), err)
+			}
+			if err := add(dfd); err != nil {
+				return err
+			}
+		}
+		set.File = append(set.File, fd)
+		return nil
+	}
+	if err := add(fd); err != nil {
+		return nil, err
+	}
+	return g.ForFileDescriptorSet(set, proto.MessageName(msg))
+}
+
+// ForFileDescriptorSet returns a schema for the JSON form of the message with
+// the fully-qualified name msgName, which must be defined in set.
+// Every type referenced by the message must also be defined in set.
+func (g *Generator) ForFileDescriptorSet(set *descpb.FileDescriptorSet, msgName string) (*Schema, error) {
+	st := &state{
+		g:          g,
+		messages:   make(map[string]*descpb.DescriptorProto),
+		enums:      make(map[string]*descpb.EnumDescriptorProto),
+		extensions: make(map[string][]extension),
+		defs:       make(map[string]*Schema),
+	}
+	for _, fd := range set.File {
+		st.addFile(fd)
+	}
+	if _, ok := st.messages[msgName]; !ok {
+		return nil, fmt.Errorf("jsonschema: unknown message %q", msgName)
+	}
+	root, err := st.messageRef(msgName)
+	if err != nil {
+		return nil, err
+	}
+	root.Schema = Draft
+	root.Definitions = st.defs
+	return root, nil
+}
+
+// extension is an extension field together with its fully-qualified name.
+type extension struct {
+	name  string
+	field *descpb.FieldDescriptorProto
+}
+
+// state holds the type index and definitions built for a single schema.
+type state struct {
+	g          *Generator
+	messages   map[string]*descpb.DescriptorProto
+	enums      map[string]*descpb.EnumDescriptorProto
+	extensions map[string][]extension // keyed by extendee name
+	defs       map[string]*Schema
+}
+
+func (st *state) addFile(fd *descpb.FileDescriptorProto) {
+	prefix := fd.GetPackage()
+	for _, md := range fd.MessageType {
+		st.addMessage(prefix, md)
+	}
+	for _, ed := range fd.EnumType {
+		st.enums[join(prefix, ed.GetName())] = ed
+	}
+	st.addExtensions(prefix, fd.Extension)
+}
+
+func (st *state) addMessage(prefix string, md *descpb.DescriptorProto) {
+	name := join(prefix, md.GetName())
+	st.messages[name] = md
+	for _, nested := range md.NestedType {
+		st.addMessage(name, nested)
+	}
+	for _, ed := range md.EnumType {
+		st.enums[join(name, ed.GetName())] = ed
+	}
+	st.addExtensions(name, md.Extension)
+}
+
+func (st *state) addExtensions(prefix string, fields []*descpb.FieldDescriptorProto) {
+	for _, f := range fields {
+		extendee := strings.TrimPrefix(f.GetExtendee(), ".")
+		st.extensions[extendee] = append(st.extensions[extendee], extension{join(prefix, f.GetName()), f})
+	}
+}
+
+func join(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// messageRef returns a reference to the definition of the named message,
+// generating the definition if necessary.
+func (st *state) messageRef(name string) (*Schema, error) {
+	if s, ok := wellKnownTypes[name]; ok {
+		return s(st.g), nil
+	}
+	ref := &Schema{Ref: "#/definitions/" + name}
+	if _, ok := st.defs[name]; ok {
+		return ref, nil
+	}
+	md, ok := st.messages[name]
+	if !ok {
+		return nil, fmt.Errorf("jsonschema: unknown message %q", name)
+	}
+	// Reserve the definition before generating it, for recursive messages.
+	def := &Schema{Type: "object", Title: name}
+	st.defs[name] = def
+	if err := st.fillMessage(def, name, md); err != nil {
+		return nil, err
+	}
+	return ref, nil
+}
+
+func (st *state) fillMessage(def *Schema, name string, md *descpb.DescriptorProto) error {
+	def.Properties = make(map[string]*Schema)
+	oneofs := make([][]*Schema, len(md.OneofDecl))
+	for _, f := range md.Field {
+		fs, err := st.field(f)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %v", name, f.GetName(), err)
+		}
+		names := st.fieldNames(f)
+		for _, n := range names {
+			def.Properties[n] = fs
+		}
+		if f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REQUIRED {
+			if len(names) == 1 {
+				def.Required = append(def.Required, names[0])
+			} else {
+				def.AllOf = append(def.AllOf, anyRequired(names))
+			}
+		}
+		if f.OneofIndex != nil {
+			i := f.GetOneofIndex()
+			oneofs[i] = append(oneofs[i], anyRequired(names))
+		}
+	}
+	// "At most one field of a oneof may be present": exactly one of the
+	// alternatives, or none of them.
+	for _, alts := range oneofs {
+		if len(alts) < 2 {
+			continue
+		}
+		none := &Schema{Not: &Schema{AnyOf: alts}}
+		def.AllOf = append(def.AllOf, &Schema{OneOf: append(alts[:len(alts):len(alts)], none)})
+	}
+	exts := st.extensions[name]
+	sort.Slice(exts, func(i, j int) bool { return exts[i].name < exts[j].name })
+	for _, ext := range exts {
+		fs, err := st.field(ext.field)
+		if err != nil {
+			return fmt.Errorf("extension %s: %v", ext.name, err)
+		}
+		def.Properties["["+ext.name+"]"] = fs
+	}
+	if !st.g.AllowUnknownFields {
+		def.AdditionalProperties = false
+	}
+	return nil
+}
+
+// anyRequired returns a schema requiring at least one of names to be present.
+func anyRequired(names []string) *Schema {
+	if len(names) == 1 {
+		return &Schema{Required: names}
+	}
+	s := new(Schema)
+	for _, n := range names {
+		s.AnyOf = append(s.AnyOf, &Schema{Required: []string{n}})
+	}
+	return s
+}
+
+// fieldNames returns the property names under which field f may appear.
+func (st *state) fieldNames(f *descpb.FieldDescriptorProto) []string {
+	orig, camel := f.GetName(), f.GetJsonName()
+	if camel == "" {
+		camel = builder.JSONName(orig)
+	}
+	switch {
+	case st.g.Lenient && orig != camel:
+		return []string{camel, orig}
+	case st.g.OrigName:
+		return []string{orig}
+	default:
+		return []string{camel}
+	}
+}
+
+// field returns the schema for the value of field f.
+func (st *state) field(f *descpb.FieldDescriptorProto) (*Schema, error) {
+	if f.GetType() == descpb.FieldDescriptorProto_TYPE_MESSAGE {
+		if entry := st.messages[strings.TrimPrefix(f.GetTypeName(), ".")]; entry.GetOptions().GetMapEntry() {
+			return st.mapField(entry)
+		}
+	}
+	s, err := st.singular(f)
+	if err != nil {
+		return nil, err
+	}
+	repeated := f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED
+	if repeated {
+		s = &Schema{Type: "array", Items: s}
+	}
+	// Marshaler.EmitDefaults renders unset messages as null, and
+	// Unmarshaler treats null as an unset value of any type.
+	isMessage := f.GetType() == descpb.FieldDescriptorProto_TYPE_MESSAGE ||
+		f.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP
+	if (st.g.Lenient || isMessage && !repeated) && !acceptsNull[strings.TrimPrefix(f.GetTypeName(), ".")] {
+		s = nullable(s)
+	}
+	return s, nil
+}
+
+func (st *state) mapField(entry *descpb.DescriptorProto) (*Schema, error) {
+	var key, val *descpb.FieldDescriptorProto
+	for _, f := range entry.Field {
+		switch f.GetNumber() {
+		case 1:
+			key = f
+		case 2:
+			val = f
+		}
+	}
+	if key == nil || val == nil {
+		return nil, fmt.Errorf("malformed map entry %q", entry.GetName())
+	}
+	vs, err := st.singular(val)
+	if err != nil {
+		return nil, err
+	}
+	s := &Schema{Type: "object", AdditionalProperties: vs}
+	switch key.GetType() {
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		s.PropertyNames = &Schema{Enum: []interface{}{"true", "false"}}
+	case descpb.FieldDescriptorProto_TYPE_STRING:
+	default:
+		s.PropertyNames = &Schema{Pattern: intPattern(isUnsigned(key.GetType()))}
+	}
+	if st.g.Lenient {
+		s = nullable(s)
+	}
+	return s, nil
+}
+
+// singular returns the schema for a single value of the type of field f.
+func (st *state) singular(f *descpb.FieldDescriptorProto) (*Schema, error) {
+	switch t := f.GetType(); t {
+	case descpb.FieldDescriptorProto_TYPE_MESSAGE, descpb.FieldDescriptorProto_TYPE_GROUP:
+		return st.messageRef(strings.TrimPrefix(f.GetTypeName(), "."))
+	case descpb.FieldDescriptorProto_TYPE_ENUM:
+		name := strings.TrimPrefix(f.GetTypeName(), ".")
+		if name == "google.protobuf.NullValue" {
+			return &Schema{Type: "null"}, nil
+		}
+		ed, ok := st.enums[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown enum %q", name)
+		}
+		return st.enum(ed), nil
+	default:
+		return scalar(t, st.g.Lenient), nil
+	}
+}
+
+func (st *state) enum(ed *descpb.EnumDescriptorProto) *Schema {
+	names := &Schema{Type: "string"}
+	numbers := &Schema{Type: "integer"}
+	for _, v := range ed.Value {
+		names.Enum = append(names.Enum, v.GetName())
+		numbers.Enum = append(numbers.Enum, v.GetNumber())
+	}
+	switch {
+	case st.g.Lenient:
+		// Unknown enum numbers are accepted as well.
+		return &Schema{AnyOf: []*Schema{names, int32Schema(false, false)}}
+	case st.g.EnumsAsInts:
+		return numbers
+	default:
+		return names
+	}
+}
+
+// scalar returns the schema for a value of the scalar type t.
+func scalar(t descpb.FieldDescriptorProto_Type, lenient bool) *Schema {
+	switch t {
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+		return &Schema{Type: "boolean"}
+	case descpb.FieldDescriptorProto_TYPE_STRING:
+		return &Schema{Type: "string"}
+	case descpb.FieldDescriptorProto_TYPE_BYTES:
+		return &Schema{Type: "string", ContentEncoding: "base64"}
+	case descpb.FieldDescriptorProto_TYPE_FLOAT, descpb.FieldDescriptorProto_TYPE_DOUBLE:
+		alts := []*Schema{
+			{Type: "number"},
+			{Type: "string", Enum: []interface{}{"NaN", "Infinity", "-Infinity"}},
+		}
+		if lenient {
+			alts = append(alts, &Schema{Type: "string", Pattern: `^-?[0-9]+(\.[0-9]*)?([eE][-+]?[0-9]+)?$`})
+		}
+		return &Schema{AnyOf: alts}
+	case descpb.FieldDescriptorProto_TYPE_INT32, descpb.FieldDescriptorProto_TYPE_SINT32,
+		descpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return int32Schema(false, lenient)
+	case descpb.FieldDescriptorProto_TYPE_UINT32, descpb.FieldDescriptorProto_TYPE_FIXED32:
+		return int32Schema(true, lenient)
+	default: // 64-bit integers
+		s := &Schema{Type: "string", Pattern: intPattern(isUnsigned(t))}
+		if lenient {
+			n := &Schema{Type: "integer"}
+			if isUnsigned(t) {
+				n.Minimum = float(0)
+			}
+			s = &Schema{AnyOf: []*Schema{n, s}}
+		}
+		return s
+	}
+}
+
+func int32Schema(unsigned, lenient bool) *Schema {
+	s := &Schema{Type: "integer", Minimum: float(math.MinInt32), Maximum: float(math.MaxInt32)}
+	if unsigned {
+		s.Minimum, s.Maximum = float(0), float(math.MaxUint32)
+	}
+	if lenient {
+		s = &Schema{AnyOf: []*Schema{s, {Type: "string", Pattern: intPattern(unsigned)}}}
+	}
+	return s
+}
+
+func isUnsigned(t descpb.FieldDescriptorProto_Type) bool {
+	switch t {
+	case descpb.FieldDescriptorProto_TYPE_UINT32, descpb.FieldDescriptorProto_TYPE_FIXED32,
+		descpb.FieldDescriptorProto_TYPE_UINT64, descpb.FieldDescriptorProto_TYPE_FIXED64:
+		return true
+	}
+	return false
+}
+
+func intPattern(unsigned bool) string {
+	if unsigned {
+		return "^[0-9]+$"
+	}
+	return "^-?[0-9]+$"
+}
+
+func float(f float64) *float64 { return &f }
+
+// nullable returns a schema that additionally accepts null.
+func nullable(s *Schema) *Schema {
+	return &Schema{AnyOf: []*Schema{s, {Type: "null"}}}
+}
+
+// wellKnownTypes maps the names of well-known types with special JSON
+// forms to functions returning their schemas.
+var wellKnownTypes = map[string]func(*Generator) *Schema{
+	"google.protobuf.Any": func(*Generator) *Schema {
+		return &Schema{
+			Type:       "object",
+			Properties: map[string]*Schema{"@type": {Type: "string"}},
+			Required:   []string{"@type"},
+		}
+	},
+	"google.protobuf.Duration": func(g *Generator) *Schema {
+		if g.Lenient {
+			// Any input accepted by time.ParseDuration.
+			return &Schema{Type: "string", Pattern: `^[-+]?([0-9]*(\.[0-9]*)?[a-zµμ]+)+$|^0$`}
+		}
+		return &Schema{Type: "string", Pattern: `^-?[0-9]+(\.[0-9]{3}|\.[0-9]{6}|\.[0-9]{9})?s$`}
+	},
+	"google.protobuf.Timestamp": func(*Generator) *Schema {
+		return &Schema{Type: "string", Format: "date-time"}
+	},
+	"google.protobuf.FieldMask": func(*Generator) *Schema {
+		// Comma-separated lowerCamelCase paths.
+		return &Schema{Type: "string", Pattern: `^([a-zA-Z0-9.]+(,[a-zA-Z0-9.]+)*)?$`}
+	},
+	"google.protobuf.Struct": func(*Generator) *Schema {
+		return &Schema{Type: "object"}
+	},
+	"google.protobuf.ListValue": func(*Generator) *Schema {
+		return &Schema{Type: "array"}
+	},
+	"google.protobuf.Value": func(*Generator) *Schema {
+		return &Schema{}
+	},
+	"google.protobuf.DoubleValue": wrapper(descpb.FieldDescriptorProto_TYPE_DOUBLE),
+	"google.protobuf.FloatValue":  wrapper(descpb.FieldDescriptorProto_TYPE_FLOAT),
+	"google.protobuf.Int64Value":  wrapper(descpb.FieldDescriptorProto_TYPE_INT64),
+	"google.protobuf.UInt64Value": wrapper(descpb.FieldDescriptorProto_TYPE_UINT64),
+	"google.protobuf.Int32Value":  wrapper(descpb.FieldDescriptorProto_TYPE_INT32),
+	"google.protobuf.UInt32Value": wrapper(descpb.FieldDescriptorProto_TYPE_UINT32),
+	"google.protobuf.BoolValue":   wrapper(descpb.FieldDescriptorProto_TYPE_BOOL),
+	"google.protobuf.StringValue": wrapper(descpb.FieldDescriptorProto_TYPE_STRING),
+	"google.protobuf.BytesValue":  wrapper(descpb.FieldDescriptorProto_TYPE_BYTES),
+}
+
+// acceptsNull is the set of well-known types whose schemas already accept null.
+var acceptsNull = map[string]bool{
+	"google.protobuf.Value":       true,
+	"google.protobuf.DoubleValue": true,
+	"google.protobuf.FloatValue":  true,
+	"google.protobuf.Int64Value":  true,
+	"google.protobuf.UInt64Value": true,
+	"google.protobuf.Int32Value":  true,
+	"google.protobuf.UInt32Value": true,
+	"google.protobuf.BoolValue":   true,
+	"google.protobuf.StringValue": true,
+	"google.protobuf.BytesValue":  true,
+}
+
+// wrapper returns the schema function for the wrapper of scalar type t,
+// which is represented as the wrapped scalar or null.
+func wrapper(t descpb.FieldDescriptorProto_Type) func(*Generator) *Schema {
+	return func(g *Generator) *Schema {
+		return nullable(scalar(t, g.Lenient))
+	}
+}
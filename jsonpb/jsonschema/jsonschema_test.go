@@ -0,0 +1,220 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+func TestSimple3(t *testing.T) {
+	s, err := ForMessage(&pb.Simple3{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"$schema":"http://json-schema.org/draft-07/schema#","$ref":"#/definitions/jsonpb.Simple3",` +
+		`"definitions":{"jsonpb.Simple3":{"title":"jsonpb.Simple3","type":"object",` +
+		`"properties":{"dub":{"anyOf":[{"type":"number"},{"type":"string","enum":["NaN","Infinity","-Infinity"]}]}},` +
+		`"additionalProperties":false}}}`
+	if string(b) != want {
+		t.Errorf("ForMessage(Simple3):\ngot  %s\nwant %s", b, want)
+	}
+}
+
+var propertyTests = []struct {
+	desc      string
+	generator Generator
+	msg       descriptor.Message
+	property  string
+	json      string
+}{
+	{"int32", Generator{}, &pb.Simple{}, "oInt32",
+		`{"type":"integer","minimum":-2147483648,"maximum":2147483647}`},
+	{"uint32", Generator{}, &pb.Simple{}, "oUint32",
+		`{"type":"integer","minimum":0,"maximum":4294967295}`},
+	{"int64", Generator{}, &pb.Simple{}, "oInt64",
+		`{"type":"string","pattern":"^-?[0-9]+$"}`},
+	{"uint64", Generator{}, &pb.Simple{}, "oUint64",
+		`{"type":"string","pattern":"^[0-9]+$"}`},
+	{"bytes", Generator{}, &pb.Simple{}, "oBytes",
+		`{"type":"string","contentEncoding":"base64"}`},
+	{"orig name", Generator{OrigName: true}, &pb.Simple{}, "o_bool",
+		`{"type":"boolean"}`},
+	{"lenient int64", Generator{Lenient: true}, &pb.Simple{}, "o_int64",
+		`{"anyOf":[{"anyOf":[{"type":"integer"},{"type":"string","pattern":"^-?[0-9]+$"}]},{"type":"null"}]}`},
+	{"enum", Generator{}, &pb.Widget{}, "color",
+		`{"type":"string","enum":["RED","GREEN","BLUE"]}`},
+	{"enum as int", Generator{EnumsAsInts: true}, &pb.Widget{}, "color",
+		`{"type":"integer","enum":[0,1,2]}`},
+	{"repeated enum", Generator{}, &pb.Widget{}, "rColor",
+		`{"type":"array","items":{"type":"string","enum":["RED","GREEN","BLUE"]}}`},
+	{"message", Generator{}, &pb.Widget{}, "simple",
+		`{"anyOf":[{"$ref":"#/definitions/jsonpb.Simple"},{"type":"null"}]}`},
+	{"repeated message", Generator{}, &pb.Widget{}, "rSimple",
+		`{"type":"array","items":{"$ref":"#/definitions/jsonpb.Simple"}}`},
+	{"map<int64, int32>", Generator{}, &pb.Mappy{}, "nummy",
+		`{"type":"object","propertyNames":{"pattern":"^-?[0-9]+$"},` +
+			`"additionalProperties":{"type":"integer","minimum":-2147483648,"maximum":2147483647}}`},
+	{"map<bool, bool>", Generator{}, &pb.Mappy{}, "booly",
+		`{"type":"object","propertyNames":{"enum":["true","false"]},"additionalProperties":{"type":"boolean"}}`},
+	{"map<int32, Object>", Generator{}, &pb.Mappy{}, "objjy",
+		`{"type":"object","propertyNames":{"pattern":"^-?[0-9]+$"},"additionalProperties":{"$ref":"#/definitions/jsonpb.Simple3"}}`},
+	{"Any", Generator{}, &pb.KnownTypes{}, "an",
+		`{"anyOf":[{"type":"object","properties":{"@type":{"type":"string"}},"required":["@type"]},{"type":"null"}]}`},
+	{"Duration", Generator{}, &pb.KnownTypes{}, "dur",
+		`{"anyOf":[{"type":"string","pattern":"^-?[0-9]+(\\.[0-9]{3}|\\.[0-9]{6}|\\.[0-9]{9})?s$"},{"type":"null"}]}`},
+	{"Timestamp", Generator{}, &pb.KnownTypes{}, "ts",
+		`{"anyOf":[{"type":"string","format":"date-time"},{"type":"null"}]}`},
+	{"Struct", Generator{}, &pb.KnownTypes{}, "st",
+		`{"anyOf":[{"type":"object"},{"type":"null"}]}`},
+	{"Value", Generator{}, &pb.KnownTypes{}, "val", `{}`},
+	{"Int64Value", Generator{}, &pb.KnownTypes{}, "i64",
+		`{"anyOf":[{"type":"string","pattern":"^-?[0-9]+$"},{"type":"null"}]}`},
+	{"BoolValue", Generator{}, &pb.KnownTypes{}, "bool",
+		`{"anyOf":[{"type":"boolean"},{"type":"null"}]}`},
+	{"extension", Generator{}, &pb.Real{}, "[jsonpb.name]", `{"type":"string"}`},
+	{"nested extension", Generator{}, &pb.Real{}, "[jsonpb.Complex.real_extension]",
+		`{"anyOf":[{"$ref":"#/definitions/jsonpb.Complex"},{"type":"null"}]}`},
+}
+
+func TestProperties(t *testing.T) {
+	for _, tt := range propertyTests {
+		s, err := tt.generator.ForMessage(tt.msg)
+		if err != nil {
+			t.Errorf("%s: %v", tt.desc, err)
+			continue
+		}
+		_, md := descriptor.ForMessage(tt.msg)
+		def := s.Definitions["jsonpb."+md.GetName()]
+		if def == nil {
+			t.Errorf("%s: no definition for %s", tt.desc, md.GetName())
+			continue
+		}
+		prop, ok := def.Properties[tt.property]
+		if !ok {
+			t.Errorf("%s: no property %q", tt.desc, tt.property)
+			continue
+		}
+		b, err := json.Marshal(prop)
+		if err != nil {
+			t.Errorf("%s: %v", tt.desc, err)
+			continue
+		}
+		if string(b) != tt.json {
+			t.Errorf("%s:\ngot  %s\nwant %s", tt.desc, b, tt.json)
+		}
+	}
+}
+
+func TestLenientNames(t *testing.T) {
+	s, err := (&Generator{Lenient: true}).ForMessage(&pb.Simple{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	props := s.Definitions["jsonpb.Simple"].Properties
+	for _, name := range []string{"oBool", "o_bool"} {
+		if _, ok := props[name]; !ok {
+			t.Errorf("lenient schema has no property %q", name)
+		}
+	}
+}
+
+func TestRequired(t *testing.T) {
+	s, err := ForMessage(&pb.MsgWithRequired{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	def := s.Definitions["jsonpb.MsgWithRequired"]
+	if len(def.Required) != 1 || def.Required[0] != "str" {
+		t.Errorf("required = %v, want [str]", def.Required)
+	}
+}
+
+func TestOneof(t *testing.T) {
+	s, err := ForMessage(&pb.MsgWithOneof{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	def := s.Definitions["jsonpb.MsgWithOneof"]
+	if len(def.AllOf) != 1 {
+		t.Fatalf("got %d allOf constraints, want 1", len(def.AllOf))
+	}
+	b, err := json.Marshal(def.AllOf[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	alts := `{"required":["title"]},{"required":["salary"]},{"required":["Country"]},` +
+		`{"required":["homeAddress"]},{"required":["msgWithRequired"]}`
+	want := `{"oneOf":[` + alts + `,{"not":{"anyOf":[` + alts + `]}}]}`
+	if string(b) != want {
+		t.Errorf("oneof constraint:\ngot  %s\nwant %s", b, want)
+	}
+	// Referenced messages are defined too.
+	if _, ok := s.Definitions["jsonpb.MsgWithRequired"]; !ok {
+		t.Errorf("no definition for jsonpb.MsgWithRequired")
+	}
+}
+
+func TestAllowUnknownFields(t *testing.T) {
+	s, err := (&Generator{AllowUnknownFields: true}).ForMessage(&pb.Simple3{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ap := s.Definitions["jsonpb.Simple3"].AdditionalProperties; ap != nil {
+		t.Errorf("additionalProperties = %v, want unset", ap)
+	}
+}
+
+func TestForFileDescriptorSet(t *testing.T) {
+	set := &descpb.FileDescriptorSet{}
+	if _, err := new(Generator).ForFileDescriptorSet(set, "jsonpb.Simple3"); err == nil {
+		t.Errorf("ForFileDescriptorSet with empty set succeeded, want error")
+	}
+
+	fd, _ := descriptor.ForMessage(&pb.Simple3{})
+	set.File = append(set.File, fd)
+	if _, err := new(Generator).ForFileDescriptorSet(set, "jsonpb.Simple3"); err != nil {
+		t.Errorf("ForFileDescriptorSet: %v", err)
+	}
+	// KnownTypes is defined in a file missing from the set.
+	if _, err := new(Generator).ForFileDescriptorSet(set, "jsonpb.KnownTypes"); err == nil {
+		t.Errorf("ForFileDescriptorSet with missing dependencies succeeded, want error")
+	}
+}
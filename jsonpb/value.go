@@ -0,0 +1,705 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+// This file implements conversion between protocol buffers and generic
+// Go values, such as those produced by encoding/json when decoding into
+// an interface{}, without going through serialized JSON.
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+
+	stpb "github.com/golang/protobuf/ptypes/struct"
+)
+
+// MarshalToInterface converts a protocol buffer object to a generic Go value
+// following the same mapping as Marshal. Messages and maps become
+// map[string]interface{}, repeated fields []interface{}, 32-bit integers and
+// floating-point numbers json.Number, 64-bit integers, bytes and enum names
+// strings, and unset messages nil.
+//
+// Messages implementing JSONPBMarshaler are converted by decoding the JSON
+// they produce.
+func (m *Marshaler) MarshalToInterface(pb proto.Message) (interface{}, error) {
+	v := reflect.ValueOf(pb)
+	if pb == nil || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return nil, errors.New("MarshalToInterface called with nil")
+	}
+	// Check for unset required fields first.
+	if err := checkRequiredFields(pb); err != nil {
+		return nil, err
+	}
+	return m.objectToInterface(pb, "")
+}
+
+// objectToInterface converts a message to a generic Go value.
+func (m *Marshaler) objectToInterface(v proto.Message, typeURL string) (interface{}, error) {
+	if jsm, ok := v.(JSONPBMarshaler); ok {
+		b, err := jsm.MarshalJSONPB(m)
+		if err != nil {
+			return nil, err
+		}
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.UseNumber()
+		var x interface{}
+		if err := dec.Decode(&x); err != nil {
+			return nil, fmt.Errorf("type %T produced invalid JSON: %v", v, err)
+		}
+		if typeURL != "" {
+			// we are marshaling this object to an Any type
+			obj, ok := x.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("type %T produced JSON that is not an object", v)
+			}
+			obj["@type"] = typeURL
+		}
+		return x, nil
+	}
+
+	s := reflect.ValueOf(v).Elem()
+
+	// Handle well-known types.
+	if name := wellKnownType(v); name == "Any" {
+		return m.anyToInterface(v)
+	} else if name != "" {
+		form, err := wellKnownValue(s, name)
+		if err != nil {
+			return nil, err
+		}
+		switch form := form.(type) {
+		case messageField:
+			return m.valueToInterface(form.prop, form.value)
+		case string:
+			return form, nil
+		}
+	}
+
+	obj := make(map[string]interface{})
+	if typeURL != "" {
+		obj["@type"] = typeURL
+	}
+	fields, err := m.messageFields(v)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		x, err := m.valueToInterface(f.prop, f.value)
+		if err != nil {
+			return nil, err
+		}
+		obj[f.prop.JSONName] = x
+	}
+	return obj, nil
+}
+
+func (m *Marshaler) anyToInterface(any proto.Message) (interface{}, error) {
+	turl, msg, err := unpackAny(m.AnyResolver, any)
+	if err != nil {
+		return nil, err
+	}
+
+	if isWellKnown(msg) {
+		x, err := m.objectToInterface(msg, "")
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"@type": turl, "value": x}, nil
+	}
+	return m.objectToInterface(msg, turl)
+}
+
+// valueToInterface converts the value of a field to a generic Go value.
+func (m *Marshaler) valueToInterface(prop *proto.Properties, v reflect.Value) (interface{}, error) {
+	v = reflect.Indirect(v)
+
+	// Handle nil pointer
+	if v.Kind() == reflect.Invalid {
+		return nil, nil
+	}
+
+	// Handle repeated elements.
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+		list := make([]interface{}, v.Len())
+		for i := range list {
+			x, err := m.valueToInterface(prop, v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			list[i] = x
+		}
+		return list, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		return m.objectToInterface(v.Addr().Interface().(proto.Message), "")
+	case reflect.Map:
+		vprop := prop
+		if prop.MapValProp != nil {
+			vprop = prop.MapValProp
+		}
+		obj := make(map[string]interface{}, v.Len())
+		for _, k := range v.MapKeys() {
+			x, err := m.valueToInterface(vprop, v.MapIndex(k))
+			if err != nil {
+				return nil, err
+			}
+			obj[mapKeyString(k)] = x
+		}
+		return obj, nil
+	}
+	return m.scalarValue(prop, v)
+}
+
+// UnmarshalInterface populates the fields of a protocol buffer from a
+// generic Go value, following the same mapping as Unmarshal.
+//
+// It accepts the values produced by encoding/json when decoding into an
+// interface{}, with or without json.Decoder.UseNumber, as well as values of
+// any Go integer or floating-point type, slices of any type and maps with
+// string keys, including the map[interface{}]interface{} produced by some
+// YAML decoders.
+//
+// If a value within v cannot be converted, the error is a *ValueError
+// locating it.
+func (u *Unmarshaler) UnmarshalInterface(v interface{}, pb proto.Message) error {
+	if err := u.interfaceToValue(reflect.ValueOf(pb).Elem(), v, nil); err != nil {
+		return err
+	}
+	return checkRequiredFields(pb)
+}
+
+// UnmarshalInterface populates the fields of a protocol buffer from a
+// generic Go value. This function is lenient and will decode any options
+// permutations of the related Marshaler.
+func UnmarshalInterface(v interface{}, pb proto.Message) error {
+	return new(Unmarshaler).UnmarshalInterface(v, pb)
+}
+
+// A ValueError reports a value that UnmarshalInterface could not convert.
+type ValueError struct {
+	// Path locates the value within the input. Each element is a string
+	// object key or an int list index.
+	Path []interface{}
+	Err  error
+}
+
+func (e *ValueError) Error() string {
+	var b bytes.Buffer
+	for i, step := range e.Path {
+		switch step := step.(type) {
+		case int:
+			fmt.Fprintf(&b, "[%d]", step)
+		default:
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			fmt.Fprint(&b, step)
+		}
+	}
+	if b.Len() == 0 {
+		return e.Err.Error()
+	}
+	return b.String() + ": " + e.Err.Error()
+}
+
+// atPath returns err located at step within the current value.
+func atPath(err error, step interface{}) error {
+	ve, ok := err.(*ValueError)
+	if !ok {
+		ve = &ValueError{Err: err}
+	}
+	ve.Path = append([]interface{}{step}, ve.Path...)
+	return ve
+}
+
+// interfaceToValue converts/copies a generic Go value into the target.
+// prop may be nil.
+func (u *Unmarshaler) interfaceToValue(target reflect.Value, in interface{}, prop *proto.Properties) error {
+	targetType := target.Type()
+
+	// Allocate memory for pointer fields.
+	if targetType.Kind() == reflect.Ptr {
+		// If input value is nil and target is a pointer type, then the field should be treated as not set
+		// UNLESS the target is structpb.Value, in which case it should be set to structpb.NullValue.
+		_, isJSONPBUnmarshaler := target.Interface().(JSONPBUnmarshaler)
+		if in == nil && targetType != reflect.TypeOf(&stpb.Value{}) && !isJSONPBUnmarshaler {
+			return nil
+		}
+		target.Set(reflect.New(targetType.Elem()))
+
+		return u.interfaceToValue(target.Elem(), in, prop)
+	}
+
+	if jsu, ok := target.Addr().Interface().(JSONPBUnmarshaler); ok {
+		norm, err := normalizeInterface(in)
+		if err != nil {
+			return err
+		}
+		b, err := json.Marshal(norm)
+		if err != nil {
+			return err
+		}
+		return jsu.UnmarshalJSONPB(u, b)
+	}
+
+	// Handle well-known types that are not pointers.
+	switch name := wellKnownType(target.Addr().Interface()); {
+	case wrapperTypes[name]:
+		return u.interfaceToValue(target.Field(0), in, prop)
+	case name == "Any":
+		return u.interfaceToAny(target, in)
+	case name == "FieldMask":
+		str, ok := in.(string)
+		if !ok {
+			return fmt.Errorf("bad FieldMask: got %T, want string", in)
+		}
+		return setFieldMask(target, str)
+	case name == "Duration":
+		str, ok := in.(string)
+		if !ok {
+			return fmt.Errorf("bad Duration: got %T, want string", in)
+		}
+		return setDuration(target, str)
+	case name == "Timestamp":
+		str, ok := in.(string)
+		if !ok {
+			return fmt.Errorf("bad Timestamp: got %T, want string", in)
+		}
+		return setTimestamp(target, str)
+	case name == "Struct":
+		obj, err := asObject(in)
+		if err != nil {
+			return fmt.Errorf("bad StructValue: %v", err)
+		}
+
+		target.Field(0).Set(reflect.ValueOf(map[string]*stpb.Value{}))
+		for k, x := range obj {
+			pv := &stpb.Value{}
+			if err := u.interfaceToValue(reflect.ValueOf(pv).Elem(), x, prop); err != nil {
+				return atPath(err, k)
+			}
+			target.Field(0).SetMapIndex(reflect.ValueOf(k), reflect.ValueOf(pv))
+		}
+		return nil
+	case name == "ListValue":
+		list, err := asList(in)
+		if err != nil {
+			return fmt.Errorf("bad ListValue: %v", err)
+		}
+
+		target.Field(0).Set(reflect.ValueOf(make([]*stpb.Value, len(list))))
+		for i, x := range list {
+			if err := u.interfaceToValue(target.Field(0).Index(i), x, prop); err != nil {
+				return atPath(err, i)
+			}
+		}
+		return nil
+	case name == "Value":
+		switch x := in.(type) {
+		case nil:
+			target.Field(0).Set(reflect.ValueOf(&stpb.Value_NullValue{}))
+		case string:
+			target.Field(0).Set(reflect.ValueOf(&stpb.Value_StringValue{StringValue: x}))
+		case bool:
+			target.Field(0).Set(reflect.ValueOf(&stpb.Value_BoolValue{BoolValue: x}))
+		default:
+			if f, err := toFloat64(in); err == nil {
+				target.Field(0).Set(reflect.ValueOf(&stpb.Value_NumberValue{NumberValue: f}))
+			} else if _, err := asList(in); err == nil {
+				lv := &stpb.ListValue{}
+				target.Field(0).Set(reflect.ValueOf(&stpb.Value_ListValue{ListValue: lv}))
+				return u.interfaceToValue(reflect.ValueOf(lv).Elem(), in, prop)
+			} else if _, err := asObject(in); err == nil {
+				sv := &stpb.Struct{}
+				target.Field(0).Set(reflect.ValueOf(&stpb.Value_StructValue{StructValue: sv}))
+				return u.interfaceToValue(reflect.ValueOf(sv).Elem(), in, prop)
+			} else {
+				return fmt.Errorf("unrecognized type for Value %T", in)
+			}
+		}
+		return nil
+	}
+
+	// Handle enums, which have an underlying type of int32,
+	// and may appear as strings.
+	if str, ok := in.(string); ok && prop != nil && prop.Enum != "" {
+		n, err := enumNumber(prop, str)
+		if err != nil {
+			return err
+		}
+		if targetType.Kind() != reflect.Int32 {
+			return fmt.Errorf("invalid target %q for enum %s", targetType.Kind(), prop.Enum)
+		}
+		target.SetInt(int64(n))
+		return nil
+	}
+
+	switch targetType.Kind() {
+	case reflect.Struct:
+		return u.interfaceToMessage(target, in)
+	case reflect.Slice:
+		if in == nil {
+			return nil
+		}
+		// Handle bytes, which are base64-encoded strings.
+		if targetType.Elem().Kind() == reflect.Uint8 {
+			switch x := in.(type) {
+			case []byte:
+				target.SetBytes(append([]byte{}, x...))
+				return nil
+			case string:
+				b, err := base64.StdEncoding.DecodeString(x)
+				if err != nil {
+					return err
+				}
+				target.SetBytes(b)
+				return nil
+			}
+			// Otherwise, like encoding/json, accept an array of bytes.
+		}
+		list, err := asList(in)
+		if err != nil {
+			return err
+		}
+		target.Set(reflect.MakeSlice(targetType, len(list), len(list)))
+		for i, x := range list {
+			if err := u.interfaceToValue(target.Index(i), x, prop); err != nil {
+				return atPath(err, i)
+			}
+		}
+		return nil
+	case reflect.Map:
+		if in == nil {
+			return nil
+		}
+		obj, err := asObject(in)
+		if err != nil {
+			return err
+		}
+		var kprop, vprop *proto.Properties
+		if prop != nil {
+			kprop, vprop = prop.MapKeyProp, prop.MapValProp
+		}
+		target.Set(reflect.MakeMap(targetType))
+		for ks, x := range obj {
+			// Map keys are always strings; convert them to the key type.
+			k := reflect.New(targetType.Key()).Elem()
+			if targetType.Key().Kind() == reflect.String {
+				k.SetString(ks)
+			} else if err := u.interfaceToValue(k, ks, kprop); err != nil {
+				return atPath(err, ks)
+			}
+			v := reflect.New(targetType.Elem()).Elem()
+			if err := u.interfaceToValue(v, x, vprop); err != nil {
+				return atPath(err, ks)
+			}
+			target.SetMapIndex(k, v)
+		}
+		return nil
+	}
+
+	// encoding/json leaves the target untouched when given null.
+	if in == nil {
+		return nil
+	}
+	switch targetType.Kind() {
+	case reflect.Bool:
+		switch x := in.(type) {
+		case bool:
+			target.SetBool(x)
+			return nil
+		case string:
+			// Map keys are given as strings.
+			b, err := strconv.ParseBool(x)
+			if err != nil {
+				return err
+			}
+			target.SetBool(b)
+			return nil
+		}
+	case reflect.Int32, reflect.Int64:
+		n, err := toInt64(in)
+		if err != nil {
+			return err
+		}
+		if target.OverflowInt(n) {
+			return fmt.Errorf("value %d overflows %v", n, targetType)
+		}
+		target.SetInt(n)
+		return nil
+	case reflect.Uint8, reflect.Uint32, reflect.Uint64:
+		n, err := toUint64(in)
+		if err != nil {
+			return err
+		}
+		if target.OverflowUint(n) {
+			return fmt.Errorf("value %d overflows %v", n, targetType)
+		}
+		target.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := toFloat64(in)
+		if err != nil {
+			return err
+		}
+		if !math.IsInf(f, 0) && target.OverflowFloat(f) {
+			return fmt.Errorf("value %v overflows %v", f, targetType)
+		}
+		target.SetFloat(f)
+		return nil
+	case reflect.String:
+		if str, ok := in.(string); ok {
+			target.SetString(str)
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot unmarshal %T into %v", in, targetType)
+}
+
+func (u *Unmarshaler) interfaceToAny(target reflect.Value, in interface{}) error {
+	obj, err := asObject(in)
+	if err != nil {
+		return err
+	}
+
+	turl, ok := obj["@type"].(string)
+	if !ok {
+		return errors.New("Any JSON doesn't have '@type'")
+	}
+	target.Field(0).SetString(turl)
+
+	m, err := resolveAny(u.AnyResolver, turl)
+	if err != nil {
+		return atPath(err, "@type")
+	}
+
+	if isWellKnown(m) {
+		val, ok := obj["value"]
+		if !ok {
+			return errors.New("Any JSON doesn't have 'value'")
+		}
+		if err := u.interfaceToValue(reflect.ValueOf(m).Elem(), val, nil); err != nil {
+			return atPath(err, "value")
+		}
+	} else {
+		nested := make(map[string]interface{}, len(obj))
+		for k, v := range obj {
+			if k != "@type" {
+				nested[k] = v
+			}
+		}
+		if err := u.interfaceToValue(reflect.ValueOf(m).Elem(), nested, nil); err != nil {
+			return err
+		}
+	}
+
+	return packAny(target, m)
+}
+
+// interfaceToMessage populates the message struct target from an object.
+func (u *Unmarshaler) interfaceToMessage(target reflect.Value, in interface{}) error {
+	obj, err := asObject(in)
+	if err != nil {
+		return err
+	}
+	keys := make(map[string]bool, len(obj))
+	for k := range obj {
+		keys[k] = true
+	}
+	for _, t := range u.fieldTargets(target, keys) {
+		if err := u.interfaceToValue(t.value, obj[t.key], t.prop); err != nil {
+			return atPath(err, t.key)
+		}
+		if err := t.setExtension(target); err != nil {
+			return err
+		}
+	}
+	if !u.AllowUnknownFields && len(keys) > 0 {
+		// Pick any field to be the scapegoat.
+		f := anyKey(keys)
+		return atPath(fmt.Errorf("unknown field %q in %v", f, target.Type()), f)
+	}
+	return nil
+}
+
+// asObject returns in as a map with string keys.
+func asObject(in interface{}) (map[string]interface{}, error) {
+	switch x := in.(type) {
+	case map[string]interface{}:
+		return x, nil
+	case map[interface{}]interface{}:
+		obj := make(map[string]interface{}, len(x))
+		for k, v := range x {
+			ks, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf("object key %v is a %T, not a string", k, k)
+			}
+			obj[ks] = v
+		}
+		return obj, nil
+	}
+	return nil, fmt.Errorf("cannot unmarshal %T into an object", in)
+}
+
+// asList returns in, which must be a slice or array, as a []interface{}.
+func asList(in interface{}) ([]interface{}, error) {
+	if list, ok := in.([]interface{}); ok {
+		return list, nil
+	}
+	v := reflect.ValueOf(in)
+	if (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) || v.Type().Elem().Kind() == reflect.Uint8 {
+		return nil, fmt.Errorf("cannot unmarshal %T into an array", in)
+	}
+	list := make([]interface{}, v.Len())
+	for i := range list {
+		list[i] = v.Index(i).Interface()
+	}
+	return list, nil
+}
+
+// normalizeInterface returns a copy of in in which every map has string keys,
+// so that it can be encoded by encoding/json.
+func normalizeInterface(in interface{}) (interface{}, error) {
+	switch in.(type) {
+	case map[string]interface{}, map[interface{}]interface{}:
+		obj, err := asObject(in)
+		if err != nil {
+			return nil, err
+		}
+		norm := make(map[string]interface{}, len(obj))
+		for k, v := range obj {
+			if norm[k], err = normalizeInterface(v); err != nil {
+				return nil, err
+			}
+		}
+		return norm, nil
+	case []interface{}:
+		list := in.([]interface{})
+		norm := make([]interface{}, len(list))
+		for i, v := range list {
+			var err error
+			if norm[i], err = normalizeInterface(v); err != nil {
+				return nil, err
+			}
+		}
+		return norm, nil
+	}
+	return in, nil
+}
+
+// toInt64 converts a number, or a string holding a decimal integer, to an int64.
+func toInt64(in interface{}) (int64, error) {
+	switch x := in.(type) {
+	case json.Number:
+		return strconv.ParseInt(string(x), 10, 64)
+	case string:
+		return strconv.ParseInt(x, 10, 64)
+	}
+	v := reflect.ValueOf(in)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v.Uint() > math.MaxInt64 {
+			return 0, fmt.Errorf("value %d overflows int64", v.Uint())
+		}
+		return int64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+			return 0, fmt.Errorf("value %v is not an int64", f)
+		}
+		return int64(f), nil
+	}
+	return 0, fmt.Errorf("cannot unmarshal %T into an integer", in)
+}
+
+// toUint64 converts a number, or a string holding a decimal integer, to a uint64.
+func toUint64(in interface{}) (uint64, error) {
+	switch x := in.(type) {
+	case json.Number:
+		return strconv.ParseUint(string(x), 10, 64)
+	case string:
+		return strconv.ParseUint(x, 10, 64)
+	}
+	v := reflect.ValueOf(in)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() < 0 {
+			return 0, fmt.Errorf("value %d is negative", v.Int())
+		}
+		return uint64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 {
+			return 0, fmt.Errorf("value %v is not a uint64", f)
+		}
+		return uint64(f), nil
+	}
+	return 0, fmt.Errorf("cannot unmarshal %T into an unsigned integer", in)
+}
+
+// toFloat64 converts a number, or a string holding a number or one of the
+// non-finite values "NaN", "Infinity" and "-Infinity", to a float64.
+func toFloat64(in interface{}) (float64, error) {
+	switch x := in.(type) {
+	case json.Number:
+		return strconv.ParseFloat(string(x), 64)
+	case string:
+		if f, ok := nonFinite[strconv.Quote(x)]; ok {
+			return f, nil
+		}
+		return strconv.ParseFloat(x, 64)
+	}
+	v := reflect.ValueOf(in)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	}
+	return 0, fmt.Errorf("cannot unmarshal %T into a number", in)
+}
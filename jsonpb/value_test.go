@@ -0,0 +1,211 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package jsonpb
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	stpb "github.com/golang/protobuf/ptypes/struct"
+)
+
+// decodeJSON decodes s into a generic Go value.
+func decodeJSON(t *testing.T, s string, useNumber bool) interface{} {
+	dec := json.NewDecoder(strings.NewReader(s))
+	if useNumber {
+		dec.UseNumber()
+	}
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		t.Fatalf("decoding %s: %v", s, err)
+	}
+	return v
+}
+
+func TestMarshalToInterface(t *testing.T) {
+	for _, tt := range marshalingTests {
+		got, err := tt.marshaler.MarshalToInterface(tt.pb)
+		if err != nil {
+			t.Errorf("%s: %v", tt.desc, err)
+			continue
+		}
+		want := decodeJSON(t, tt.json, true)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %#v, want %#v", tt.desc, got, want)
+		}
+	}
+}
+
+func TestMarshalToInterfaceJSONPBMarshaler(t *testing.T) {
+	rawJSON := `{ "foo": "bar", "baz": [0, 1, 2, 3] }`
+	msg := dynamicMessage{RawJson: rawJSON}
+	got, err := new(Marshaler).MarshalToInterface(&msg)
+	if err != nil {
+		t.Fatalf("an unexpected error occurred when marshalling JSONPBMarshaler: %v", err)
+	}
+	if want := decodeJSON(t, rawJSON, true); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestMarshalToInterfaceNil(t *testing.T) {
+	var msg *pb.Simple
+	if _, err := new(Marshaler).MarshalToInterface(msg); err == nil {
+		t.Errorf("MarshalToInterface(nil) succeeded, want error")
+	}
+}
+
+func TestUnmarshalInterface(t *testing.T) {
+	for _, useNumber := range []bool{true, false} {
+		for _, tt := range unmarshalingTests {
+			in := decodeJSON(t, tt.json, useNumber)
+			p := reflect.New(reflect.TypeOf(tt.pb).Elem()).Interface().(proto.Message)
+			if err := tt.unmarshaler.UnmarshalInterface(in, p); err != nil {
+				t.Errorf("%s (UseNumber=%v): %v", tt.desc, useNumber, err)
+				continue
+			}
+			// For easier diffs, compare text strings of the protos.
+			exp := proto.MarshalTextString(tt.pb)
+			act := proto.MarshalTextString(p)
+			if exp != act {
+				t.Errorf("%s (UseNumber=%v): got [%s] want [%s]", tt.desc, useNumber, act, exp)
+			}
+		}
+	}
+}
+
+func TestUnmarshalInterfaceGoValues(t *testing.T) {
+	tests := []struct {
+		desc string
+		in   interface{}
+		pb   proto.Message
+	}{
+		{"Go integers", map[string]interface{}{"oInt32": 7, "oUint64": uint8(8), "oInt64": int64(-9)},
+			&pb.Simple{OInt32: proto.Int32(7), OUint64: proto.Uint64(8), OInt64: proto.Int64(-9)}},
+		{"integral float", map[string]interface{}{"oInt32": 7.0, "oFloat": float32(1.5)},
+			&pb.Simple{OInt32: proto.Int32(7), OFloat: proto.Float32(1.5)}},
+		{"bytes", map[string]interface{}{"oBytes": []byte("wow")}, &pb.Simple{OBytes: []byte("wow")}},
+		{"YAML-style map", map[interface{}]interface{}{"rString": []string{"a", "b"}},
+			&pb.Repeats{RString: []string{"a", "b"}}},
+		{"Struct from YAML-style map", map[interface{}]interface{}{"a": map[interface{}]interface{}{"b": 1}},
+			&stpb.Struct{Fields: map[string]*stpb.Value{
+				"a": {Kind: &stpb.Value_StructValue{StructValue: &stpb.Struct{Fields: map[string]*stpb.Value{
+					"b": {Kind: &stpb.Value_NumberValue{NumberValue: 1}},
+				}}}},
+			}}},
+	}
+	for _, tt := range tests {
+		p := reflect.New(reflect.TypeOf(tt.pb).Elem()).Interface().(proto.Message)
+		if err := UnmarshalInterface(tt.in, p); err != nil {
+			t.Errorf("%s: %v", tt.desc, err)
+			continue
+		}
+		if !proto.Equal(p, tt.pb) {
+			t.Errorf("%s: got %v, want %v", tt.desc, p, tt.pb)
+		}
+	}
+}
+
+func TestUnmarshalInterfaceBadInput(t *testing.T) {
+	tests := []struct {
+		desc string
+		in   interface{}
+		pb   proto.Message
+	}{
+		{"not an object", []interface{}{}, &pb.Simple{}},
+		{"unknown field", map[string]interface{}{"unknown": 1}, &pb.Simple{}},
+		{"int32 overflow", map[string]interface{}{"oInt32": int64(1) << 40}, &pb.Simple{}},
+		{"fractional int", map[string]interface{}{"oInt32": 1.5}, &pb.Simple{}},
+		{"negative uint", map[string]interface{}{"oUint32": -1}, &pb.Simple{}},
+		{"string for bool", map[string]interface{}{"oBool": 1}, &pb.Simple{}},
+		{"non-string key", map[interface{}]interface{}{1: "x"}, &pb.Simple{}},
+		{"unknown enum", map[string]interface{}{"color": "PURPLE"}, &pb.Widget{}},
+		{"bad Duration", map[string]interface{}{"dur": 3}, &pb.KnownTypes{}},
+		{"Any without @type", map[string]interface{}{"an": map[string]interface{}{}}, &pb.KnownTypes{}},
+		{"unsupported Value", map[string]interface{}{"val": make(chan int)}, &pb.KnownTypes{}},
+		{"required", map[string]interface{}{}, &pb.MsgWithRequired{}},
+	}
+	for _, tt := range tests {
+		if err := UnmarshalInterface(tt.in, tt.pb); err == nil {
+			t.Errorf("%s: succeeded, want error", tt.desc)
+		}
+	}
+}
+
+func TestUnmarshalInterfaceErrorPath(t *testing.T) {
+	tests := []struct {
+		desc string
+		in   interface{}
+		pb   proto.Message
+		want []interface{}
+	}{
+		{"field", map[string]interface{}{"oInt32": "x"}, &pb.Simple{}, []interface{}{"oInt32"}},
+		{"orig name", map[string]interface{}{"o_int32": "x"}, &pb.Simple{}, []interface{}{"o_int32"}},
+		{"unknown field", map[string]interface{}{"unknown": 1}, &pb.Simple{}, []interface{}{"unknown"}},
+		{"repeated message", map[string]interface{}{
+			"rSimple": []interface{}{map[string]interface{}{}, map[string]interface{}{"oBool": 1}},
+		}, &pb.Widget{}, []interface{}{"rSimple", 1, "oBool"}},
+		{"map value", map[string]interface{}{
+			"nummy": map[string]interface{}{"1": "x"},
+		}, &pb.Mappy{}, []interface{}{"nummy", "1"}},
+		{"Struct", map[string]interface{}{
+			"st": map[string]interface{}{"a": []interface{}{make(chan int)}},
+		}, &pb.KnownTypes{}, []interface{}{"st", "a", 0}},
+		{"Any", map[string]interface{}{
+			"an": map[string]interface{}{"@type": "type.googleapis.com/google.protobuf.Duration", "value": 1},
+		}, &pb.KnownTypes{}, []interface{}{"an", "value"}},
+	}
+	for _, tt := range tests {
+		err := UnmarshalInterface(tt.in, tt.pb)
+		ve, ok := err.(*ValueError)
+		if !ok {
+			t.Errorf("%s: got error %v, want *ValueError", tt.desc, err)
+			continue
+		}
+		if !reflect.DeepEqual(ve.Path, tt.want) {
+			t.Errorf("%s: got path %v, want %v", tt.desc, ve.Path, tt.want)
+		}
+	}
+}
+
+func TestValueErrorString(t *testing.T) {
+	err := &ValueError{Path: []interface{}{"a", "b", 2, "c"}, Err: errors.New("oops")}
+	if got, want := err.Error(), "a.b[2].c: oops"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
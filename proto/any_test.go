@@ -32,6 +32,7 @@
 package proto_test
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -298,3 +299,75 @@ func TestUnmarshalAnyMixAndMatch(t *testing.T) {
 		t.Errorf("incorrect error.\nHave: %v\nWant: %v", err.Error(), want)
 	}
 }
+
+// bunnyResolver resolves every type URL ending in "/bunny" to a pb.Nested.
+type bunnyResolver struct{}
+
+func (bunnyResolver) Resolve(typeUrl string) (proto.Message, error) {
+	if strings.HasSuffix(typeUrl, "/bunny") {
+		return &pb.Nested{}, nil
+	}
+	return nil, fmt.Errorf("unknown type URL %q", typeUrl)
+}
+
+func TestAnyResolver(t *testing.T) {
+	m := &pb.Message{
+		Anything: &anypb.Any{
+			TypeUrl: "example.com/bunny",
+			Value:   []byte("\x0a\x05Monty\x10\x01"),
+		},
+	}
+	tm := proto.TextMarshaler{ExpandAny: true, AnyResolver: bunnyResolver{}}
+	want := `anything: <
+  [example.com/bunny]: <
+    bunny: "Monty"
+    cute: true
+  >
+>
+`
+	got := tm.Text(m)
+	if got != want {
+		t.Errorf("got\n`%s`\nwant\n`%s`", got, want)
+	}
+
+	got2 := &pb.Message{}
+	tu := proto.TextUnmarshaler{AnyResolver: bunnyResolver{}}
+	if err := tu.Unmarshal(got, got2); err != nil {
+		t.Fatalf("Unmarshal with a resolver: %v", err)
+	}
+	if !proto.Equal(got2, m) {
+		t.Errorf("Unmarshal with a resolver = %v, want %v", got2, m)
+	}
+	if err := proto.UnmarshalText(got, got2); err == nil {
+		t.Errorf("UnmarshalText without a resolver succeeded")
+	}
+}
+
+func TestMarshalRawAny(t *testing.T) {
+	m := &pb.Message{
+		Anything: &anypb.Any{
+			TypeUrl: "type.googleapis.com/unknown.Type",
+			Value:   []byte("\x0a\x05Monty\x10\x01"),
+		},
+	}
+	tm := proto.TextMarshaler{ExpandAny: true, RawAny: true}
+	want := `anything: <
+  [type.googleapis.com/unknown.Type]: <
+    /* 9 unknown bytes */
+    1: "Monty"
+    2: 1
+  >
+>
+`
+	if got := tm.Text(m); got != want {
+		t.Errorf("got\n`%s`\nwant\n`%s`", got, want)
+	}
+
+	// RawAny has no effect without ExpandAny.
+	tm.ExpandAny = false
+	want = `anything:<type_url:"type.googleapis.com/unknown.Type" value:"\n\005Monty\020\001" > `
+	tm.Compact = true
+	if got := tm.Text(m); got != want {
+		t.Errorf("got\n`%s`\nwant\n`%s`", got, want)
+	}
+}
@@ -533,3 +533,18 @@ func RegisterFile(filename string, fileDescriptor []byte) {
 
 // FileDescriptor returns the compressed FileDescriptorProto for a .proto file.
 func FileDescriptor(filename string) []byte { return protoFiles[filename] }
+
+// NumRegisteredFiles returns the number of registered .proto files. It
+// changes only when a new file is registered, so callers indexing the
+// files can tell cheaply whether RegisteredFiles has new names.
+func NumRegisteredFiles() int { return len(protoFiles) }
+
+// RegisteredFiles returns the names of all registered .proto files, sorted.
+func RegisteredFiles() []string {
+	names := make([]string, 0, len(protoFiles))
+	for name := range protoFiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -6,6 +6,8 @@ package test_proto
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	math "math"
 )
 
@@ -4157,6 +4159,53 @@ func _TestUTF8_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type TimeFields struct {
+	Ts                   *timestamp.Timestamp `protobuf:"bytes,1,opt,name=ts" json:"ts,omitempty"`
+	Dur                  *duration.Duration   `protobuf:"bytes,2,opt,name=dur" json:"dur,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TimeFields) Reset()         { *m = TimeFields{} }
+func (m *TimeFields) String() string { return proto.CompactTextString(m) }
+func (*TimeFields) ProtoMessage()    {}
+func (*TimeFields) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ca34d01332f1402, []int{32}
+}
+
+func (m *TimeFields) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeFields.Unmarshal(m, b)
+}
+func (m *TimeFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeFields.Marshal(b, m, deterministic)
+}
+func (m *TimeFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeFields.Merge(m, src)
+}
+func (m *TimeFields) XXX_Size() int {
+	return xxx_messageInfo_TimeFields.Size(m)
+}
+func (m *TimeFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeFields.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeFields proto.InternalMessageInfo
+
+func (m *TimeFields) GetTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.Ts
+	}
+	return nil
+}
+
+func (m *TimeFields) GetDur() *duration.Duration {
+	if m != nil {
+		return m.Dur
+	}
+	return nil
+}
+
 var E_Greeting = &proto.ExtensionDesc{
 	ExtendedType:  (*MyMessage)(nil),
 	ExtensionType: ([]string)(nil),
@@ -4983,6 +5032,7 @@ func init() {
 	proto.RegisterType((*TestUTF8)(nil), "test_proto.TestUTF8")
 	proto.RegisterMapType((map[string]int64)(nil), "test_proto.TestUTF8.MapKeyEntry")
 	proto.RegisterMapType((map[int64]string)(nil), "test_proto.TestUTF8.MapValueEntry")
+	proto.RegisterType((*TimeFields)(nil), "test_proto.TimeFields")
 	proto.RegisterExtension(E_Greeting)
 	proto.RegisterExtension(E_Complex)
 	proto.RegisterExtension(E_RComplex)
@@ -5073,305 +5123,309 @@ func init() {
 func init() { proto.RegisterFile("test_proto/test.proto", fileDescriptor_8ca34d01332f1402) }
 
 var fileDescriptor_8ca34d01332f1402 = []byte{
-	// 4795 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x5b, 0xd9, 0x73, 0x1b, 0x47,
-	0x7a, 0xd7, 0x0c, 0xee, 0x0f, 0x20, 0x31, 0x6c, 0xc9, 0x12, 0x44, 0x59, 0xd2, 0x08, 0x6b, 0xaf,
-	0x61, 0xc9, 0xa2, 0x48, 0x60, 0x08, 0x49, 0x70, 0xec, 0x58, 0x07, 0x41, 0xb3, 0x24, 0x12, 0xf2,
-	0x90, 0xb6, 0xb3, 0xca, 0x03, 0x0a, 0x24, 0x06, 0x20, 0x56, 0xc0, 0x0c, 0x0c, 0x0c, 0x56, 0x64,
-	0x52, 0xa9, 0xf2, 0x63, 0xaa, 0xf2, 0x94, 0x4d, 0x52, 0x95, 0xf7, 0xbc, 0xe4, 0x25, 0xd7, 0x43,
-	0xf2, 0x37, 0xc4, 0xd7, 0x7a, 0x77, 0xbd, 0x57, 0x92, 0x4d, 0x36, 0xf7, 0x9d, 0xcd, 0xbd, 0x47,
-	0x5e, 0x9c, 0xea, 0xaf, 0x7b, 0x66, 0x7a, 0x06, 0x50, 0x93, 0x7c, 0xe2, 0x74, 0xf7, 0xef, 0xfb,
-	0xf5, 0xf5, 0x9b, 0xef, 0xfb, 0xba, 0x31, 0x84, 0xe7, 0x5c, 0x6b, 0xec, 0x36, 0x87, 0x23, 0xc7,
-	0x75, 0x6e, 0xd0, 0xc7, 0x25, 0x7c, 0x24, 0x10, 0x54, 0x17, 0xaf, 0x41, 0x72, 0xdd, 0x59, 0xb3,
-	0x27, 0x03, 0x72, 0x05, 0x62, 0x1d, 0xc7, 0x29, 0x28, 0xba, 0x5a, 0x9a, 0x2f, 0xe7, 0x97, 0x02,
-	0xcc, 0x52, 0xbd, 0xd1, 0x30, 0x69, 0x5b, 0xf1, 0x26, 0x64, 0xd7, 0x9d, 0x1d, 0x6b, 0xec, 0xd6,
-	0x7b, 0x56, 0xbf, 0x4d, 0xce, 0x40, 0xe2, 0x61, 0x6b, 0xd7, 0xea, 0xa3, 0x4d, 0xc6, 0x64, 0x05,
-	0x42, 0x20, 0xbe, 0x73, 0x38, 0xb4, 0x0a, 0x2a, 0x56, 0xe2, 0x73, 0xf1, 0x0f, 0x8b, 0xb4, 0x1b,
-	0x6a, 0x49, 0xae, 0x41, 0xfc, 0x41, 0xcf, 0x6e, 0xf3, 0x7e, 0xce, 0x89, 0xfd, 0x30, 0xc4, 0xd2,
-	0x83, 0x8d, 0xad, 0xfb, 0x26, 0x82, 0x68, 0x0f, 0x3b, 0xad, 0xdd, 0x3e, 0x25, 0x53, 0x68, 0x0f,
-	0x58, 0xa0, 0xb5, 0x8f, 0x5a, 0xa3, 0xd6, 0xa0, 0x10, 0xd3, 0x95, 0x52, 0xc2, 0x64, 0x05, 0xf2,
-	0x1a, 0xcc, 0x99, 0xd6, 0x7b, 0x93, 0xde, 0xc8, 0x6a, 0xe3, 0xf0, 0x0a, 0x71, 0x5d, 0x2d, 0x65,
-	0x67, 0xf5, 0x80, 0xcd, 0x66, 0x18, 0xcd, 0xcc, 0x87, 0x56, 0xcb, 0xf5, 0xcc, 0x13, 0x7a, 0xec,
-	0x08, 0x73, 0x01, 0x4d, 0xcd, 0x1b, 0x43, 0xb7, 0xe7, 0xd8, 0xad, 0x3e, 0x33, 0x4f, 0xea, 0x8a,
-	0xd4, 0x3c, 0x84, 0x26, 0x5f, 0x84, 0x7c, 0xbd, 0x79, 0xd7, 0x71, 0xfa, 0xcd, 0x11, 0x1f, 0x55,
-	0x01, 0x74, 0xb5, 0x94, 0x36, 0xe7, 0xea, 0xb4, 0xd6, 0x1b, 0x2a, 0x29, 0x81, 0x56, 0x6f, 0x6e,
-	0xd8, 0x6e, 0xa5, 0x1c, 0x00, 0xb3, 0xba, 0x5a, 0x4a, 0x98, 0xf3, 0x75, 0xac, 0x9e, 0x42, 0x56,
-	0x8d, 0x00, 0x99, 0xd3, 0xd5, 0x52, 0x8c, 0x21, 0xab, 0x86, 0x8f, 0x7c, 0x05, 0x48, 0xbd, 0x59,
-	0xef, 0x1d, 0x58, 0x6d, 0x91, 0x75, 0x4e, 0x57, 0x4b, 0x29, 0x53, 0xab, 0xf3, 0x86, 0x19, 0x68,
-	0x91, 0x79, 0x5e, 0x57, 0x4b, 0x49, 0x0f, 0x2d, 0x70, 0x5f, 0x85, 0x85, 0x7a, 0xf3, 0xed, 0x5e,
-	0x78, 0xc0, 0x79, 0x5d, 0x2d, 0xcd, 0x99, 0xf9, 0x3a, 0xab, 0x9f, 0xc6, 0x8a, 0xc4, 0x9a, 0xae,
-	0x96, 0xe2, 0x1c, 0x2b, 0xf0, 0xe2, 0xec, 0xea, 0x7d, 0xa7, 0xe5, 0x06, 0xd0, 0x05, 0x5d, 0x2d,
-	0xa9, 0xe6, 0x7c, 0x1d, 0xab, 0xc3, 0xac, 0xf7, 0x9d, 0xc9, 0x6e, 0xdf, 0x0a, 0xa0, 0x44, 0x57,
-	0x4b, 0x8a, 0x99, 0xaf, 0xb3, 0xfa, 0x30, 0x76, 0xdb, 0x1d, 0xf5, 0xec, 0x6e, 0x80, 0x3d, 0x8d,
-	0x3a, 0xce, 0xd7, 0x59, 0x7d, 0x78, 0x04, 0x77, 0x0f, 0x5d, 0x6b, 0x1c, 0x40, 0x2d, 0x5d, 0x2d,
-	0xe5, 0xcc, 0xf9, 0x3a, 0x56, 0x47, 0x58, 0x23, 0x6b, 0xd0, 0xd1, 0xd5, 0xd2, 0x02, 0x65, 0x9d,
-	0xb1, 0x06, 0xdb, 0x91, 0x35, 0xe8, 0xea, 0x6a, 0x89, 0x70, 0xac, 0xb0, 0x06, 0x4b, 0x70, 0xba,
-	0xde, 0xdc, 0xee, 0x44, 0x37, 0x6e, 0x5f, 0x57, 0x4b, 0x79, 0x73, 0xa1, 0xee, 0xb5, 0xcc, 0xc2,
-	0x8b, 0xec, 0x3d, 0x5d, 0x2d, 0x69, 0x3e, 0x5e, 0xe0, 0x17, 0x35, 0xc9, 0xa4, 0x5e, 0x38, 0xa3,
-	0xc7, 0x04, 0x4d, 0xb2, 0xca, 0xb0, 0x26, 0x39, 0xf0, 0x39, 0x3d, 0x26, 0x6a, 0x32, 0x82, 0xc4,
-	0xee, 0x39, 0xf2, 0xac, 0x1e, 0x13, 0x35, 0xc9, 0x91, 0x11, 0x4d, 0x72, 0xec, 0x39, 0x3d, 0x16,
-	0xd6, 0xe4, 0x14, 0x5a, 0x64, 0x2e, 0xe8, 0xb1, 0xb0, 0x26, 0x39, 0x3a, 0xac, 0x49, 0x0e, 0x3e,
-	0xaf, 0xc7, 0x42, 0x9a, 0x8c, 0x62, 0x45, 0xe2, 0x45, 0x3d, 0x16, 0xd2, 0xa4, 0x38, 0x3b, 0x4f,
-	0x93, 0x1c, 0x7a, 0x41, 0x8f, 0x89, 0x9a, 0x14, 0x59, 0x7d, 0x4d, 0x72, 0xe8, 0xf3, 0x7a, 0x2c,
-	0xa4, 0x49, 0x11, 0xeb, 0x6b, 0x92, 0x63, 0x2f, 0xea, 0xb1, 0x90, 0x26, 0x39, 0xf6, 0x65, 0x51,
-	0x93, 0x1c, 0xfa, 0x81, 0xa2, 0xc7, 0x44, 0x51, 0x72, 0xe8, 0xb5, 0x90, 0x28, 0x39, 0xf6, 0x43,
-	0x8a, 0x15, 0x55, 0x19, 0x05, 0x8b, 0xab, 0xf0, 0x11, 0x05, 0x8b, 0xb2, 0xe4, 0xe0, 0x1b, 0x11,
-	0x59, 0x72, 0xf8, 0xc7, 0x14, 0x1e, 0xd6, 0xe5, 0xb4, 0x81, 0xc8, 0xff, 0x09, 0x35, 0x08, 0x0b,
-	0x93, 0x1b, 0x04, 0xc2, 0x74, 0xb8, 0x13, 0x2d, 0x5c, 0xd2, 0x15, 0x5f, 0x98, 0x9e, 0x67, 0x15,
-	0x85, 0xe9, 0x03, 0x2f, 0x63, 0xc8, 0xe0, 0xc2, 0x9c, 0x42, 0x56, 0x8d, 0x00, 0xa9, 0xeb, 0x4a,
-	0x20, 0x4c, 0x1f, 0x19, 0x12, 0xa6, 0x8f, 0xbd, 0xa2, 0x2b, 0xa2, 0x30, 0x67, 0xa0, 0x45, 0xe6,
-	0xa2, 0xae, 0x88, 0xc2, 0xf4, 0xd1, 0xa2, 0x30, 0x7d, 0xf0, 0x17, 0x74, 0x45, 0x10, 0xe6, 0x34,
-	0x56, 0x24, 0x7e, 0x41, 0x57, 0x04, 0x61, 0x86, 0x67, 0xc7, 0x84, 0xe9, 0x43, 0x5f, 0xd4, 0x95,
-	0x40, 0x98, 0x61, 0x56, 0x2e, 0x4c, 0x1f, 0xfa, 0x45, 0x5d, 0x11, 0x84, 0x19, 0xc6, 0x72, 0x61,
-	0xfa, 0xd8, 0x97, 0x30, 0x4e, 0x7b, 0xc2, 0xf4, 0xb1, 0x82, 0x30, 0x7d, 0xe8, 0xef, 0xd0, 0x98,
-	0xee, 0x0b, 0xd3, 0x87, 0x8a, 0xc2, 0xf4, 0xb1, 0xbf, 0x4b, 0xb1, 0x81, 0x30, 0xa7, 0xc1, 0xe2,
-	0x2a, 0xfc, 0x1e, 0x05, 0x07, 0xc2, 0xf4, 0xc1, 0x61, 0x61, 0xfa, 0xf0, 0xdf, 0xa7, 0x70, 0x51,
-	0x98, 0xb3, 0x0c, 0x44, 0xfe, 0x3f, 0xa0, 0x06, 0xa2, 0x30, 0x7d, 0x83, 0x25, 0x9c, 0x26, 0x15,
-	0x66, 0xdb, 0xea, 0xb4, 0x26, 0x7d, 0x2a, 0xe3, 0x12, 0x55, 0x66, 0x2d, 0xee, 0x8e, 0x26, 0x16,
-	0x9d, 0xab, 0xe3, 0xf4, 0xef, 0x7b, 0x6d, 0x64, 0x89, 0x0e, 0x9f, 0x09, 0x34, 0x30, 0x78, 0x99,
-	0x2a, 0xb4, 0xa6, 0x56, 0xca, 0x66, 0x9e, 0xa9, 0x74, 0x1a, 0x5f, 0x35, 0x04, 0xfc, 0x55, 0xaa,
-	0xd3, 0x9a, 0x5a, 0x35, 0x18, 0xbe, 0x6a, 0x04, 0xf8, 0x0a, 0x9d, 0x80, 0x27, 0xd6, 0xc0, 0xe2,
-	0x1a, 0x55, 0x6b, 0x2d, 0x56, 0x29, 0x2f, 0x9b, 0x0b, 0x9e, 0x64, 0x67, 0x19, 0x85, 0xba, 0x79,
-	0x85, 0x8a, 0xb6, 0x16, 0xab, 0x1a, 0xbe, 0x91, 0xd8, 0x53, 0x99, 0x0a, 0x9d, 0x4b, 0x37, 0xb0,
-	0xb9, 0x4e, 0xb5, 0x5b, 0x8b, 0x57, 0xca, 0xcb, 0xcb, 0xa6, 0xc6, 0x15, 0x3c, 0xc3, 0x26, 0xd4,
-	0xcf, 0x12, 0xd5, 0x70, 0x2d, 0x5e, 0x35, 0x7c, 0x9b, 0x70, 0x3f, 0x0b, 0x9e, 0x94, 0x03, 0x93,
-	0x1b, 0x54, 0xcb, 0xb5, 0x64, 0x65, 0xc5, 0x58, 0x59, 0xbd, 0x6d, 0xe6, 0x99, 0xa6, 0x03, 0x1b,
-	0x83, 0xf6, 0xc3, 0x45, 0x1d, 0x18, 0x2d, 0x53, 0x55, 0xd7, 0x92, 0xe5, 0x9b, 0x2b, 0xb7, 0xca,
-	0xb7, 0x4c, 0x8d, 0xab, 0x3b, 0xb0, 0x7a, 0x9d, 0x5a, 0x71, 0x79, 0x07, 0x56, 0x2b, 0x54, 0xdf,
-	0x35, 0x6d, 0xdf, 0xea, 0xf7, 0x9d, 0x57, 0xf4, 0xe2, 0x53, 0x67, 0xd4, 0x6f, 0x5f, 0x29, 0x82,
-	0xa9, 0x71, 0xc5, 0x8b, 0xbd, 0x2e, 0x78, 0x92, 0x0f, 0xcc, 0x7f, 0x95, 0x66, 0xac, 0xb9, 0x5a,
-	0xea, 0x6e, 0xaf, 0x6b, 0x3b, 0x63, 0xcb, 0xcc, 0x33, 0xf1, 0x47, 0xd6, 0x64, 0x3b, 0xba, 0x8e,
-	0x5f, 0xa5, 0x66, 0x0b, 0xb5, 0xd8, 0xf5, 0x4a, 0x99, 0xf6, 0x34, 0x6b, 0x1d, 0xb7, 0xa3, 0xeb,
-	0xf8, 0x6b, 0xd4, 0x86, 0xd4, 0x62, 0xd7, 0xab, 0x06, 0xb7, 0x11, 0xd7, 0xb1, 0x0a, 0x67, 0x84,
-	0x77, 0x21, 0xb0, 0xfa, 0x75, 0x6a, 0x95, 0x67, 0x3d, 0x11, 0xff, 0x8d, 0x98, 0x69, 0x17, 0xea,
-	0xed, 0x37, 0xa8, 0x9d, 0xc6, 0x7a, 0x23, 0xfe, 0x8b, 0x11, 0xd8, 0xdd, 0x84, 0xb3, 0x91, 0x5c,
-	0xa2, 0x39, 0x6c, 0xed, 0x3d, 0xb1, 0xda, 0x85, 0x32, 0x4d, 0x29, 0xee, 0xaa, 0x9a, 0x62, 0x9e,
-	0x0e, 0xa5, 0x15, 0x8f, 0xb0, 0x99, 0xdc, 0x86, 0x73, 0xd1, 0xe4, 0xc2, 0xb3, 0xac, 0xd0, 0x1c,
-	0x03, 0x2d, 0xcf, 0x84, 0xf3, 0x8c, 0x88, 0xa9, 0x10, 0x54, 0x3c, 0x53, 0x83, 0x26, 0x1d, 0x81,
-	0x69, 0x10, 0x5b, 0xb8, 0xe9, 0x6b, 0x70, 0x7e, 0x3a, 0xfd, 0xf0, 0x8c, 0x57, 0x69, 0x16, 0x82,
-	0xc6, 0x67, 0xa3, 0x99, 0xc8, 0x94, 0xf9, 0x8c, 0xbe, 0xab, 0x34, 0x2d, 0x11, 0xcd, 0xa7, 0x7a,
-	0x7f, 0x15, 0x0a, 0x53, 0x09, 0x8a, 0x67, 0x7d, 0x93, 0xe6, 0x29, 0x68, 0xfd, 0x5c, 0x24, 0x57,
-	0x89, 0x1a, 0xcf, 0xe8, 0xfa, 0x16, 0x4d, 0x5c, 0x04, 0xe3, 0xa9, 0x9e, 0x71, 0xc9, 0xc2, 0x29,
-	0x8c, 0x67, 0x7b, 0x9b, 0x66, 0x32, 0x7c, 0xc9, 0x42, 0xd9, 0x8c, 0xd8, 0x6f, 0x24, 0xa7, 0xf1,
-	0x6c, 0x6b, 0x34, 0xb5, 0xe1, 0xfd, 0x86, 0xd3, 0x1b, 0x6e, 0xfc, 0x33, 0xd4, 0x78, 0x7b, 0xf6,
-	0x8c, 0x7f, 0x14, 0xa3, 0x49, 0x09, 0xb7, 0xde, 0x9e, 0x35, 0x65, 0xdf, 0x7a, 0xc6, 0x94, 0x7f,
-	0x4c, 0xad, 0x89, 0x60, 0x3d, 0x35, 0xe7, 0x37, 0x60, 0x71, 0x46, 0xbe, 0xe2, 0xd9, 0xff, 0x84,
-	0xda, 0xe7, 0xd1, 0xfe, 0xdc, 0x54, 0xea, 0x32, 0xcd, 0x30, 0x63, 0x04, 0x3f, 0xa5, 0x0c, 0x5a,
-	0x88, 0x61, 0x6a, 0x0c, 0x75, 0x98, 0xf3, 0xf2, 0xf1, 0xee, 0xc8, 0x99, 0x0c, 0x0b, 0x75, 0x5d,
-	0x2d, 0x41, 0x59, 0x9f, 0x71, 0x3a, 0xf6, 0xd2, 0xf3, 0x75, 0x8a, 0x33, 0xc3, 0x66, 0x8c, 0x87,
-	0x31, 0x33, 0x9e, 0x47, 0x7a, 0xec, 0x99, 0x3c, 0x0c, 0xe7, 0xf3, 0x08, 0x66, 0x94, 0xc7, 0x0b,
-	0x77, 0x8c, 0xe7, 0xb1, 0xae, 0x3c, 0x83, 0xc7, 0x0b, 0x7e, 0x9c, 0x27, 0x64, 0xb6, 0xb8, 0x1a,
-	0x9c, 0xc9, 0xb1, 0x9d, 0xbc, 0x10, 0x3d, 0xa4, 0xaf, 0xe3, 0xe9, 0x2a, 0x5c, 0xc9, 0xcc, 0x84,
-	0xe1, 0x4d, 0x9b, 0xbd, 0xf5, 0x0c, 0xb3, 0xd0, 0x68, 0xa6, 0xcd, 0x7e, 0x7e, 0x86, 0x59, 0xf1,
-	0x37, 0x15, 0x88, 0x3f, 0xd8, 0xd8, 0xba, 0x4f, 0xd2, 0x10, 0x7f, 0xa7, 0xb1, 0x71, 0x5f, 0x3b,
-	0x45, 0x9f, 0xee, 0x36, 0x1a, 0x0f, 0x35, 0x85, 0x64, 0x20, 0x71, 0xf7, 0x4b, 0x3b, 0x6b, 0xdb,
-	0x9a, 0x4a, 0xf2, 0x90, 0xad, 0x6f, 0x6c, 0xad, 0xaf, 0x99, 0x8f, 0xcc, 0x8d, 0xad, 0x1d, 0x2d,
-	0x46, 0xdb, 0xea, 0x0f, 0x1b, 0x77, 0x76, 0xb4, 0x38, 0x49, 0x41, 0x8c, 0xd6, 0x25, 0x08, 0x40,
-	0x72, 0x7b, 0xc7, 0xdc, 0xd8, 0x5a, 0xd7, 0x92, 0x94, 0x65, 0x67, 0x63, 0x73, 0x4d, 0x4b, 0x51,
-	0xe4, 0xce, 0xdb, 0x8f, 0x1e, 0xae, 0x69, 0x69, 0xfa, 0x78, 0xc7, 0x34, 0xef, 0x7c, 0x49, 0xcb,
-	0x50, 0xa3, 0xcd, 0x3b, 0x8f, 0x34, 0xc0, 0xe6, 0x3b, 0x77, 0x1f, 0xae, 0x69, 0x59, 0x92, 0x83,
-	0x74, 0xfd, 0xed, 0xad, 0x7b, 0x3b, 0x1b, 0x8d, 0x2d, 0x2d, 0x57, 0xfc, 0x45, 0x28, 0xb0, 0x65,
-	0x0e, 0xad, 0x22, 0xbb, 0x32, 0x78, 0x03, 0x12, 0x6c, 0x6f, 0x14, 0xd4, 0xca, 0xd5, 0xe9, 0xbd,
-	0x99, 0x36, 0x5a, 0x62, 0xbb, 0xc4, 0x0c, 0x17, 0x2f, 0x42, 0x82, 0xad, 0xd3, 0x19, 0x48, 0xb0,
-	0xf5, 0x51, 0xf1, 0x2a, 0x81, 0x15, 0x8a, 0xbf, 0xa5, 0x02, 0xac, 0x3b, 0xdb, 0x4f, 0x7a, 0x43,
-	0xbc, 0xb8, 0xb9, 0x08, 0x30, 0x7e, 0xd2, 0x1b, 0x36, 0xf1, 0x0d, 0xe4, 0x97, 0x0e, 0x19, 0x5a,
-	0x83, 0xbe, 0x97, 0x5c, 0x81, 0x1c, 0x36, 0xf3, 0x57, 0x04, 0xef, 0x1a, 0x52, 0x66, 0x96, 0xd6,
-	0x71, 0x27, 0x19, 0x86, 0x54, 0x0d, 0xbc, 0x62, 0x48, 0x0a, 0x90, 0xaa, 0x41, 0x2e, 0x03, 0x16,
-	0x9b, 0x63, 0x8c, 0xa6, 0x78, 0xad, 0x90, 0x31, 0xb1, 0x5f, 0x16, 0x5f, 0xc9, 0xeb, 0x80, 0x7d,
-	0xb2, 0x99, 0xe7, 0x67, 0xbd, 0x25, 0xde, 0x80, 0x97, 0xe8, 0x03, 0x9b, 0x6f, 0x60, 0xb2, 0xd8,
-	0x80, 0x8c, 0x5f, 0x4f, 0x7b, 0xc3, 0x5a, 0x3e, 0x27, 0x0d, 0xe7, 0x04, 0x58, 0xe5, 0x4f, 0x8a,
-	0x01, 0xf8, 0x78, 0x16, 0x70, 0x3c, 0xcc, 0x88, 0x0d, 0xa8, 0x78, 0x11, 0xe6, 0xb6, 0x1c, 0x9b,
-	0xbd, 0xc7, 0xb8, 0x4e, 0x39, 0x50, 0x5a, 0x05, 0x05, 0xcf, 0xbf, 0x4a, 0xab, 0x78, 0x09, 0x40,
-	0x68, 0xd3, 0x40, 0xd9, 0x65, 0x6d, 0xe8, 0x0f, 0x94, 0xdd, 0xe2, 0x35, 0x48, 0x6e, 0xb6, 0x0e,
-	0x76, 0x5a, 0x5d, 0x72, 0x05, 0xa0, 0xdf, 0x1a, 0xbb, 0xcd, 0x0e, 0xee, 0xc4, 0xe7, 0x9f, 0x7f,
-	0xfe, 0xb9, 0x82, 0xc9, 0x74, 0x86, 0xd6, 0xb2, 0x1d, 0x19, 0x03, 0x34, 0xfa, 0xed, 0x4d, 0x6b,
-	0x3c, 0x6e, 0x75, 0x2d, 0xb2, 0x0a, 0x49, 0xdb, 0x1a, 0xd3, 0xe8, 0xab, 0xe0, 0x5d, 0xd3, 0x45,
-	0x71, 0x1d, 0x02, 0xdc, 0xd2, 0x16, 0x82, 0x4c, 0x0e, 0x26, 0x1a, 0xc4, 0xec, 0xc9, 0x00, 0x6f,
-	0xd4, 0x12, 0x26, 0x7d, 0x5c, 0x7c, 0x1e, 0x92, 0x0c, 0x43, 0x08, 0xc4, 0xed, 0xd6, 0xc0, 0x2a,
-	0xb0, 0x9e, 0xf1, 0xb9, 0xf8, 0x55, 0x05, 0x60, 0xcb, 0x7a, 0x7a, 0xac, 0x5e, 0x03, 0x9c, 0xa4,
-	0xd7, 0x18, 0xeb, 0xf5, 0x55, 0x59, 0xaf, 0x54, 0x6d, 0x1d, 0xc7, 0x69, 0x37, 0xd9, 0x46, 0xb3,
-	0xeb, 0xbf, 0x0c, 0xad, 0xc1, 0x9d, 0x2b, 0x3e, 0x86, 0xdc, 0x86, 0x6d, 0x5b, 0x23, 0x6f, 0x54,
-	0x04, 0xe2, 0xfb, 0xce, 0xd8, 0xe5, 0x37, 0x91, 0xf8, 0x4c, 0x0a, 0x10, 0x1f, 0x3a, 0x23, 0x97,
-	0xcd, 0xb4, 0x16, 0x37, 0x96, 0x97, 0x97, 0x4d, 0xac, 0x21, 0xcf, 0x43, 0x66, 0xcf, 0xb1, 0x6d,
-	0x6b, 0x8f, 0x4e, 0x23, 0x86, 0x47, 0xc7, 0xa0, 0xa2, 0xf8, 0xcb, 0x0a, 0xe4, 0x1a, 0xee, 0x7e,
-	0x40, 0xae, 0x41, 0xec, 0x89, 0x75, 0x88, 0xc3, 0x8b, 0x99, 0xf4, 0x91, 0xbe, 0x30, 0x5f, 0x69,
-	0xf5, 0x27, 0xec, 0x5e, 0x32, 0x67, 0xb2, 0x02, 0x39, 0x0b, 0xc9, 0xa7, 0x56, 0xaf, 0xbb, 0xef,
-	0x22, 0xa7, 0x6a, 0xf2, 0x12, 0x59, 0x82, 0x44, 0x8f, 0x0e, 0xb6, 0x10, 0xc7, 0x15, 0x2b, 0x88,
-	0x2b, 0x26, 0xce, 0xc2, 0x64, 0xb0, 0xab, 0xe9, 0x74, 0x5b, 0x7b, 0xff, 0xfd, 0xf7, 0xdf, 0x57,
-	0x8b, 0xfb, 0x70, 0xc6, 0x7b, 0x89, 0x43, 0xd3, 0x7d, 0x04, 0x85, 0xbe, 0xe5, 0x34, 0x3b, 0x3d,
-	0xbb, 0xd5, 0xef, 0x1f, 0x36, 0x9f, 0x3a, 0x76, 0xb3, 0x65, 0x37, 0x9d, 0xf1, 0x5e, 0x6b, 0x84,
-	0x4b, 0x20, 0xeb, 0xe4, 0x4c, 0xdf, 0x72, 0xea, 0xcc, 0xf0, 0x5d, 0xc7, 0xbe, 0x63, 0x37, 0xa8,
-	0x55, 0xf1, 0xb3, 0x38, 0x64, 0x36, 0x0f, 0x3d, 0xfe, 0x33, 0x90, 0xd8, 0x73, 0x26, 0x36, 0x5b,
-	0xcf, 0x84, 0xc9, 0x0a, 0xfe, 0x3e, 0xa9, 0xc2, 0x3e, 0x9d, 0x81, 0xc4, 0x7b, 0x13, 0xc7, 0xb5,
-	0x70, 0xca, 0x19, 0x93, 0x15, 0xe8, 0x8a, 0x0d, 0x2d, 0xb7, 0x10, 0xc7, 0x6b, 0x0a, 0xfa, 0x18,
-	0xac, 0x41, 0xe2, 0x58, 0x6b, 0x40, 0x96, 0x21, 0xe9, 0xd0, 0x3d, 0x18, 0x17, 0x92, 0x78, 0x0f,
-	0x1b, 0x32, 0x10, 0x77, 0xc7, 0xe4, 0x38, 0xf2, 0x00, 0x16, 0x9e, 0x5a, 0xcd, 0xc1, 0x64, 0xec,
-	0x36, 0xbb, 0x4e, 0xb3, 0x6d, 0x59, 0x43, 0x6b, 0x54, 0x98, 0xc3, 0xde, 0x42, 0x1e, 0x62, 0xd6,
-	0x82, 0x9a, 0xf3, 0x4f, 0xad, 0xcd, 0xc9, 0xd8, 0x5d, 0x77, 0xee, 0xa3, 0x1d, 0x59, 0x85, 0xcc,
-	0xc8, 0xa2, 0x7e, 0x81, 0x0e, 0x39, 0x37, 0x3d, 0x82, 0x90, 0x71, 0x7a, 0x64, 0x0d, 0xb1, 0x82,
-	0xdc, 0x84, 0xf4, 0x6e, 0xef, 0x89, 0x35, 0xde, 0xb7, 0xda, 0x85, 0x94, 0xae, 0x94, 0xe6, 0xcb,
-	0x17, 0x44, 0x2b, 0x7f, 0x81, 0x97, 0xee, 0x39, 0x7d, 0x67, 0x64, 0xfa, 0x60, 0xf2, 0x1a, 0x64,
-	0xc6, 0xce, 0xc0, 0x62, 0x6a, 0x4f, 0x63, 0xb0, 0xbd, 0x3c, 0xdb, 0x72, 0xdb, 0x19, 0x58, 0x9e,
-	0x57, 0xf3, 0x2c, 0xc8, 0x05, 0x36, 0xdc, 0x5d, 0x7a, 0x98, 0x28, 0x00, 0x5e, 0xf8, 0xd0, 0x41,
-	0xe1, 0xe1, 0x82, 0x2c, 0xd2, 0x41, 0x75, 0x3b, 0x34, 0x67, 0x2b, 0x64, 0xf1, 0x2c, 0xef, 0x97,
-	0x17, 0x5f, 0x81, 0x8c, 0x4f, 0x18, 0xb8, 0x43, 0xe6, 0x82, 0x32, 0xe8, 0x21, 0x98, 0x3b, 0x64,
-	0xfe, 0xe7, 0x45, 0x48, 0xe0, 0xc0, 0x69, 0xe4, 0x32, 0xd7, 0x68, 0xa0, 0xcc, 0x40, 0x62, 0xdd,
-	0x5c, 0x5b, 0xdb, 0xd2, 0x14, 0x8c, 0x99, 0x0f, 0xdf, 0x5e, 0xd3, 0x54, 0x41, 0xbf, 0xbf, 0xad,
-	0x42, 0x6c, 0xed, 0x00, 0x95, 0xd3, 0x6e, 0xb9, 0x2d, 0xef, 0x0d, 0xa7, 0xcf, 0xa4, 0x06, 0x99,
-	0x41, 0xcb, 0xeb, 0x4b, 0xc5, 0x25, 0x0e, 0xf9, 0x92, 0xb5, 0x03, 0x77, 0x69, 0xb3, 0xc5, 0x7a,
-	0x5e, 0xb3, 0xdd, 0xd1, 0xa1, 0x99, 0x1e, 0xf0, 0xe2, 0xe2, 0xab, 0x30, 0x17, 0x6a, 0x12, 0x5f,
-	0xd1, 0xc4, 0x8c, 0x57, 0x34, 0xc1, 0x5f, 0xd1, 0x9a, 0x7a, 0x4b, 0x29, 0xd7, 0x20, 0x3e, 0x70,
-	0x46, 0x16, 0x79, 0x6e, 0xe6, 0x02, 0x17, 0xba, 0x28, 0x99, 0x7c, 0x64, 0x28, 0x26, 0xda, 0x94,
-	0x5f, 0x86, 0xb8, 0x6b, 0x1d, 0xb8, 0xcf, 0xb2, 0xdd, 0x67, 0xf3, 0xa3, 0x90, 0xf2, 0x75, 0x48,
-	0xda, 0x93, 0xc1, 0xae, 0x35, 0x7a, 0x16, 0xb8, 0x87, 0x03, 0xe3, 0xa0, 0xe2, 0x3b, 0xa0, 0xdd,
-	0x73, 0x06, 0xc3, 0xbe, 0x75, 0xb0, 0x76, 0xe0, 0x5a, 0xf6, 0xb8, 0xe7, 0xd8, 0x74, 0x0e, 0x9d,
-	0xde, 0x08, 0xdd, 0x1a, 0xce, 0x01, 0x0b, 0xd4, 0xcd, 0x8c, 0xad, 0x3d, 0xc7, 0x6e, 0xf3, 0xa9,
-	0xf1, 0x12, 0x45, 0xbb, 0xfb, 0xbd, 0x11, 0xf5, 0x68, 0x34, 0xf8, 0xb0, 0x42, 0x71, 0x1d, 0xf2,
-	0xfc, 0x18, 0x36, 0xe6, 0x1d, 0x17, 0xaf, 0x42, 0xce, 0xab, 0xc2, 0x5f, 0x7e, 0xd2, 0x10, 0x7f,
-	0xbc, 0x66, 0x36, 0xb4, 0x53, 0x74, 0x5f, 0x1b, 0x5b, 0x6b, 0x9a, 0x42, 0x1f, 0x76, 0xde, 0x6d,
-	0x84, 0xf6, 0xf2, 0x79, 0xc8, 0xf9, 0x63, 0xdf, 0xb6, 0x5c, 0x6c, 0xa1, 0x51, 0x2a, 0x55, 0x53,
-	0xd3, 0x4a, 0x31, 0x05, 0x89, 0xb5, 0xc1, 0xd0, 0x3d, 0x2c, 0xfe, 0x12, 0x64, 0x39, 0xe8, 0x61,
-	0x6f, 0xec, 0x92, 0xdb, 0x90, 0x1a, 0xf0, 0xf9, 0x2a, 0x98, 0x8b, 0x86, 0x65, 0x1d, 0x20, 0xbd,
-	0x67, 0xd3, 0xc3, 0x2f, 0x56, 0x20, 0x25, 0xb8, 0x77, 0xee, 0x79, 0x54, 0xd1, 0xf3, 0x30, 0x1f,
-	0x15, 0x13, 0x7c, 0x54, 0x71, 0x13, 0x52, 0x2c, 0x30, 0x8f, 0x31, 0xdd, 0x60, 0xe7, 0x77, 0xa6,
-	0x31, 0x26, 0xbe, 0x2c, 0xab, 0x63, 0x39, 0xd4, 0x65, 0xc8, 0xe2, 0x3b, 0xe3, 0xab, 0x90, 0x7a,
-	0x73, 0xc0, 0x2a, 0xa6, 0xf8, 0x3f, 0x4a, 0x40, 0xda, 0x5b, 0x2b, 0x72, 0x01, 0x92, 0xec, 0x10,
-	0x8b, 0x54, 0xde, 0xa5, 0x4e, 0x02, 0x8f, 0xad, 0xe4, 0x02, 0xa4, 0xf8, 0x41, 0x95, 0x07, 0x1c,
-	0xb5, 0x52, 0x36, 0x93, 0xec, 0x60, 0xea, 0x37, 0x56, 0x0d, 0xf4, 0x93, 0xec, 0xba, 0x26, 0xc9,
-	0x8e, 0x9e, 0x44, 0x87, 0x8c, 0x7f, 0xd8, 0xc4, 0x10, 0xc1, 0xef, 0x66, 0xd2, 0xde, 0xe9, 0x52,
-	0x40, 0x54, 0x0d, 0x74, 0xa0, 0xfc, 0x22, 0x26, 0x5d, 0x0f, 0xf2, 0xa6, 0xb4, 0x77, 0x64, 0xc4,
-	0x5f, 0x9e, 0xbc, 0x5b, 0x97, 0x14, 0x3f, 0x24, 0x06, 0x80, 0xaa, 0x81, 0x9e, 0xc9, 0xbb, 0x62,
-	0x49, 0xf1, 0x83, 0x20, 0xb9, 0x4c, 0x87, 0x88, 0x07, 0x3b, 0xf4, 0x3f, 0xc1, 0x7d, 0x4a, 0x92,
-	0x1d, 0xf7, 0xc8, 0x15, 0xca, 0xc0, 0x4e, 0x6f, 0xe8, 0x1a, 0x82, 0xcb, 0x93, 0x14, 0x3f, 0xd4,
-	0x91, 0x6b, 0x14, 0xc2, 0x96, 0xbf, 0x00, 0xcf, 0xb8, 0x29, 0x49, 0xf1, 0x9b, 0x12, 0xa2, 0xd3,
-	0x0e, 0xd1, 0x43, 0xa1, 0x57, 0x12, 0x6e, 0x45, 0x92, 0xec, 0x56, 0x84, 0x5c, 0x42, 0x3a, 0x36,
-	0xa9, 0x5c, 0x70, 0x03, 0x92, 0xe2, 0xa7, 0xc0, 0xa0, 0x1d, 0x73, 0x49, 0xff, 0xb6, 0x23, 0xc5,
-	0xcf, 0x79, 0xe4, 0x16, 0xdd, 0x2f, 0xaa, 0xf0, 0xc2, 0x3c, 0xfa, 0xe2, 0x45, 0x51, 0x7a, 0xde,
-	0xae, 0x32, 0x57, 0x5c, 0x63, 0x6e, 0xcc, 0x4c, 0xd4, 0xf1, 0x8d, 0x58, 0xa4, 0x96, 0x8f, 0x7a,
-	0x76, 0xa7, 0x90, 0xc7, 0xb5, 0x88, 0xf5, 0xec, 0x8e, 0x99, 0xa8, 0xd3, 0x1a, 0xa6, 0x82, 0x2d,
-	0xda, 0xa6, 0x61, 0x5b, 0xfc, 0x3a, 0x6b, 0xa4, 0x55, 0xa4, 0x00, 0x89, 0x7a, 0x73, 0xab, 0x65,
-	0x17, 0x16, 0x98, 0x9d, 0xdd, 0xb2, 0xcd, 0x78, 0x7d, 0xab, 0x65, 0x93, 0x97, 0x21, 0x36, 0x9e,
-	0xec, 0x16, 0xc8, 0xf4, 0xcf, 0x82, 0xdb, 0x93, 0x5d, 0x6f, 0x30, 0x26, 0xc5, 0x90, 0x0b, 0x90,
-	0x1e, 0xbb, 0xa3, 0xe6, 0x2f, 0x58, 0x23, 0xa7, 0x70, 0x1a, 0x97, 0xf1, 0x94, 0x99, 0x1a, 0xbb,
-	0xa3, 0xc7, 0xd6, 0xc8, 0x39, 0xa6, 0x0f, 0x2e, 0x5e, 0x82, 0xac, 0xc0, 0x4b, 0xf2, 0xa0, 0xd8,
-	0x2c, 0x81, 0xa9, 0x29, 0x37, 0x4d, 0xc5, 0x2e, 0xbe, 0x03, 0x39, 0xef, 0x88, 0x85, 0x33, 0x36,
-	0xe8, 0xdb, 0xd4, 0x77, 0x46, 0xf8, 0x96, 0xce, 0x97, 0x2f, 0x85, 0x23, 0x66, 0x00, 0xe4, 0x91,
-	0x8b, 0x81, 0x8b, 0x5a, 0x64, 0x30, 0x4a, 0xf1, 0x07, 0x0a, 0xe4, 0x36, 0x9d, 0x51, 0xf0, 0xfb,
-	0xc5, 0x19, 0x48, 0xec, 0x3a, 0x4e, 0x7f, 0x8c, 0xc4, 0x69, 0x93, 0x15, 0xc8, 0x8b, 0x90, 0xc3,
-	0x07, 0xef, 0x90, 0xac, 0xfa, 0xb7, 0x40, 0x59, 0xac, 0xe7, 0xe7, 0x62, 0x02, 0xf1, 0x9e, 0xed,
-	0x8e, 0xb9, 0x47, 0xc3, 0x67, 0xf2, 0x05, 0xc8, 0xd2, 0xbf, 0x9e, 0x65, 0xdc, 0xcf, 0xa6, 0x81,
-	0x56, 0x73, 0xc3, 0x97, 0x60, 0x0e, 0x35, 0xe0, 0xc3, 0x52, 0xfe, 0x8d, 0x4f, 0x8e, 0x35, 0x70,
-	0x60, 0x01, 0x52, 0xcc, 0x21, 0x8c, 0xf1, 0x07, 0xdf, 0x8c, 0xe9, 0x15, 0xa9, 0x9b, 0xc5, 0x83,
-	0x0a, 0xcb, 0x40, 0x52, 0x26, 0x2f, 0x15, 0xef, 0x41, 0x1a, 0xc3, 0x65, 0xa3, 0xdf, 0x26, 0x2f,
-	0x80, 0xd2, 0x2d, 0x58, 0x18, 0xae, 0xcf, 0x86, 0x4e, 0x21, 0x1c, 0xb0, 0xb4, 0x6e, 0x2a, 0xdd,
-	0xc5, 0x05, 0x50, 0xd6, 0xe9, 0xb1, 0xe0, 0x80, 0x3b, 0x6c, 0xe5, 0xa0, 0xf8, 0x16, 0x27, 0xd9,
-	0xb2, 0x9e, 0xca, 0x49, 0xb6, 0xac, 0xa7, 0x8c, 0xe4, 0xf2, 0x14, 0x09, 0x2d, 0x1d, 0xf2, 0xdf,
-	0xc0, 0x95, 0xc3, 0x62, 0x05, 0xe6, 0xf0, 0x45, 0xed, 0xd9, 0xdd, 0x47, 0x4e, 0xcf, 0xc6, 0x83,
-	0x48, 0x07, 0x13, 0x38, 0xc5, 0x54, 0x3a, 0x74, 0x1f, 0xac, 0x83, 0xd6, 0x1e, 0x4b, 0x87, 0xd3,
-	0x26, 0x2b, 0x14, 0xbf, 0x1f, 0x87, 0x79, 0xee, 0x64, 0xdf, 0xed, 0xb9, 0xfb, 0x9b, 0xad, 0x21,
-	0xd9, 0x82, 0x1c, 0xf5, 0xaf, 0xcd, 0x41, 0x6b, 0x38, 0xa4, 0x2f, 0xb2, 0x82, 0xa1, 0xf9, 0xda,
-	0x0c, 0xb7, 0xcd, 0x2d, 0x96, 0xb6, 0x5a, 0x03, 0x6b, 0x93, 0xa1, 0x59, 0xa0, 0xce, 0xda, 0x41,
-	0x0d, 0x79, 0x00, 0xd9, 0xc1, 0xb8, 0xeb, 0xd3, 0xb1, 0x48, 0x7f, 0x55, 0x42, 0xb7, 0x39, 0xee,
-	0x86, 0xd8, 0x60, 0xe0, 0x57, 0xd0, 0xc1, 0x51, 0xef, 0xec, 0xb3, 0xc5, 0x8e, 0x1c, 0x1c, 0x75,
-	0x25, 0xe1, 0xc1, 0xed, 0x06, 0x35, 0xa4, 0x0e, 0x40, 0x5f, 0x35, 0xd7, 0xa1, 0x27, 0x3c, 0xd4,
-	0x52, 0xb6, 0x5c, 0x92, 0xb0, 0x6d, 0xbb, 0xa3, 0x1d, 0x67, 0xdb, 0x1d, 0xf1, 0x84, 0x64, 0xcc,
-	0x8b, 0x8b, 0xaf, 0x83, 0x16, 0x5d, 0x85, 0xa3, 0x72, 0x92, 0x8c, 0x90, 0x93, 0x2c, 0xfe, 0x1c,
-	0xe4, 0x23, 0xd3, 0x16, 0xcd, 0x09, 0x33, 0xbf, 0x21, 0x9a, 0x67, 0xcb, 0xe7, 0x43, 0xdf, 0x68,
-	0x88, 0x5b, 0x2f, 0x32, 0xbf, 0x0e, 0x5a, 0x74, 0x09, 0x44, 0xea, 0xb4, 0xe4, 0x40, 0x83, 0xf6,
-	0xaf, 0xc2, 0x5c, 0x68, 0xd2, 0xa2, 0x71, 0xe6, 0x88, 0x69, 0x15, 0x7f, 0x25, 0x01, 0x89, 0x86,
-	0x6d, 0x39, 0x1d, 0x72, 0x2e, 0x1c, 0x3b, 0xdf, 0x3c, 0xe5, 0xc5, 0xcd, 0xf3, 0x91, 0xb8, 0xf9,
-	0xe6, 0x29, 0x3f, 0x6a, 0x9e, 0x8f, 0x44, 0x4d, 0xaf, 0xa9, 0x6a, 0x90, 0x8b, 0x53, 0x31, 0xf3,
-	0xcd, 0x53, 0x42, 0xc0, 0xbc, 0x38, 0x15, 0x30, 0x83, 0xe6, 0xaa, 0x41, 0x1d, 0x6c, 0x38, 0x5a,
-	0xbe, 0x79, 0x2a, 0x88, 0x94, 0x17, 0xa2, 0x91, 0xd2, 0x6f, 0xac, 0x1a, 0x6c, 0x48, 0x42, 0x94,
-	0xc4, 0x21, 0xb1, 0xf8, 0x78, 0x21, 0x1a, 0x1f, 0xd1, 0x8e, 0x47, 0xc6, 0x0b, 0xd1, 0xc8, 0x88,
-	0x8d, 0x3c, 0x12, 0x9e, 0x8f, 0x44, 0x42, 0x24, 0x65, 0x21, 0xf0, 0x42, 0x34, 0x04, 0x32, 0x3b,
-	0x61, 0xa4, 0x62, 0xfc, 0xf3, 0x1b, 0xab, 0x06, 0x31, 0x22, 0xc1, 0x4f, 0x76, 0x10, 0xc1, 0xdd,
-	0xc0, 0x30, 0x50, 0xa5, 0x0b, 0xe7, 0x25, 0xa8, 0x79, 0xe9, 0x27, 0x2c, 0xb8, 0xa2, 0x5e, 0x82,
-	0x66, 0x40, 0xaa, 0xc3, 0xcf, 0xea, 0x1a, 0x7a, 0xb2, 0x90, 0x38, 0x51, 0x02, 0x4b, 0xf5, 0x26,
-	0x7a, 0x34, 0x3a, 0xbb, 0x0e, 0x3b, 0x70, 0x94, 0x60, 0xae, 0xde, 0x7c, 0xd8, 0x1a, 0x75, 0x29,
-	0x74, 0xa7, 0xd5, 0xf5, 0x6f, 0x3d, 0xa8, 0x0a, 0xb2, 0x75, 0xde, 0xb2, 0xd3, 0xea, 0x92, 0xb3,
-	0x9e, 0xc4, 0xda, 0xd8, 0xaa, 0x70, 0x91, 0x2d, 0x9e, 0xa3, 0x4b, 0xc7, 0xc8, 0xd0, 0x37, 0x2e,
-	0x70, 0xdf, 0x78, 0x37, 0x05, 0x89, 0x89, 0xdd, 0x73, 0xec, 0xbb, 0x19, 0x48, 0xb9, 0xce, 0x68,
-	0xd0, 0x72, 0x9d, 0xe2, 0x0f, 0x15, 0x80, 0x7b, 0xce, 0x60, 0x30, 0xb1, 0x7b, 0xef, 0x4d, 0x2c,
-	0x72, 0x09, 0xb2, 0x83, 0xd6, 0x13, 0xab, 0x39, 0xb0, 0x9a, 0x7b, 0x23, 0xef, 0x6d, 0xc8, 0xd0,
-	0xaa, 0x4d, 0xeb, 0xde, 0xe8, 0x90, 0x14, 0xbc, 0x04, 0x1e, 0x15, 0x84, 0xc2, 0xe4, 0x09, 0xfd,
-	0x19, 0x9e, 0x8e, 0x26, 0xf9, 0x4e, 0x7a, 0x09, 0x29, 0x3b, 0xe4, 0xa4, 0xf8, 0x1e, 0xb2, 0x63,
-	0xce, 0x39, 0x48, 0xba, 0xd6, 0x60, 0xd8, 0xdc, 0x43, 0xc1, 0x50, 0x51, 0x24, 0x68, 0xf9, 0x1e,
-	0xb9, 0x01, 0xb1, 0x3d, 0xa7, 0x8f, 0x52, 0x39, 0x72, 0x77, 0x28, 0x92, 0xbc, 0x04, 0xb1, 0xc1,
-	0x98, 0xc9, 0x27, 0x5b, 0x3e, 0x1d, 0xca, 0x20, 0x58, 0xc8, 0xa2, 0xc0, 0xc1, 0xb8, 0xeb, 0xcf,
-	0xbd, 0xf8, 0xa9, 0x0a, 0x69, 0xba, 0x5f, 0x6f, 0xef, 0xd4, 0x6f, 0xe1, 0xb1, 0x61, 0xaf, 0xd5,
-	0xc7, 0x1b, 0x02, 0xfa, 0x9a, 0xf2, 0x12, 0xad, 0xff, 0x8a, 0xb5, 0xe7, 0x3a, 0x23, 0x74, 0xcd,
-	0x19, 0x93, 0x97, 0xe8, 0x92, 0xb3, 0xac, 0x38, 0xc6, 0x67, 0xc9, 0x8a, 0x98, 0xd1, 0xb7, 0x86,
-	0x4d, 0xea, 0x03, 0x98, 0xbf, 0x0c, 0x9d, 0xae, 0xbd, 0xee, 0xe8, 0xd1, 0xed, 0x81, 0x75, 0xc8,
-	0xfc, 0x64, 0x72, 0x80, 0x05, 0xf2, 0xb3, 0xec, 0xc8, 0xc7, 0x76, 0x92, 0x7d, 0x5f, 0x55, 0x7c,
-	0x96, 0xf1, 0x3b, 0x14, 0x14, 0x9c, 0xfb, 0xb0, 0xb8, 0x78, 0x1b, 0xb2, 0x02, 0xef, 0x51, 0xae,
-	0x28, 0x16, 0xf1, 0x63, 0x21, 0xd6, 0xa3, 0x6e, 0x75, 0x44, 0x3f, 0x46, 0x57, 0xd4, 0xa1, 0x1a,
-	0xbe, 0x9a, 0x87, 0x58, 0xbd, 0xd1, 0xa0, 0x79, 0x56, 0xbd, 0xd1, 0x58, 0xd1, 0x94, 0xda, 0x0a,
-	0xa4, 0xbb, 0x23, 0xcb, 0xa2, 0xae, 0xf7, 0x59, 0xe7, 0xbc, 0x2f, 0xe3, 0xb2, 0xfa, 0xb0, 0xda,
-	0x5b, 0x90, 0xda, 0x63, 0x27, 0x3d, 0xf2, 0xcc, 0x5b, 0x8d, 0xc2, 0x1f, 0xb3, 0xdb, 0xb5, 0xe7,
-	0x45, 0x40, 0xf4, 0x7c, 0x68, 0x7a, 0x3c, 0xb5, 0x1d, 0xc8, 0x8c, 0x9a, 0x47, 0x93, 0x7e, 0xc0,
-	0x62, 0xb9, 0x9c, 0x34, 0x3d, 0xe2, 0x55, 0xb5, 0x75, 0x58, 0xb0, 0x1d, 0xef, 0x47, 0xbe, 0x66,
-	0x9b, 0x7b, 0xb2, 0x59, 0x49, 0xb4, 0xd7, 0x81, 0xc5, 0x3e, 0x15, 0xb0, 0x1d, 0xde, 0xc0, 0xbc,
-	0x5f, 0x6d, 0x0d, 0x34, 0x81, 0xa8, 0xc3, 0xdc, 0xa5, 0x8c, 0xa7, 0xc3, 0xbe, 0x4e, 0xf0, 0x79,
-	0xd0, 0xc3, 0x46, 0x68, 0xb8, 0x0f, 0x94, 0xd1, 0x74, 0xd9, 0xc7, 0x1e, 0x3e, 0x0d, 0x86, 0x95,
-	0x69, 0x1a, 0x1a, 0x11, 0x64, 0x34, 0xfb, 0xec, 0x4b, 0x10, 0x91, 0xa6, 0x6a, 0x44, 0x56, 0x67,
-	0x72, 0x8c, 0xe1, 0xf4, 0xd8, 0xa7, 0x1c, 0x3e, 0x0f, 0x0b, 0x38, 0x33, 0x88, 0x8e, 0x1a, 0xd0,
-	0x97, 0xd9, 0x77, 0x1e, 0x21, 0xa2, 0xa9, 0x11, 0x8d, 0x8f, 0x31, 0xa2, 0x27, 0xec, 0xb3, 0x0a,
-	0x9f, 0x68, 0x7b, 0xd6, 0x88, 0xc6, 0xc7, 0x18, 0x51, 0x9f, 0x7d, 0x72, 0x11, 0x22, 0xaa, 0x1a,
-	0xb5, 0x0d, 0x20, 0xe2, 0xc6, 0xf3, 0xe8, 0x2c, 0x65, 0x1a, 0xb0, 0x4f, 0x69, 0x82, 0xad, 0x67,
-	0x46, 0xb3, 0xa8, 0x8e, 0x1a, 0x94, 0xcd, 0xbe, 0xb3, 0x09, 0x53, 0x55, 0x8d, 0xda, 0x03, 0x38,
-	0x2d, 0x4e, 0xef, 0x58, 0xc3, 0x72, 0xd8, 0x47, 0x22, 0xc1, 0x04, 0xb9, 0xd5, 0x4c, 0xb2, 0xa3,
-	0x06, 0x36, 0x64, 0x1f, 0x90, 0x44, 0xc8, 0xaa, 0x46, 0xed, 0x1e, 0xe4, 0x05, 0xb2, 0x5d, 0xbc,
-	0x57, 0x90, 0x11, 0xbd, 0xc7, 0x3e, 0x7b, 0xf2, 0x89, 0x68, 0x46, 0x15, 0xdd, 0x3d, 0x96, 0x63,
-	0x48, 0x69, 0x46, 0xec, 0xab, 0x9d, 0x60, 0x3c, 0x68, 0x13, 0x79, 0x51, 0x76, 0x59, 0x42, 0x22,
-	0xe3, 0x19, 0xb3, 0x2f, 0x7a, 0x82, 0xe1, 0x50, 0x93, 0xda, 0x20, 0x34, 0x29, 0x8b, 0xa6, 0x19,
-	0x52, 0x16, 0x17, 0x23, 0x62, 0x49, 0x02, 0x59, 0x12, 0xaf, 0xaf, 0x84, 0xe9, 0xd3, 0x62, 0xed,
-	0x01, 0xcc, 0x9f, 0xc4, 0x65, 0x7d, 0xa0, 0xb0, 0xbb, 0x8c, 0xca, 0xd2, 0x8a, 0xb1, 0xb2, 0x6a,
-	0xce, 0xb5, 0x43, 0x9e, 0x6b, 0x1d, 0xe6, 0x4e, 0xe0, 0xb6, 0x3e, 0x54, 0xd8, 0x8d, 0x00, 0xe5,
-	0x32, 0x73, 0xed, 0xb0, 0xef, 0x9a, 0x3b, 0x81, 0xe3, 0xfa, 0x48, 0x61, 0x57, 0x48, 0x46, 0xd9,
-	0xa7, 0xf1, 0x7c, 0xd7, 0xdc, 0x09, 0x1c, 0xd7, 0xc7, 0xec, 0xc4, 0xaf, 0x1a, 0x15, 0x91, 0x06,
-	0x3d, 0xc5, 0xfc, 0x49, 0x1c, 0xd7, 0x27, 0x0a, 0x5e, 0x29, 0xa9, 0x86, 0xe1, 0xaf, 0x8f, 0xef,
-	0xbb, 0xe6, 0x4f, 0xe2, 0xb8, 0xbe, 0xa6, 0xe0, 0xd5, 0x93, 0x6a, 0xac, 0x86, 0x88, 0xc2, 0x23,
-	0x3a, 0x8e, 0xe3, 0xfa, 0x54, 0xc1, 0xfb, 0x20, 0xd5, 0xa8, 0xfa, 0x44, 0xdb, 0x53, 0x23, 0x3a,
-	0x8e, 0xe3, 0xfa, 0x3a, 0x9e, 0xaf, 0x6a, 0xaa, 0x71, 0x33, 0x44, 0x84, 0xbe, 0x2b, 0x7f, 0x22,
-	0xc7, 0xf5, 0x0d, 0x05, 0xaf, 0xee, 0x54, 0xe3, 0x96, 0xe9, 0x8d, 0x20, 0xf0, 0x5d, 0xf9, 0x13,
-	0x39, 0xae, 0x6f, 0x2a, 0x78, 0xc7, 0xa7, 0x1a, 0xb7, 0xc3, 0x54, 0xe8, 0xbb, 0xb4, 0x93, 0x39,
-	0xae, 0xcf, 0x14, 0xfc, 0xa2, 0x47, 0x5d, 0x5d, 0x36, 0xbd, 0x41, 0x08, 0xbe, 0x4b, 0x3b, 0x99,
-	0xe3, 0xfa, 0x96, 0x82, 0x9f, 0xf9, 0xa8, 0xab, 0x2b, 0x11, 0xb2, 0xaa, 0x51, 0x5b, 0x83, 0xdc,
-	0xf1, 0x1d, 0xd7, 0xb7, 0xc5, 0x1b, 0xd4, 0x6c, 0x5b, 0xf0, 0x5e, 0x8f, 0x85, 0xfd, 0x3b, 0x86,
-	0xeb, 0xfa, 0x0e, 0x26, 0x7f, 0xb5, 0xe7, 0xde, 0x64, 0xf7, 0x8c, 0xcc, 0xe4, 0x95, 0xb6, 0xd5,
-	0x79, 0xad, 0xe3, 0x38, 0xc1, 0x96, 0x32, 0x87, 0xd6, 0x08, 0xde, 0x9e, 0x63, 0x78, 0xb3, 0xef,
-	0x2a, 0x78, 0x2d, 0x99, 0xe3, 0xd4, 0x68, 0xe1, 0xbf, 0x47, 0xcc, 0xb5, 0xd9, 0xc1, 0x9c, 0x8f,
-	0xf6, 0x6b, 0xdf, 0x53, 0x4e, 0xe6, 0xd8, 0x6a, 0xb1, 0xc6, 0xd6, 0x9a, 0xbf, 0x38, 0x58, 0xf3,
-	0x06, 0xc4, 0x0f, 0xca, 0xcb, 0x2b, 0xe1, 0x14, 0x4f, 0xbc, 0x95, 0x67, 0xee, 0x2c, 0x5b, 0x5e,
-	0x08, 0xfd, 0x7c, 0x31, 0x18, 0xba, 0x87, 0x26, 0x5a, 0x72, 0x86, 0xb2, 0x84, 0xe1, 0x43, 0x29,
-	0x43, 0x99, 0x33, 0x54, 0x24, 0x0c, 0x1f, 0x49, 0x19, 0x2a, 0x9c, 0xc1, 0x90, 0x30, 0x7c, 0x2c,
-	0x65, 0x30, 0x38, 0xc3, 0xaa, 0x84, 0xe1, 0x13, 0x29, 0xc3, 0x2a, 0x67, 0xa8, 0x4a, 0x18, 0xbe,
-	0x26, 0x65, 0xa8, 0x72, 0x86, 0x9b, 0x12, 0x86, 0x4f, 0xa5, 0x0c, 0x37, 0x39, 0xc3, 0x2d, 0x09,
-	0xc3, 0xd7, 0xa5, 0x0c, 0xb7, 0x38, 0xc3, 0x6d, 0x09, 0xc3, 0x37, 0xa4, 0x0c, 0xb7, 0x19, 0xc3,
-	0xca, 0xb2, 0x84, 0xe1, 0x9b, 0x32, 0x86, 0x95, 0x65, 0xce, 0x20, 0xd3, 0xe4, 0x67, 0x52, 0x06,
-	0xae, 0xc9, 0x15, 0x99, 0x26, 0xbf, 0x25, 0x65, 0xe0, 0x9a, 0x5c, 0x91, 0x69, 0xf2, 0xdb, 0x52,
-	0x06, 0xae, 0xc9, 0x15, 0x99, 0x26, 0xbf, 0x23, 0x65, 0xe0, 0x9a, 0x5c, 0x91, 0x69, 0xf2, 0xbb,
-	0x52, 0x06, 0xae, 0xc9, 0x15, 0x99, 0x26, 0xbf, 0x27, 0x65, 0xe0, 0x9a, 0x5c, 0x91, 0x69, 0xf2,
-	0x4f, 0xa4, 0x0c, 0x5c, 0x93, 0x2b, 0x32, 0x4d, 0xfe, 0xa9, 0x94, 0x81, 0x6b, 0x72, 0x45, 0xa6,
-	0xc9, 0x3f, 0x93, 0x32, 0x70, 0x4d, 0x96, 0x65, 0x9a, 0xfc, 0xbe, 0x8c, 0xa1, 0xcc, 0x35, 0x59,
-	0x96, 0x69, 0xf2, 0xcf, 0xa5, 0x0c, 0x5c, 0x93, 0x65, 0x99, 0x26, 0xff, 0x42, 0xca, 0xc0, 0x35,
-	0x59, 0x96, 0x69, 0xf2, 0x07, 0x52, 0x06, 0xae, 0xc9, 0xb2, 0x4c, 0x93, 0x7f, 0x29, 0x65, 0xe0,
-	0x9a, 0x2c, 0xcb, 0x34, 0xf9, 0x57, 0x52, 0x06, 0xae, 0xc9, 0xb2, 0x4c, 0x93, 0x7f, 0x2d, 0x65,
-	0xe0, 0x9a, 0x2c, 0xcb, 0x34, 0xf9, 0x37, 0x52, 0x06, 0xae, 0xc9, 0xb2, 0x4c, 0x93, 0x7f, 0x2b,
-	0x65, 0xe0, 0x9a, 0x2c, 0xcb, 0x34, 0xf9, 0x77, 0x52, 0x06, 0xae, 0xc9, 0x8a, 0x4c, 0x93, 0x7f,
-	0x2f, 0x63, 0xa8, 0x70, 0x4d, 0x56, 0x64, 0x9a, 0xfc, 0x07, 0x29, 0x03, 0xd7, 0x64, 0x45, 0xa6,
-	0xc9, 0x7f, 0x94, 0x32, 0x70, 0x4d, 0x56, 0x64, 0x9a, 0xfc, 0x27, 0x29, 0x03, 0xd7, 0x64, 0x45,
-	0xa6, 0xc9, 0x7f, 0x96, 0x32, 0x70, 0x4d, 0x56, 0x64, 0x9a, 0xfc, 0x17, 0x29, 0x03, 0xd7, 0x64,
-	0x45, 0xa6, 0xc9, 0x7f, 0x95, 0x32, 0x70, 0x4d, 0x56, 0x64, 0x9a, 0xfc, 0x37, 0x29, 0x03, 0xd7,
-	0x64, 0x45, 0xa6, 0xc9, 0x1f, 0x4a, 0x19, 0xb8, 0x26, 0x2b, 0x32, 0x4d, 0xfe, 0xbb, 0x94, 0x81,
-	0x6b, 0xd2, 0x90, 0x69, 0xf2, 0x3f, 0x64, 0x0c, 0x06, 0xd7, 0xa4, 0x21, 0xd3, 0xe4, 0x7f, 0x4a,
-	0x19, 0xb8, 0x26, 0x0d, 0x99, 0x26, 0xff, 0x4b, 0xca, 0xc0, 0x35, 0x69, 0xc8, 0x34, 0xf9, 0xdf,
-	0x52, 0x06, 0xae, 0x49, 0x43, 0xa6, 0xc9, 0xff, 0x91, 0x32, 0x70, 0x4d, 0x1a, 0x32, 0x4d, 0xfe,
-	0xaf, 0x94, 0x81, 0x6b, 0xd2, 0x90, 0x69, 0xf2, 0x47, 0x52, 0x06, 0xae, 0x49, 0x43, 0xa6, 0xc9,
-	0x1f, 0x4b, 0x19, 0xb8, 0x26, 0x0d, 0x99, 0x26, 0x7f, 0x22, 0x65, 0xe0, 0x9a, 0x34, 0x64, 0x9a,
-	0xfc, 0xa9, 0x94, 0x81, 0x6b, 0x72, 0x55, 0xa6, 0xc9, 0xff, 0x93, 0x31, 0xac, 0x2e, 0xdf, 0xbd,
-	0xfe, 0xf8, 0x5a, 0xb7, 0xe7, 0xee, 0x4f, 0x76, 0x97, 0xf6, 0x9c, 0xc1, 0x8d, 0xae, 0xd3, 0x6f,
-	0xd9, 0xdd, 0x1b, 0x08, 0xdb, 0x9d, 0x74, 0x6e, 0x04, 0xff, 0xcc, 0xce, 0x4c, 0xff, 0x3f, 0x00,
-	0x00, 0xff, 0xff, 0x8e, 0xb4, 0x0c, 0xbd, 0xe4, 0x3e, 0x00, 0x00,
+	// 4852 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x5b, 0xd9, 0x77, 0xdb, 0x56,
+	0x7a, 0x37, 0x00, 0xae, 0x1f, 0x29, 0x11, 0xba, 0x56, 0x6c, 0x84, 0x8e, 0x6d, 0x98, 0x93, 0x4c,
+	0x18, 0x3b, 0xa6, 0x25, 0x12, 0xa2, 0x6d, 0xa6, 0x49, 0xe3, 0x45, 0x54, 0x7c, 0x6c, 0x89, 0x0e,
+	0x24, 0x27, 0x1d, 0xf7, 0x81, 0x87, 0x12, 0x41, 0x8a, 0x63, 0x12, 0x60, 0x08, 0x70, 0x2c, 0xb5,
+	0xa7, 0xe7, 0xe4, 0xb1, 0xe7, 0xf4, 0xa9, 0xd3, 0xf6, 0x9c, 0xbe, 0xf7, 0xa5, 0x2f, 0xdd, 0x1e,
+	0xda, 0xbf, 0xa1, 0xd9, 0x26, 0x33, 0x93, 0xd9, 0xda, 0x4e, 0x3b, 0xdd, 0xf7, 0x4e, 0xf7, 0x59,
+	0xfa, 0x92, 0x9e, 0xbb, 0x00, 0xb8, 0x00, 0xe9, 0x2b, 0xe9, 0x49, 0xb8, 0xf7, 0xfe, 0xbe, 0xdf,
+	0xdd, 0x7e, 0xf8, 0xee, 0x77, 0x3f, 0x42, 0xf0, 0x9c, 0x67, 0xb9, 0x5e, 0x7b, 0x3c, 0x71, 0x3c,
+	0xe7, 0x1a, 0x7e, 0xac, 0x90, 0x47, 0x04, 0x61, 0x75, 0xf1, 0x42, 0xdf, 0x71, 0xfa, 0x43, 0xeb,
+	0x1a, 0x29, 0xed, 0x4e, 0x7b, 0xd7, 0xba, 0xd3, 0x49, 0xc7, 0x1b, 0x38, 0x36, 0xc5, 0x16, 0x2f,
+	0xc6, 0xdb, 0xbd, 0xc1, 0xc8, 0x72, 0xbd, 0xce, 0x68, 0x4c, 0x01, 0xa5, 0x2b, 0x90, 0xda, 0x70,
+	0xd6, 0xed, 0xe9, 0x08, 0x5d, 0x02, 0xa5, 0xe7, 0x38, 0x9a, 0xa4, 0xcb, 0xe5, 0xc5, 0x6a, 0xa1,
+	0x12, 0x76, 0x52, 0x69, 0xb6, 0x5a, 0x26, 0x6e, 0x2b, 0x5d, 0x87, 0xdc, 0x86, 0xb3, 0x63, 0xb9,
+	0x5e, 0x73, 0x60, 0x0d, 0xbb, 0x68, 0x19, 0x92, 0x0f, 0x3a, 0xbb, 0xd6, 0x90, 0xd8, 0x64, 0x4d,
+	0x5a, 0x40, 0x08, 0x12, 0x3b, 0x87, 0x63, 0x4b, 0x93, 0x49, 0x25, 0x79, 0x2e, 0xfd, 0x61, 0x09,
+	0x52, 0xd4, 0x12, 0x5d, 0x81, 0xc4, 0xfd, 0x81, 0xdd, 0x65, 0xfd, 0x9c, 0xe5, 0xfb, 0xa1, 0x88,
+	0xca, 0xfd, 0x7b, 0x5b, 0x77, 0x4d, 0x02, 0xc2, 0x3d, 0xec, 0x74, 0x76, 0x87, 0x98, 0x4c, 0xc2,
+	0x3d, 0x90, 0x02, 0xae, 0x7d, 0xd8, 0x99, 0x74, 0x46, 0x9a, 0xa2, 0x4b, 0xe5, 0xa4, 0x49, 0x0b,
+	0xe8, 0x75, 0x58, 0x30, 0xad, 0xf7, 0xa6, 0x83, 0x89, 0xd5, 0x25, 0xc3, 0xd3, 0x12, 0xba, 0x5c,
+	0xce, 0xcd, 0xeb, 0x81, 0x34, 0x9b, 0x51, 0x34, 0x35, 0x1f, 0x5b, 0x1d, 0xcf, 0x37, 0x4f, 0xea,
+	0xca, 0x11, 0xe6, 0x1c, 0x1a, 0x9b, 0xb7, 0xc6, 0x78, 0xe1, 0x3b, 0x43, 0x6a, 0x9e, 0xd2, 0x25,
+	0xa1, 0x79, 0x04, 0x8d, 0xbe, 0x08, 0x85, 0x66, 0xfb, 0xb6, 0xe3, 0x0c, 0xdb, 0x13, 0x36, 0x2a,
+	0x0d, 0x74, 0xb9, 0x9c, 0x31, 0x17, 0x9a, 0xb8, 0xd6, 0x1f, 0x2a, 0x2a, 0x83, 0xda, 0x6c, 0xdf,
+	0xb3, 0xbd, 0x5a, 0x35, 0x04, 0xe6, 0x74, 0xb9, 0x9c, 0x34, 0x17, 0x9b, 0xa4, 0x7a, 0x06, 0x59,
+	0x37, 0x42, 0x64, 0x5e, 0x97, 0xcb, 0x0a, 0x45, 0xd6, 0x8d, 0x00, 0xf9, 0x2a, 0xa0, 0x66, 0xbb,
+	0x39, 0x38, 0xb0, 0xba, 0x3c, 0xeb, 0x82, 0x2e, 0x97, 0xd3, 0xa6, 0xda, 0x64, 0x0d, 0x73, 0xd0,
+	0x3c, 0xf3, 0xa2, 0x2e, 0x97, 0x53, 0x3e, 0x9a, 0xe3, 0xbe, 0x0c, 0x4b, 0xcd, 0xf6, 0xa3, 0x41,
+	0x74, 0xc0, 0x05, 0x5d, 0x2e, 0x2f, 0x98, 0x85, 0x26, 0xad, 0x9f, 0xc5, 0xf2, 0xc4, 0xaa, 0x2e,
+	0x97, 0x13, 0x0c, 0x5b, 0x37, 0xa2, 0xb3, 0x6b, 0x0e, 0x9d, 0x8e, 0x17, 0x42, 0x97, 0x74, 0xb9,
+	0x2c, 0x9b, 0x8b, 0x4d, 0x52, 0x1d, 0x65, 0xbd, 0xeb, 0x4c, 0x77, 0x87, 0x56, 0x08, 0x45, 0xba,
+	0x5c, 0x96, 0xcc, 0x42, 0x93, 0xd6, 0x47, 0xb1, 0xdb, 0xde, 0x64, 0x60, 0xf7, 0x43, 0xec, 0x69,
+	0xa2, 0xe3, 0x42, 0x93, 0xd6, 0x47, 0x47, 0x70, 0xfb, 0xd0, 0xb3, 0xdc, 0x10, 0x6a, 0xe9, 0x72,
+	0x39, 0x6f, 0x2e, 0x36, 0x49, 0x75, 0x8c, 0x35, 0xb6, 0x06, 0x3d, 0x5d, 0x2e, 0x2f, 0x61, 0xd6,
+	0x39, 0x6b, 0xb0, 0x1d, 0x5b, 0x83, 0xbe, 0x2e, 0x97, 0x11, 0xc3, 0x72, 0x6b, 0x50, 0x81, 0xd3,
+	0xcd, 0xf6, 0x76, 0x2f, 0xbe, 0x71, 0xfb, 0xba, 0x5c, 0x2e, 0x98, 0x4b, 0x4d, 0xbf, 0x65, 0x1e,
+	0x9e, 0x67, 0x1f, 0xe8, 0x72, 0x59, 0x0d, 0xf0, 0x1c, 0x3f, 0xaf, 0x49, 0x2a, 0x75, 0x6d, 0x59,
+	0x57, 0x38, 0x4d, 0xd2, 0xca, 0xa8, 0x26, 0x19, 0xf0, 0x39, 0x5d, 0xe1, 0x35, 0x19, 0x43, 0x92,
+	0xee, 0x19, 0xf2, 0x8c, 0xae, 0xf0, 0x9a, 0x64, 0xc8, 0x98, 0x26, 0x19, 0xf6, 0xac, 0xae, 0x44,
+	0x35, 0x39, 0x83, 0xe6, 0x99, 0x35, 0x5d, 0x89, 0x6a, 0x92, 0xa1, 0xa3, 0x9a, 0x64, 0xe0, 0xe7,
+	0x75, 0x25, 0xa2, 0xc9, 0x38, 0x96, 0x27, 0x2e, 0xea, 0x4a, 0x44, 0x93, 0xfc, 0xec, 0x7c, 0x4d,
+	0x32, 0xe8, 0x39, 0x5d, 0xe1, 0x35, 0xc9, 0xb3, 0x06, 0x9a, 0x64, 0xd0, 0x17, 0x74, 0x25, 0xa2,
+	0x49, 0x1e, 0x1b, 0x68, 0x92, 0x61, 0xcf, 0xeb, 0x4a, 0x44, 0x93, 0x0c, 0xfb, 0x0a, 0xaf, 0x49,
+	0x06, 0xfd, 0x40, 0xd2, 0x15, 0x5e, 0x94, 0x0c, 0x7a, 0x25, 0x22, 0x4a, 0x86, 0xfd, 0x10, 0x63,
+	0x79, 0x55, 0xc6, 0xc1, 0xfc, 0x2a, 0x7c, 0x84, 0xc1, 0xbc, 0x2c, 0x19, 0xf8, 0x5a, 0x4c, 0x96,
+	0x0c, 0xfe, 0x31, 0x86, 0x47, 0x75, 0x39, 0x6b, 0xc0, 0xf3, 0x7f, 0x82, 0x0d, 0xa2, 0xc2, 0x64,
+	0x06, 0xa1, 0x30, 0x1d, 0xe6, 0x44, 0xb5, 0x0b, 0xba, 0x14, 0x08, 0xd3, 0xf7, 0xac, 0xbc, 0x30,
+	0x03, 0xe0, 0x45, 0x72, 0x64, 0x30, 0x61, 0xce, 0x20, 0xeb, 0x46, 0x88, 0xd4, 0x75, 0x29, 0x14,
+	0x66, 0x80, 0x8c, 0x08, 0x33, 0xc0, 0x5e, 0xd2, 0x25, 0x5e, 0x98, 0x73, 0xd0, 0x3c, 0x73, 0x49,
+	0x97, 0x78, 0x61, 0x06, 0x68, 0x5e, 0x98, 0x01, 0xf8, 0x0b, 0xba, 0xc4, 0x09, 0x73, 0x16, 0xcb,
+	0x13, 0xbf, 0xa8, 0x4b, 0x9c, 0x30, 0xa3, 0xb3, 0xa3, 0xc2, 0x0c, 0xa0, 0x2f, 0xe9, 0x52, 0x28,
+	0xcc, 0x28, 0x2b, 0x13, 0x66, 0x00, 0xfd, 0xa2, 0x2e, 0x71, 0xc2, 0x8c, 0x62, 0x99, 0x30, 0x03,
+	0xec, 0xcb, 0xba, 0xc4, 0x09, 0x33, 0xc0, 0x72, 0xc2, 0x0c, 0xa0, 0xbf, 0x83, 0xcf, 0xf4, 0x40,
+	0x98, 0x01, 0x94, 0x17, 0x66, 0x80, 0xfd, 0x5d, 0x8c, 0x0d, 0x85, 0x39, 0x0b, 0xe6, 0x57, 0xe1,
+	0xf7, 0x30, 0x38, 0x14, 0x66, 0x00, 0x8e, 0x0a, 0x33, 0x80, 0xff, 0x3e, 0x86, 0xf3, 0xc2, 0x9c,
+	0x67, 0xc0, 0xf3, 0xff, 0x01, 0x36, 0xe0, 0x85, 0x19, 0x18, 0x54, 0x40, 0x65, 0xc2, 0xec, 0x5a,
+	0xbd, 0xce, 0x74, 0x88, 0x65, 0x5c, 0xc6, 0xca, 0x6c, 0x24, 0xbc, 0xc9, 0xd4, 0xc2, 0x73, 0x75,
+	0x9c, 0xe1, 0x5d, 0xbf, 0x0d, 0x55, 0xf0, 0xf0, 0xa9, 0x40, 0x43, 0x83, 0x57, 0xb0, 0x42, 0x1b,
+	0x72, 0xad, 0x6a, 0x16, 0xa8, 0x4a, 0x67, 0xf1, 0x75, 0x83, 0xc3, 0x5f, 0xc6, 0x3a, 0x6d, 0xc8,
+	0x75, 0x83, 0xe2, 0xeb, 0x46, 0x88, 0xaf, 0xe1, 0x09, 0xf8, 0x62, 0x0d, 0x2d, 0xae, 0x60, 0xb5,
+	0x36, 0x94, 0x5a, 0x75, 0xc5, 0x5c, 0xf2, 0x25, 0x3b, 0xcf, 0x28, 0xd2, 0xcd, 0xab, 0x58, 0xb4,
+	0x0d, 0xa5, 0x6e, 0x04, 0x46, 0x7c, 0x4f, 0x55, 0x2c, 0x74, 0x26, 0xdd, 0xd0, 0xe6, 0x2a, 0xd6,
+	0x6e, 0x23, 0x51, 0xab, 0xae, 0xac, 0x98, 0x2a, 0x53, 0xf0, 0x1c, 0x9b, 0x48, 0x3f, 0x15, 0xac,
+	0xe1, 0x46, 0xa2, 0x6e, 0x04, 0x36, 0xd1, 0x7e, 0x96, 0x7c, 0x29, 0x87, 0x26, 0xd7, 0xb0, 0x96,
+	0x1b, 0xa9, 0xda, 0xaa, 0xb1, 0xba, 0x76, 0xd3, 0x2c, 0x50, 0x4d, 0x87, 0x36, 0x06, 0xee, 0x87,
+	0x89, 0x3a, 0x34, 0x5a, 0xc1, 0xaa, 0x6e, 0xa4, 0xaa, 0xd7, 0x57, 0x6f, 0x54, 0x6f, 0x98, 0x2a,
+	0x53, 0x77, 0x68, 0xf5, 0x06, 0xb6, 0x62, 0xf2, 0x0e, 0xad, 0x56, 0xb1, 0xbe, 0x1b, 0xea, 0xbe,
+	0x35, 0x1c, 0x3a, 0xaf, 0xea, 0xa5, 0xa7, 0xce, 0x64, 0xd8, 0xbd, 0x54, 0x02, 0x53, 0x65, 0x8a,
+	0xe7, 0x7b, 0x5d, 0xf2, 0x25, 0x1f, 0x9a, 0xff, 0x2a, 0x8e, 0x58, 0xf3, 0x8d, 0xf4, 0xed, 0x41,
+	0xdf, 0x76, 0x5c, 0xcb, 0x2c, 0x50, 0xf1, 0xc7, 0xd6, 0x64, 0x3b, 0xbe, 0x8e, 0x5f, 0xc5, 0x66,
+	0x4b, 0x0d, 0xe5, 0x6a, 0xad, 0x8a, 0x7b, 0x9a, 0xb7, 0x8e, 0xdb, 0xf1, 0x75, 0xfc, 0x35, 0x6c,
+	0x83, 0x1a, 0xca, 0xd5, 0xba, 0xc1, 0x6c, 0xf8, 0x75, 0xac, 0xc3, 0x32, 0xf7, 0x2e, 0x84, 0x56,
+	0xbf, 0x8e, 0xad, 0x0a, 0xb4, 0x27, 0x14, 0xbc, 0x11, 0x73, 0xed, 0x22, 0xbd, 0xfd, 0x06, 0xb6,
+	0x53, 0x69, 0x6f, 0x28, 0x78, 0x31, 0x42, 0xbb, 0xeb, 0x70, 0x26, 0x16, 0x4b, 0xb4, 0xc7, 0x9d,
+	0xbd, 0x27, 0x56, 0x57, 0xab, 0xe2, 0x90, 0xe2, 0xb6, 0xac, 0x4a, 0xe6, 0xe9, 0x48, 0x58, 0xf1,
+	0x90, 0x34, 0xa3, 0x9b, 0x70, 0x36, 0x1e, 0x5c, 0xf8, 0x96, 0x35, 0x1c, 0x63, 0x10, 0xcb, 0xe5,
+	0x68, 0x9c, 0x11, 0x33, 0xad, 0x1b, 0x33, 0xa6, 0x06, 0x0e, 0x3a, 0x42, 0xd3, 0xba, 0x11, 0x33,
+	0x7d, 0x1d, 0x9e, 0x9f, 0x0d, 0x3f, 0x7c, 0xe3, 0x35, 0x1c, 0x85, 0x10, 0xe3, 0x33, 0xf1, 0x48,
+	0x64, 0xc6, 0x7c, 0x4e, 0xdf, 0x75, 0x1c, 0x96, 0xf0, 0xe6, 0x33, 0xbd, 0xbf, 0x06, 0xda, 0x4c,
+	0x80, 0xe2, 0x5b, 0x5f, 0xc7, 0x71, 0x0a, 0xb1, 0x7e, 0x2e, 0x16, 0xab, 0xc4, 0x8d, 0xe7, 0x74,
+	0x7d, 0x03, 0x07, 0x2e, 0x9c, 0x71, 0xdd, 0x98, 0xb7, 0x64, 0xd1, 0x10, 0xc6, 0xb7, 0xbd, 0x89,
+	0x23, 0x19, 0xb6, 0x64, 0x91, 0x68, 0x86, 0xef, 0x37, 0x16, 0xd3, 0xf8, 0xb6, 0x0d, 0x1c, 0xda,
+	0xb0, 0x7e, 0xa3, 0xe1, 0x0d, 0x33, 0xfe, 0x19, 0x6c, 0xbc, 0x3d, 0x7f, 0xc6, 0x3f, 0x52, 0x70,
+	0x50, 0xc2, 0xac, 0xb7, 0xe7, 0x4d, 0x39, 0xb0, 0x9e, 0x33, 0xe5, 0x1f, 0x63, 0x6b, 0xc4, 0x59,
+	0xcf, 0xcc, 0xf9, 0x4d, 0x28, 0xce, 0x89, 0x57, 0x7c, 0xfb, 0x9f, 0x60, 0xfb, 0x02, 0xb1, 0x3f,
+	0x3b, 0x13, 0xba, 0xcc, 0x32, 0xcc, 0x19, 0xc1, 0x4f, 0x31, 0x83, 0x1a, 0x61, 0x98, 0x19, 0x43,
+	0x13, 0x16, 0xfc, 0x78, 0xbc, 0x3f, 0x71, 0xa6, 0x63, 0xad, 0xa9, 0xcb, 0x65, 0xa8, 0xea, 0x73,
+	0x6e, 0xc7, 0x7e, 0x78, 0xbe, 0x81, 0x71, 0x66, 0xd4, 0x8c, 0xf2, 0x50, 0x66, 0xca, 0xf3, 0x50,
+	0x57, 0x9e, 0xc9, 0x43, 0x71, 0x01, 0x0f, 0x67, 0x86, 0x79, 0xfc, 0xe3, 0x8e, 0xf2, 0x3c, 0xd6,
+	0xa5, 0x67, 0xf0, 0xf8, 0x87, 0x1f, 0xe3, 0x89, 0x98, 0x15, 0xd7, 0xc2, 0x3b, 0x39, 0x69, 0x47,
+	0x2f, 0xc6, 0x2f, 0xe9, 0x1b, 0xe4, 0x76, 0x15, 0xad, 0xa4, 0x66, 0xdc, 0xf0, 0x66, 0xcd, 0xde,
+	0x7e, 0x86, 0x59, 0x64, 0x34, 0xb3, 0x66, 0x3f, 0x3f, 0xc7, 0xac, 0xf4, 0x9b, 0x12, 0x24, 0x70,
+	0xce, 0x01, 0x65, 0x20, 0xf1, 0x4e, 0xeb, 0xde, 0x5d, 0xf5, 0x14, 0x7e, 0xba, 0xdd, 0x6a, 0x3d,
+	0x50, 0x25, 0x94, 0x85, 0xe4, 0xed, 0x2f, 0xed, 0xac, 0x6f, 0xab, 0x32, 0x2a, 0x40, 0xae, 0x79,
+	0x6f, 0x6b, 0x63, 0xdd, 0x7c, 0x68, 0xde, 0xdb, 0xda, 0x51, 0x15, 0xdc, 0xd6, 0x7c, 0xd0, 0xba,
+	0xb5, 0xa3, 0x26, 0x50, 0x1a, 0x14, 0x5c, 0x97, 0x44, 0x00, 0xa9, 0xed, 0x1d, 0xf3, 0xde, 0xd6,
+	0x86, 0x9a, 0xc2, 0x2c, 0x3b, 0xf7, 0x36, 0xd7, 0xd5, 0x34, 0x46, 0xee, 0x3c, 0x7a, 0xf8, 0x60,
+	0x5d, 0xcd, 0xe0, 0xc7, 0x5b, 0xa6, 0x79, 0xeb, 0x4b, 0x6a, 0x16, 0x1b, 0x6d, 0xde, 0x7a, 0xa8,
+	0x02, 0x69, 0xbe, 0x75, 0xfb, 0xc1, 0xba, 0x9a, 0x43, 0x79, 0xc8, 0x34, 0x1f, 0x6d, 0xdd, 0xd9,
+	0xb9, 0xd7, 0xda, 0x52, 0xf3, 0xa5, 0x5f, 0x04, 0x8d, 0x2e, 0x73, 0x64, 0x15, 0xc9, 0xb0, 0xd1,
+	0x9b, 0x90, 0xa4, 0x7b, 0x23, 0x11, 0xad, 0x5c, 0x9e, 0xdd, 0x9b, 0x59, 0xa3, 0x0a, 0x79, 0x34,
+	0xa9, 0x61, 0xf1, 0x3c, 0x24, 0xe9, 0x3a, 0x2d, 0x43, 0x92, 0xae, 0x8f, 0x4c, 0x52, 0x09, 0xb4,
+	0x50, 0xfa, 0x2d, 0x19, 0x60, 0xc3, 0xd9, 0x7e, 0x32, 0x18, 0x63, 0x32, 0x74, 0x1e, 0xc0, 0x7d,
+	0x32, 0x18, 0xb7, 0xc9, 0x1b, 0xc8, 0x92, 0x0e, 0x59, 0x5c, 0x43, 0x7c, 0x2f, 0xba, 0x04, 0x79,
+	0xd2, 0xcc, 0x5e, 0x11, 0x92, 0x6b, 0x48, 0x9b, 0x39, 0x5c, 0xc7, 0x9c, 0x64, 0x14, 0x52, 0x37,
+	0x48, 0x8a, 0x21, 0xc5, 0x41, 0xea, 0x06, 0xba, 0x08, 0xa4, 0xd8, 0x76, 0xc9, 0x69, 0x4a, 0xd2,
+	0x0a, 0x59, 0x93, 0xf4, 0x4b, 0xcf, 0x57, 0xf4, 0x06, 0x90, 0x3e, 0xe9, 0xcc, 0x0b, 0xf3, 0xde,
+	0x12, 0x7f, 0xc0, 0x15, 0xfc, 0x40, 0xe7, 0x1b, 0x9a, 0x14, 0x5b, 0x90, 0x0d, 0xea, 0x71, 0x6f,
+	0xa4, 0x96, 0xcd, 0x49, 0x25, 0x73, 0x02, 0x52, 0x15, 0x4c, 0x8a, 0x02, 0xd8, 0x78, 0x96, 0xc8,
+	0x78, 0xa8, 0x11, 0x1d, 0x50, 0xe9, 0x3c, 0x2c, 0x6c, 0x39, 0x36, 0x7d, 0x8f, 0xc9, 0x3a, 0xe5,
+	0x41, 0xea, 0x68, 0x12, 0xb9, 0xff, 0x4a, 0x9d, 0xd2, 0x05, 0x00, 0xae, 0x4d, 0x05, 0x69, 0x97,
+	0xb6, 0x11, 0x7f, 0x20, 0xed, 0xe2, 0xfc, 0xdb, 0x66, 0xe7, 0x60, 0xa7, 0xd3, 0x47, 0x97, 0x00,
+	0x86, 0x1d, 0xd7, 0x6b, 0xf7, 0xc8, 0x4e, 0x7c, 0xfe, 0xf9, 0xe7, 0x9f, 0x4b, 0x24, 0x98, 0xce,
+	0xe2, 0x5a, 0xba, 0x23, 0x2e, 0x40, 0x6b, 0xd8, 0xdd, 0xb4, 0x5c, 0xb7, 0xd3, 0xb7, 0xd0, 0x1a,
+	0xa4, 0x6c, 0xcb, 0xc5, 0xa7, 0xaf, 0x44, 0x72, 0x4d, 0xe7, 0xf9, 0x75, 0x08, 0x71, 0x95, 0x2d,
+	0x02, 0x32, 0x19, 0x18, 0xa9, 0xa0, 0xd8, 0xd3, 0x11, 0xc9, 0xa8, 0x25, 0x4d, 0xfc, 0x58, 0x7c,
+	0x01, 0x52, 0x14, 0x83, 0x73, 0x77, 0x76, 0x67, 0x64, 0x69, 0xb4, 0x67, 0xf2, 0x5c, 0xfa, 0xaa,
+	0x04, 0xb0, 0x65, 0x3d, 0x3d, 0x56, 0xaf, 0x21, 0x4e, 0xd0, 0xab, 0x42, 0x7b, 0x7d, 0x4d, 0xd4,
+	0x2b, 0x56, 0x5b, 0xcf, 0x71, 0xba, 0x6d, 0xba, 0xd1, 0x34, 0xfd, 0x97, 0xc5, 0x35, 0x64, 0xe7,
+	0x4a, 0x8f, 0x21, 0x7f, 0xcf, 0xb6, 0xad, 0x89, 0x3f, 0x2a, 0x04, 0x89, 0x7d, 0xc7, 0xf5, 0x58,
+	0x26, 0x92, 0x3c, 0x23, 0x0d, 0x12, 0x63, 0x67, 0xe2, 0xd1, 0x99, 0x36, 0x12, 0xc6, 0xca, 0xca,
+	0x8a, 0x49, 0x6a, 0xd0, 0x0b, 0x90, 0xdd, 0x73, 0x6c, 0xdb, 0xda, 0xc3, 0xd3, 0x50, 0xc8, 0xd5,
+	0x31, 0xac, 0x28, 0xfd, 0xb2, 0x04, 0xf9, 0x96, 0xb7, 0x1f, 0x92, 0xab, 0xa0, 0x3c, 0xb1, 0x0e,
+	0xc9, 0xf0, 0x14, 0x13, 0x3f, 0xe2, 0x17, 0xe6, 0x2b, 0x9d, 0xe1, 0x94, 0xe6, 0x25, 0xf3, 0x26,
+	0x2d, 0xa0, 0x33, 0x90, 0x7a, 0x6a, 0x0d, 0xfa, 0xfb, 0x1e, 0xe1, 0x94, 0x4d, 0x56, 0x42, 0x15,
+	0x48, 0x0e, 0xf0, 0x60, 0xb5, 0x04, 0x59, 0x31, 0x8d, 0x5f, 0x31, 0x7e, 0x16, 0x26, 0x85, 0x5d,
+	0xce, 0x64, 0xba, 0xea, 0xfb, 0xef, 0xbf, 0xff, 0xbe, 0x5c, 0xda, 0x87, 0x65, 0xff, 0x25, 0x8e,
+	0x4c, 0xf7, 0x21, 0x68, 0x43, 0xcb, 0x69, 0xf7, 0x06, 0x76, 0x67, 0x38, 0x3c, 0x6c, 0x3f, 0x75,
+	0xec, 0x76, 0xc7, 0x6e, 0x3b, 0xee, 0x5e, 0x67, 0x42, 0x96, 0x40, 0xd4, 0xc9, 0xf2, 0xd0, 0x72,
+	0x9a, 0xd4, 0xf0, 0x5d, 0xc7, 0xbe, 0x65, 0xb7, 0xb0, 0x55, 0xe9, 0xb3, 0x04, 0x64, 0x37, 0x0f,
+	0x7d, 0xfe, 0x65, 0x48, 0xee, 0x39, 0x53, 0x9b, 0xae, 0x67, 0xd2, 0xa4, 0x85, 0x60, 0x9f, 0x64,
+	0x6e, 0x9f, 0x96, 0x21, 0xf9, 0xde, 0xd4, 0xf1, 0x2c, 0x32, 0xe5, 0xac, 0x49, 0x0b, 0x78, 0xc5,
+	0xc6, 0x96, 0xa7, 0x25, 0x48, 0x9a, 0x02, 0x3f, 0x86, 0x6b, 0x90, 0x3c, 0xd6, 0x1a, 0xa0, 0x15,
+	0x48, 0x39, 0x78, 0x0f, 0x5c, 0x2d, 0xa5, 0x2b, 0x71, 0x03, 0x7e, 0x77, 0x4c, 0x86, 0x43, 0xf7,
+	0x61, 0xe9, 0xa9, 0xd5, 0x1e, 0x4d, 0x5d, 0xaf, 0xdd, 0x77, 0xda, 0x5d, 0xcb, 0x1a, 0x5b, 0x13,
+	0x6d, 0x81, 0xf4, 0x16, 0xf1, 0x10, 0xf3, 0x16, 0xd4, 0x5c, 0x7c, 0x6a, 0x6d, 0x4e, 0x5d, 0x6f,
+	0xc3, 0xb9, 0x4b, 0xec, 0xd0, 0x1a, 0x64, 0x27, 0xd6, 0xb8, 0x4d, 0x87, 0x9c, 0x9f, 0x1d, 0x41,
+	0xc4, 0x38, 0x33, 0xb1, 0xc6, 0xa4, 0x02, 0x5d, 0x87, 0xcc, 0xee, 0xe0, 0x89, 0xe5, 0xee, 0x5b,
+	0x5d, 0x2d, 0xad, 0x4b, 0xe5, 0xc5, 0xea, 0x39, 0xde, 0x2a, 0x58, 0xe0, 0xca, 0x1d, 0x67, 0xe8,
+	0x4c, 0xcc, 0x00, 0x8c, 0x5e, 0x87, 0xac, 0xeb, 0x8c, 0x2c, 0xaa, 0xf6, 0x0c, 0x39, 0x6c, 0x2f,
+	0xce, 0xb7, 0xdc, 0x76, 0x46, 0x96, 0xef, 0xd5, 0x7c, 0x0b, 0x74, 0x8e, 0x0e, 0x77, 0x17, 0x5f,
+	0x26, 0x34, 0x20, 0x09, 0x1f, 0x3c, 0x28, 0x72, 0xb9, 0x40, 0x45, 0x3c, 0xa8, 0x7e, 0x0f, 0xc7,
+	0x6c, 0x5a, 0x8e, 0xdc, 0xe5, 0x83, 0x72, 0xf1, 0x55, 0xc8, 0x06, 0x84, 0xa1, 0x3b, 0xa4, 0x2e,
+	0x28, 0xab, 0x4b, 0x81, 0x3b, 0xa4, 0xfe, 0xe7, 0x25, 0x48, 0x92, 0x81, 0xe3, 0x93, 0xcb, 0x5c,
+	0xc7, 0x07, 0x65, 0x16, 0x92, 0x1b, 0xe6, 0xfa, 0xfa, 0x96, 0x2a, 0x91, 0x33, 0xf3, 0xc1, 0xa3,
+	0x75, 0x55, 0xe6, 0xf4, 0xfb, 0xdb, 0x32, 0x28, 0xeb, 0x07, 0x44, 0x39, 0xdd, 0x8e, 0xd7, 0xf1,
+	0xdf, 0x70, 0xfc, 0x8c, 0x1a, 0x90, 0x1d, 0x75, 0xfc, 0xbe, 0x64, 0x5d, 0x89, 0xfb, 0x92, 0xf5,
+	0x03, 0xaf, 0xb2, 0xd9, 0xa1, 0x3d, 0xaf, 0xdb, 0xde, 0xe4, 0xd0, 0xcc, 0x8c, 0x58, 0xb1, 0xf8,
+	0x1a, 0x2c, 0x44, 0x9a, 0xf8, 0x57, 0x34, 0x39, 0xe7, 0x15, 0x4d, 0xb2, 0x57, 0xb4, 0x21, 0xdf,
+	0x90, 0xaa, 0x0d, 0x48, 0x8c, 0x9c, 0x89, 0x85, 0x9e, 0x9b, 0xbb, 0xc0, 0x5a, 0x9f, 0x48, 0xa6,
+	0x10, 0x1b, 0x8a, 0x49, 0x6c, 0xaa, 0xaf, 0x40, 0xc2, 0xb3, 0x0e, 0xbc, 0x67, 0xd9, 0xee, 0xd3,
+	0xf9, 0x61, 0x48, 0xf5, 0x2a, 0xa4, 0xec, 0xe9, 0x68, 0xd7, 0x9a, 0x3c, 0x0b, 0x3c, 0x20, 0x03,
+	0x63, 0xa0, 0xd2, 0x3b, 0xa0, 0xde, 0x71, 0x46, 0xe3, 0xa1, 0x75, 0xb0, 0x7e, 0xe0, 0x59, 0xb6,
+	0x3b, 0x70, 0x6c, 0x3c, 0x87, 0xde, 0x60, 0x42, 0xdc, 0x1a, 0x99, 0x03, 0x29, 0x60, 0x37, 0xe3,
+	0x5a, 0x7b, 0x8e, 0xdd, 0x65, 0x53, 0x63, 0x25, 0x8c, 0xf6, 0xf6, 0x07, 0x13, 0xec, 0xd1, 0xf0,
+	0xe1, 0x43, 0x0b, 0xa5, 0x0d, 0x28, 0xb0, 0x6b, 0x98, 0xcb, 0x3a, 0x2e, 0x5d, 0x86, 0xbc, 0x5f,
+	0x45, 0x7e, 0xf9, 0xc9, 0x40, 0xe2, 0xf1, 0xba, 0xd9, 0x52, 0x4f, 0xe1, 0x7d, 0x6d, 0x6d, 0xad,
+	0xab, 0x12, 0x7e, 0xd8, 0x79, 0xb7, 0x15, 0xd9, 0xcb, 0x17, 0x20, 0x1f, 0x8c, 0x7d, 0xdb, 0xf2,
+	0x48, 0x0b, 0x3e, 0xa5, 0xd2, 0x0d, 0x39, 0x23, 0x95, 0xd2, 0x90, 0x5c, 0x1f, 0x8d, 0xbd, 0xc3,
+	0xd2, 0x2f, 0x41, 0x8e, 0x81, 0x1e, 0x0c, 0x5c, 0x0f, 0xdd, 0x84, 0xf4, 0x88, 0xcd, 0x57, 0xd2,
+	0x95, 0x19, 0x59, 0x87, 0x48, 0xff, 0xd9, 0xf4, 0xf1, 0xc5, 0x1a, 0xa4, 0x39, 0xf7, 0xce, 0x3c,
+	0x8f, 0xcc, 0x7b, 0x1e, 0xea, 0xa3, 0x14, 0xce, 0x47, 0x95, 0x36, 0x21, 0x4d, 0x0f, 0x66, 0x97,
+	0x84, 0x1b, 0xe4, 0x91, 0x69, 0x8c, 0x8a, 0x2f, 0x47, 0xeb, 0x68, 0x0c, 0x75, 0x11, 0x72, 0xe4,
+	0x9d, 0x09, 0x54, 0x88, 0xbd, 0x39, 0x90, 0x2a, 0xaa, 0xf8, 0x3f, 0x4a, 0x42, 0xc6, 0x5f, 0x2b,
+	0x74, 0x0e, 0x52, 0xf4, 0x12, 0xab, 0x49, 0x5c, 0x52, 0x27, 0x49, 0xae, 0xad, 0xe8, 0x1c, 0xa4,
+	0xd9, 0x45, 0x55, 0x93, 0x83, 0x0c, 0x4e, 0x8a, 0x5e, 0x4c, 0x83, 0xc6, 0xba, 0xa1, 0x29, 0x41,
+	0xba, 0x26, 0x45, 0xaf, 0x9e, 0x48, 0x87, 0x6c, 0x70, 0xd9, 0xd4, 0x12, 0x61, 0x6e, 0x26, 0xe3,
+	0xdf, 0x2e, 0x39, 0x44, 0xdd, 0xd0, 0x92, 0x61, 0x22, 0x26, 0xd3, 0x0c, 0xe3, 0xa6, 0x8c, 0x7f,
+	0x65, 0xd4, 0x52, 0x5c, 0xd6, 0x25, 0xcd, 0x2e, 0x89, 0x21, 0xa0, 0x6e, 0x68, 0x69, 0x2e, 0xc5,
+	0x92, 0x66, 0x17, 0x41, 0x74, 0x11, 0x0f, 0x91, 0x5c, 0xec, 0xb4, 0x4c, 0x24, 0x9f, 0x92, 0xa2,
+	0xd7, 0x3d, 0x74, 0x09, 0x33, 0xd0, 0xdb, 0x9b, 0x96, 0x8d, 0x24, 0x4f, 0xd2, 0xec, 0x52, 0x87,
+	0xae, 0x60, 0x08, 0x5d, 0x7e, 0x0d, 0x9e, 0x91, 0x29, 0x49, 0xb3, 0x4c, 0x09, 0xd2, 0x71, 0x87,
+	0xc4, 0x43, 0x69, 0xb9, 0x68, 0x56, 0x24, 0x45, 0xb3, 0x22, 0xe8, 0x02, 0xa1, 0xa3, 0x93, 0xca,
+	0x87, 0x19, 0x90, 0x34, 0xbb, 0x05, 0x86, 0xed, 0x24, 0x96, 0x0c, 0xb2, 0x1d, 0x69, 0x76, 0xcf,
+	0x43, 0x37, 0xf0, 0x7e, 0x61, 0x85, 0x6b, 0x8b, 0xc4, 0x17, 0x17, 0x79, 0xe9, 0xf9, 0xbb, 0x4a,
+	0x5d, 0x71, 0x83, 0xba, 0x31, 0x33, 0xd9, 0x24, 0x6f, 0x44, 0x11, 0x5b, 0x3e, 0x1c, 0xd8, 0x3d,
+	0xad, 0x40, 0xd6, 0x42, 0x19, 0xd8, 0x3d, 0x33, 0xd9, 0xc4, 0x35, 0x54, 0x05, 0x5b, 0xb8, 0x4d,
+	0x25, 0x6d, 0x89, 0xab, 0xb4, 0x11, 0x57, 0x21, 0x0d, 0x92, 0xcd, 0xf6, 0x56, 0xc7, 0xd6, 0x96,
+	0xa8, 0x9d, 0xdd, 0xb1, 0xcd, 0x44, 0x73, 0xab, 0x63, 0xa3, 0x57, 0x40, 0x71, 0xa7, 0xbb, 0x1a,
+	0x9a, 0xfd, 0x59, 0x70, 0x7b, 0xba, 0xeb, 0x0f, 0xc6, 0xc4, 0x18, 0x74, 0x0e, 0x32, 0xae, 0x37,
+	0x69, 0xff, 0x82, 0x35, 0x71, 0xb4, 0xd3, 0x64, 0x19, 0x4f, 0x99, 0x69, 0xd7, 0x9b, 0x3c, 0xb6,
+	0x26, 0xce, 0x31, 0x7d, 0x70, 0xe9, 0x02, 0xe4, 0x38, 0x5e, 0x54, 0x00, 0xc9, 0xa6, 0x01, 0x4c,
+	0x43, 0xba, 0x6e, 0x4a, 0x76, 0xe9, 0x1d, 0xc8, 0xfb, 0x57, 0x2c, 0x32, 0x63, 0x03, 0xbf, 0x4d,
+	0x43, 0x67, 0x42, 0xde, 0xd2, 0xc5, 0xea, 0x85, 0xe8, 0x89, 0x19, 0x02, 0xd9, 0xc9, 0x45, 0xc1,
+	0x25, 0x35, 0x36, 0x18, 0xa9, 0xf4, 0x03, 0x09, 0xf2, 0x9b, 0xce, 0x24, 0xfc, 0xfd, 0x62, 0x19,
+	0x92, 0xbb, 0x8e, 0x33, 0x74, 0x09, 0x71, 0xc6, 0xa4, 0x05, 0xf4, 0x12, 0xe4, 0xc9, 0x83, 0x7f,
+	0x49, 0x96, 0x83, 0x2c, 0x50, 0x8e, 0xd4, 0xb3, 0x7b, 0x31, 0x82, 0xc4, 0xc0, 0xf6, 0x5c, 0xe6,
+	0xd1, 0xc8, 0x33, 0xfa, 0x02, 0xe4, 0xf0, 0x5f, 0xdf, 0x32, 0x11, 0x44, 0xd3, 0x80, 0xab, 0x99,
+	0xe1, 0xcb, 0xb0, 0x40, 0x34, 0x10, 0xc0, 0xd2, 0x41, 0xc6, 0x27, 0x4f, 0x1b, 0x18, 0x50, 0x83,
+	0x34, 0x75, 0x08, 0x2e, 0xf9, 0xc1, 0x37, 0x6b, 0xfa, 0x45, 0xec, 0x66, 0xc9, 0x45, 0x85, 0x46,
+	0x20, 0x69, 0x93, 0x95, 0x4a, 0x77, 0x20, 0x43, 0x8e, 0xcb, 0xd6, 0xb0, 0x8b, 0x5e, 0x04, 0xa9,
+	0xaf, 0x59, 0xe4, 0xb8, 0x3e, 0x13, 0xb9, 0x85, 0x30, 0x40, 0x65, 0xc3, 0x94, 0xfa, 0xc5, 0x25,
+	0x90, 0x36, 0xf0, 0xb5, 0xe0, 0x80, 0x39, 0x6c, 0xe9, 0xa0, 0xf4, 0x36, 0x23, 0xd9, 0xb2, 0x9e,
+	0x8a, 0x49, 0xb6, 0xac, 0xa7, 0x94, 0xe4, 0xe2, 0x0c, 0x09, 0x2e, 0x1d, 0xb2, 0xdf, 0xc0, 0xa5,
+	0xc3, 0x52, 0x0d, 0x16, 0xc8, 0x8b, 0x3a, 0xb0, 0xfb, 0x0f, 0x9d, 0x81, 0x4d, 0x2e, 0x22, 0x3d,
+	0x12, 0xc0, 0x49, 0xa6, 0xd4, 0xc3, 0xfb, 0x60, 0x1d, 0x74, 0xf6, 0x68, 0x38, 0x9c, 0x31, 0x69,
+	0xa1, 0xf4, 0xfd, 0x04, 0x2c, 0x32, 0x27, 0xfb, 0xee, 0xc0, 0xdb, 0xdf, 0xec, 0x8c, 0xd1, 0x16,
+	0xe4, 0xb1, 0x7f, 0x6d, 0x8f, 0x3a, 0xe3, 0x31, 0x7e, 0x91, 0x25, 0x72, 0x34, 0x5f, 0x99, 0xe3,
+	0xb6, 0x99, 0x45, 0x65, 0xab, 0x33, 0xb2, 0x36, 0x29, 0x9a, 0x1e, 0xd4, 0x39, 0x3b, 0xac, 0x41,
+	0xf7, 0x21, 0x37, 0x72, 0xfb, 0x01, 0x1d, 0x3d, 0xe9, 0x2f, 0x0b, 0xe8, 0x36, 0xdd, 0x7e, 0x84,
+	0x0d, 0x46, 0x41, 0x05, 0x1e, 0x1c, 0xf6, 0xce, 0x01, 0x9b, 0x72, 0xe4, 0xe0, 0xb0, 0x2b, 0x89,
+	0x0e, 0x6e, 0x37, 0xac, 0x41, 0x4d, 0x00, 0xfc, 0xaa, 0x79, 0x0e, 0xbe, 0xe1, 0x11, 0x2d, 0xe5,
+	0xaa, 0x65, 0x01, 0xdb, 0xb6, 0x37, 0xd9, 0x71, 0xb6, 0xbd, 0x09, 0x0b, 0x48, 0x5c, 0x56, 0x2c,
+	0xbe, 0x01, 0x6a, 0x7c, 0x15, 0x8e, 0x8a, 0x49, 0xb2, 0x5c, 0x4c, 0x52, 0xfc, 0x39, 0x28, 0xc4,
+	0xa6, 0xcd, 0x9b, 0x23, 0x6a, 0x7e, 0x8d, 0x37, 0xcf, 0x55, 0x9f, 0xe7, 0xc7, 0x19, 0xd9, 0x7a,
+	0x9e, 0xf9, 0x0d, 0x50, 0xe3, 0x4b, 0xc0, 0x53, 0x67, 0x04, 0x17, 0x1a, 0x62, 0xff, 0x1a, 0x2c,
+	0x44, 0x26, 0xcd, 0x1b, 0x67, 0x8f, 0x98, 0x56, 0xe9, 0x57, 0x92, 0x90, 0x6c, 0xd9, 0x96, 0xd3,
+	0x43, 0x67, 0xa3, 0x67, 0xe7, 0x5b, 0xa7, 0xfc, 0x73, 0xf3, 0xf9, 0xd8, 0xb9, 0xf9, 0xd6, 0xa9,
+	0xe0, 0xd4, 0x7c, 0x3e, 0x76, 0x6a, 0xfa, 0x4d, 0x75, 0x03, 0x9d, 0x9f, 0x39, 0x33, 0xdf, 0x3a,
+	0xc5, 0x1d, 0x98, 0xe7, 0x67, 0x0e, 0xcc, 0xb0, 0xb9, 0x6e, 0xa0, 0x73, 0xfe, 0x61, 0xe8, 0x9f,
+	0x96, 0x6f, 0x9d, 0x0a, 0x4f, 0xca, 0x73, 0xf1, 0x93, 0x32, 0x68, 0xac, 0x1b, 0x74, 0x48, 0xdc,
+	0x29, 0x49, 0x86, 0x44, 0xca, 0xd4, 0x8e, 0x3f, 0x1f, 0x89, 0x1d, 0xad, 0xa0, 0x8d, 0xfc, 0xc9,
+	0x48, 0x1a, 0x69, 0x05, 0x25, 0xe5, 0x4e, 0x42, 0x42, 0x4a, 0xca, 0xcc, 0x8e, 0x3b, 0x02, 0xa9,
+	0x1d, 0x37, 0x52, 0xfe, 0xfc, 0x0b, 0x1a, 0xeb, 0x06, 0x32, 0x62, 0x87, 0x9f, 0xe8, 0x22, 0x42,
+	0x76, 0x03, 0x63, 0x51, 0x1d, 0x2f, 0x1c, 0x6b, 0xd3, 0x0a, 0xb3, 0x67, 0x15, 0xf7, 0x09, 0x0b,
+	0x59, 0x51, 0x06, 0x45, 0x06, 0xa4, 0x7b, 0xec, 0xae, 0xae, 0x12, 0x4f, 0x16, 0x11, 0x27, 0x91,
+	0x40, 0xa5, 0xd9, 0x26, 0x1e, 0x0d, 0xcf, 0xae, 0x47, 0x9e, 0x50, 0x19, 0x16, 0x9a, 0xed, 0x07,
+	0x9d, 0x49, 0x1f, 0x43, 0x77, 0x3a, 0xfd, 0x20, 0xeb, 0x81, 0x55, 0x90, 0x6b, 0xb2, 0x16, 0x9c,
+	0x1c, 0x39, 0xe3, 0x4b, 0xac, 0x4b, 0x5a, 0x25, 0x26, 0xb2, 0xe2, 0x59, 0xbc, 0x74, 0x94, 0x8c,
+	0xf8, 0xc6, 0x25, 0xe6, 0x1b, 0x6f, 0xa7, 0x21, 0x39, 0xb5, 0x07, 0x8e, 0x7d, 0x3b, 0x0b, 0x69,
+	0xcf, 0x99, 0x8c, 0x3a, 0x9e, 0x53, 0xfa, 0xa1, 0x04, 0x70, 0xc7, 0x19, 0x8d, 0xa6, 0xf6, 0xe0,
+	0xbd, 0xa9, 0x85, 0x2e, 0x40, 0x6e, 0xd4, 0x79, 0x62, 0xb5, 0x47, 0x56, 0x7b, 0x6f, 0xe2, 0xbf,
+	0x0d, 0x59, 0x5c, 0xb5, 0x69, 0xdd, 0x99, 0x1c, 0x22, 0xcd, 0x0f, 0xe0, 0xb5, 0x24, 0x1b, 0x12,
+	0x2b, 0xa3, 0x65, 0x16, 0x8e, 0xa6, 0xd8, 0x4e, 0xfa, 0x01, 0x29, 0xbd, 0xe4, 0xa4, 0xd9, 0x1e,
+	0x92, 0x12, 0x16, 0xbe, 0x67, 0x8d, 0xc6, 0xed, 0x3d, 0x2d, 0xc3, 0x44, 0x91, 0xc4, 0xe5, 0x3b,
+	0xe8, 0x1a, 0x28, 0x7b, 0xce, 0x50, 0xcb, 0x1e, 0x67, 0x77, 0x30, 0x12, 0xbd, 0x0c, 0xca, 0xc8,
+	0xa5, 0xf2, 0xc9, 0x55, 0x4f, 0x47, 0x22, 0x08, 0x7a, 0x64, 0x61, 0xe0, 0xc8, 0xed, 0x07, 0x73,
+	0x2f, 0x7d, 0x2a, 0x43, 0x06, 0xef, 0xd7, 0xa3, 0x9d, 0xe6, 0x0d, 0x72, 0x6d, 0xd8, 0xeb, 0x0c,
+	0x49, 0x86, 0x00, 0xbf, 0xa6, 0xac, 0x84, 0xeb, 0xbf, 0x62, 0xed, 0x79, 0xce, 0x84, 0xb8, 0xe6,
+	0xac, 0xc9, 0x4a, 0x78, 0xc9, 0x69, 0x54, 0xac, 0xb0, 0x59, 0xd2, 0x22, 0x89, 0xe8, 0x3b, 0xe3,
+	0x36, 0xf6, 0x01, 0xd4, 0x5f, 0x46, 0x6e, 0xd7, 0x7e, 0x77, 0xf8, 0xea, 0x76, 0xdf, 0x3a, 0xa4,
+	0x7e, 0x32, 0x35, 0x22, 0x05, 0xf4, 0xb3, 0xf4, 0xca, 0x47, 0x77, 0x92, 0x7e, 0x5f, 0x55, 0x7a,
+	0x96, 0xf1, 0x3b, 0x18, 0x14, 0xde, 0xfb, 0x48, 0xb1, 0x78, 0x13, 0x72, 0x1c, 0xef, 0x51, 0xae,
+	0x48, 0x89, 0xf9, 0xb1, 0x08, 0xeb, 0x51, 0x59, 0x1d, 0xde, 0x8f, 0xe1, 0x15, 0x75, 0xb0, 0x86,
+	0x4b, 0x16, 0xc0, 0xce, 0x60, 0x64, 0x91, 0x17, 0xc0, 0x45, 0x97, 0x41, 0xf6, 0x5c, 0x96, 0x07,
+	0x2b, 0x56, 0xe8, 0xa7, 0x76, 0x15, 0xff, 0x53, 0xbb, 0xca, 0x8e, 0xff, 0xa9, 0x9d, 0x29, 0x7b,
+	0x2e, 0xba, 0x02, 0x4a, 0x77, 0x3a, 0x09, 0x5c, 0x77, 0x1c, 0x7c, 0x97, 0x7d, 0xb7, 0x67, 0x62,
+	0xd4, 0xe5, 0x02, 0x28, 0xcd, 0x56, 0x0b, 0x87, 0x73, 0xcd, 0x56, 0x6b, 0x55, 0x95, 0x1a, 0xab,
+	0x90, 0xe9, 0x4f, 0x2c, 0x0b, 0x7b, 0xf8, 0x67, 0x5d, 0x27, 0xbf, 0x4c, 0x76, 0x2f, 0x80, 0x35,
+	0xde, 0x86, 0xf4, 0x1e, 0xbd, 0x50, 0xa2, 0x67, 0x26, 0x4f, 0xb4, 0x3f, 0xa6, 0x83, 0x7f, 0x81,
+	0x07, 0xc4, 0xaf, 0xa1, 0xa6, 0xcf, 0xd3, 0xd8, 0x81, 0xec, 0xa4, 0x7d, 0x34, 0xe9, 0x07, 0x34,
+	0x64, 0x10, 0x93, 0x66, 0x26, 0xac, 0xaa, 0xb1, 0x01, 0x4b, 0xb6, 0xe3, 0xff, 0x96, 0xd8, 0xee,
+	0x32, 0x87, 0x39, 0x2f, 0x56, 0xf7, 0x3b, 0xb0, 0xe8, 0x17, 0x09, 0xb6, 0xc3, 0x1a, 0xa8, 0x93,
+	0x6d, 0xac, 0x83, 0xca, 0x11, 0xf5, 0xa8, 0x57, 0x16, 0xf1, 0xf4, 0xe8, 0x47, 0x10, 0x01, 0x0f,
+	0x71, 0xe4, 0x31, 0x1a, 0xe6, 0x6a, 0x45, 0x34, 0x7d, 0xfa, 0x4d, 0x49, 0x40, 0x43, 0x4e, 0xaf,
+	0x59, 0x9a, 0xba, 0x21, 0xa6, 0xd9, 0xa7, 0x1f, 0x9c, 0xf0, 0x34, 0x75, 0x23, 0xb6, 0x3a, 0xd3,
+	0x63, 0x0c, 0x67, 0x40, 0xbf, 0x18, 0x09, 0x78, 0xe8, 0xb9, 0x36, 0x87, 0xe8, 0xa8, 0x01, 0x7d,
+	0x99, 0x7e, 0x4e, 0x12, 0x21, 0x9a, 0x19, 0x91, 0x7b, 0x8c, 0x11, 0x3d, 0xa1, 0x5f, 0x6f, 0x04,
+	0x44, 0xdb, 0xf3, 0x46, 0xe4, 0x1e, 0x63, 0x44, 0x43, 0xfa, 0x65, 0x47, 0x84, 0xa8, 0x6e, 0x34,
+	0xee, 0x01, 0xe2, 0x37, 0x9e, 0x05, 0x01, 0x42, 0xa6, 0x11, 0xfd, 0x62, 0x27, 0xdc, 0x7a, 0x6a,
+	0x34, 0x8f, 0xea, 0xa8, 0x41, 0xd9, 0xf4, 0x73, 0x9e, 0x28, 0x55, 0xdd, 0x68, 0xdc, 0x87, 0xd3,
+	0xfc, 0xf4, 0x8e, 0x35, 0x2c, 0x87, 0x7e, 0x8b, 0x12, 0x4e, 0x90, 0x59, 0xcd, 0x25, 0x3b, 0x6a,
+	0x60, 0x63, 0xfa, 0x9d, 0x4a, 0x8c, 0xac, 0x6e, 0x34, 0xee, 0x40, 0x81, 0x23, 0xdb, 0x25, 0xe9,
+	0x0b, 0x11, 0xd1, 0x7b, 0xf4, 0xeb, 0xaa, 0x80, 0x08, 0x07, 0x6e, 0xf1, 0xdd, 0xa3, 0xa1, 0x8c,
+	0x90, 0x66, 0x42, 0x3f, 0x0e, 0x0a, 0xc7, 0x43, 0x6c, 0x62, 0x2f, 0xca, 0x2e, 0x8d, 0x7b, 0x44,
+	0x3c, 0x2e, 0xfd, 0x70, 0x28, 0x1c, 0x0e, 0x36, 0x69, 0x8c, 0x22, 0x93, 0xb2, 0x70, 0x34, 0x23,
+	0x64, 0xf1, 0xc8, 0xc1, 0x5b, 0x16, 0x40, 0x2a, 0x7c, 0x96, 0x8c, 0x9b, 0x3e, 0x2e, 0x36, 0xee,
+	0xc3, 0xe2, 0x49, 0x5c, 0xd6, 0x07, 0x12, 0x4d, 0x99, 0xd4, 0x2a, 0x38, 0xab, 0x62, 0x2e, 0x74,
+	0x23, 0x9e, 0x6b, 0x03, 0x16, 0x4e, 0xe0, 0xb6, 0x3e, 0x94, 0x68, 0xe2, 0x01, 0x73, 0x99, 0xf9,
+	0x6e, 0xd4, 0x77, 0x2d, 0x9c, 0xc0, 0x71, 0x7d, 0x24, 0xd1, 0x4c, 0x95, 0x51, 0x0d, 0x68, 0x7c,
+	0xdf, 0xb5, 0x70, 0x02, 0xc7, 0xf5, 0x31, 0x4d, 0x2c, 0xc8, 0x46, 0x8d, 0xa7, 0x21, 0x9e, 0x62,
+	0xf1, 0x24, 0x8e, 0xeb, 0x13, 0x89, 0x64, 0xae, 0x64, 0xc3, 0x08, 0xd6, 0x27, 0xf0, 0x5d, 0x8b,
+	0x27, 0x71, 0x5c, 0x5f, 0x93, 0x48, 0x86, 0x4b, 0x36, 0xd6, 0x22, 0x44, 0xd1, 0x11, 0x1d, 0xc7,
+	0x71, 0x7d, 0x2a, 0x91, 0xb4, 0x93, 0x6c, 0xd4, 0x03, 0xa2, 0xed, 0x99, 0x11, 0x1d, 0xc7, 0x71,
+	0x7d, 0x5d, 0x22, 0xf9, 0x29, 0xd9, 0xb8, 0x1e, 0x21, 0x22, 0xbe, 0xab, 0x70, 0x22, 0xc7, 0xf5,
+	0x0d, 0x89, 0x64, 0x08, 0x65, 0xe3, 0x86, 0xb9, 0xd8, 0xe5, 0x1c, 0x4e, 0xad, 0x3a, 0x43, 0x75,
+	0xd4, 0xa0, 0xbe, 0x29, 0x91, 0x54, 0xa2, 0x6c, 0xdc, 0x8c, 0x52, 0x11, 0xdf, 0xa5, 0x9e, 0xcc,
+	0x71, 0x7d, 0x26, 0x91, 0x0f, 0x87, 0xe4, 0xb5, 0x15, 0xb3, 0xd0, 0xe5, 0xdd, 0x4d, 0xad, 0x3a,
+	0x4b, 0x76, 0xd4, 0xc0, 0xbe, 0x25, 0x91, 0xaf, 0x89, 0xe4, 0xb5, 0xd5, 0x18, 0x59, 0xdd, 0x68,
+	0xac, 0x43, 0xfe, 0xf8, 0x8e, 0xeb, 0xdb, 0x7c, 0xa2, 0x36, 0xd7, 0xe5, 0xbc, 0xd7, 0x63, 0x6e,
+	0xff, 0x8e, 0xe1, 0xba, 0xbe, 0x43, 0x62, 0xcc, 0xc6, 0x73, 0x6f, 0xd1, 0x74, 0x26, 0x35, 0x79,
+	0xb5, 0x6b, 0xf5, 0x5e, 0xef, 0x39, 0x4e, 0xb8, 0xa5, 0xd4, 0xa1, 0xb5, 0x60, 0xe1, 0x04, 0xde,
+	0xec, 0xbb, 0x12, 0xc9, 0x7e, 0xe6, 0x19, 0x35, 0xb1, 0x08, 0xde, 0x23, 0xea, 0xda, 0x6c, 0xc8,
+	0x1f, 0xdf, 0xaf, 0x7d, 0x4f, 0x3a, 0x99, 0x63, 0x6b, 0xe0, 0x94, 0x7f, 0xb0, 0x38, 0xa4, 0xe6,
+	0x4d, 0x48, 0x1c, 0x54, 0x57, 0x56, 0x91, 0x36, 0x37, 0xd2, 0xdc, 0xb6, 0x3c, 0xea, 0xce, 0x72,
+	0xd5, 0x25, 0x1e, 0x40, 0xf2, 0xff, 0x26, 0xb1, 0x64, 0x0c, 0x55, 0x01, 0xc3, 0x87, 0x42, 0x86,
+	0x2a, 0x63, 0xa8, 0x09, 0x18, 0x3e, 0x12, 0x32, 0xd4, 0x18, 0x83, 0x21, 0x60, 0xf8, 0x58, 0xc8,
+	0x60, 0x30, 0x86, 0x35, 0x01, 0xc3, 0x27, 0x42, 0x86, 0x35, 0xc6, 0x50, 0x17, 0x30, 0x7c, 0x4d,
+	0xc8, 0x50, 0x67, 0x0c, 0xd7, 0x05, 0x0c, 0x9f, 0x0a, 0x19, 0xae, 0x33, 0x86, 0x1b, 0x02, 0x86,
+	0xaf, 0x0b, 0x19, 0x6e, 0x30, 0x86, 0x9b, 0x02, 0x86, 0x6f, 0x08, 0x19, 0x6e, 0x52, 0x86, 0xd5,
+	0x15, 0x01, 0xc3, 0x37, 0x45, 0x0c, 0xab, 0x2b, 0x8c, 0x41, 0xa4, 0xc9, 0xcf, 0x84, 0x0c, 0x4c,
+	0x93, 0xab, 0x22, 0x4d, 0x7e, 0x4b, 0xc8, 0xc0, 0x34, 0xb9, 0x2a, 0xd2, 0xe4, 0xb7, 0x85, 0x0c,
+	0x4c, 0x93, 0xab, 0x22, 0x4d, 0x7e, 0x47, 0xc8, 0xc0, 0x34, 0xb9, 0x2a, 0xd2, 0xe4, 0x77, 0x85,
+	0x0c, 0x4c, 0x93, 0xab, 0x22, 0x4d, 0x7e, 0x4f, 0xc8, 0xc0, 0x34, 0xb9, 0x2a, 0xd2, 0xe4, 0x9f,
+	0x08, 0x19, 0x98, 0x26, 0x57, 0x45, 0x9a, 0xfc, 0x53, 0x21, 0x03, 0xd3, 0xe4, 0xaa, 0x48, 0x93,
+	0x7f, 0x26, 0x64, 0x60, 0x9a, 0xac, 0x8a, 0x34, 0xf9, 0x7d, 0x11, 0x43, 0x95, 0x69, 0xb2, 0x2a,
+	0xd2, 0xe4, 0x9f, 0x0b, 0x19, 0x98, 0x26, 0xab, 0x22, 0x4d, 0xfe, 0x85, 0x90, 0x81, 0x69, 0xb2,
+	0x2a, 0xd2, 0xe4, 0x0f, 0x84, 0x0c, 0x4c, 0x93, 0x55, 0x91, 0x26, 0xff, 0x52, 0xc8, 0xc0, 0x34,
+	0x59, 0x15, 0x69, 0xf2, 0xaf, 0x84, 0x0c, 0x4c, 0x93, 0x55, 0x91, 0x26, 0xff, 0x5a, 0xc8, 0xc0,
+	0x34, 0x59, 0x15, 0x69, 0xf2, 0x6f, 0x84, 0x0c, 0x4c, 0x93, 0x55, 0x91, 0x26, 0xff, 0x56, 0xc8,
+	0xc0, 0x34, 0x59, 0x15, 0x69, 0xf2, 0xef, 0x84, 0x0c, 0x4c, 0x93, 0x35, 0x91, 0x26, 0xff, 0x5e,
+	0xc4, 0x50, 0x63, 0x9a, 0xac, 0x89, 0x34, 0xf9, 0x0f, 0x42, 0x06, 0xa6, 0xc9, 0x9a, 0x48, 0x93,
+	0xff, 0x28, 0x64, 0x60, 0x9a, 0xac, 0x89, 0x34, 0xf9, 0x4f, 0x42, 0x06, 0xa6, 0xc9, 0x9a, 0x48,
+	0x93, 0xff, 0x2c, 0x64, 0x60, 0x9a, 0xac, 0x89, 0x34, 0xf9, 0x2f, 0x42, 0x06, 0xa6, 0xc9, 0x9a,
+	0x48, 0x93, 0xff, 0x2a, 0x64, 0x60, 0x9a, 0xac, 0x89, 0x34, 0xf9, 0x6f, 0x42, 0x06, 0xa6, 0xc9,
+	0x9a, 0x48, 0x93, 0x3f, 0x14, 0x32, 0x30, 0x4d, 0xd6, 0x44, 0x9a, 0xfc, 0x77, 0x21, 0x03, 0xd3,
+	0xa4, 0x21, 0xd2, 0xe4, 0x7f, 0x88, 0x18, 0x0c, 0xa6, 0x49, 0x43, 0xa4, 0xc9, 0xff, 0x14, 0x32,
+	0x30, 0x4d, 0x1a, 0x22, 0x4d, 0xfe, 0x97, 0x90, 0x81, 0x69, 0xd2, 0x10, 0x69, 0xf2, 0xbf, 0x85,
+	0x0c, 0x4c, 0x93, 0x86, 0x48, 0x93, 0xff, 0x23, 0x64, 0x60, 0x9a, 0x34, 0x44, 0x9a, 0xfc, 0x5f,
+	0x21, 0x03, 0xd3, 0xa4, 0x21, 0xd2, 0xe4, 0x8f, 0x84, 0x0c, 0x4c, 0x93, 0x86, 0x48, 0x93, 0x3f,
+	0x16, 0x32, 0x30, 0x4d, 0x1a, 0x22, 0x4d, 0xfe, 0x44, 0xc8, 0xc0, 0x34, 0x69, 0x88, 0x34, 0xf9,
+	0x53, 0x21, 0x03, 0xd3, 0xe4, 0x9a, 0x48, 0x93, 0xff, 0x27, 0x62, 0x58, 0x5b, 0xb9, 0x7d, 0xf5,
+	0xf1, 0x95, 0xfe, 0xc0, 0xdb, 0x9f, 0xee, 0x56, 0xf6, 0x9c, 0xd1, 0xb5, 0xbe, 0x33, 0xec, 0xd8,
+	0xfd, 0xf0, 0xdf, 0xe4, 0xc3, 0x7f, 0xba, 0xa7, 0xa6, 0xff, 0x3f, 0x00, 0xff, 0xec, 0x1b, 0xe7,
+	0x8c, 0x3f, 0x00, 0x00,
 }
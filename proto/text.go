@@ -45,6 +45,8 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+
+	"github.com/golang/protobuf/internal/timefmt"
 )
 
 var (
@@ -197,10 +199,62 @@ func isAny(sv reflect.Value) bool {
 	return ok && t.XXX_WellKnownType() == "Any"
 }
 
+// wellKnownType returns the name of the well-known type of struct type t,
+// such as "Timestamp", or "" if t is not a well-known type.
+func wellKnownType(t reflect.Type) string {
+	type wkt interface {
+		XXX_WellKnownType() string
+	}
+	if w, ok := reflect.New(t).Interface().(wkt); ok {
+		return w.XXX_WellKnownType()
+	}
+	return ""
+}
+
+// formatTime returns sv, a google.protobuf.Timestamp or Duration message,
+// in its proto3 JSON string form. It reports false if sv is out of range.
+func formatTime(sv reflect.Value, wkt string) (string, bool) {
+	secs := sv.FieldByName("Seconds").Int()
+	nanos := int32(sv.FieldByName("Nanos").Int())
+	switch wkt {
+	case "Timestamp":
+		if timefmt.ValidTimestamp(secs, nanos) {
+			return timefmt.FormatTimestamp(secs, nanos), true
+		}
+	case "Duration":
+		if timefmt.ValidDuration(secs, nanos) {
+			return timefmt.FormatDuration(secs, nanos), true
+		}
+	}
+	return "", false
+}
+
+// AnyResolver takes a type URL, present in an Any message, and resolves it
+// into an empty instance of the associated message. It is the same
+// interface as ptypes.Resolver and jsonpb.AnyResolver.
+type AnyResolver interface {
+	Resolve(typeUrl string) (Message, error)
+}
+
+// resolveAny resolves typeUrl with r, or with the registered message types
+// if r is nil.
+func resolveAny(r AnyResolver, typeUrl string) (Message, error) {
+	if r != nil {
+		return r.Resolve(typeUrl)
+	}
+	mname := typeUrl[strings.LastIndex(typeUrl, "/")+1:]
+	mt := MessageType(mname)
+	if mt == nil {
+		return nil, fmt.Errorf("unknown message type %q", mname)
+	}
+	return reflect.New(mt.Elem()).Interface().(Message), nil
+}
+
 // writeProto3Any writes an expanded google.protobuf.Any message.
 //
 // It returns (false, nil) if sv value can't be unmarshaled (e.g. because
-// required messages are not linked in).
+// required messages are not linked in), unless tm.RawAny is set, in which
+// case the payload is written as unknown fields.
 //
 // It returns (true, error) when sv was written in expanded format or an error
 // was encountered.
@@ -216,13 +270,11 @@ func (tm *TextMarshaler) writeProto3Any(w *textWriter, sv reflect.Value) (bool,
 		return true, errors.New("proto: invalid google.protobuf.Any message")
 	}
 
-	parts := strings.Split(turl.String(), "/")
-	mt := MessageType(parts[len(parts)-1])
-	if mt == nil {
-		return false, nil
+	m, err := resolveAny(tm.AnyResolver, turl.String())
+	if err == nil {
+		err = Unmarshal(b, m)
 	}
-	m := reflect.New(mt.Elem())
-	if err := Unmarshal(b, m.Interface().(Message)); err != nil {
+	if err != nil && !tm.RawAny {
 		return false, nil
 	}
 	w.Write([]byte("["))
@@ -238,7 +290,11 @@ func (tm *TextMarshaler) writeProto3Any(w *textWriter, sv reflect.Value) (bool,
 		w.Write([]byte("]: <\n"))
 		w.ind++
 	}
-	if err := tm.writeStruct(w, m.Elem()); err != nil {
+	if err != nil {
+		if err := writeUnknownStruct(w, b); err != nil {
+			return true, err
+		}
+	} else if err := tm.writeStruct(w, reflect.ValueOf(m).Elem()); err != nil {
 		return true, err
 	}
 	if w.compact {
@@ -492,6 +548,11 @@ func (tm *TextMarshaler) writeAny(w *textWriter, v reflect.Value, props *Propert
 			return err
 		}
 	case reflect.Struct:
+		if tm.TimeAsString {
+			if x, ok := formatTime(v, wellKnownType(v.Type())); ok {
+				return writeString(w, x)
+			}
+		}
 		// Required/optional group/message.
 		var bra, ket byte = '<', '>'
 		if props != nil && props.Wire == "group" {
@@ -769,6 +830,21 @@ func (w *textWriter) writeIndent() {
 type TextMarshaler struct {
 	Compact   bool // use compact text format (one line).
 	ExpandAny bool // expand google.protobuf.Any messages of known types
+
+	// AnyResolver resolves the type URLs of expanded google.protobuf.Any
+	// messages. If it is nil, the registered message types are used.
+	AnyResolver AnyResolver
+
+	// RawAny expands google.protobuf.Any messages whose type can't be
+	// resolved, or whose payload doesn't decode, with the payload written
+	// as raw numbered fields like unknown fields. The output can't be
+	// parsed back. It has no effect unless ExpandAny is set.
+	RawAny bool
+
+	// TimeAsString writes google.protobuf.Timestamp and Duration fields as
+	// strings, such as "2009-11-10T23:00:00Z" and "1.5s", instead of as
+	// messages. UnmarshalText accepts either form.
+	TimeAsString bool
 }
 
 // Marshal writes a given protocol buffer in text format.
@@ -42,6 +42,8 @@ import (
 	"strconv"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/golang/protobuf/internal/timefmt"
 )
 
 // Error string emitted when deserializing Any and fields are already set
@@ -82,6 +84,7 @@ type textParser struct {
 	backed       bool   // whether back() was called
 	offset, line int
 	cur          token
+	anyResolver  AnyResolver // resolves expanded Any type URLs, if non-nil
 }
 
 func newTextParser(s string) *textParser {
@@ -465,8 +468,8 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 			if s := strings.LastIndex(extName, "/"); s >= 0 {
 				// If it contains a slash, it's an Any type URL.
 				messageName := extName[s+1:]
-				mt := MessageType(messageName)
-				if mt == nil {
+				m, err := resolveAny(p.anyResolver, extName)
+				if err != nil {
 					return p.errorf("unrecognized message %q in google.protobuf.Any", messageName)
 				}
 				tok = p.next()
@@ -489,11 +492,10 @@ func (p *textParser) readStruct(sv reflect.Value, terminator string) error {
 				default:
 					return p.errorf("expected '{' or '<', found %q", tok.value)
 				}
-				v := reflect.New(mt.Elem())
-				if pe := p.readStruct(v.Elem(), terminator); pe != nil {
+				if pe := p.readStruct(reflect.ValueOf(m).Elem(), terminator); pe != nil {
 					return pe
 				}
-				b, err := Marshal(v.Interface().(Message))
+				b, err := Marshal(m)
 				if err != nil {
 					return p.errorf("failed to marshal message of type %q: %v", messageName, err)
 				}
@@ -848,6 +850,15 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 		case "<":
 			terminator = ">"
 		default:
+			if wkt := wellKnownType(fv.Type()); isQuote(tok.value[0]) && (wkt == "Timestamp" || wkt == "Duration") {
+				secs, nanos, err := parseTime(tok.unquoted, wkt)
+				if err != nil {
+					return p.errorf("invalid %v: %v", wkt, err)
+				}
+				fv.FieldByName("Seconds").SetInt(secs)
+				fv.FieldByName("Nanos").SetInt(int64(nanos))
+				return nil
+			}
 			return p.errorf("expected '{' or '<', found %q", tok.value)
 		}
 		// TODO: Handle nested messages which implement encoding.TextUnmarshaler.
@@ -866,15 +877,38 @@ func (p *textParser) readAny(v reflect.Value, props *Properties) error {
 	return p.errorf("invalid %v: %v", v.Type(), tok.value)
 }
 
+// parseTime parses the proto3 JSON string form of a google.protobuf.Timestamp
+// or Duration message, as written by formatTime.
+func parseTime(s, wkt string) (secs int64, nanos int32, err error) {
+	if wkt == "Timestamp" {
+		return timefmt.ParseTimestamp(s)
+	}
+	return timefmt.ParseDuration(s)
+}
+
 // UnmarshalText reads a protocol buffer in Text format. UnmarshalText resets pb
 // before starting to unmarshal, so any existing data in pb is always removed.
 // If a required field is not set and no other error occurs,
 // UnmarshalText returns *RequiredNotSetError.
 func UnmarshalText(s string, pb Message) error {
+	return new(TextUnmarshaler).Unmarshal(s, pb)
+}
+
+// TextUnmarshaler is a configurable text format unmarshaler.
+type TextUnmarshaler struct {
+	// AnyResolver resolves the type URLs of expanded google.protobuf.Any
+	// messages. If it is nil, the registered message types are used.
+	AnyResolver AnyResolver
+}
+
+// Unmarshal reads a protocol buffer in text format, as UnmarshalText does.
+func (tu *TextUnmarshaler) Unmarshal(s string, pb Message) error {
 	if um, ok := pb.(encoding.TextUnmarshaler); ok {
 		return um.UnmarshalText([]byte(s))
 	}
 	pb.Reset()
 	v := reflect.ValueOf(pb)
-	return newTextParser(s).readStruct(v.Elem(), "")
+	p := newTextParser(s)
+	p.anyResolver = tu.AnyResolver
+	return p.readStruct(v.Elem(), "")
 }
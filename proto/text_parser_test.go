@@ -39,6 +39,8 @@ import (
 	. "github.com/golang/protobuf/proto"
 	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
 	. "github.com/golang/protobuf/proto/test_proto"
+	durpb "github.com/golang/protobuf/ptypes/duration"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
 )
 
 type UnmarshalTextTest struct {
@@ -625,6 +627,42 @@ func TestProto3TextParsing(t *testing.T) {
 	}
 }
 
+func TestTimeStringParsing(t *testing.T) {
+	tests := []struct {
+		in   string
+		want *TimeFields
+		err  string
+	}{
+		{in: `ts: "2014-05-13T16:53:20.021Z"`, want: &TimeFields{Ts: &tspb.Timestamp{Seconds: 14e8, Nanos: 21e6}}},
+		{in: `ts "2014-05-13T18:53:20.5+02:00"`, want: &TimeFields{Ts: &tspb.Timestamp{Seconds: 14e8, Nanos: 5e8}}},
+		{in: `ts: <seconds: 14e8>`, err: `line 1.14: invalid int64: 14e8`},
+		{in: `dur: '1.5s'`, want: &TimeFields{Dur: &durpb.Duration{Seconds: 1, Nanos: 5e8}}},
+		{in: `dur: "-0.000000001s"`, want: &TimeFields{Dur: &durpb.Duration{Nanos: -1}}},
+		{in: `dur: "1.s"`, err: `line 1.5: invalid Duration: invalid "1.s"`},
+		{in: `dur: "1m"`, err: `line 1.5: invalid Duration: "1m" does not end in "s"`},
+		{in: `dur: "315576000001s"`, err: `line 1.5: invalid Duration: "315576000001s" out of range`},
+		{in: `ts: "yesterday"`, err: `line 1.4: invalid Timestamp: parsing time "yesterday" as "2006-01-02T15:04:05.999999999Z07:00": cannot parse "yesterday" as "2006"`},
+		{in: `ts: 1`, err: `line 1.4: expected '{' or '<', found "1"`},
+	}
+	for _, test := range tests {
+		m := new(TimeFields)
+		err := UnmarshalText(test.in, m)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("UnmarshalText(%s): got error %v, want %s", test.in, err, test.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("UnmarshalText(%s): %v", test.in, err)
+			continue
+		}
+		if !Equal(m, test.want) {
+			t.Errorf("UnmarshalText(%s) = %v, want %v", test.in, m, test.want)
+		}
+	}
+}
+
 func TestMapParsing(t *testing.T) {
 	m := new(MessageWithMap)
 	const in = `name_mapping:<key:1234 value:"Feist"> name_mapping:<key:1 value:"Beatles">` +
@@ -45,6 +45,8 @@ import (
 	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
 	pb "github.com/golang/protobuf/proto/test_proto"
 	anypb "github.com/golang/protobuf/ptypes/any"
+	durpb "github.com/golang/protobuf/ptypes/duration"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
 )
 
 // textMessage implements the methods that allow it to marshal and unmarshal
@@ -475,6 +477,47 @@ func TestProto3Text(t *testing.T) {
 	}
 }
 
+func TestTimeAsString(t *testing.T) {
+	tests := []struct {
+		m    *pb.TimeFields
+		want string
+	}{
+		{&pb.TimeFields{Ts: &tspb.Timestamp{}}, `ts:"1970-01-01T00:00:00Z"`},
+		{&pb.TimeFields{Ts: &tspb.Timestamp{Seconds: 14e8, Nanos: 21e6}}, `ts:"2014-05-13T16:53:20.021Z"`},
+		{&pb.TimeFields{Ts: &tspb.Timestamp{Seconds: -62135596800, Nanos: 1}}, `ts:"0001-01-01T00:00:00.000000001Z"`},
+		{&pb.TimeFields{Dur: &durpb.Duration{}}, `dur:"0s"`},
+		{&pb.TimeFields{Dur: &durpb.Duration{Seconds: 3, Nanos: 1e8}}, `dur:"3.100s"`},
+		{&pb.TimeFields{Dur: &durpb.Duration{Seconds: -1, Nanos: -1000}}, `dur:"-1.000001s"`},
+		{&pb.TimeFields{Dur: &durpb.Duration{Nanos: -5e8}}, `dur:"-0.500s"`},
+		{&pb.TimeFields{Dur: &durpb.Duration{Seconds: 315576000000, Nanos: 999999999}}, `dur:"315576000000.999999999s"`},
+		// Invalid values are written as messages.
+		{&pb.TimeFields{Ts: &tspb.Timestamp{Nanos: -1}}, `ts:<nanos:-1 >`},
+		{&pb.TimeFields{Dur: &durpb.Duration{Seconds: 1, Nanos: -1}}, `dur:<seconds:1 nanos:-1 >`},
+	}
+	tm := proto.TextMarshaler{Compact: true, TimeAsString: true}
+	for _, test := range tests {
+		got := strings.TrimSpace(tm.Text(test.m))
+		if got != test.want {
+			t.Errorf("\n got %s\nwant %s", got, test.want)
+			continue
+		}
+		m := new(pb.TimeFields)
+		if err := proto.UnmarshalText(got, m); err != nil {
+			t.Errorf("UnmarshalText(%s): %v", got, err)
+			continue
+		}
+		if !proto.Equal(m, test.m) {
+			t.Errorf("UnmarshalText(%s) = %v, want %v", got, m, test.m)
+		}
+	}
+
+	// Without the option, the message form is unchanged.
+	m := &pb.TimeFields{Dur: &durpb.Duration{Seconds: 3}}
+	if got, want := strings.TrimSpace(proto.CompactTextString(m)), `dur:<seconds:3 >`; got != want {
+		t.Errorf("\n got %s\nwant %s", got, want)
+	}
+}
+
 func TestRacyMarshal(t *testing.T) {
 	// This test should be run with the race detector.
 
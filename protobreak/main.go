@@ -0,0 +1,140 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2010 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// protobreak reports incompatible changes between two versions of a set of
+// .proto files. Each version is given as a FileDescriptorSet, such as one
+// written by
+//
+//	protoc --include_imports --descriptor_set_out=schema.pb file.proto...
+//
+// Run it as
+//
+//	protobreak [flags] old.pb new.pb
+//
+// It prints one change per line, or a JSON array of changes with -json,
+// and exits with status 1 if it finds any.
+//
+// The rules to apply are selected with -rules and -except, which take
+// comma-separated lists of rule IDs and categories. Run protobreak -list
+// to list the rules.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/golang/protobuf/descriptor/breaking"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+var (
+	rules    = flag.String("rules", "WIRE,JSON", "comma-separated `list` of rules and categories to apply")
+	except   = flag.String("except", "", "comma-separated `list` of rules and categories not to apply")
+	jsonOut  = flag.Bool("json", false, "print changes as JSON")
+	listOnly = flag.Bool("list", false, "list the rules and exit")
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: protobreak [flags] old.pb new.pb")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if *listOnly {
+		for _, r := range breaking.Rules {
+			fmt.Printf("%-28s %-15s %s\n", r.ID, strings.Join(r.Categories, ","), r.Description)
+		}
+		return
+	}
+	if flag.NArg() != 2 {
+		usage()
+	}
+
+	before, after := readSet(flag.Arg(0)), readSet(flag.Arg(1))
+	c := &breaking.Checker{Rules: split(*rules), Except: split(*except)}
+	changes, err := c.Check(before, after)
+	if err != nil {
+		fail(err)
+	}
+
+	if *jsonOut {
+		if changes == nil {
+			changes = []breaking.Change{}
+		}
+		b, err := json.MarshalIndent(changes, "", "  ")
+		if err != nil {
+			fail(err)
+		}
+		fmt.Printf("%s\n", b)
+	} else {
+		for _, ch := range changes {
+			fmt.Println(ch)
+		}
+	}
+	if len(changes) > 0 {
+		os.Exit(1)
+	}
+}
+
+func readSet(name string) *descpb.FileDescriptorSet {
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		fail(err)
+	}
+	fds := new(descpb.FileDescriptorSet)
+	if err := proto.Unmarshal(b, fds); err != nil {
+		fail(fmt.Errorf("%s: %v", name, err))
+	}
+	return fds
+}
+
+func split(s string) []string {
+	var list []string
+	for _, x := range strings.Split(s, ",") {
+		if x = strings.TrimSpace(x); x != "" {
+			list = append(list, x)
+		}
+	}
+	return list
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "protobreak:", err)
+	os.Exit(2)
+}
@@ -427,6 +427,7 @@ type Generator struct {
 	pathType         pathType // How to generate output filenames.
 	writeOutput      bool
 	annotateCode     bool                                       // whether to store annotations
+	sourceInfo       bool                                       // whether to keep source_code_info in the embedded descriptor
 	annotations      []*descriptor.GeneratedCodeInfo_Annotation // annotations to store
 }
 
@@ -496,6 +497,10 @@ func (g *Generator) CommandLineParameters(parameter string) {
 			if v == "true" {
 				g.annotateCode = true
 			}
+		case "source_info":
+			if v == "true" {
+				g.sourceInfo = true
+			}
 		default:
 			if len(k) > 0 && k[0] == 'M' {
 				g.ImportMap[k[1:]] = v
@@ -2452,14 +2457,23 @@ func (g *Generator) generateCommonMethods(mc *msgCtx) {
 	g.P()
 }
 
-// Generate the type, methods and default constant definitions for this Descriptor.
-func (g *Generator) generateMessage(message *Descriptor) {
-	topLevelFields := []topLevelField{}
-	oFields := make(map[int32]*oneofField)
-	// The full type name
-	typeName := message.TypeName()
-	// The full type name, CamelCased.
-	goTypeName := CamelCaseSlice(typeName)
+// FieldNames holds the Go names generated for a field of a message.
+type FieldNames struct {
+	Field  string // name of the struct field, or of the field of its oneof wrapper type
+	Getter string // name of the getter method
+
+	// For a field in a oneof, the names of the struct field holding the
+	// oneof, of its getter method, and of the oneof wrapper type of the field.
+	Oneof       string
+	OneofGetter string
+	OneofType   string
+}
+
+// GoNames returns the Go names generated for the fields of the message,
+// in the order of its Field slice. Plugins referring to the fields of a
+// message in the code they generate should use these names.
+func (d *Descriptor) GoNames() []FieldNames {
+	goTypeName := CamelCaseSlice(d.TypeName())
 
 	usedNames := make(map[string]bool)
 	for _, n := range methodNames {
@@ -2487,10 +2501,9 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		}
 	}
 
-	mapFieldTypes := make(map[*descriptor.FieldDescriptorProto]string) // keep track of the map fields to be added later
-
-	// Build a structure more suitable for generating the text in one pass
-	for i, field := range message.Field {
+	names := make([]FieldNames, len(d.Field))
+	oneofs := make(map[int32]*FieldNames)
+	for i, field := range d.Field {
 		// Allocate the getter and the field at the same time so name
 		// collisions create field/method consistent names.
 		// TODO: This allocation occurs based on the order of the fields
@@ -2498,7 +2511,63 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		// ordering can change generated Method/Field names.
 		base := CamelCase(*field.Name)
 		ns := allocNames(base, "Get"+base)
-		fieldName, fieldGetterName := ns[0], ns[1]
+		names[i].Field, names[i].Getter = ns[0], ns[1]
+		if field.OneofIndex == nil {
+			continue
+		}
+
+		o := oneofs[*field.OneofIndex]
+		if o == nil {
+			base := CamelCase(d.OneofDecl[int(*field.OneofIndex)].GetName())
+			ns := allocNames(base, "Get"+base)
+			o = &FieldNames{Oneof: ns[0], OneofGetter: ns[1]}
+			oneofs[*field.OneofIndex] = o
+		}
+		names[i].Oneof, names[i].OneofGetter = o.Oneof, o.OneofGetter
+
+		tname := goTypeName + "_" + names[i].Field
+		// It is possible for this to collide with a message or enum
+		// nested in this message. Check for collisions.
+		for {
+			ok := true
+			for _, desc := range d.nested {
+				if CamelCaseSlice(desc.TypeName()) == tname {
+					ok = false
+					break
+				}
+			}
+			for _, enum := range d.enums {
+				if CamelCaseSlice(enum.TypeName()) == tname {
+					ok = false
+					break
+				}
+			}
+			if !ok {
+				tname += "_"
+				continue
+			}
+			break
+		}
+		names[i].OneofType = tname
+	}
+	return names
+}
+
+// Generate the type, methods and default constant definitions for this Descriptor.
+func (g *Generator) generateMessage(message *Descriptor) {
+	topLevelFields := []topLevelField{}
+	oFields := make(map[int32]*oneofField)
+	// The full type name
+	typeName := message.TypeName()
+	// The full type name, CamelCased.
+	goTypeName := CamelCaseSlice(typeName)
+	goNames := message.GoNames()
+
+	mapFieldTypes := make(map[*descriptor.FieldDescriptorProto]string) // keep track of the map fields to be added later
+
+	// Build a structure more suitable for generating the text in one pass
+	for i, field := range message.Field {
+		fieldName, fieldGetterName := goNames[i].Field, goNames[i].Getter
 		typename, wiretype := g.GoType(message, field)
 		jsonName := *field.Name
 		tag := fmt.Sprintf("protobuf:%s json:%q", g.goTag(message, field, wiretype), jsonName+",omitempty")
@@ -2506,9 +2575,7 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		oneof := field.OneofIndex != nil
 		if oneof && oFields[*field.OneofIndex] == nil {
 			odp := message.OneofDecl[int(*field.OneofIndex)]
-			base := CamelCase(odp.GetName())
-			names := allocNames(base, "Get"+base)
-			fname, gname := names[0], names[1]
+			fname, gname := goNames[i].Oneof, goNames[i].OneofGetter
 
 			// This is the first field of a oneof we haven't seen before.
 			// Generate the union field.
@@ -2575,30 +2642,7 @@ func (g *Generator) generateMessage(message *Descriptor) {
 
 		dvalue := g.getterDefault(field, goTypeName)
 		if oneof {
-			tname := goTypeName + "_" + fieldName
-			// It is possible for this to collide with a message or enum
-			// nested in this message. Check for collisions.
-			for {
-				ok := true
-				for _, desc := range message.nested {
-					if CamelCaseSlice(desc.TypeName()) == tname {
-						ok = false
-						break
-					}
-				}
-				for _, enum := range message.enums {
-					if CamelCaseSlice(enum.TypeName()) == tname {
-						ok = false
-						break
-					}
-				}
-				if !ok {
-					tname += "_"
-					continue
-				}
-				break
-			}
-
+			tname := goNames[i].OneofType
 			oneofField := oFields[*field.OneofIndex]
 			tag := "protobuf:" + g.goTag(message, field, wiretype)
 			sf := oneofSubField{
@@ -2853,10 +2897,13 @@ func (g *Generator) generateInitFunction() {
 }
 
 func (g *Generator) generateFileDescriptor(file *FileDescriptor) {
-	// Make a copy and trim source_code_info data.
+	// Make a copy and trim source_code_info data, unless it was
+	// explicitly requested with the source_info parameter.
 	// TODO: Trim this more when we know exactly what we need.
 	pb := proto.Clone(file.FileDescriptorProto).(*descriptor.FileDescriptorProto)
-	pb.SourceCodeInfo = nil
+	if !g.sourceInfo {
+		pb.SourceCodeInfo = nil
+	}
 
 	b, err := proto.Marshal(pb)
 	if err != nil {
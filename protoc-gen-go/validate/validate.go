@@ -0,0 +1,480 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package validate outputs Validate methods checking the rules that the
+// (golang.protobuf.validate.rules) option sets on the fields of messages,
+// as the github.com/golang/protobuf/validate package checks them by
+// reflection.
+// It runs as a plugin for the Go protocol buffer compiler plugin.
+// It is linked in to protoc-gen-go.
+package validate
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/protoc-gen-go/generator"
+	"github.com/golang/protobuf/validate/rules"
+)
+
+// Paths for packages used by code generated in this file,
+// relative to the import_prefix of the generator.Generator.
+const (
+	regexpPkgPath   = "regexp"
+	sortPkgPath     = "sort"
+	utf8PkgPath     = "unicode/utf8"
+	validatePkgPath = "github.com/golang/protobuf/validate"
+)
+
+func init() {
+	generator.RegisterPlugin(new(validate))
+}
+
+// validate is an implementation of the Go protocol buffer compiler's
+// plugin architecture.  It generates Validate methods for messages.
+type validate struct {
+	gen *generator.Generator
+}
+
+// Name returns the name of this plugin, "validate".
+func (g *validate) Name() string {
+	return "validate"
+}
+
+// Init initializes the plugin.
+func (g *validate) Init(gen *generator.Generator) {
+	g.gen = gen
+}
+
+// pkg returns the name of the package with the given import path in the
+// generated code, which is imported the first time it is used.
+func (g *validate) pkg(importPath string) string {
+	return string(g.gen.AddImport(generator.GoImportPath(importPath)))
+}
+
+// P forwards to g.gen.P.
+func (g *validate) P(args ...interface{}) { g.gen.P(args...) }
+
+// Generate generates Validate methods for the messages in the given file.
+func (g *validate) Generate(file *generator.FileDescriptor) {
+	prefix := ""
+	if pkg := file.GetPackage(); pkg != "" {
+		prefix = "." + pkg
+	}
+	for _, md := range file.MessageType {
+		g.generateMessages(prefix+"."+md.GetName(), md)
+	}
+}
+
+// GenerateImports generates the import declaration for this file.
+func (g *validate) GenerateImports(file *generator.FileDescriptor) {
+}
+
+// generateMessages generates the Validate methods of the message with the
+// given full name and of the messages nested in it.
+func (g *validate) generateMessages(name string, md *pb.DescriptorProto) {
+	// Maps have no Go type of their own.
+	if md.GetOptions().GetMapEntry() {
+		return
+	}
+	g.generateMessage(g.gen.ObjectNamed(name).(*generator.Descriptor))
+	for _, nd := range md.NestedType {
+		g.generateMessages(name+"."+nd.GetName(), nd)
+	}
+}
+
+// generateMessage generates the Validate method of a message.
+func (g *validate) generateMessage(message *generator.Descriptor) {
+	names := message.GoNames()
+	goTypeName := generator.CamelCaseSlice(message.TypeName())
+	for _, n := range names {
+		if n.Field == "Validate" && n.Oneof == "" || n.Oneof == "Validate" {
+			// A field is named Validate. The validate package checks the
+			// message by reflection instead.
+			return
+		}
+	}
+
+	var patterns []*field
+	g.P("// Validate checks m against the rules set on its fields by the (golang.protobuf.validate.rules) option.")
+	g.P("func (m *", goTypeName, ") Validate() error {")
+	g.gen.In()
+	g.P("if m == nil {")
+	g.gen.In()
+	g.P("return nil")
+	g.gen.Out()
+	g.P("}")
+	g.P("var errs ", g.pkg(validatePkgPath), ".Errors")
+	for i, fd := range message.Field {
+		f := g.newField(message, fd, goTypeName+"_"+names[i].Field)
+		if f == nil {
+			continue
+		}
+		if f.pattern != "" {
+			patterns = append(patterns, f)
+		}
+		switch {
+		case fd.OneofIndex != nil:
+			g.generateOneof(f, "m."+names[i].Oneof, names[i].OneofType, names[i].Field)
+		case f.isMap:
+			g.generateMap(f, "m."+names[i].Field)
+		case f.GetLabel() == pb.FieldDescriptorProto_LABEL_REPEATED:
+			g.generateRepeated(f, "m."+names[i].Field)
+		default:
+			g.generateSingular(f, "m."+names[i].Field)
+		}
+	}
+	g.P("return errs.Err()")
+	g.gen.Out()
+	g.P("}")
+	g.P()
+
+	for _, f := range patterns {
+		g.P("var ", f.pattern, " = ", g.pkg(regexpPkgPath), ".MustCompile(", strconv.Quote(f.rules.GetPattern()), ")")
+		g.P()
+	}
+}
+
+// A field is a field whose values are checked by a Validate method.
+type field struct {
+	*pb.FieldDescriptorProto
+	rules  *rules.FieldRules
+	path   string // quoted name of the field, as the path in errors
+	proto3 bool
+
+	isMap   bool
+	keyType string                       // Go type of the keys of a map
+	typ     pb.FieldDescriptorProto_Type // type of the values, or map values
+	enum    string                       // Go type of an enum that must be defined
+	pattern string                       // name of the variable holding the compiled pattern
+}
+
+// newField returns the field to check for fd, or nil if fd has no rules
+// and holds no messages. prefix is used to name the variables it needs.
+func (g *validate) newField(message *generator.Descriptor, fd *pb.FieldDescriptorProto, prefix string) *field {
+	f := &field{
+		FieldDescriptorProto: fd,
+		rules:                new(rules.FieldRules),
+		path:                 strconv.Quote(fd.GetName()),
+		proto3:               message.File().GetSyntax() == "proto3",
+		typ:                  fd.GetType(),
+	}
+	if fd.Options != nil && proto.HasExtension(fd.Options, rules.E_Rules) {
+		x, err := proto.GetExtension(fd.Options, rules.E_Rules)
+		if err != nil {
+			g.gen.Fail("invalid rules on", fd.GetName(), err.Error())
+		}
+		f.rules = x.(*rules.FieldRules)
+	} else if !isMessage(f.typ) {
+		return nil
+	}
+
+	values := fd
+	if fd.GetType() == pb.FieldDescriptorProto_TYPE_MESSAGE {
+		if d, ok := g.gen.ObjectNamed(fd.GetTypeName()).(*generator.Descriptor); ok && d.GetOptions().GetMapEntry() {
+			keyType, _ := g.gen.GoType(d, d.Field[0])
+			f.isMap = true
+			f.keyType = strings.TrimPrefix(keyType, "*")
+			values = d.Field[1]
+			f.typ = values.GetType()
+		}
+	}
+	if f.rules.Pattern != nil && f.typ == pb.FieldDescriptorProto_TYPE_STRING {
+		if _, err := regexp.Compile(f.rules.GetPattern()); err != nil {
+			g.gen.Fail("invalid pattern on", fd.GetName(), err.Error())
+		}
+		f.pattern = "validatePattern_" + prefix
+	}
+	if f.rules.GetDefinedOnly() && f.typ == pb.FieldDescriptorProto_TYPE_ENUM {
+		g.gen.RecordTypeUse(values.GetTypeName())
+		f.enum = g.gen.TypeName(g.gen.ObjectNamed(values.GetTypeName()))
+	}
+	return f
+}
+
+func isMessage(t pb.FieldDescriptorProto_Type) bool {
+	return t == pb.FieldDescriptorProto_TYPE_MESSAGE || t == pb.FieldDescriptorProto_TYPE_GROUP
+}
+
+// generateSingular generates the checks of a field outside a oneof,
+// held in the Go struct field named by v.
+func (g *validate) generateSingular(f *field, v string) {
+	if f.proto3 && !isMessage(f.typ) {
+		// A proto3 scalar is always present, and set unless zero.
+		if f.rules.GetRequired() {
+			g.P("if ", isZero(f.typ, v), " {")
+			g.gen.In()
+			g.required(f)
+			g.gen.Out()
+			g.P("}")
+		}
+		g.checks(f, v, f.path, true)
+		return
+	}
+
+	hasChecks := g.hasChecks(f, true)
+	if f.typ != pb.FieldDescriptorProto_TYPE_BYTES && !isMessage(f.typ) {
+		v = "*" + v
+	}
+	switch {
+	case f.rules.GetRequired():
+		g.P("if ", strings.TrimPrefix(v, "*"), " == nil {")
+		g.gen.In()
+		g.required(f)
+		g.gen.Out()
+		if hasChecks {
+			g.P("} else {")
+			g.gen.In()
+			g.checks(f, v, f.path, true)
+			g.gen.Out()
+		}
+		g.P("}")
+	case hasChecks:
+		g.P("if ", strings.TrimPrefix(v, "*"), " != nil {")
+		g.gen.In()
+		g.checks(f, v, f.path, true)
+		g.gen.Out()
+		g.P("}")
+	}
+}
+
+// generateOneof generates the checks of a field of the oneof held in the
+// Go struct field named by v, set when it holds a wrapper of the given type.
+func (g *validate) generateOneof(f *field, v, wrapper, name string) {
+	switch {
+	case g.hasChecks(f, true):
+		g.P("if x, ok := ", v, ".(*", wrapper, "); ok {")
+		g.gen.In()
+		g.checks(f, "x."+name, f.path, true)
+		g.gen.Out()
+		if f.rules.GetRequired() {
+			g.P("} else {")
+			g.gen.In()
+			g.required(f)
+			g.gen.Out()
+		}
+		g.P("}")
+	case f.rules.GetRequired():
+		g.P("if _, ok := ", v, ".(*", wrapper, "); !ok {")
+		g.gen.In()
+		g.required(f)
+		g.gen.Out()
+		g.P("}")
+	}
+}
+
+// generateRepeated generates the checks of a repeated field held in the Go
+// struct field named by v.
+func (g *validate) generateRepeated(f *field, v string) {
+	g.checkCount(f, v)
+	if !g.hasChecks(f, false) {
+		return
+	}
+	g.P("for i, v := range ", v, " {")
+	g.gen.In()
+	g.checks(f, "v", g.pkg(validatePkgPath)+".Index("+f.path+", i)", false)
+	g.gen.Out()
+	g.P("}")
+}
+
+// generateMap generates the checks of a map field held in the Go struct
+// field named by v. The values are checked in the order of their keys.
+func (g *validate) generateMap(f *field, v string) {
+	g.checkCount(f, v)
+	if !g.hasChecks(f, false) {
+		return
+	}
+	less := "keys[i] < keys[j]"
+	if f.keyType == "bool" {
+		less = "!keys[i] && keys[j]"
+	}
+	g.P("if len(", v, ") > 0 {")
+	g.gen.In()
+	g.P("keys := make([]", f.keyType, ", 0, len(", v, "))")
+	g.P("for k := range ", v, " {")
+	g.gen.In()
+	g.P("keys = append(keys, k)")
+	g.gen.Out()
+	g.P("}")
+	g.P(g.pkg(sortPkgPath), ".Slice(keys, func(i, j int) bool { return ", less, " })")
+	g.P("for _, k := range keys {")
+	g.gen.In()
+	g.P("v := ", v, "[k]")
+	g.checks(f, "v", g.pkg(validatePkgPath)+".Key("+f.path+", k)", false)
+	g.gen.Out()
+	g.P("}")
+	g.gen.Out()
+	g.P("}")
+}
+
+// checkCount generates the checks of the number of elements of a repeated
+// or map field held in the Go struct field named by v.
+func (g *validate) checkCount(f *field, v string) {
+	if f.rules.GetRequired() {
+		g.P("if len(", v, ") == 0 {")
+		g.gen.In()
+		g.required(f)
+		g.gen.Out()
+		g.P("}")
+	}
+	g.checkLen(f, "uint64(len("+v+"))", f.path, "elements")
+}
+
+func (g *validate) required(f *field) {
+	g.P("errs = append(errs, ", g.pkg(validatePkgPath), ".RequiredError(", f.path, "))")
+}
+
+// hasChecks reports whether checks generates any code for f. Length rules
+// apply to the value only if it is the whole field.
+func (g *validate) hasChecks(f *field, whole bool) bool {
+	r := f.rules
+	switch f.typ {
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+		return true
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		return f.pattern != "" || whole && (r.MinLen != nil || r.MaxLen != nil)
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		return whole && (r.MinLen != nil || r.MaxLen != nil)
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return false
+	case pb.FieldDescriptorProto_TYPE_ENUM:
+		return r.Min != nil || r.Max != nil || f.enum != ""
+	}
+	return r.Min != nil || r.Max != nil
+}
+
+// checks generates the checks of the value v of f, or of one of its
+// elements, at the given path.
+func (g *validate) checks(f *field, v, path string, whole bool) {
+	r := f.rules
+	switch f.typ {
+	case pb.FieldDescriptorProto_TYPE_MESSAGE, pb.FieldDescriptorProto_TYPE_GROUP:
+		g.P("errs = ", g.pkg(validatePkgPath), ".AppendNested(errs, ", path, ", ", v, ")")
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		if f.pattern != "" {
+			g.P("if !", f.pattern, ".MatchString(", v, ") {")
+			g.gen.In()
+			g.P("errs = append(errs, ", g.pkg(validatePkgPath), ".PatternError(", path, ", ", strconv.Quote(r.GetPattern()), "))")
+			g.gen.Out()
+			g.P("}")
+		}
+		if whole {
+			g.checkLen(f, "uint64("+g.pkg(utf8PkgPath)+".RuneCountInString("+v+"))", path, "characters")
+		}
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		if whole {
+			g.checkLen(f, "uint64(len("+v+"))", path, "bytes")
+		}
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+	case pb.FieldDescriptorProto_TYPE_ENUM:
+		g.checkRange(f, v, path)
+		if f.enum != "" {
+			g.P("if _, ok := ", f.enum, "_name[int32(", v, ")]; !ok {")
+			g.gen.In()
+			g.P("errs = append(errs, ", g.pkg(validatePkgPath), ".UndefinedError(", path, ", int32(", v, ")))")
+			g.gen.Out()
+			g.P("}")
+		}
+	default:
+		g.checkRange(f, v, path)
+	}
+}
+
+func (g *validate) checkRange(f *field, v, path string) {
+	if f.rules.Min != nil {
+		min := g.float(f.rules.GetMin())
+		g.P("if float64(", v, ") < ", min, " {")
+		g.gen.In()
+		g.P("errs = append(errs, ", g.pkg(validatePkgPath), ".MinError(", path, ", ", min, "))")
+		g.gen.Out()
+		g.P("}")
+	}
+	if f.rules.Max != nil {
+		max := g.float(f.rules.GetMax())
+		g.P("if float64(", v, ") > ", max, " {")
+		g.gen.In()
+		g.P("errs = append(errs, ", g.pkg(validatePkgPath), ".MaxError(", path, ", ", max, "))")
+		g.gen.Out()
+		g.P("}")
+	}
+}
+
+// checkLen generates the checks of n, the length of the value at path in
+// the given units.
+func (g *validate) checkLen(f *field, n, path, units string) {
+	if f.rules.MinLen != nil {
+		min := strconv.FormatUint(f.rules.GetMinLen(), 10)
+		g.P("if ", n, " < ", min, " {")
+		g.gen.In()
+		g.P("errs = append(errs, ", g.pkg(validatePkgPath), ".MinLenError(", path, ", ", min, ", ", strconv.Quote(units), "))")
+		g.gen.Out()
+		g.P("}")
+	}
+	if f.rules.MaxLen != nil {
+		max := strconv.FormatUint(f.rules.GetMaxLen(), 10)
+		g.P("if ", n, " > ", max, " {")
+		g.gen.In()
+		g.P("errs = append(errs, ", g.pkg(validatePkgPath), ".MaxLenError(", path, ", ", max, ", ", strconv.Quote(units), "))")
+		g.gen.Out()
+		g.P("}")
+	}
+}
+
+// float returns a Go expression for x, which is used as a float64.
+func (g *validate) float(x float64) string {
+	switch {
+	case math.IsInf(x, 1):
+		return g.gen.Pkg["math"] + ".Inf(1)"
+	case math.IsInf(x, -1):
+		return g.gen.Pkg["math"] + ".Inf(-1)"
+	case math.IsNaN(x):
+		return g.gen.Pkg["math"] + ".NaN()"
+	}
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+// isZero returns a Go expression reporting whether v, a proto3 scalar of
+// type t, holds its zero value.
+func isZero(t pb.FieldDescriptorProto_Type, v string) string {
+	switch t {
+	case pb.FieldDescriptorProto_TYPE_STRING:
+		return v + ` == ""`
+	case pb.FieldDescriptorProto_TYPE_BYTES:
+		return "len(" + v + ") == 0"
+	case pb.FieldDescriptorProto_TYPE_BOOL:
+		return "!" + v
+	}
+	return v + " == 0"
+}
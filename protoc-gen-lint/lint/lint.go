@@ -0,0 +1,387 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package lint checks .proto files, given as FileDescriptorProtos, against
+// style and correctness rules.
+//
+// A rule can be suppressed for an element, and everything declared inside
+// it, by a comment on the element containing "lint:ignore" followed by the
+// IDs of the rules, and for a whole file by a comment anywhere in the file
+// containing "lint:file-ignore" followed by rule IDs:
+//
+//	// lint:ignore FIELD_NO_REQUIRED
+//	required string name = 1;
+//
+// Comments are only available when the file carries its SourceCodeInfo,
+// as the files that protoc passes to plugins do.
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// A Rule is a check applied to each file.
+type Rule struct {
+	ID          string
+	Description string
+}
+
+// Rules lists every rule.
+var Rules = []Rule{
+	{"FILE_GO_PACKAGE", "files set option go_package"},
+	{"MESSAGE_CAMEL_CASE", "message names are CamelCase"},
+	{"FIELD_SNAKE_CASE", "field names are lower_snake_case"},
+	{"FIELD_NO_REQUIRED", "fields are not required"},
+	{"FIELD_NOT_RESERVED", "fields do not use reserved numbers or names"},
+	{"ENUM_ZERO_VALUE_UNSPECIFIED", "enums have a zero value whose name ends in _UNSPECIFIED"},
+	{"ENUM_VALUE_NOT_RESERVED", "enum values do not use reserved numbers or names"},
+	{"COMMENTS", "messages, enums, services and methods have leading comments"},
+}
+
+// A Problem is a violation of a rule.
+type Problem struct {
+	Rule    string // ID of the rule
+	File    string // name of the file
+	Line    int    // line of the element, starting at 1, or 0 if unknown
+	Column  int    // column of the element, starting at 1, or 0 if unknown
+	Element string // full name of the element
+	Message string
+}
+
+func (p Problem) String() string {
+	pos := p.File
+	if p.Line > 0 {
+		pos = fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+	}
+	return fmt.Sprintf("%s: %s: %s (%s)", pos, p.Element, p.Message, p.Rule)
+}
+
+// A Linter checks files against the rules.
+type Linter struct {
+	// Disable lists the IDs of rules not to apply.
+	Disable []string
+}
+
+// Lint returns the problems found in fd, in the order of its declarations.
+// It returns an error if l disables an unknown rule.
+func (l *Linter) Lint(fd *descpb.FileDescriptorProto) ([]Problem, error) {
+	disabled := make(ruleSet)
+	for _, id := range l.Disable {
+		if !knownRule(id) {
+			return nil, fmt.Errorf("lint: unknown rule %q", id)
+		}
+		disabled[id] = true
+	}
+	fl := &fileLinter{
+		fd:   fd,
+		locs: make(map[string]*descpb.SourceCodeInfo_Location),
+	}
+	for _, loc := range fd.GetSourceCodeInfo().GetLocation() {
+		if k := pathKey(loc.Path); fl.locs[k] == nil {
+			fl.locs[k] = loc
+		}
+		for _, c := range comments(loc) {
+			disabled.add(directive(c, "lint:file-ignore"))
+		}
+	}
+	fl.file(disabled)
+	return fl.problems, nil
+}
+
+func knownRule(id string) bool {
+	for _, r := range Rules {
+		if r.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// A ruleSet holds the IDs of suppressed rules.
+type ruleSet map[string]bool
+
+func (s ruleSet) add(ids []string) {
+	for _, id := range ids {
+		s[id] = true
+	}
+}
+
+// Field numbers of the elements of descriptor.proto that appear in the
+// paths of SourceCodeInfo locations.
+const (
+	// FileDescriptorProto
+	fileMessagePath = 4
+	fileEnumPath    = 5
+	fileServicePath = 6
+	fileOptionsPath = 8
+	// DescriptorProto
+	messageFieldPath  = 2
+	messageNestedPath = 3
+	messageEnumPath   = 4
+	// EnumDescriptorProto
+	enumValuePath = 2
+	// ServiceDescriptorProto
+	serviceMethodPath = 2
+)
+
+// A fileLinter holds the state of linting a single file.
+type fileLinter struct {
+	fd       *descpb.FileDescriptorProto
+	locs     map[string]*descpb.SourceCodeInfo_Location // path => location
+	problems []Problem
+}
+
+func pathKey(path []int32) string {
+	return fmt.Sprint(path)
+}
+
+func appendPath(path []int32, elems ...int32) []int32 {
+	return append(append([]int32(nil), path...), elems...)
+}
+
+func comments(loc *descpb.SourceCodeInfo_Location) []string {
+	cs := append([]string(nil), loc.LeadingDetachedComments...)
+	if loc.LeadingComments != nil {
+		cs = append(cs, loc.GetLeadingComments())
+	}
+	if loc.TrailingComments != nil {
+		cs = append(cs, loc.GetTrailingComments())
+	}
+	return cs
+}
+
+// directive returns the rule IDs following each occurrence of the
+// directive in the comment c, up to the end of its line.
+func directive(c, name string) []string {
+	var ids []string
+	for _, line := range strings.Split(c, "\n") {
+		i := strings.Index(line, name)
+		if i < 0 {
+			continue
+		}
+		rest := line[i+len(name):]
+		ids = append(ids, strings.FieldsFunc(rest, func(r rune) bool { return r == ' ' || r == ',' || r == '\t' })...)
+	}
+	return ids
+}
+
+// scope returns the rules suppressed for the element at path, given the
+// rules suppressed for its parent.
+func (fl *fileLinter) scope(parent ruleSet, path []int32) ruleSet {
+	loc := fl.locs[pathKey(path)]
+	if loc == nil {
+		return parent
+	}
+	var ids []string
+	if loc.LeadingComments != nil {
+		ids = append(ids, directive(loc.GetLeadingComments(), "lint:ignore")...)
+	}
+	if loc.TrailingComments != nil {
+		ids = append(ids, directive(loc.GetTrailingComments(), "lint:ignore")...)
+	}
+	if len(ids) == 0 {
+		return parent
+	}
+	s := make(ruleSet)
+	for id := range parent {
+		s[id] = true
+	}
+	s.add(ids)
+	return s
+}
+
+func (fl *fileLinter) report(suppressed ruleSet, rule string, path []int32, element, format string, args ...interface{}) {
+	if suppressed[rule] {
+		return
+	}
+	p := Problem{
+		Rule:    rule,
+		File:    fl.fd.GetName(),
+		Element: element,
+		Message: fmt.Sprintf(format, args...),
+	}
+	if loc := fl.locs[pathKey(path)]; loc != nil && len(loc.Span) >= 2 {
+		p.Line, p.Column = int(loc.Span[0])+1, int(loc.Span[1])+1
+	}
+	fl.problems = append(fl.problems, p)
+}
+
+// hasComment reports whether the element at path has a leading comment.
+// Without source code info, every element is taken to have one.
+func (fl *fileLinter) hasComment(path []int32) bool {
+	if fl.fd.SourceCodeInfo == nil {
+		return true
+	}
+	loc := fl.locs[pathKey(path)]
+	return loc != nil && strings.TrimSpace(loc.GetLeadingComments()) != ""
+}
+
+func joinName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+var (
+	camelCase = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+	snakeCase = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+)
+
+func (fl *fileLinter) file(suppressed ruleSet) {
+	fd := fl.fd
+	if fd.GetOptions().GetGoPackage() == "" {
+		fl.report(suppressed, "FILE_GO_PACKAGE", []int32{fileOptionsPath}, fd.GetName(), "missing option go_package")
+	}
+	pkg := fd.GetPackage()
+	for i, md := range fd.MessageType {
+		fl.message(suppressed, []int32{fileMessagePath, int32(i)}, pkg, md)
+	}
+	for i, ed := range fd.EnumType {
+		fl.enum(suppressed, []int32{fileEnumPath, int32(i)}, pkg, ed)
+	}
+	for i, sd := range fd.Service {
+		path := []int32{fileServicePath, int32(i)}
+		name := joinName(pkg, sd.GetName())
+		s := fl.scope(suppressed, path)
+		if !fl.hasComment(path) {
+			fl.report(s, "COMMENTS", path, name, "service has no comment")
+		}
+		for j, md := range sd.Method {
+			path := appendPath(path, serviceMethodPath, int32(j))
+			if !fl.hasComment(path) {
+				fl.report(fl.scope(s, path), "COMMENTS", path, joinName(name, md.GetName()), "method has no comment")
+			}
+		}
+	}
+}
+
+func (fl *fileLinter) message(suppressed ruleSet, path []int32, scope string, md *descpb.DescriptorProto) {
+	if md.GetOptions().GetMapEntry() {
+		return
+	}
+	name := joinName(scope, md.GetName())
+	s := fl.scope(suppressed, path)
+	if !camelCase.MatchString(md.GetName()) {
+		fl.report(s, "MESSAGE_CAMEL_CASE", path, name, "message name %s is not CamelCase", md.GetName())
+	}
+	if !fl.hasComment(path) {
+		fl.report(s, "COMMENTS", path, name, "message has no comment")
+	}
+
+	groups := make(map[string]bool)
+	for i, f := range md.Field {
+		path := appendPath(path, messageFieldPath, int32(i))
+		fname := joinName(name, f.GetName())
+		fs := fl.scope(s, path)
+		if !snakeCase.MatchString(f.GetName()) {
+			fl.report(fs, "FIELD_SNAKE_CASE", path, fname, "field name %s is not lower_snake_case", f.GetName())
+		}
+		if f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REQUIRED {
+			fl.report(fs, "FIELD_NO_REQUIRED", path, fname, "field is required")
+		}
+		for _, r := range md.ReservedRange {
+			if r.GetStart() <= f.GetNumber() && f.GetNumber() < r.GetEnd() {
+				fl.report(fs, "FIELD_NOT_RESERVED", path, fname, "field number %d is reserved", f.GetNumber())
+			}
+		}
+		for _, n := range md.ReservedName {
+			if n == f.GetName() {
+				fl.report(fs, "FIELD_NOT_RESERVED", path, fname, "field name %s is reserved", n)
+			}
+		}
+		if f.GetType() == descpb.FieldDescriptorProto_TYPE_GROUP {
+			groups[f.GetTypeName()] = true
+		}
+	}
+
+	for i, nested := range md.NestedType {
+		if groups["."+joinName(name, nested.GetName())] {
+			// A group's message is documented by its field.
+			fl.groupMessage(s, appendPath(path, messageNestedPath, int32(i)), name, nested)
+			continue
+		}
+		fl.message(s, appendPath(path, messageNestedPath, int32(i)), name, nested)
+	}
+	for i, ed := range md.EnumType {
+		fl.enum(s, appendPath(path, messageEnumPath, int32(i)), name, ed)
+	}
+}
+
+// groupMessage checks the message of a group, which needs no comment.
+func (fl *fileLinter) groupMessage(suppressed ruleSet, path []int32, scope string, md *descpb.DescriptorProto) {
+	s := make(ruleSet)
+	for id := range suppressed {
+		s[id] = true
+	}
+	s["COMMENTS"] = true
+	fl.message(s, path, scope, md)
+}
+
+func (fl *fileLinter) enum(suppressed ruleSet, path []int32, scope string, ed *descpb.EnumDescriptorProto) {
+	name := joinName(scope, ed.GetName())
+	s := fl.scope(suppressed, path)
+	if !fl.hasComment(path) {
+		fl.report(s, "COMMENTS", path, name, "enum has no comment")
+	}
+
+	var zero *descpb.EnumValueDescriptorProto
+	zeroPath := path
+	for i, v := range ed.Value {
+		path := appendPath(path, enumValuePath, int32(i))
+		vname := joinName(scope, v.GetName()) // enum values are siblings of their enum
+		vs := fl.scope(s, path)
+		if v.GetNumber() == 0 && zero == nil {
+			zero, zeroPath = v, path
+		}
+		for _, r := range ed.ReservedRange {
+			if r.GetStart() <= v.GetNumber() && v.GetNumber() <= r.GetEnd() {
+				fl.report(vs, "ENUM_VALUE_NOT_RESERVED", path, vname, "enum value number %d is reserved", v.GetNumber())
+			}
+		}
+		for _, n := range ed.ReservedName {
+			if n == v.GetName() {
+				fl.report(vs, "ENUM_VALUE_NOT_RESERVED", path, vname, "enum value name %s is reserved", n)
+			}
+		}
+	}
+	switch {
+	case zero == nil:
+		fl.report(s, "ENUM_ZERO_VALUE_UNSPECIFIED", path, name, "enum has no zero value")
+	case !strings.HasSuffix(zero.GetName(), "_UNSPECIFIED"):
+		fl.report(fl.scope(s, zeroPath), "ENUM_ZERO_VALUE_UNSPECIFIED", zeroPath, joinName(scope, zero.GetName()),
+			"zero value %s does not end in _UNSPECIFIED", zero.GetName())
+	}
+}
@@ -0,0 +1,131 @@
+package lint_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	cpb "github.com/golang/protobuf/descriptor/descriptor_test_proto"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/protoc-gen-lint/lint"
+)
+
+func TestLintGenerated(t *testing.T) {
+	fd, _ := descriptor.ForMessage(new(cpb.Article))
+	problems, err := new(lint.Linter).Lint(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, p := range problems {
+		got = append(got, p.String())
+	}
+	want := []string{
+		"test_comments.proto:62:3: descriptor_test.Article.Author: message has no comment (COMMENTS)",
+		"test_comments.proto:57:5: descriptor_test.Article.DRAFT: zero value DRAFT does not end in _UNSPECIFIED (ENUM_ZERO_VALUE_UNSPECIFIED)",
+		"test_comments.proto:71:3: descriptor_test.FORMAT_TEXT: zero value FORMAT_TEXT does not end in _UNSPECIFIED (ENUM_ZERO_VALUE_UNSPECIFIED)",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got problems:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func location(path []int32, line int32, leading string) *descpb.SourceCodeInfo_Location {
+	loc := &descpb.SourceCodeInfo_Location{Path: path, Span: []int32{line, 0, 10}}
+	if leading != "" {
+		loc.LeadingComments = proto.String(leading)
+	}
+	return loc
+}
+
+func TestLint(t *testing.T) {
+	fd := &descpb.FileDescriptorProto{
+		Name:    proto.String("bad.proto"),
+		Package: proto.String("bad"),
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("user_info"),
+			Field: []*descpb.FieldDescriptorProto{{
+				Name:   proto.String("UserName"),
+				Number: proto.Int32(1),
+				Label:  descpb.FieldDescriptorProto_LABEL_REQUIRED.Enum(),
+				Type:   descpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			}, {
+				Name:   proto.String("id"),
+				Number: proto.Int32(5),
+				Label:  descpb.FieldDescriptorProto_LABEL_REQUIRED.Enum(),
+				Type:   descpb.FieldDescriptorProto_TYPE_INT64.Enum(),
+			}, {
+				Name:   proto.String("email"),
+				Number: proto.Int32(6),
+				Label:  descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:   descpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			}},
+			ReservedRange: []*descpb.DescriptorProto_ReservedRange{{Start: proto.Int32(4), End: proto.Int32(6)}},
+			ReservedName:  []string{"email"},
+		}},
+		EnumType: []*descpb.EnumDescriptorProto{{
+			Name: proto.String("Color"),
+			Value: []*descpb.EnumValueDescriptorProto{
+				{Name: proto.String("RED"), Number: proto.Int32(1)},
+				{Name: proto.String("BLUE"), Number: proto.Int32(2)},
+			},
+			ReservedRange: []*descpb.EnumDescriptorProto_EnumReservedRange{{Start: proto.Int32(2), End: proto.Int32(2)}},
+		}},
+		Service: []*descpb.ServiceDescriptorProto{{
+			Name: proto.String("Users"),
+			Method: []*descpb.MethodDescriptorProto{{
+				Name:       proto.String("Get"),
+				InputType:  proto.String(".bad.user_info"),
+				OutputType: proto.String(".bad.user_info"),
+			}},
+		}},
+		SourceCodeInfo: &descpb.SourceCodeInfo{Location: []*descpb.SourceCodeInfo_Location{
+			location([]int32{4, 0}, 3, " User information.\n lint:ignore FIELD_NO_REQUIRED, MESSAGE_CAMEL_CASE\n"),
+			location([]int32{4, 0, 2, 0}, 4, " lint:ignore FIELD_SNAKE_CASE\n"),
+			location([]int32{4, 0, 2, 1}, 5, ""),
+			location([]int32{4, 0, 2, 2}, 6, ""),
+			location([]int32{5, 0}, 9, ""),
+			location([]int32{5, 0, 2, 1}, 11, ""),
+			location([]int32{6, 0}, 14, " Users manages users.\n"),
+			location([]int32{6, 0, 2, 0}, 15, ""),
+		}},
+	}
+
+	problems, err := new(lint.Linter).Lint(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []lint.Problem{
+		{"FILE_GO_PACKAGE", "bad.proto", 0, 0, "bad.proto", "missing option go_package"},
+		{"FIELD_NOT_RESERVED", "bad.proto", 6, 1, "bad.user_info.id", "field number 5 is reserved"},
+		{"FIELD_NOT_RESERVED", "bad.proto", 7, 1, "bad.user_info.email", "field name email is reserved"},
+		{"COMMENTS", "bad.proto", 10, 1, "bad.Color", "enum has no comment"},
+		{"ENUM_VALUE_NOT_RESERVED", "bad.proto", 12, 1, "bad.BLUE", "enum value number 2 is reserved"},
+		{"ENUM_ZERO_VALUE_UNSPECIFIED", "bad.proto", 10, 1, "bad.Color", "enum has no zero value"},
+		{"COMMENTS", "bad.proto", 16, 1, "bad.Users.Get", "method has no comment"},
+	}
+	if !reflect.DeepEqual(problems, want) {
+		t.Errorf("got problems:")
+		for _, p := range problems {
+			t.Errorf("\t%v", p)
+		}
+	}
+
+	// Suppress rules for the whole file, and disable others.
+	fd.SourceCodeInfo.Location[4].LeadingDetachedComments = []string{" lint:file-ignore COMMENTS\n"}
+	problems, err = (&lint.Linter{Disable: []string{"FIELD_NOT_RESERVED", "ENUM_VALUE_NOT_RESERVED", "FILE_GO_PACKAGE"}}).Lint(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []lint.Problem{
+		{"ENUM_ZERO_VALUE_UNSPECIFIED", "bad.proto", 10, 1, "bad.Color", "enum has no zero value"},
+	}
+	if !reflect.DeepEqual(problems, want) {
+		t.Errorf("with suppressed rules, got problems %v, want %v", problems, want)
+	}
+
+	if _, err := (&lint.Linter{Disable: []string{"NO_SUCH_RULE"}}).Lint(fd); err == nil {
+		t.Error("Lint with an unknown disabled rule succeeded")
+	}
+}
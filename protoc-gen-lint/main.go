@@ -0,0 +1,125 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2010 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// protoc-gen-lint is a plugin for the Google protocol buffer compiler that
+// checks .proto files against the style and correctness rules of package
+// github.com/golang/protobuf/protoc-gen-lint/lint. Run it by building this
+// program and putting it in your path with the name
+//
+//	protoc-gen-lint
+//
+// and then running
+//
+//	protoc --lint_out=. input_directory/file.proto
+//
+// If any rule is violated, protoc fails and prints the problems found.
+//
+// The plugin accepts the parameters
+//
+//	disable=RULE1+RULE2  rules not to apply
+//	report=name          write the problems, one per line, to the output
+//	                     file name instead of failing
+//
+// passed as in
+//
+//	protoc --lint_out=disable=COMMENTS+FIELD_NO_REQUIRED:. file.proto
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/generator"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+	"github.com/golang/protobuf/protoc-gen-lint/lint"
+)
+
+func main() {
+	g := generator.New()
+
+	data, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		g.Error(err, "reading input")
+	}
+
+	if err := proto.Unmarshal(data, g.Request); err != nil {
+		g.Error(err, "parsing input proto")
+	}
+
+	if len(g.Request.FileToGenerate) == 0 {
+		g.Fail("no files to lint")
+	}
+
+	g.CommandLineParameters(g.Request.GetParameter())
+
+	l := new(lint.Linter)
+	if v := g.Param["disable"]; v != "" {
+		l.Disable = strings.Split(v, "+")
+	}
+	var report bytes.Buffer
+	for _, name := range g.Request.FileToGenerate {
+		for _, fd := range g.Request.ProtoFile {
+			if fd.GetName() != name {
+				continue
+			}
+			problems, err := l.Lint(fd)
+			if err != nil {
+				g.Fail(err.Error())
+			}
+			for _, p := range problems {
+				fmt.Fprintln(&report, p)
+			}
+		}
+	}
+
+	if name := g.Param["report"]; name != "" {
+		g.Response.File = append(g.Response.File, &plugin.CodeGeneratorResponse_File{
+			Name:    proto.String(name),
+			Content: proto.String(report.String()),
+		})
+	} else if report.Len() > 0 {
+		g.Response.Error = proto.String(strings.TrimSuffix(report.String(), "\n"))
+	}
+
+	// Send back the results.
+	data, err = proto.Marshal(g.Response)
+	if err != nil {
+		g.Error(err, "failed to marshal output proto")
+	}
+	_, err = os.Stdout.Write(data)
+	if err != nil {
+		g.Error(err, "failed to write output proto")
+	}
+}
@@ -37,7 +37,9 @@ package ptypes
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
@@ -63,11 +65,90 @@ func AnyMessageName(any *any.Any) (string, error) {
 
 // MarshalAny takes the protocol buffer and encodes it into google.protobuf.Any.
 func MarshalAny(pb proto.Message) (*any.Any, error) {
+	return MarshalAnyWithPrefix(pb, googleApis)
+}
+
+// MarshalAnyWithPrefix is like MarshalAny, but builds the type URL from
+// prefix instead of "type.googleapis.com/". A slash is added between prefix
+// and the message name if prefix does not end with one.
+func MarshalAnyWithPrefix(pb proto.Message, prefix string) (*any.Any, error) {
 	value, err := proto.Marshal(pb)
 	if err != nil {
 		return nil, err
 	}
-	return &any.Any{TypeUrl: googleApis + proto.MessageName(pb), Value: value}, nil
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return &any.Any{TypeUrl: prefix + proto.MessageName(pb), Value: value}, nil
+}
+
+// Resolver takes a type URL, present in an Any message, and resolves it into
+// an empty instance of the associated message.
+//
+// It is the same interface as proto.AnyResolver and jsonpb.AnyResolver, so
+// a single Resolver serves the binary, text and JSON forms of Any messages.
+type Resolver = proto.AnyResolver
+
+// GlobalResolver resolves type URLs to the message types registered with
+// proto.RegisterType, using the part of the type URL after the last slash
+// as the message name. It is the Resolver used by Empty and UnmarshalAny.
+var GlobalResolver Resolver = globalResolver{}
+
+type globalResolver struct{}
+
+func (globalResolver) Resolve(typeUrl string) (proto.Message, error) {
+	mname := typeUrlName(typeUrl)
+	t := proto.MessageType(mname)
+	if t == nil {
+		return nil, fmt.Errorf("any: message type %q isn't linked in", mname)
+	}
+	return reflect.New(t.Elem()).Interface().(proto.Message), nil
+}
+
+// typeUrlName returns the part of typeUrl after the last slash.
+func typeUrlName(typeUrl string) string {
+	return typeUrl[strings.LastIndex(typeUrl, "/")+1:]
+}
+
+// A Registry is a Resolver for an explicit set of message types, such as
+// those of a single tenant. Type URLs are resolved by the part after the
+// last slash, whatever their prefix. A Registry is safe for concurrent use.
+type Registry struct {
+	mu    sync.RWMutex
+	types map[string]reflect.Type
+}
+
+// NewRegistry returns a Registry containing the types of msgs.
+func NewRegistry(msgs ...proto.Message) *Registry {
+	r := &Registry{}
+	for _, m := range msgs {
+		r.Register(m)
+	}
+	return r
+}
+
+// Register adds the type of m to the registry, replacing any type
+// registered under the same name.
+func (r *Registry) Register(m proto.Message) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.types == nil {
+		r.types = make(map[string]reflect.Type)
+	}
+	r.types[proto.MessageName(m)] = reflect.TypeOf(m)
+}
+
+// Resolve returns an empty message of the type named by typeUrl.
+// It returns an error if the type is not in the registry.
+func (r *Registry) Resolve(typeUrl string) (proto.Message, error) {
+	mname := typeUrlName(typeUrl)
+	r.mu.RLock()
+	t, ok := r.types[mname]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("any: message type %q isn't registered", mname)
+	}
+	return reflect.New(t.Elem()).Interface().(proto.Message), nil
 }
 
 // DynamicAny is a value that can be passed to UnmarshalAny to automatically
@@ -87,16 +168,19 @@ type DynamicAny struct {
 // google.protobuf.Any message. It returns an error if corresponding message
 // type isn't linked in.
 func Empty(any *any.Any) (proto.Message, error) {
-	aname, err := AnyMessageName(any)
-	if err != nil {
+	return EmptyWithResolver(any, GlobalResolver)
+}
+
+// EmptyWithResolver is like Empty, but resolves the type of the message
+// with r. If r is nil, GlobalResolver is used.
+func EmptyWithResolver(any *any.Any, r Resolver) (proto.Message, error) {
+	if _, err := AnyMessageName(any); err != nil {
 		return nil, err
 	}
-
-	t := proto.MessageType(aname)
-	if t == nil {
-		return nil, fmt.Errorf("any: message type %q isn't linked in", aname)
+	if r == nil {
+		r = GlobalResolver
 	}
-	return reflect.New(t.Elem()).Interface().(proto.Message), nil
+	return r.Resolve(any.TypeUrl)
 }
 
 // UnmarshalAny parses the protocol buffer representation in a google.protobuf.Any
@@ -105,15 +189,22 @@ func Empty(any *any.Any) (proto.Message, error) {
 //
 // pb can be a proto.Message, or a *DynamicAny.
 func UnmarshalAny(any *any.Any, pb proto.Message) error {
+	return UnmarshalAnyWithResolver(any, pb, GlobalResolver)
+}
+
+// UnmarshalAnyWithResolver is like UnmarshalAny, but if pb is a *DynamicAny
+// without a message, the message is allocated with r. If r is nil,
+// GlobalResolver is used.
+func UnmarshalAnyWithResolver(any *any.Any, pb proto.Message, r Resolver) error {
 	if d, ok := pb.(*DynamicAny); ok {
 		if d.Message == nil {
 			var err error
-			d.Message, err = Empty(any)
+			d.Message, err = EmptyWithResolver(any, r)
 			if err != nil {
 				return err
 			}
 		}
-		return UnmarshalAny(any, d.Message)
+		return UnmarshalAnyWithResolver(any, d.Message, r)
 	}
 
 	aname, err := AnyMessageName(any)
@@ -139,3 +230,221 @@ func Is(any *any.Any, pb proto.Message) bool {
 	prefix := len(any.TypeUrl) - len(name)
 	return prefix >= 1 && any.TypeUrl[prefix-1] == '/' && any.TypeUrl[prefix:] == name
 }
+
+// AnyField is a google.protobuf.Any message found in a message tree.
+type AnyField struct {
+	// Path locates the Any message in the tree, such as "details[2].payload".
+	// The fields of a decoded payload are reached through the path of its
+	// Any message, as in the JSON form, and extensions appear as
+	// "[full.name]".
+	Path string
+	Any  *any.Any
+	// Message is the decoded payload, or nil if it could not be decoded.
+	Message proto.Message
+	// Err reports why the payload could not be resolved or decoded.
+	Err error
+}
+
+// AnyError reports a google.protobuf.Any message whose type is unknown or
+// whose payload is malformed.
+type AnyError struct {
+	Path    string
+	TypeUrl string
+	Err     error
+}
+
+func (e *AnyError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("any %q: %v", e.TypeUrl, e.Err)
+	}
+	return fmt.Sprintf("%s: any %q: %v", e.Path, e.TypeUrl, e.Err)
+}
+
+// AnyErrors is returned by ValidateAny. It lists the Any messages that
+// failed, in the order of AnyFields.
+type AnyErrors []*AnyError
+
+func (errs AnyErrors) Error() string {
+	switch len(errs) {
+	case 0:
+		return "no errors"
+	case 1:
+		return errs[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", errs[0], len(errs)-1)
+}
+
+// defaultAnyDepth is the nesting limit of AnyFields and ValidateAny when
+// maxDepth is not positive.
+const defaultAnyDepth = 100
+
+// AnyFields returns every google.protobuf.Any message in the tree rooted at
+// pb, which may itself be an Any, in depth-first field order. Each payload
+// is resolved with r and decoded, and the Any messages it contains are
+// returned as well. An Any nested inside maxDepth or more other Any messages
+// is returned with an error instead of being decoded; if maxDepth <= 0, the
+// limit is 100. If r is nil, GlobalResolver is used.
+func AnyFields(pb proto.Message, r Resolver, maxDepth int) []AnyField {
+	if r == nil {
+		r = GlobalResolver
+	}
+	if maxDepth <= 0 {
+		maxDepth = defaultAnyDepth
+	}
+	w := &anyWalker{r: r, maxDepth: maxDepth}
+	w.walkMessage(pb, "", 0)
+	return w.fields
+}
+
+// ValidateAny checks that every google.protobuf.Any message in the tree
+// rooted at pb names a type known to r and holds a payload that decodes as
+// that type, recursively through nested Any messages up to maxDepth levels,
+// as AnyFields does. It returns AnyErrors listing every Any that fails, or
+// nil if none does. If r is nil, GlobalResolver is used.
+func ValidateAny(pb proto.Message, r Resolver, maxDepth int) error {
+	var errs AnyErrors
+	for _, f := range AnyFields(pb, r, maxDepth) {
+		if f.Err != nil {
+			errs = append(errs, &AnyError{Path: f.Path, TypeUrl: f.Any.TypeUrl, Err: f.Err})
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+type anyWalker struct {
+	r        Resolver
+	maxDepth int
+	fields   []AnyField
+}
+
+// walkMessage visits pb, found at path inside depth levels of Any messages.
+func (w *anyWalker) walkMessage(pb proto.Message, path string, depth int) {
+	if a, ok := pb.(*any.Any); ok {
+		w.visitAny(a, path, depth)
+		return
+	}
+	v := reflect.ValueOf(pb)
+	if !v.IsValid() || v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	s := v.Elem()
+	sprops := proto.GetProperties(s.Type())
+	for i := 0; i < s.NumField(); i++ {
+		f := s.Type().Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		fv := s.Field(i)
+		if f.Type.Kind() == reflect.Interface {
+			// Oneof field: the value is a pointer to a wrapper struct
+			// with a single field.
+			if fv.IsNil() || fv.Elem().Kind() != reflect.Ptr || fv.Elem().IsNil() {
+				continue
+			}
+			wrapper := fv.Elem().Elem()
+			if wrapper.Kind() != reflect.Struct || wrapper.NumField() != 1 {
+				continue
+			}
+			var props proto.Properties
+			props.Parse(wrapper.Type().Field(0).Tag.Get("protobuf"))
+			w.walkValue(wrapper.Field(0), joinPath(path, props.OrigName), depth)
+			continue
+		}
+		w.walkValue(fv, joinPath(path, sprops.Prop[i].OrigName), depth)
+	}
+
+	descs, err := proto.ExtensionDescs(pb)
+	if err != nil {
+		return
+	}
+	sort.Slice(descs, func(i, j int) bool { return descs[i].Field < descs[j].Field })
+	for _, desc := range descs {
+		if desc.ExtensionType == nil {
+			continue // unregistered extension
+		}
+		ext, err := proto.GetExtension(pb, desc)
+		if err != nil {
+			continue
+		}
+		w.walkValue(reflect.ValueOf(ext), joinPath(path, "["+desc.Name+"]"), depth)
+	}
+}
+
+// walkValue visits the messages held in field value v.
+func (w *anyWalker) walkValue(v reflect.Value, path string, depth int) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if m, ok := v.Interface().(proto.Message); ok && !v.IsNil() {
+			w.walkMessage(m, path, depth)
+		}
+	case reflect.Slice:
+		if !isMessageType(v.Type().Elem()) {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			w.walkValue(v.Index(i), fmt.Sprintf("%s[%d]", path, i), depth)
+		}
+	case reflect.Map:
+		if !isMessageType(v.Type().Elem()) {
+			return
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return mapKeyLess(keys[i], keys[j]) })
+		for _, k := range keys {
+			var key string
+			if k.Kind() == reflect.String {
+				key = fmt.Sprintf("%q", k.String())
+			} else {
+				key = fmt.Sprint(k.Interface())
+			}
+			w.walkValue(v.MapIndex(k), path+"["+key+"]", depth)
+		}
+	}
+}
+
+// visitAny records a, found at path inside depth levels of Any messages,
+// and walks its decoded payload.
+func (w *anyWalker) visitAny(a *any.Any, path string, depth int) {
+	i := len(w.fields)
+	w.fields = append(w.fields, AnyField{Path: path, Any: a})
+	if depth >= w.maxDepth {
+		w.fields[i].Err = fmt.Errorf("nested more than %d levels deep", w.maxDepth)
+		return
+	}
+	m, err := EmptyWithResolver(a, w.r)
+	if err == nil {
+		err = proto.Unmarshal(a.Value, m)
+	}
+	if err != nil {
+		w.fields[i].Err = err
+		return
+	}
+	w.fields[i].Message = m
+	w.walkMessage(m, path, depth+1)
+}
+
+func isMessageType(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Implements(reflect.TypeOf((*proto.Message)(nil)).Elem())
+}
+
+func mapKeyLess(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	case reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint32, reflect.Uint64:
+		return a.Uint() < b.Uint()
+	}
+	return a.String() < b.String()
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -32,9 +32,11 @@
 package ptypes
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	proto3pb "github.com/golang/protobuf/proto/proto3_proto"
 	pb "github.com/golang/protobuf/protoc-gen-go/descriptor"
 	"github.com/golang/protobuf/ptypes/any"
 )
@@ -152,3 +154,178 @@ func TestEmptyCornerCases(t *testing.T) {
 		t.Errorf("Empty for any type %q differs, got %q, want %q", shortPrefix.TypeUrl, got, want)
 	}
 }
+
+func TestMarshalAnyWithPrefix(t *testing.T) {
+	want := &pb.FileDescriptorProto{Name: proto.String("foo")}
+	for _, prefix := range []string{"types.example.com", "types.example.com/"} {
+		a, err := MarshalAnyWithPrefix(want, prefix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := a.TypeUrl, "types.example.com/google.protobuf.FileDescriptorProto"; got != want {
+			t.Errorf("MarshalAnyWithPrefix(_, %q).TypeUrl = %q, want %q", prefix, got, want)
+		}
+		got := &pb.FileDescriptorProto{}
+		if err := UnmarshalAny(a, got); err != nil || !proto.Equal(got, want) {
+			t.Errorf("UnmarshalAny(%v) = %v, %v; want %v", a, got, err, want)
+		}
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry(&pb.FileDescriptorProto{})
+	want := &pb.FileDescriptorProto{Name: proto.String("foo")}
+	a, err := MarshalAnyWithPrefix(want, "types.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := EmptyWithResolver(a, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.(*pb.FileDescriptorProto); !ok {
+		t.Errorf("EmptyWithResolver returned %T, want *descriptor.FileDescriptorProto", m)
+	}
+	var got DynamicAny
+	if err := UnmarshalAnyWithResolver(a, &got, r); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got.Message, want) {
+		t.Errorf("UnmarshalAnyWithResolver: got %v, want %v", got.Message, want)
+	}
+
+	// Types are resolved only from the registry, even if linked in.
+	a, err = MarshalAny(&pb.DescriptorProto{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := EmptyWithResolver(a, r); err == nil {
+		t.Errorf("EmptyWithResolver resolved %q, which is not in the registry", a.TypeUrl)
+	}
+	r.Register(&pb.DescriptorProto{})
+	if _, err := EmptyWithResolver(a, r); err != nil {
+		t.Errorf("EmptyWithResolver after Register: %v", err)
+	}
+}
+
+func TestNilResolver(t *testing.T) {
+	want := &pb.FileDescriptorProto{Name: proto.String("foo")}
+	a, err := MarshalAnyWithPrefix(want, "types.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got DynamicAny
+	if err := UnmarshalAnyWithResolver(a, &got, nil); err != nil {
+		t.Fatalf("UnmarshalAnyWithResolver with nil Resolver: %v", err)
+	}
+	if !proto.Equal(got.Message, want) {
+		t.Errorf("UnmarshalAnyWithResolver: got %v, want %v", got.Message, want)
+	}
+}
+
+func mustMarshalAny(t *testing.T, m proto.Message) *any.Any {
+	a, err := MarshalAny(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestAnyFields(t *testing.T) {
+	inner := &proto3pb.Message{
+		Name:       "inner",
+		ManyThings: []*any.Any{mustMarshalAny(t, &proto3pb.Nested{Bunny: "Monty"})},
+	}
+	m := &proto3pb.Message{
+		Anything: mustMarshalAny(t, inner),
+		ManyThings: []*any.Any{
+			mustMarshalAny(t, &proto3pb.Nested{Cute: true}),
+			{TypeUrl: "type.googleapis.com/no.such.Message"},
+			{TypeUrl: "type.googleapis.com/proto3_proto.Nested", Value: []byte{0xff}},
+		},
+	}
+	fields := AnyFields(m, nil, 10)
+	want := []struct {
+		path string
+		msg  proto.Message
+		err  string
+	}{
+		{"anything", inner, ""},
+		{"anything.many_things[0]", &proto3pb.Nested{Bunny: "Monty"}, ""},
+		{"many_things[0]", &proto3pb.Nested{Cute: true}, ""},
+		{"many_things[1]", nil, "isn't linked in"},
+		{"many_things[2]", nil, "unexpected EOF"},
+	}
+	if len(fields) != len(want) {
+		t.Fatalf("AnyFields returned %d fields, want %d: %+v", len(fields), len(want), fields)
+	}
+	for i, w := range want {
+		f := fields[i]
+		if f.Path != w.path {
+			t.Errorf("field %d: path %q, want %q", i, f.Path, w.path)
+		}
+		if w.err != "" {
+			if f.Err == nil || !strings.Contains(f.Err.Error(), w.err) {
+				t.Errorf("%s: error %v, want %q", f.Path, f.Err, w.err)
+			}
+			continue
+		}
+		if f.Err != nil || !proto.Equal(f.Message, w.msg) {
+			t.Errorf("%s: got %v, %v; want %v", f.Path, f.Message, f.Err, w.msg)
+		}
+	}
+
+	// The nested Any is beyond a depth limit of 1.
+	fields = AnyFields(m.Anything, nil, 1)
+	if len(fields) != 2 || fields[0].Path != "" || fields[0].Err != nil ||
+		fields[1].Path != "many_things[0]" || fields[1].Err == nil || fields[1].Message != nil {
+		t.Errorf("AnyFields with depth limit 1 = %+v", fields)
+	}
+
+	// A depth limit of zero or less means the default.
+	for _, depth := range []int{0, -1} {
+		if got := AnyFields(m, nil, depth); len(got) != len(want) || got[1].Err != nil {
+			t.Errorf("AnyFields with depth limit %d = %+v", depth, got)
+		}
+	}
+}
+
+func TestValidateAny(t *testing.T) {
+	good := &proto3pb.Message{Anything: mustMarshalAny(t, &proto3pb.Message{
+		Anything: mustMarshalAny(t, &proto3pb.Nested{Bunny: "Bugs"}),
+	})}
+	if err := ValidateAny(good, nil, 2); err != nil {
+		t.Errorf("ValidateAny(%v): %v", good, err)
+	}
+	if err := ValidateAny(good, nil, 1); err == nil {
+		t.Errorf("ValidateAny(%v) with depth limit 1 succeeded", good)
+	}
+	if err := ValidateAny(good, NewRegistry(&proto3pb.Message{}), 2); err == nil {
+		t.Errorf("ValidateAny(%v) with a registry lacking Nested succeeded", good)
+	} else if errs, ok := err.(AnyErrors); !ok || len(errs) != 1 || errs[0].Path != "anything.anything" || errs[0].TypeUrl != "type.googleapis.com/proto3_proto.Nested" {
+		t.Errorf("ValidateAny with a registry lacking Nested: %#v", err)
+	}
+	if err := ValidateAny(good, nil, 0); err != nil {
+		t.Errorf("ValidateAny(%v) with the default depth limit: %v", good, err)
+	}
+
+	// Every failing Any is reported.
+	two := &proto3pb.Message{ManyThings: []*any.Any{
+		{TypeUrl: "type.googleapis.com/no.such.Message"},
+		mustMarshalAny(t, &proto3pb.Nested{}),
+		{TypeUrl: "type.googleapis.com/proto3_proto.Nested", Value: []byte{0xff}},
+	}}
+	err := ValidateAny(two, nil, 0)
+	if errs, ok := err.(AnyErrors); !ok || len(errs) != 2 || errs[0].Path != "many_things[0]" || errs[1].Path != "many_things[2]" {
+		t.Errorf("ValidateAny(%v) = %#v, want errors for many_things[0] and many_things[2]", two, err)
+	} else if want := `many_things[0]: any "type.googleapis.com/no.such.Message": ` + errs[0].Err.Error() + " (and 1 more errors)"; err.Error() != want {
+		t.Errorf("ValidateAny(%v) = %v, want %s", two, err, want)
+	}
+
+	bad := &any.Any{TypeUrl: "type.googleapis.com/proto3_proto.Nested", Value: []byte{0x0a, 0x05}}
+	want := `any "type.googleapis.com/proto3_proto.Nested": unexpected EOF`
+	if err := ValidateAny(bad, nil, 1); err == nil || err.Error() != want {
+		t.Errorf("ValidateAny(%v) = %v, want %s", bad, err, want)
+	}
+}
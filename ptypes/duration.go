@@ -32,21 +32,23 @@
 package ptypes
 
 // This file implements conversions between google.protobuf.Duration
-// and time.Duration.
+// and time.Duration, and arithmetic on google.protobuf.Duration.
 
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
+	"github.com/golang/protobuf/internal/timefmt"
 	durpb "github.com/golang/protobuf/ptypes/duration"
 )
 
 const (
 	// Range of a durpb.Duration in seconds, as specified in
 	// google/protobuf/duration.proto. This is about 10,000 years in seconds.
-	maxSeconds = int64(10000 * 365.25 * 24 * 60 * 60)
-	minSeconds = -maxSeconds
+	maxSeconds = timefmt.MaxDurationSeconds
+	minSeconds = timefmt.MinDurationSeconds
 )
 
 // validateDuration determines whether the durpb.Duration is valid according to the
@@ -100,3 +102,152 @@ func DurationProto(d time.Duration) *durpb.Duration {
 		Nanos:   int32(nanos),
 	}
 }
+
+// FormatDuration returns the proto3 JSON form of d: a number of seconds
+// with 0, 3, 6 or 9 fractional digits followed by "s", such as "1.500s".
+// It returns an error if d is invalid.
+func FormatDuration(d *durpb.Duration) (string, error) {
+	if err := validateDuration(d); err != nil {
+		return "", err
+	}
+	return timefmt.FormatDuration(d.Seconds, d.Nanos), nil
+}
+
+// ParseDuration parses the proto3 JSON form of a duration, such as "1.5s"
+// or "-0.000000001s". Unlike time.ParseDuration, it accepts the whole range
+// of durpb.Duration, but no units other than seconds.
+func ParseDuration(s string) (*durpb.Duration, error) {
+	secs, nanos, err := timefmt.ParseDuration(s)
+	if err != nil {
+		return nil, fmt.Errorf("duration: %v", err)
+	}
+	return &durpb.Duration{Seconds: secs, Nanos: nanos}, nil
+}
+
+// ValidateDuration returns an error if d is nil or invalid according to the
+// definition in google/protobuf/duration.proto.
+func ValidateDuration(d *durpb.Duration) error {
+	return validateDuration(d)
+}
+
+// ClampDuration returns the valid durpb.Duration closest to d, normalizing
+// its nanos and limiting it to the range of about ±10,000 years.
+// A nil Duration is treated as zero.
+func ClampDuration(d *durpb.Duration) *durpb.Duration {
+	n := durationNanos(d)
+	if n.Cmp(maxDurationNanos) > 0 {
+		n = maxDurationNanos
+	} else if n.Cmp(minDurationNanos) < 0 {
+		n = minDurationNanos
+	}
+	return nanosToDuration(n)
+}
+
+// CompareDurations returns -1, 0 or +1 depending on whether a is shorter
+// than, equal to or longer than b. A nil Duration is treated as zero.
+func CompareDurations(a, b *durpb.Duration) int {
+	return durationNanos(a).Cmp(durationNanos(b))
+}
+
+// DurationAdd returns a+b. It returns an error if a or b is invalid or if
+// the sum is out of range.
+func DurationAdd(a, b *durpb.Duration) (*durpb.Duration, error) {
+	if err := validateDuration(a); err != nil {
+		return nil, err
+	}
+	if err := validateDuration(b); err != nil {
+		return nil, err
+	}
+	return checkedDuration(new(big.Int).Add(durationNanos(a), durationNanos(b)))
+}
+
+// DurationSub returns a-b. It returns an error if a or b is invalid or if
+// the difference is out of range.
+func DurationSub(a, b *durpb.Duration) (*durpb.Duration, error) {
+	if err := validateDuration(a); err != nil {
+		return nil, err
+	}
+	if err := validateDuration(b); err != nil {
+		return nil, err
+	}
+	return checkedDuration(new(big.Int).Sub(durationNanos(a), durationNanos(b)))
+}
+
+// DurationTruncate returns the result of rounding d toward zero to a
+// multiple of m, like time.Duration.Truncate. If m <= 0, it returns d
+// unchanged. It returns an error if d or m is invalid.
+func DurationTruncate(d, m *durpb.Duration) (*durpb.Duration, error) {
+	if err := validateDuration(d); err != nil {
+		return nil, err
+	}
+	if err := validateDuration(m); err != nil {
+		return nil, err
+	}
+	n, mn := durationNanos(d), durationNanos(m)
+	if mn.Sign() <= 0 {
+		return nanosToDuration(n), nil
+	}
+	return nanosToDuration(n.Sub(n, new(big.Int).Rem(n, mn))), nil
+}
+
+// DurationRound returns the result of rounding d to the nearest multiple
+// of m, like time.Duration.Round. Halfway values are rounded away from zero.
+// If m <= 0, it returns d unchanged. It returns an error if d or m is
+// invalid or if the result is out of range.
+func DurationRound(d, m *durpb.Duration) (*durpb.Duration, error) {
+	if err := validateDuration(d); err != nil {
+		return nil, err
+	}
+	if err := validateDuration(m); err != nil {
+		return nil, err
+	}
+	n, mn := durationNanos(d), durationNanos(m)
+	if mn.Sign() <= 0 {
+		return nanosToDuration(n), nil
+	}
+	return checkedDuration(roundNanos(n, mn))
+}
+
+var (
+	nanosPerSecond   = big.NewInt(1e9)
+	maxDurationNanos = new(big.Int).Add(new(big.Int).Mul(big.NewInt(maxSeconds), nanosPerSecond), big.NewInt(999999999))
+	minDurationNanos = new(big.Int).Neg(maxDurationNanos)
+)
+
+// durationNanos returns the length of d in nanoseconds. Unlike
+// time.Duration, a big.Int holds the whole range of durpb.Duration.
+func durationNanos(d *durpb.Duration) *big.Int {
+	n := new(big.Int).Mul(big.NewInt(d.GetSeconds()), nanosPerSecond)
+	return n.Add(n, big.NewInt(int64(d.GetNanos())))
+}
+
+// nanosToDuration returns the normalized durpb.Duration of n nanoseconds,
+// which must be within the range of int64 seconds.
+func nanosToDuration(n *big.Int) *durpb.Duration {
+	secs, nanos := new(big.Int).QuoRem(n, nanosPerSecond, new(big.Int))
+	return &durpb.Duration{Seconds: secs.Int64(), Nanos: int32(nanos.Int64())}
+}
+
+// checkedDuration returns the durpb.Duration of n nanoseconds, or an
+// error if it is out of range.
+func checkedDuration(n *big.Int) (*durpb.Duration, error) {
+	if n.Cmp(maxDurationNanos) > 0 || n.Cmp(minDurationNanos) < 0 {
+		return nil, fmt.Errorf("duration: %vns out of range", n)
+	}
+	return nanosToDuration(n), nil
+}
+
+// roundNanos returns n rounded to the nearest multiple of m > 0, with
+// halfway values rounded away from zero.
+func roundNanos(n, m *big.Int) *big.Int {
+	r := new(big.Int).Rem(n, m)
+	n = new(big.Int).Sub(n, r)
+	if twice := new(big.Int).Lsh(new(big.Int).Abs(r), 1); twice.Cmp(m) >= 0 {
+		if r.Sign() < 0 {
+			n.Sub(n, m)
+		} else {
+			n.Add(n, m)
+		}
+	}
+	return n
+}
@@ -119,3 +119,132 @@ func TestDurationProto(t *testing.T) {
 		}
 	}
 }
+
+func TestDurationArithmetic(t *testing.T) {
+	// Check against time.Duration where the values are in its range.
+	durs := []time.Duration{0, 1, -1, 1500 * time.Millisecond, -1500 * time.Millisecond,
+		90 * time.Minute, -7*time.Second - 3, 999999999, 123456789123}
+	for _, a := range durs {
+		for _, b := range durs {
+			pa, pb := DurationProto(a), DurationProto(b)
+			if got, want := CompareDurations(pa, pb), compareInt64(int64(a), int64(b)); got != want {
+				t.Errorf("CompareDurations(%v, %v) = %d, want %d", a, b, got, want)
+			}
+			checkDuration(t, "DurationAdd", a, b, a+b)(DurationAdd(pa, pb))
+			checkDuration(t, "DurationSub", a, b, a-b)(DurationSub(pa, pb))
+			checkDuration(t, "DurationTruncate", a, b, a.Truncate(b))(DurationTruncate(pa, pb))
+			checkDuration(t, "DurationRound", a, b, a.Round(b))(DurationRound(pa, pb))
+		}
+	}
+}
+
+func checkDuration(t *testing.T, name string, a, b, want time.Duration) func(*durpb.Duration, error) {
+	return func(got *durpb.Duration, err error) {
+		if err != nil {
+			t.Errorf("%s(%v, %v): %v", name, a, b, err)
+			return
+		}
+		if !proto.Equal(got, DurationProto(want)) {
+			t.Errorf("%s(%v, %v) = %v, want %v", name, a, b, got, DurationProto(want))
+		}
+	}
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+func TestDurationArithmeticBeyondGoRange(t *testing.T) {
+	year := &durpb.Duration{Seconds: 365 * 24 * 60 * 60}
+	thousand := &durpb.Duration{Seconds: 1000 * 365 * 24 * 60 * 60}
+	got, err := DurationAdd(thousand, &durpb.Duration{Seconds: 1, Nanos: 5})
+	if want := (&durpb.Duration{Seconds: thousand.Seconds + 1, Nanos: 5}); err != nil || !proto.Equal(got, want) {
+		t.Errorf("DurationAdd = %v, %v; want %v", got, err, want)
+	}
+	got, err = DurationSub(year, thousand)
+	if want := (&durpb.Duration{Seconds: year.Seconds - thousand.Seconds}); err != nil || !proto.Equal(got, want) {
+		t.Errorf("DurationSub = %v, %v; want %v", got, err, want)
+	}
+	got, err = DurationTruncate(&durpb.Duration{Seconds: -thousand.Seconds - 100, Nanos: -1}, year)
+	if want := (&durpb.Duration{Seconds: -thousand.Seconds}); err != nil || !proto.Equal(got, want) {
+		t.Errorf("DurationTruncate = %v, %v; want %v", got, err, want)
+	}
+	if CompareDurations(thousand, year) != 1 || CompareDurations(nil, &durpb.Duration{}) != 0 {
+		t.Errorf("CompareDurations gave wrong order")
+	}
+
+	max := &durpb.Duration{Seconds: maxSeconds, Nanos: 999999999}
+	if _, err := DurationAdd(max, &durpb.Duration{Nanos: 1}); err == nil {
+		t.Errorf("DurationAdd beyond the maximum succeeded")
+	}
+	if _, err := DurationRound(max, &durpb.Duration{Seconds: 1}); err == nil {
+		t.Errorf("DurationRound beyond the maximum succeeded")
+	}
+	if _, err := DurationAdd(&durpb.Duration{Seconds: 1, Nanos: -1}, year); err == nil {
+		t.Errorf("DurationAdd with an invalid Duration succeeded")
+	}
+}
+
+func TestClampDuration(t *testing.T) {
+	for _, test := range []struct {
+		in, want *durpb.Duration
+	}{
+		{nil, &durpb.Duration{}},
+		{&durpb.Duration{Seconds: 5, Nanos: 1}, &durpb.Duration{Seconds: 5, Nanos: 1}},
+		{&durpb.Duration{Seconds: 1, Nanos: -1}, &durpb.Duration{Nanos: 999999999}},
+		{&durpb.Duration{Seconds: -1, Nanos: 2e9}, &durpb.Duration{Seconds: 1}},
+		{&durpb.Duration{Seconds: math.MaxInt64, Nanos: math.MaxInt32}, &durpb.Duration{Seconds: maxSeconds, Nanos: 999999999}},
+		{&durpb.Duration{Seconds: math.MinInt64, Nanos: math.MinInt32}, &durpb.Duration{Seconds: minSeconds, Nanos: -999999999}},
+	} {
+		got := ClampDuration(test.in)
+		if !proto.Equal(got, test.want) {
+			t.Errorf("ClampDuration(%v) = %v, want %v", test.in, got, test.want)
+		}
+		if err := ValidateDuration(got); err != nil {
+			t.Errorf("ClampDuration(%v) is invalid: %v", test.in, err)
+		}
+	}
+}
+
+func TestFormatParseDuration(t *testing.T) {
+	for _, test := range []struct {
+		d *durpb.Duration
+		s string
+	}{
+		{&durpb.Duration{}, "0s"},
+		{&durpb.Duration{Seconds: 1, Nanos: 5e8}, "1.500s"},
+		{&durpb.Duration{Seconds: -1, Nanos: -5e8}, "-1.500s"},
+		{&durpb.Duration{Nanos: -1000}, "-0.000001s"},
+		{&durpb.Duration{Seconds: 100, Nanos: 987}, "100.000000987s"},
+		{&durpb.Duration{Seconds: maxSeconds, Nanos: 1e9 - 1}, "315576000000.999999999s"},
+		{&durpb.Duration{Seconds: minSeconds, Nanos: -(1e9 - 1)}, "-315576000000.999999999s"},
+	} {
+		got, err := FormatDuration(test.d)
+		if err != nil || got != test.s {
+			t.Errorf("FormatDuration(%v) = %q, %v; want %q", test.d, got, err, test.s)
+		}
+		d, err := ParseDuration(test.s)
+		if err != nil || !proto.Equal(d, test.d) {
+			t.Errorf("ParseDuration(%q) = %v, %v; want %v", test.s, d, err, test.d)
+		}
+	}
+	if _, err := FormatDuration(&durpb.Duration{Seconds: 1, Nanos: -1}); err == nil {
+		t.Errorf("FormatDuration of an invalid Duration succeeded")
+	}
+
+	for _, s := range []string{"1.2", "1.0000000001s", "0.5", "1m", "1.s", ".5s", "-s", "+1s", "1e3s", "315576000001s"} {
+		if d, err := ParseDuration(s); err == nil {
+			t.Errorf("ParseDuration(%q) = %v, want error", s, d)
+		}
+	}
+	// Fractions of other lengths are accepted too.
+	if d, err := ParseDuration("0.25s"); err != nil || !proto.Equal(d, &durpb.Duration{Nanos: 25e7}) {
+		t.Errorf("ParseDuration(0.25s) = %v, %v", d, err)
+	}
+}
@@ -0,0 +1,86 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package ptype forwards to google.golang.org/genproto/protobuf/ptype, the
+// Go package of the well-known types declared in google/protobuf/type.proto.
+package ptype
+
+import "google.golang.org/genproto/protobuf/ptype"
+
+type (
+	Type              = ptype.Type
+	Field             = ptype.Field
+	Field_Kind        = ptype.Field_Kind
+	Field_Cardinality = ptype.Field_Cardinality
+	Enum              = ptype.Enum
+	EnumValue         = ptype.EnumValue
+	Option            = ptype.Option
+	Syntax            = ptype.Syntax
+)
+
+const (
+	Field_TYPE_UNKNOWN  = ptype.Field_TYPE_UNKNOWN
+	Field_TYPE_DOUBLE   = ptype.Field_TYPE_DOUBLE
+	Field_TYPE_FLOAT    = ptype.Field_TYPE_FLOAT
+	Field_TYPE_INT64    = ptype.Field_TYPE_INT64
+	Field_TYPE_UINT64   = ptype.Field_TYPE_UINT64
+	Field_TYPE_INT32    = ptype.Field_TYPE_INT32
+	Field_TYPE_FIXED64  = ptype.Field_TYPE_FIXED64
+	Field_TYPE_FIXED32  = ptype.Field_TYPE_FIXED32
+	Field_TYPE_BOOL     = ptype.Field_TYPE_BOOL
+	Field_TYPE_STRING   = ptype.Field_TYPE_STRING
+	Field_TYPE_GROUP    = ptype.Field_TYPE_GROUP
+	Field_TYPE_MESSAGE  = ptype.Field_TYPE_MESSAGE
+	Field_TYPE_BYTES    = ptype.Field_TYPE_BYTES
+	Field_TYPE_UINT32   = ptype.Field_TYPE_UINT32
+	Field_TYPE_ENUM     = ptype.Field_TYPE_ENUM
+	Field_TYPE_SFIXED32 = ptype.Field_TYPE_SFIXED32
+	Field_TYPE_SFIXED64 = ptype.Field_TYPE_SFIXED64
+	Field_TYPE_SINT32   = ptype.Field_TYPE_SINT32
+	Field_TYPE_SINT64   = ptype.Field_TYPE_SINT64
+
+	Field_CARDINALITY_UNKNOWN  = ptype.Field_CARDINALITY_UNKNOWN
+	Field_CARDINALITY_OPTIONAL = ptype.Field_CARDINALITY_OPTIONAL
+	Field_CARDINALITY_REQUIRED = ptype.Field_CARDINALITY_REQUIRED
+	Field_CARDINALITY_REPEATED = ptype.Field_CARDINALITY_REPEATED
+
+	Syntax_SYNTAX_PROTO2 = ptype.Syntax_SYNTAX_PROTO2
+	Syntax_SYNTAX_PROTO3 = ptype.Syntax_SYNTAX_PROTO3
+)
+
+var (
+	Field_Kind_name         = ptype.Field_Kind_name
+	Field_Kind_value        = ptype.Field_Kind_value
+	Field_Cardinality_name  = ptype.Field_Cardinality_name
+	Field_Cardinality_value = ptype.Field_Cardinality_value
+	Syntax_name             = ptype.Syntax_name
+	Syntax_value            = ptype.Syntax_value
+)
@@ -0,0 +1,342 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package ptypes
+
+// This file implements conversions between google.protobuf.Struct, Value
+// and ListValue messages and native Go values.
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+
+	structpb "github.com/golang/protobuf/ptypes/struct"
+)
+
+// StructProto converts a map to a google.protobuf.Struct proto.
+// The values of the map are converted as by ValueProto.
+func StructProto(m map[string]interface{}) (*structpb.Struct, error) {
+	s := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(m))}
+	for k, v := range m {
+		pv, err := valueProto(v)
+		if err != nil {
+			return nil, fmt.Errorf("struct: field %q: %v", k, err)
+		}
+		s.Fields[k] = pv
+	}
+	return s, nil
+}
+
+// ListValueProto converts a slice to a google.protobuf.ListValue proto.
+// The elements of the slice are converted as by ValueProto.
+func ListValueProto(l []interface{}) (*structpb.ListValue, error) {
+	lv := &structpb.ListValue{Values: make([]*structpb.Value, len(l))}
+	for i, v := range l {
+		pv, err := valueProto(v)
+		if err != nil {
+			return nil, fmt.Errorf("struct: element %d: %v", i, err)
+		}
+		lv.Values[i] = pv
+	}
+	return lv, nil
+}
+
+// ValueProto converts a Go value to a google.protobuf.Value proto.
+//
+// It accepts the values encoding/json would marshal as JSON null, booleans,
+// numbers, strings, arrays and objects: nil and nil pointers; bools; Go
+// integer and floating-point types and json.Number, all of which become
+// float64 numbers; strings; []byte, which becomes a base64 string; slices and
+// arrays; maps with string keys; and Struct, ListValue and Value protos,
+// which are used as is. It returns an error for other types, such as
+// channels and functions, and for NaN and infinite numbers, which have no
+// JSON representation.
+func ValueProto(v interface{}) (*structpb.Value, error) {
+	pv, err := valueProto(v)
+	if err != nil {
+		return nil, fmt.Errorf("struct: %v", err)
+	}
+	return pv, nil
+}
+
+func valueProto(v interface{}) (*structpb.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return nullValue(), nil
+	case *structpb.Value:
+		if v == nil {
+			return nullValue(), nil
+		}
+		return v, nil
+	case *structpb.Struct:
+		if v == nil {
+			return nullValue(), nil
+		}
+		return &structpb.Value{Kind: &structpb.Value_StructValue{StructValue: v}}, nil
+	case *structpb.ListValue:
+		if v == nil {
+			return nullValue(), nil
+		}
+		return &structpb.Value{Kind: &structpb.Value_ListValue{ListValue: v}}, nil
+	case json.Number:
+		f, err := strconv.ParseFloat(string(v), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", v)
+		}
+		return numberValue(f)
+	case []byte:
+		return stringValue(base64.StdEncoding.EncodeToString(v)), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Bool:
+		return &structpb.Value{Kind: &structpb.Value_BoolValue{BoolValue: rv.Bool()}}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return numberValue(float64(rv.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return numberValue(float64(rv.Uint()))
+	case reflect.Float32, reflect.Float64:
+		return numberValue(rv.Float())
+	case reflect.String:
+		return stringValue(rv.String()), nil
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return nullValue(), nil
+		}
+		return valueProto(rv.Elem().Interface())
+	case reflect.Slice:
+		if rv.IsNil() {
+			return nullValue(), nil
+		}
+		fallthrough
+	case reflect.Array:
+		lv := &structpb.ListValue{Values: make([]*structpb.Value, rv.Len())}
+		for i := range lv.Values {
+			pv, err := valueProto(rv.Index(i).Interface())
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %v", i, err)
+			}
+			lv.Values[i] = pv
+		}
+		return &structpb.Value{Kind: &structpb.Value_ListValue{ListValue: lv}}, nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			break
+		}
+		if rv.IsNil() {
+			return nullValue(), nil
+		}
+		s := &structpb.Struct{Fields: make(map[string]*structpb.Value, rv.Len())}
+		for _, k := range rv.MapKeys() {
+			pv, err := valueProto(rv.MapIndex(k).Interface())
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %v", k.String(), err)
+			}
+			s.Fields[k.String()] = pv
+		}
+		return &structpb.Value{Kind: &structpb.Value_StructValue{StructValue: s}}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %T", v)
+}
+
+func nullValue() *structpb.Value {
+	return &structpb.Value{Kind: &structpb.Value_NullValue{NullValue: structpb.NullValue_NULL_VALUE}}
+}
+
+func stringValue(s string) *structpb.Value {
+	return &structpb.Value{Kind: &structpb.Value_StringValue{StringValue: s}}
+}
+
+func numberValue(f float64) (*structpb.Value, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, fmt.Errorf("unsupported number %v", f)
+	}
+	return &structpb.Value{Kind: &structpb.Value_NumberValue{NumberValue: f}}, nil
+}
+
+// Struct converts a google.protobuf.Struct proto to a map whose values
+// are converted as by Value. A nil Struct returns a nil map.
+func Struct(s *structpb.Struct) (map[string]interface{}, error) {
+	if s == nil {
+		return nil, nil
+	}
+	m := make(map[string]interface{}, len(s.Fields))
+	for k, pv := range s.Fields {
+		v, err := nativeValue(pv)
+		if err != nil {
+			return nil, fmt.Errorf("struct: field %q: %v", k, err)
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
+// ListValue converts a google.protobuf.ListValue proto to a slice whose
+// elements are converted as by Value. A nil ListValue returns a nil slice.
+func ListValue(l *structpb.ListValue) ([]interface{}, error) {
+	if l == nil {
+		return nil, nil
+	}
+	s := make([]interface{}, len(l.Values))
+	for i, pv := range l.Values {
+		v, err := nativeValue(pv)
+		if err != nil {
+			return nil, fmt.Errorf("struct: element %d: %v", i, err)
+		}
+		s[i] = v
+	}
+	return s, nil
+}
+
+// Value converts a google.protobuf.Value proto to the Go value encoding/json
+// produces when decoding into an interface{}: nil, bool, float64, string,
+// []interface{} or map[string]interface{}. A nil Value returns nil.
+// It returns an error if a Value within v has no kind set.
+func Value(v *structpb.Value) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	x, err := nativeValue(v)
+	if err != nil {
+		return nil, fmt.Errorf("struct: %v", err)
+	}
+	return x, nil
+}
+
+func nativeValue(v *structpb.Value) (interface{}, error) {
+	switch k := v.GetKind().(type) {
+	case *structpb.Value_NullValue:
+		return nil, nil
+	case *structpb.Value_BoolValue:
+		return k.BoolValue, nil
+	case *structpb.Value_NumberValue:
+		return k.NumberValue, nil
+	case *structpb.Value_StringValue:
+		return k.StringValue, nil
+	case *structpb.Value_ListValue:
+		s := make([]interface{}, len(k.ListValue.GetValues()))
+		for i, pv := range k.ListValue.GetValues() {
+			x, err := nativeValue(pv)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %v", i, err)
+			}
+			s[i] = x
+		}
+		return s, nil
+	case *structpb.Value_StructValue:
+		m := make(map[string]interface{}, len(k.StructValue.GetFields()))
+		for key, pv := range k.StructValue.GetFields() {
+			x, err := nativeValue(pv)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %v", key, err)
+			}
+			m[key] = x
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("Value has no kind set")
+}
+
+// StructFromJSON parses a JSON object into a google.protobuf.Struct proto.
+// Numbers are parsed as float64.
+func StructFromJSON(b []byte) (*structpb.Struct, error) {
+	var m map[string]interface{}
+	if err := decodeJSON(b, &m); err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, fmt.Errorf("struct: JSON null is not an object")
+	}
+	return StructProto(m)
+}
+
+// ValueFromJSON parses any JSON value into a google.protobuf.Value proto.
+// Numbers are parsed as float64.
+func ValueFromJSON(b []byte) (*structpb.Value, error) {
+	var x interface{}
+	if err := decodeJSON(b, &x); err != nil {
+		return nil, err
+	}
+	return ValueProto(x)
+}
+
+// decodeJSON decodes exactly one JSON value from b into v.
+func decodeJSON(b []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("struct: %v", err)
+	}
+	// More reports false before a closing bracket or brace, so read the
+	// next token, which must be the end of the input.
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("struct: unexpected data after JSON value")
+	}
+	return nil
+}
+
+// StructToJSON encodes a google.protobuf.Struct proto as a JSON object.
+// A nil Struct encodes as {}.
+func StructToJSON(s *structpb.Struct) ([]byte, error) {
+	m, err := Struct(s)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		m = map[string]interface{}{}
+	}
+	return encodeJSON(m)
+}
+
+// ValueToJSON encodes a google.protobuf.Value proto as JSON.
+// A nil Value encodes as null.
+func ValueToJSON(v *structpb.Value) ([]byte, error) {
+	x, err := Value(v)
+	if err != nil {
+		return nil, err
+	}
+	return encodeJSON(x)
+}
+
+func encodeJSON(v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("struct: %v", err)
+	}
+	return b, nil
+}
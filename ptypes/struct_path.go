@@ -0,0 +1,388 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package ptypes
+
+// This file implements path access, merging and comparison of
+// google.protobuf.Struct messages.
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+)
+
+// pathStep is a field name or list index of a Struct path.
+type pathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func parseStructPath(path string) ([]pathStep, error) {
+	bad := fmt.Errorf("struct: invalid path %q", path)
+	var steps []pathStep
+	s := path
+	for {
+		switch {
+		case strings.HasPrefix(s, `["`):
+			key, rest, ok := unquotePrefix(s[1:])
+			if !ok || !strings.HasPrefix(rest, "]") {
+				return nil, bad
+			}
+			steps = append(steps, pathStep{key: key})
+			s = rest[1:]
+		case strings.HasPrefix(s, "["):
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return nil, bad
+			}
+			n, err := strconv.ParseUint(s[1:end], 10, 31)
+			if err != nil {
+				return nil, bad
+			}
+			steps = append(steps, pathStep{index: int(n), isIndex: true})
+			s = s[end+1:]
+		default:
+			if len(steps) > 0 {
+				if !strings.HasPrefix(s, ".") {
+					return nil, bad
+				}
+				s = s[1:]
+			}
+			end := strings.IndexAny(s, ".[")
+			if end < 0 {
+				end = len(s)
+			}
+			if end == 0 {
+				return nil, bad
+			}
+			steps = append(steps, pathStep{key: s[:end]})
+			s = s[end:]
+		}
+		if s == "" {
+			return steps, nil
+		}
+	}
+}
+
+// unquotePrefix unquotes the double-quoted string at the start of s and
+// returns the rest of s.
+func unquotePrefix(s string) (unquoted, rest string, ok bool) {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			u, err := strconv.Unquote(s[:i+1])
+			return u, s[i+1:], err == nil
+		}
+	}
+	return "", "", false
+}
+
+// formatStructPath returns steps in the syntax parsed by parseStructPath.
+func formatStructPath(steps []pathStep) string {
+	var b bytes.Buffer
+	for i, step := range steps {
+		switch {
+		case step.isIndex:
+			fmt.Fprintf(&b, "[%d]", step.index)
+		case step.key == "" || strings.ContainsAny(step.key, `.[]"`):
+			fmt.Fprintf(&b, "[%q]", step.key)
+		default:
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(step.key)
+		}
+	}
+	return b.String()
+}
+
+func structValue(s *structpb.Struct) *structpb.Value {
+	return &structpb.Value{Kind: &structpb.Value_StructValue{StructValue: s}}
+}
+
+// lookupStep returns the value that steps[i] selects inside v, and reports
+// whether it exists. It returns an error if v is not a Struct or list as
+// required by steps[i].
+func lookupStep(v *structpb.Value, steps []pathStep, i int) (*structpb.Value, bool, error) {
+	step := steps[i]
+	if step.isIndex {
+		l, ok := v.GetKind().(*structpb.Value_ListValue)
+		if !ok {
+			return nil, false, fmt.Errorf("struct: %s is not a list", formatStructPath(steps[:i]))
+		}
+		if step.index >= len(l.ListValue.GetValues()) {
+			return nil, false, nil
+		}
+		return l.ListValue.Values[step.index], true, nil
+	}
+	sv, ok := v.GetKind().(*structpb.Value_StructValue)
+	if !ok {
+		return nil, false, fmt.Errorf("struct: %s is not a struct", formatStructPath(steps[:i]))
+	}
+	x, ok := sv.StructValue.GetFields()[step.key]
+	return x, ok, nil
+}
+
+// GetStructPath returns the value at path inside s. It returns an error if
+// the path is invalid or there is no value at it.
+//
+// A path is a sequence of field names separated by dots, each optionally
+// followed by list indexes in brackets, such as "a.b[2].c". A field name
+// containing dots or brackets is written as a quoted string in brackets,
+// as in `a["b.c"]`.
+func GetStructPath(s *structpb.Struct, path string) (*structpb.Value, error) {
+	steps, err := parseStructPath(path)
+	if err != nil {
+		return nil, err
+	}
+	v := structValue(s)
+	for i := range steps {
+		x, ok, err := lookupStep(v, steps, i)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("struct: no value at %s", formatStructPath(steps[:i+1]))
+		}
+		v = x
+	}
+	return v, nil
+}
+
+// SetStructPath sets the value at path inside s to x, or to null if x is
+// nil. Missing fields along the path are created as Structs, or as lists
+// when followed by an index. An index may be the length of its list, to
+// append x to it. The path has the syntax described at GetStructPath.
+func SetStructPath(s *structpb.Struct, path string, x *structpb.Value) error {
+	steps, err := parseStructPath(path)
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return fmt.Errorf("struct: set %s in nil Struct", formatStructPath(steps))
+	}
+	if x == nil {
+		x = nullValue()
+	}
+	v := structValue(s)
+	last := len(steps) - 1
+	for i := range steps[:last] {
+		next, ok, err := lookupStep(v, steps, i)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			if steps[i+1].isIndex {
+				next = &structpb.Value{Kind: &structpb.Value_ListValue{ListValue: &structpb.ListValue{}}}
+			} else {
+				next = structValue(&structpb.Struct{})
+			}
+			if err := setStep(v, steps, i, next); err != nil {
+				return err
+			}
+		}
+		v = next
+	}
+	return setStep(v, steps, last, x)
+}
+
+// setStep sets the value that steps[i] selects inside v to x.
+func setStep(v *structpb.Value, steps []pathStep, i int, x *structpb.Value) error {
+	if _, _, err := lookupStep(v, steps, i); err != nil {
+		return err
+	}
+	step := steps[i]
+	switch k := v.Kind.(type) {
+	case *structpb.Value_ListValue:
+		if k.ListValue == nil {
+			k.ListValue = &structpb.ListValue{}
+		}
+		switch l := k.ListValue; {
+		case step.index < len(l.Values):
+			l.Values[step.index] = x
+		case step.index == len(l.Values):
+			l.Values = append(l.Values, x)
+		default:
+			return fmt.Errorf("struct: index out of range at %s", formatStructPath(steps[:i+1]))
+		}
+	case *structpb.Value_StructValue:
+		if k.StructValue == nil {
+			k.StructValue = &structpb.Struct{}
+		}
+		if k.StructValue.Fields == nil {
+			k.StructValue.Fields = make(map[string]*structpb.Value)
+		}
+		k.StructValue.Fields[step.key] = x
+	}
+	return nil
+}
+
+// DeleteStructPath removes the value at path inside s. Removing a list
+// element shifts the elements after it down. It returns an error if the
+// path, in the syntax described at GetStructPath, is invalid or there is
+// no value at it.
+func DeleteStructPath(s *structpb.Struct, path string) error {
+	steps, err := parseStructPath(path)
+	if err != nil {
+		return err
+	}
+	v := structValue(s)
+	for i := range steps {
+		x, ok, err := lookupStep(v, steps, i)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("struct: no value at %s", formatStructPath(steps[:i+1]))
+		}
+		if i < len(steps)-1 {
+			v = x
+			continue
+		}
+		switch k := v.Kind.(type) {
+		case *structpb.Value_ListValue:
+			l := k.ListValue
+			l.Values = append(l.Values[:steps[i].index], l.Values[steps[i].index+1:]...)
+		case *structpb.Value_StructValue:
+			delete(k.StructValue.Fields, steps[i].key)
+		}
+	}
+	return nil
+}
+
+// ListMerge selects how MergeStructs combines two lists.
+type ListMerge int
+
+const (
+	ReplaceLists ListMerge = iota // a list in src replaces the list in dst
+	AppendLists                   // the elements of a list in src are appended to the list in dst
+)
+
+// MergeStructs merges src into dst, which must not be nil. Fields that are
+// Structs in both are merged recursively, lists in both are combined as
+// selected by lists, and all other fields of src replace those of dst.
+// The merged values are copies, so dst shares no memory with src.
+func MergeStructs(dst, src *structpb.Struct, lists ListMerge) {
+	if len(src.GetFields()) == 0 {
+		return
+	}
+	if dst.Fields == nil {
+		dst.Fields = make(map[string]*structpb.Value, len(src.Fields))
+	}
+	for k, sv := range src.Fields {
+		dv := dst.Fields[k]
+		switch s := sv.GetKind().(type) {
+		case *structpb.Value_StructValue:
+			if d, ok := dv.GetKind().(*structpb.Value_StructValue); ok && d.StructValue != nil {
+				MergeStructs(d.StructValue, s.StructValue, lists)
+				continue
+			}
+		case *structpb.Value_ListValue:
+			if d, ok := dv.GetKind().(*structpb.Value_ListValue); ok && d.ListValue != nil && lists == AppendLists {
+				for _, e := range s.ListValue.GetValues() {
+					d.ListValue.Values = append(d.ListValue.Values, proto.Clone(e).(*structpb.Value))
+				}
+				continue
+			}
+		}
+		if sv == nil {
+			dst.Fields[k] = nil
+		} else {
+			dst.Fields[k] = proto.Clone(sv).(*structpb.Value)
+		}
+	}
+}
+
+// EqualStructs reports whether a and b hold the same fields with equal
+// values, as by EqualValues. A nil Struct equals an empty one.
+func EqualStructs(a, b *structpb.Struct) bool {
+	if len(a.GetFields()) != len(b.GetFields()) {
+		return false
+	}
+	for k, av := range a.GetFields() {
+		bv, ok := b.Fields[k]
+		if !ok || !EqualValues(av, bv) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualValues reports whether a and b are deeply equal, comparing Structs
+// regardless of the order of their keys. A nil Value, or one with no kind
+// set, equals null.
+func EqualValues(a, b *structpb.Value) bool {
+	switch a := a.GetKind().(type) {
+	case nil, *structpb.Value_NullValue:
+		switch b.GetKind().(type) {
+		case nil, *structpb.Value_NullValue:
+			return true
+		}
+	case *structpb.Value_NumberValue:
+		if b, ok := b.GetKind().(*structpb.Value_NumberValue); ok {
+			return a.NumberValue == b.NumberValue
+		}
+	case *structpb.Value_StringValue:
+		if b, ok := b.GetKind().(*structpb.Value_StringValue); ok {
+			return a.StringValue == b.StringValue
+		}
+	case *structpb.Value_BoolValue:
+		if b, ok := b.GetKind().(*structpb.Value_BoolValue); ok {
+			return a.BoolValue == b.BoolValue
+		}
+	case *structpb.Value_StructValue:
+		if b, ok := b.GetKind().(*structpb.Value_StructValue); ok {
+			return EqualStructs(a.StructValue, b.StructValue)
+		}
+	case *structpb.Value_ListValue:
+		if b, ok := b.GetKind().(*structpb.Value_ListValue); ok {
+			av, bv := a.ListValue.GetValues(), b.ListValue.GetValues()
+			if len(av) != len(bv) {
+				return false
+			}
+			for i := range av {
+				if !EqualValues(av[i], bv[i]) {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,215 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package ptypes
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+)
+
+func mustStructFromJSON(t *testing.T, s string) *structpb.Struct {
+	st, err := StructFromJSON([]byte(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+func mustValueFromJSON(t *testing.T, s string) *structpb.Value {
+	v, err := ValueFromJSON([]byte(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestParseStructPath(t *testing.T) {
+	for _, path := range []string{"a", "a.b[2].c", `a["b.c"][0][1]`, `["x]"].y`, `[""]`} {
+		steps, err := parseStructPath(path)
+		if err != nil {
+			t.Errorf("parseStructPath(%q): %v", path, err)
+			continue
+		}
+		if got := formatStructPath(steps); got != path {
+			t.Errorf("formatStructPath(parseStructPath(%q)) = %q", path, got)
+		}
+	}
+	for _, path := range []string{"", ".a", "a.", "a..b", "a[", "a[x]", "a[-1]", "a[+1]", "a[1]b", `a["b]`, `a["b"`} {
+		if steps, err := parseStructPath(path); err == nil {
+			t.Errorf("parseStructPath(%q) = %v, want error", path, steps)
+		}
+	}
+}
+
+func TestGetStructPath(t *testing.T) {
+	s := mustStructFromJSON(t, `{"a": {"b": [1, 2, {"c": "x"}], "d.e": true}}`)
+	for _, test := range []struct {
+		path, want, err string
+	}{
+		{path: "a.b[2].c", want: `"x"`},
+		{path: "a.b[1]", want: `2`},
+		{path: `a["d.e"]`, want: `true`},
+		{path: "a.b", want: `[1,2,{"c":"x"}]`},
+		{path: "a.b[3]", err: `struct: no value at a.b[3]`},
+		{path: "a.x.y", err: `struct: no value at a.x`},
+		{path: "a.b.c", err: `struct: a.b is not a struct`},
+		{path: "a[0]", err: `struct: a is not a list`},
+		{path: "a..b", err: `struct: invalid path "a..b"`},
+	} {
+		v, err := GetStructPath(s, test.path)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("GetStructPath(%q): got error %v, want %s", test.path, err, test.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("GetStructPath(%q): %v", test.path, err)
+			continue
+		}
+		if got, _ := ValueToJSON(v); string(got) != test.want {
+			t.Errorf("GetStructPath(%q) = %s, want %s", test.path, got, test.want)
+		}
+	}
+}
+
+func TestSetStructPath(t *testing.T) {
+	s := mustStructFromJSON(t, `{"a": {"b": [1, 2]}}`)
+	sets := []struct {
+		path  string
+		value *structpb.Value
+	}{
+		{"a.b[0]", numVal(10)},
+		{"a.b[2]", numVal(3)},
+		{"a.c.d", boolVal(true)},
+		{"x[0].y", numVal(1)},
+		{`a["d.e"]`, nil},
+	}
+	for _, set := range sets {
+		if err := SetStructPath(s, set.path, set.value); err != nil {
+			t.Errorf("SetStructPath(%q): %v", set.path, err)
+		}
+	}
+	want := mustStructFromJSON(t, `{"a": {"b": [10, 2, 3], "c": {"d": true}, "d.e": null}, "x": [{"y": 1}]}`)
+	if !proto.Equal(s, want) {
+		got, _ := StructToJSON(s)
+		t.Errorf("after SetStructPath: %s", got)
+	}
+
+	for _, path := range []string{"a.b[5]", "a.b.c", "a.c.d.e", "a["} {
+		if err := SetStructPath(s, path, numVal(0)); err == nil {
+			t.Errorf("SetStructPath(%q) succeeded", path)
+		}
+	}
+	if err := SetStructPath(nil, "a", numVal(0)); err == nil {
+		t.Errorf("SetStructPath on a nil Struct succeeded")
+	}
+}
+
+func TestDeleteStructPath(t *testing.T) {
+	s := mustStructFromJSON(t, `{"a": {"b": [1, 2, 3], "c": "x"}, "d": null}`)
+	for _, path := range []string{"a.b[1]", "a.c", "d"} {
+		if err := DeleteStructPath(s, path); err != nil {
+			t.Errorf("DeleteStructPath(%q): %v", path, err)
+		}
+	}
+	want := mustStructFromJSON(t, `{"a": {"b": [1, 3]}}`)
+	if !proto.Equal(s, want) {
+		got, _ := StructToJSON(s)
+		t.Errorf("after DeleteStructPath: %s", got)
+	}
+	for _, path := range []string{"a.b[2]", "a.c", "x.y", "a.b.c"} {
+		if err := DeleteStructPath(s, path); err == nil {
+			t.Errorf("DeleteStructPath(%q) succeeded", path)
+		}
+	}
+}
+
+func TestMergeStructs(t *testing.T) {
+	const (
+		dst = `{"a": {"b": [1], "c": 1}, "l": [1], "s": "x"}`
+		src = `{"a": {"b": [2], "d": 2}, "l": {"k": 1}, "n": null}`
+	)
+	for _, test := range []struct {
+		lists ListMerge
+		want  string
+	}{
+		{ReplaceLists, `{"a": {"b": [2], "c": 1, "d": 2}, "l": {"k": 1}, "n": null, "s": "x"}`},
+		{AppendLists, `{"a": {"b": [1, 2], "c": 1, "d": 2}, "l": {"k": 1}, "n": null, "s": "x"}`},
+	} {
+		d, s := mustStructFromJSON(t, dst), mustStructFromJSON(t, src)
+		MergeStructs(d, s, test.lists)
+		if want := mustStructFromJSON(t, test.want); !proto.Equal(d, want) {
+			got, _ := StructToJSON(d)
+			t.Errorf("MergeStructs(%s, %s, %v) = %s, want %s", dst, src, test.lists, got, test.want)
+		}
+		// The result must not share memory with src.
+		s.Fields["a"].GetStructValue().Fields["b"].GetListValue().Values[0] = numVal(99)
+		if v, _ := GetStructPath(d, "a.b[0]"); v.GetNumberValue() == 99 {
+			t.Errorf("MergeStructs result shares memory with src")
+		}
+	}
+
+	d := &structpb.Struct{}
+	MergeStructs(d, nil, AppendLists)
+	MergeStructs(d, mustStructFromJSON(t, `{"a": 1}`), AppendLists)
+	if want := mustStructFromJSON(t, `{"a": 1}`); !proto.Equal(d, want) {
+		t.Errorf("MergeStructs into an empty Struct = %v", d)
+	}
+}
+
+func TestEqualStructs(t *testing.T) {
+	a := mustStructFromJSON(t, `{"x": [1, {"y": "z", "w": null}], "v": true}`)
+	b := mustStructFromJSON(t, `{"v": true, "x": [1, {"w": null, "y": "z"}]}`)
+	if !EqualStructs(a, b) {
+		t.Errorf("EqualStructs(%v, %v) = false", a, b)
+	}
+	for _, c := range []string{
+		`{"x": [1, {"y": "z", "w": null}]}`,
+		`{"x": [1, {"y": "z", "w": null}], "v": false}`,
+		`{"x": [{"y": "z", "w": null}, 1], "v": true}`,
+		`{"x": [1, {"y": "z", "w": 0}], "v": true}`,
+		`{"x": [1, {"y": "z", "w": null}], "v": "true"}`,
+	} {
+		if EqualStructs(a, mustStructFromJSON(t, c)) {
+			t.Errorf("EqualStructs(%v, %s) = true", a, c)
+		}
+	}
+	if !EqualStructs(nil, &structpb.Struct{}) {
+		t.Errorf("EqualStructs(nil, {}) = false")
+	}
+	if !EqualValues(nil, mustValueFromJSON(t, `null`)) || EqualValues(nil, mustValueFromJSON(t, `0`)) {
+		t.Errorf("EqualValues treats nil wrongly")
+	}
+}
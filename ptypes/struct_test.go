@@ -0,0 +1,230 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package ptypes
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+)
+
+func numVal(f float64) *structpb.Value {
+	return &structpb.Value{Kind: &structpb.Value_NumberValue{NumberValue: f}}
+}
+
+func listVal(vs ...*structpb.Value) *structpb.Value {
+	return &structpb.Value{Kind: &structpb.Value_ListValue{ListValue: &structpb.ListValue{Values: vs}}}
+}
+
+func structVal(fields map[string]*structpb.Value) *structpb.Value {
+	return &structpb.Value{Kind: &structpb.Value_StructValue{StructValue: &structpb.Struct{Fields: fields}}}
+}
+
+func boolVal(b bool) *structpb.Value {
+	return &structpb.Value{Kind: &structpb.Value_BoolValue{BoolValue: b}}
+}
+
+var valueProtoTests = []struct {
+	in   interface{}
+	want *structpb.Value
+}{
+	{nil, nullValue()},
+	{(*int)(nil), nullValue()},
+	{[]interface{}(nil), nullValue()},
+	{map[string]interface{}(nil), nullValue()},
+	{true, boolVal(true)},
+	{"s", stringValue("s")},
+	{int8(-3), numVal(-3)},
+	{uint64(7), numVal(7)},
+	{float32(1.5), numVal(1.5)},
+	{json.Number("2.5e3"), numVal(2500)},
+	{[]byte("hi"), stringValue("aGk=")},
+	{[]interface{}{1, "a", nil, []int{2}}, listVal(numVal(1), stringValue("a"), nullValue(), listVal(numVal(2)))},
+	{[2]bool{true, false}, listVal(boolVal(true), boolVal(false))},
+	{map[string]interface{}{"a": map[string]int{"b": 1}}, structVal(map[string]*structpb.Value{
+		"a": structVal(map[string]*structpb.Value{"b": numVal(1)}),
+	})},
+	{&structpb.ListValue{}, listVal()},
+	{stringValue("v"), stringValue("v")},
+}
+
+func TestValueProto(t *testing.T) {
+	for _, tt := range valueProtoTests {
+		got, err := ValueProto(tt.in)
+		if err != nil {
+			t.Errorf("ValueProto(%#v): %v", tt.in, err)
+			continue
+		}
+		if !proto.Equal(got, tt.want) {
+			t.Errorf("ValueProto(%#v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestValueProtoErrors(t *testing.T) {
+	for _, in := range []interface{}{
+		make(chan int),
+		func() {},
+		math.NaN(),
+		math.Inf(-1),
+		json.Number("abc"),
+		map[int]interface{}{1: 2},
+		[]interface{}{1, make(chan int)},
+		map[string]interface{}{"a": []interface{}{math.NaN()}},
+	} {
+		if got, err := ValueProto(in); err == nil {
+			t.Errorf("ValueProto(%#v) = %v, want error", in, got)
+		}
+	}
+	if _, err := StructProto(map[string]interface{}{"c": make(chan int)}); err == nil {
+		t.Errorf("StructProto with a channel succeeded, want error")
+	}
+	if _, err := ListValueProto([]interface{}{math.Inf(1)}); err == nil {
+		t.Errorf("ListValueProto with Inf succeeded, want error")
+	}
+}
+
+func TestStructRoundTrip(t *testing.T) {
+	in := map[string]interface{}{
+		"null":   nil,
+		"bool":   true,
+		"number": 1.5,
+		"string": "s",
+		"list":   []interface{}{1.0, "two", []interface{}{}},
+		"struct": map[string]interface{}{"nested": false},
+	}
+	s, err := StructProto(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Struct(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, in) {
+		t.Errorf("Struct(StructProto(%v)) = %v", in, got)
+	}
+
+	lv, err := ListValueProto(in["list"].([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := ListValue(lv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(l, in["list"]) {
+		t.Errorf("ListValue(ListValueProto(%v)) = %v", in["list"], l)
+	}
+}
+
+func TestNilConversions(t *testing.T) {
+	if m, err := Struct(nil); m != nil || err != nil {
+		t.Errorf("Struct(nil) = %v, %v; want nil, nil", m, err)
+	}
+	if l, err := ListValue(nil); l != nil || err != nil {
+		t.Errorf("ListValue(nil) = %v, %v; want nil, nil", l, err)
+	}
+	if v, err := Value(nil); v != nil || err != nil {
+		t.Errorf("Value(nil) = %v, %v; want nil, nil", v, err)
+	}
+	if _, err := Value(&structpb.Value{}); err == nil {
+		t.Errorf("Value with no kind succeeded, want error")
+	}
+	if _, err := Struct(&structpb.Struct{Fields: map[string]*structpb.Value{"a": listVal(nil)}}); err == nil {
+		t.Errorf("Struct with nil Value succeeded, want error")
+	}
+}
+
+func TestStructJSON(t *testing.T) {
+	const in = `{"a":[1,"x",null,{"b":true}],"c":12345678901}`
+	s, err := StructFromJSON([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &structpb.Struct{Fields: map[string]*structpb.Value{
+		"a": listVal(numVal(1), stringValue("x"), nullValue(), structVal(map[string]*structpb.Value{"b": boolVal(true)})),
+		"c": numVal(12345678901),
+	}}
+	if !proto.Equal(s, want) {
+		t.Errorf("StructFromJSON(%s) = %v, want %v", in, s, want)
+	}
+	b, err := StructToJSON(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != in {
+		t.Errorf("StructToJSON() = %s, want %s", b, in)
+	}
+
+	for _, bad := range []string{`[1]`, `null`, `{`, `{} {}`, `{"a":1}}`, `{"a":1}]`} {
+		if _, err := StructFromJSON([]byte(bad)); err == nil {
+			t.Errorf("StructFromJSON(%s) succeeded, want error", bad)
+		}
+	}
+	if b, err := StructToJSON(nil); err != nil || string(b) != "{}" {
+		t.Errorf("StructToJSON(nil) = %s, %v; want {}", b, err)
+	}
+	if _, err := StructToJSON(&structpb.Struct{Fields: map[string]*structpb.Value{"n": numVal(math.NaN())}}); err == nil {
+		t.Errorf("StructToJSON with NaN succeeded, want error")
+	}
+}
+
+func TestValueJSON(t *testing.T) {
+	for _, in := range []string{`null`, `true`, `"s"`, `-2.5`, `[]`, `{"a":{}}`} {
+		v, err := ValueFromJSON([]byte(in))
+		if err != nil {
+			t.Errorf("ValueFromJSON(%s): %v", in, err)
+			continue
+		}
+		b, err := ValueToJSON(v)
+		if err != nil {
+			t.Errorf("ValueToJSON(%v): %v", v, err)
+			continue
+		}
+		if string(b) != in {
+			t.Errorf("ValueToJSON(ValueFromJSON(%s)) = %s", in, b)
+		}
+	}
+	for _, bad := range []string{``, `[1]]`, `1 2`, `{}}`} {
+		if _, err := ValueFromJSON([]byte(bad)); err == nil {
+			t.Errorf("ValueFromJSON(%s) succeeded, want error", bad)
+		}
+	}
+	if b, err := ValueToJSON(nil); err != nil || string(b) != "null" {
+		t.Errorf("ValueToJSON(nil) = %s, %v; want null", b, err)
+	}
+}
@@ -36,18 +36,21 @@ package ptypes
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
+	"github.com/golang/protobuf/internal/timefmt"
+	durpb "github.com/golang/protobuf/ptypes/duration"
 	tspb "github.com/golang/protobuf/ptypes/timestamp"
 )
 
 const (
 	// Seconds field of the earliest valid Timestamp.
 	// This is time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC).Unix().
-	minValidSeconds = -62135596800
+	minValidSeconds = timefmt.MinTimestampSeconds
 	// Seconds field just after the latest valid Timestamp.
 	// This is time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC).Unix().
-	maxValidSeconds = 253402300800
+	maxValidSeconds = timefmt.MaxTimestampSeconds
 )
 
 // validateTimestamp determines whether a Timestamp is valid.
@@ -132,3 +135,155 @@ func TimestampString(ts *tspb.Timestamp) string {
 	}
 	return t.Format(time.RFC3339Nano)
 }
+
+// FormatTimestamp returns the proto3 JSON form of ts: an RFC 3339 string
+// in UTC with 0, 3, 6 or 9 fractional digits, such as
+// "2014-05-13T16:53:20.021Z". It returns an error if ts is invalid.
+func FormatTimestamp(ts *tspb.Timestamp) (string, error) {
+	if err := validateTimestamp(ts); err != nil {
+		return "", err
+	}
+	return timefmt.FormatTimestamp(ts.Seconds, ts.Nanos), nil
+}
+
+// ParseTimestamp parses an RFC 3339 string, such as the proto3 JSON form
+// of a timestamp. The string may have any UTC offset and up to nine
+// fractional digits.
+func ParseTimestamp(s string) (*tspb.Timestamp, error) {
+	secs, nanos, err := timefmt.ParseTimestamp(s)
+	if err != nil {
+		return nil, fmt.Errorf("timestamp: %v", err)
+	}
+	return &tspb.Timestamp{Seconds: secs, Nanos: nanos}, nil
+}
+
+// ValidateTimestamp returns an error if ts is nil or invalid according to
+// the definition in google/protobuf/timestamp.proto.
+func ValidateTimestamp(ts *tspb.Timestamp) error {
+	return validateTimestamp(ts)
+}
+
+// ClampTimestamp returns the valid Timestamp closest to ts, normalizing its
+// nanos and limiting it to the range [0001-01-01, 9999-12-31T23:59:59.999999999Z].
+// A nil Timestamp is treated as the Unix epoch.
+func ClampTimestamp(ts *tspb.Timestamp) *tspb.Timestamp {
+	n := timestampNanos(ts)
+	if n.Cmp(minTimestampNanos) < 0 {
+		n = minTimestampNanos
+	} else if n.Cmp(maxTimestampNanos) > 0 {
+		n = maxTimestampNanos
+	}
+	return nanosToTimestamp(n)
+}
+
+// CompareTimestamps returns -1, 0 or +1 depending on whether a is before,
+// equal to or after b. A nil Timestamp is treated as the Unix epoch.
+func CompareTimestamps(a, b *tspb.Timestamp) int {
+	return timestampNanos(a).Cmp(timestampNanos(b))
+}
+
+// TimestampAdd returns ts+d. It returns an error if ts or d is invalid or
+// if the result is out of range.
+func TimestampAdd(ts *tspb.Timestamp, d *durpb.Duration) (*tspb.Timestamp, error) {
+	if err := validateTimestamp(ts); err != nil {
+		return nil, err
+	}
+	if err := validateDuration(d); err != nil {
+		return nil, err
+	}
+	return checkedTimestamp(new(big.Int).Add(timestampNanos(ts), durationNanos(d)))
+}
+
+// TimestampSub returns ts-d. It returns an error if ts or d is invalid or
+// if the result is out of range.
+func TimestampSub(ts *tspb.Timestamp, d *durpb.Duration) (*tspb.Timestamp, error) {
+	if err := validateTimestamp(ts); err != nil {
+		return nil, err
+	}
+	if err := validateDuration(d); err != nil {
+		return nil, err
+	}
+	return checkedTimestamp(new(big.Int).Sub(timestampNanos(ts), durationNanos(d)))
+}
+
+// TimestampDiff returns the duration a-b. It returns an error if a or b is
+// invalid. The difference between two valid Timestamps is always a valid
+// Duration, even where it does not fit in a time.Duration.
+func TimestampDiff(a, b *tspb.Timestamp) (*durpb.Duration, error) {
+	if err := validateTimestamp(a); err != nil {
+		return nil, err
+	}
+	if err := validateTimestamp(b); err != nil {
+		return nil, err
+	}
+	return nanosToDuration(new(big.Int).Sub(timestampNanos(a), timestampNanos(b))), nil
+}
+
+// TimestampTruncate returns the result of rounding ts down to a multiple
+// of d since 0001-01-01, like time.Time.Truncate. If d <= 0, it returns ts
+// unchanged. It returns an error if ts or d is invalid.
+func TimestampTruncate(ts *tspb.Timestamp, d *durpb.Duration) (*tspb.Timestamp, error) {
+	if err := validateTimestamp(ts); err != nil {
+		return nil, err
+	}
+	if err := validateDuration(d); err != nil {
+		return nil, err
+	}
+	n, dn := timestampNanos(ts), durationNanos(d)
+	if dn.Sign() <= 0 {
+		return nanosToTimestamp(n), nil
+	}
+	// Valid timestamps are never before the zero time, so the offset from it
+	// is non-negative and truncation rounds down.
+	off := new(big.Int).Sub(n, minTimestampNanos)
+	return nanosToTimestamp(n.Sub(n, off.Rem(off, dn))), nil
+}
+
+// TimestampRound returns the result of rounding ts to the nearest multiple
+// of d since 0001-01-01, like time.Time.Round. Halfway values are rounded
+// up. If d <= 0, it returns ts unchanged. It returns an error if ts or d is
+// invalid or if the result is out of range.
+func TimestampRound(ts *tspb.Timestamp, d *durpb.Duration) (*tspb.Timestamp, error) {
+	if err := validateTimestamp(ts); err != nil {
+		return nil, err
+	}
+	if err := validateDuration(d); err != nil {
+		return nil, err
+	}
+	n, dn := timestampNanos(ts), durationNanos(d)
+	if dn.Sign() <= 0 {
+		return nanosToTimestamp(n), nil
+	}
+	off := new(big.Int).Sub(n, minTimestampNanos)
+	off = roundNanos(off, dn)
+	return checkedTimestamp(off.Add(off, minTimestampNanos))
+}
+
+var (
+	minTimestampNanos = new(big.Int).Mul(big.NewInt(minValidSeconds), nanosPerSecond)
+	maxTimestampNanos = new(big.Int).Sub(new(big.Int).Mul(big.NewInt(maxValidSeconds), nanosPerSecond), big.NewInt(1))
+)
+
+// timestampNanos returns the number of nanoseconds between the Unix epoch
+// and ts.
+func timestampNanos(ts *tspb.Timestamp) *big.Int {
+	n := new(big.Int).Mul(big.NewInt(ts.GetSeconds()), nanosPerSecond)
+	return n.Add(n, big.NewInt(int64(ts.GetNanos())))
+}
+
+// nanosToTimestamp returns the normalized Timestamp n nanoseconds after
+// the Unix epoch, which must be within the range of int64 seconds.
+func nanosToTimestamp(n *big.Int) *tspb.Timestamp {
+	// Unlike QuoRem, DivMod leaves a non-negative remainder.
+	secs, nanos := new(big.Int).DivMod(n, nanosPerSecond, new(big.Int))
+	return &tspb.Timestamp{Seconds: secs.Int64(), Nanos: int32(nanos.Int64())}
+}
+
+// checkedTimestamp returns the Timestamp n nanoseconds after the Unix
+// epoch, or an error if it is out of range.
+func checkedTimestamp(n *big.Int) (*tspb.Timestamp, error) {
+	if n.Cmp(minTimestampNanos) < 0 || n.Cmp(maxTimestampNanos) > 0 {
+		return nil, fmt.Errorf("timestamp: %vns after the Unix epoch out of range", n)
+	}
+	return nanosToTimestamp(n), nil
+}
@@ -37,6 +37,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	durpb "github.com/golang/protobuf/ptypes/duration"
 	tspb "github.com/golang/protobuf/ptypes/timestamp"
 )
 
@@ -151,3 +152,137 @@ func TestTimestampNow(t *testing.T) {
 		t.Errorf("between %v and %v\nTimestamp(TimestampNow()) = %v", before, after, tm)
 	}
 }
+
+func TestTimestampArithmetic(t *testing.T) {
+	// Check against time.Time where the values are in the range of time.Duration.
+	times := []time.Time{utcDate(1970, 1, 1), utcDate(1969, 12, 31).Add(-1), utcDate(2018, 9, 1).Add(1500 * time.Millisecond)}
+	durs := []time.Duration{0, 1, -1, 1500 * time.Millisecond, -90 * time.Minute, 24 * time.Hour, 7 * 24 * time.Hour}
+	for _, tm := range times {
+		ts := mustTimestampProto(t, tm)
+		for _, d := range durs {
+			pd := DurationProto(d)
+			checkTimestamp(t, "TimestampAdd", tm, d, tm.Add(d))(TimestampAdd(ts, pd))
+			checkTimestamp(t, "TimestampSub", tm, d, tm.Add(-d))(TimestampSub(ts, pd))
+			checkTimestamp(t, "TimestampTruncate", tm, d, tm.Truncate(d))(TimestampTruncate(ts, pd))
+			checkTimestamp(t, "TimestampRound", tm, d, tm.Round(d))(TimestampRound(ts, pd))
+		}
+		for _, u := range times {
+			tu := mustTimestampProto(t, u)
+			want := compareInt64(tm.UnixNano(), u.UnixNano())
+			if got := CompareTimestamps(ts, tu); got != want {
+				t.Errorf("CompareTimestamps(%v, %v) = %d, want %d", tm, u, got, want)
+			}
+			got, err := TimestampDiff(ts, tu)
+			if err != nil || !proto.Equal(got, DurationProto(tm.Sub(u))) {
+				t.Errorf("TimestampDiff(%v, %v) = %v, %v; want %v", tm, u, got, err, tm.Sub(u))
+			}
+		}
+	}
+}
+
+func mustTimestampProto(t *testing.T, tm time.Time) *tspb.Timestamp {
+	ts, err := TimestampProto(tm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ts
+}
+
+func checkTimestamp(t *testing.T, name string, tm time.Time, d time.Duration, want time.Time) func(*tspb.Timestamp, error) {
+	return func(got *tspb.Timestamp, err error) {
+		if err != nil {
+			t.Errorf("%s(%v, %v): %v", name, tm, d, err)
+			return
+		}
+		if w := mustTimestampProto(t, want); !proto.Equal(got, w) {
+			t.Errorf("%s(%v, %v) = %v, want %v", name, tm, d, got, w)
+		}
+	}
+}
+
+func TestTimestampArithmeticBeyondGoRange(t *testing.T) {
+	min := &tspb.Timestamp{Seconds: minValidSeconds}
+	max := &tspb.Timestamp{Seconds: maxValidSeconds - 1, Nanos: 999999999}
+	diff, err := TimestampDiff(max, min)
+	if want := (&durpb.Duration{Seconds: maxValidSeconds - minValidSeconds - 1, Nanos: 999999999}); err != nil || !proto.Equal(diff, want) {
+		t.Errorf("TimestampDiff(max, min) = %v, %v; want %v", diff, err, want)
+	}
+	got, err := TimestampAdd(min, diff)
+	if err != nil || !proto.Equal(got, max) {
+		t.Errorf("TimestampAdd(min, %v) = %v, %v; want %v", diff, got, err, max)
+	}
+	got, err = TimestampSub(max, diff)
+	if err != nil || !proto.Equal(got, min) {
+		t.Errorf("TimestampSub(max, %v) = %v, %v; want %v", diff, got, err, min)
+	}
+	if _, err := TimestampAdd(max, &durpb.Duration{Nanos: 1}); err == nil {
+		t.Errorf("TimestampAdd beyond the maximum succeeded")
+	}
+	if _, err := TimestampSub(min, &durpb.Duration{Nanos: 1}); err == nil {
+		t.Errorf("TimestampSub before the minimum succeeded")
+	}
+	if _, err := TimestampRound(max, &durpb.Duration{Seconds: 1}); err == nil {
+		t.Errorf("TimestampRound beyond the maximum succeeded")
+	}
+	if _, err := TimestampDiff(&tspb.Timestamp{Nanos: -1}, min); err == nil {
+		t.Errorf("TimestampDiff with an invalid Timestamp succeeded")
+	}
+	if CompareTimestamps(nil, &tspb.Timestamp{}) != 0 || CompareTimestamps(min, max) != -1 {
+		t.Errorf("CompareTimestamps gave wrong order")
+	}
+}
+
+func TestClampTimestamp(t *testing.T) {
+	for _, test := range []struct {
+		in, want *tspb.Timestamp
+	}{
+		{nil, &tspb.Timestamp{}},
+		{&tspb.Timestamp{Seconds: 5, Nanos: 1}, &tspb.Timestamp{Seconds: 5, Nanos: 1}},
+		{&tspb.Timestamp{Seconds: 5, Nanos: -1}, &tspb.Timestamp{Seconds: 4, Nanos: 999999999}},
+		{&tspb.Timestamp{Seconds: math.MaxInt64, Nanos: math.MaxInt32}, &tspb.Timestamp{Seconds: maxValidSeconds - 1, Nanos: 999999999}},
+		{&tspb.Timestamp{Seconds: math.MinInt64, Nanos: math.MinInt32}, &tspb.Timestamp{Seconds: minValidSeconds}},
+	} {
+		got := ClampTimestamp(test.in)
+		if !proto.Equal(got, test.want) {
+			t.Errorf("ClampTimestamp(%v) = %v, want %v", test.in, got, test.want)
+		}
+		if err := ValidateTimestamp(got); err != nil {
+			t.Errorf("ClampTimestamp(%v) is invalid: %v", test.in, err)
+		}
+	}
+}
+
+func TestFormatParseTimestamp(t *testing.T) {
+	for _, test := range []struct {
+		ts *tspb.Timestamp
+		s  string
+	}{
+		{&tspb.Timestamp{}, "1970-01-01T00:00:00Z"},
+		{&tspb.Timestamp{Seconds: 14e8, Nanos: 21e6}, "2014-05-13T16:53:20.021Z"},
+		{&tspb.Timestamp{Seconds: -1, Nanos: 1}, "1969-12-31T23:59:59.000000001Z"},
+		{&tspb.Timestamp{Seconds: minValidSeconds}, "0001-01-01T00:00:00Z"},
+		{&tspb.Timestamp{Seconds: maxValidSeconds - 1, Nanos: 999999999}, "9999-12-31T23:59:59.999999999Z"},
+	} {
+		got, err := FormatTimestamp(test.ts)
+		if err != nil || got != test.s {
+			t.Errorf("FormatTimestamp(%v) = %q, %v; want %q", test.ts, got, err, test.s)
+		}
+		ts, err := ParseTimestamp(test.s)
+		if err != nil || !proto.Equal(ts, test.ts) {
+			t.Errorf("ParseTimestamp(%q) = %v, %v; want %v", test.s, ts, err, test.ts)
+		}
+	}
+	if _, err := FormatTimestamp(&tspb.Timestamp{Nanos: -1}); err == nil {
+		t.Errorf("FormatTimestamp of an invalid Timestamp succeeded")
+	}
+
+	ts, err := ParseTimestamp("2014-05-13T18:53:20.5+02:00")
+	if want := (&tspb.Timestamp{Seconds: 14e8, Nanos: 5e8}); err != nil || !proto.Equal(ts, want) {
+		t.Errorf("ParseTimestamp with an offset = %v, %v; want %v", ts, err, want)
+	}
+	for _, s := range []string{"", "2014-05-13", "2014-05-13T16:53:20", "0000-12-31T23:59:59Z"} {
+		if ts, err := ParseTimestamp(s); err == nil {
+			t.Errorf("ParseTimestamp(%q) = %v, want error", s, ts)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package wrappers
+
+// This file implements constructors for the wrapper messages and
+// conversions between them and Go pointers.
+//
+// A nil wrapper represents an unset value, like a nil pointer. The
+// generated GetValue methods already return the zero value for a nil
+// wrapper; the Ptr methods below tell an unset value apart from a zero one.
+// The conversions to and from database/sql Null types are in package
+// github.com/golang/protobuf/sqlpb.
+
+// Double returns a new DoubleValue holding v.
+func Double(v float64) *DoubleValue {
+	return &DoubleValue{Value: v}
+}
+
+// DoubleFromPtr returns a new DoubleValue holding *p, or nil if p is nil.
+func DoubleFromPtr(p *float64) *DoubleValue {
+	if p == nil {
+		return nil
+	}
+	return &DoubleValue{Value: *p}
+}
+
+// Ptr returns a pointer to a copy of the wrapped value, or nil if m is nil.
+func (m *DoubleValue) Ptr() *float64 {
+	if m == nil {
+		return nil
+	}
+	v := m.Value
+	return &v
+}
+
+// Float returns a new FloatValue holding v.
+func Float(v float32) *FloatValue {
+	return &FloatValue{Value: v}
+}
+
+// FloatFromPtr returns a new FloatValue holding *p, or nil if p is nil.
+func FloatFromPtr(p *float32) *FloatValue {
+	if p == nil {
+		return nil
+	}
+	return &FloatValue{Value: *p}
+}
+
+// Ptr returns a pointer to a copy of the wrapped value, or nil if m is nil.
+func (m *FloatValue) Ptr() *float32 {
+	if m == nil {
+		return nil
+	}
+	v := m.Value
+	return &v
+}
+
+// Int64 returns a new Int64Value holding v.
+func Int64(v int64) *Int64Value {
+	return &Int64Value{Value: v}
+}
+
+// Int64FromPtr returns a new Int64Value holding *p, or nil if p is nil.
+func Int64FromPtr(p *int64) *Int64Value {
+	if p == nil {
+		return nil
+	}
+	return &Int64Value{Value: *p}
+}
+
+// Ptr returns a pointer to a copy of the wrapped value, or nil if m is nil.
+func (m *Int64Value) Ptr() *int64 {
+	if m == nil {
+		return nil
+	}
+	v := m.Value
+	return &v
+}
+
+// UInt64 returns a new UInt64Value holding v.
+func UInt64(v uint64) *UInt64Value {
+	return &UInt64Value{Value: v}
+}
+
+// UInt64FromPtr returns a new UInt64Value holding *p, or nil if p is nil.
+func UInt64FromPtr(p *uint64) *UInt64Value {
+	if p == nil {
+		return nil
+	}
+	return &UInt64Value{Value: *p}
+}
+
+// Ptr returns a pointer to a copy of the wrapped value, or nil if m is nil.
+func (m *UInt64Value) Ptr() *uint64 {
+	if m == nil {
+		return nil
+	}
+	v := m.Value
+	return &v
+}
+
+// Int32 returns a new Int32Value holding v.
+func Int32(v int32) *Int32Value {
+	return &Int32Value{Value: v}
+}
+
+// Int32FromPtr returns a new Int32Value holding *p, or nil if p is nil.
+func Int32FromPtr(p *int32) *Int32Value {
+	if p == nil {
+		return nil
+	}
+	return &Int32Value{Value: *p}
+}
+
+// Ptr returns a pointer to a copy of the wrapped value, or nil if m is nil.
+func (m *Int32Value) Ptr() *int32 {
+	if m == nil {
+		return nil
+	}
+	v := m.Value
+	return &v
+}
+
+// UInt32 returns a new UInt32Value holding v.
+func UInt32(v uint32) *UInt32Value {
+	return &UInt32Value{Value: v}
+}
+
+// UInt32FromPtr returns a new UInt32Value holding *p, or nil if p is nil.
+func UInt32FromPtr(p *uint32) *UInt32Value {
+	if p == nil {
+		return nil
+	}
+	return &UInt32Value{Value: *p}
+}
+
+// Ptr returns a pointer to a copy of the wrapped value, or nil if m is nil.
+func (m *UInt32Value) Ptr() *uint32 {
+	if m == nil {
+		return nil
+	}
+	v := m.Value
+	return &v
+}
+
+// Bool returns a new BoolValue holding v.
+func Bool(v bool) *BoolValue {
+	return &BoolValue{Value: v}
+}
+
+// BoolFromPtr returns a new BoolValue holding *p, or nil if p is nil.
+func BoolFromPtr(p *bool) *BoolValue {
+	if p == nil {
+		return nil
+	}
+	return &BoolValue{Value: *p}
+}
+
+// Ptr returns a pointer to a copy of the wrapped value, or nil if m is nil.
+func (m *BoolValue) Ptr() *bool {
+	if m == nil {
+		return nil
+	}
+	v := m.Value
+	return &v
+}
+
+// String returns a new StringValue holding v.
+func String(v string) *StringValue {
+	return &StringValue{Value: v}
+}
+
+// StringFromPtr returns a new StringValue holding *p, or nil if p is nil.
+func StringFromPtr(p *string) *StringValue {
+	if p == nil {
+		return nil
+	}
+	return &StringValue{Value: *p}
+}
+
+// Ptr returns a pointer to a copy of the wrapped value, or nil if m is nil.
+func (m *StringValue) Ptr() *string {
+	if m == nil {
+		return nil
+	}
+	v := m.Value
+	return &v
+}
+
+// Bytes returns a new BytesValue holding v.
+func Bytes(v []byte) *BytesValue {
+	return &BytesValue{Value: v}
+}
+
+// BytesFromPtr returns a new BytesValue holding *p, or nil if p is nil.
+func BytesFromPtr(p *[]byte) *BytesValue {
+	if p == nil {
+		return nil
+	}
+	return &BytesValue{Value: *p}
+}
+
+// Ptr returns a pointer to a copy of the wrapped value, or nil if m is nil.
+func (m *BytesValue) Ptr() *[]byte {
+	if m == nil {
+		return nil
+	}
+	v := m.Value
+	return &v
+}
@@ -0,0 +1,87 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package wrappers
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestConstructors(t *testing.T) {
+	tests := []struct {
+		got, want proto.Message
+	}{
+		{Double(1.5), &DoubleValue{Value: 1.5}},
+		{Float(2.5), &FloatValue{Value: 2.5}},
+		{Int64(-3), &Int64Value{Value: -3}},
+		{UInt64(4), &UInt64Value{Value: 4}},
+		{Int32(-5), &Int32Value{Value: -5}},
+		{UInt32(6), &UInt32Value{Value: 6}},
+		{Bool(true), &BoolValue{Value: true}},
+		{String("s"), &StringValue{Value: "s"}},
+		{Bytes([]byte("b")), &BytesValue{Value: []byte("b")}},
+	}
+	for _, tt := range tests {
+		if !proto.Equal(tt.got, tt.want) {
+			t.Errorf("got %v, want %v", tt.got, tt.want)
+		}
+	}
+}
+
+func TestPtr(t *testing.T) {
+	i := int64(7)
+	w := Int64FromPtr(&i)
+	if w.GetValue() != 7 {
+		t.Errorf("Int64FromPtr(&7) = %v", w)
+	}
+	p := w.Ptr()
+	if p == nil || *p != 7 {
+		t.Errorf("Ptr() = %v, want pointer to 7", p)
+	}
+	*p = 8
+	if w.Value != 7 {
+		t.Errorf("modifying the result of Ptr changed the wrapper to %v", w)
+	}
+
+	if w := StringFromPtr(nil); w != nil {
+		t.Errorf("StringFromPtr(nil) = %v, want nil", w)
+	}
+	var nilw *StringValue
+	if p := nilw.Ptr(); p != nil {
+		t.Errorf("(*StringValue)(nil).Ptr() = %v, want nil", p)
+	}
+	zero := false
+	if p := BoolFromPtr(&zero).Ptr(); p == nil || *p {
+		t.Errorf("BoolFromPtr(&false).Ptr() = %v, want pointer to false", p)
+	}
+}
@@ -18,6 +18,7 @@ fi
 # Generate various test protos.
 PROTO_DIRS=(
   conformance/internal/conformance_proto
+  descriptor/descriptor_test_proto
   jsonpb/jsonpb_test_proto
   proto
   protoc-gen-go/testdata
@@ -29,15 +30,35 @@ for dir in ${PROTO_DIRS[@]}; do
       continue;
     fi
     echo "# $p"
-    protoc -I$dir --go_out=plugins=grpc,paths=source_relative:$dir $p
+    params=plugins=grpc,paths=source_relative
+    if [[ $dir == descriptor/descriptor_test_proto ]]; then
+      # The descriptor tests inspect comments from the source code info.
+      params=$params,source_info=true
+    fi
+    protoc -I$dir --go_out=$params:$dir $p
   done
 done
 
+# Validation rules, and the test protos for the Validate methods generated
+# from them. These import rules.proto by its path from the repository root.
+echo "# validate/rules/rules.proto"
+protoc -I. --go_out=paths=source_relative:. validate/rules/rules.proto
+for p in validate/validate_test_proto/*.proto; do
+  echo "# $p"
+  protoc -I. --go_out=plugins=validate,paths=source_relative:. $p
+done
+
 # Deriving the location of the source protos from the path to the
 # protoc binary may be a bit odd, but this is what protoc itself does.
 PROTO_INCLUDE=$(dirname $(dirname $(which protoc)))/include
 
 # Well-known types.
+#
+# The Go packages of api, field_mask, source_context and type are those of
+# google.golang.org/genproto named by the go_package options of their
+# protos. Only those packages register the types, so they are linked in
+# once however they are imported; the packages of the same names in ptypes
+# are hand-written type aliases of them and are not generated here.
 WKT_PROTOS=(any duration empty struct timestamp wrappers)
 for p in ${WKT_PROTOS[@]}; do
   echo "# google/protobuf/$p.proto"
@@ -0,0 +1,171 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package sqlpb adapts protocol buffer messages and well-known types to
+database/sql columns.
+
+Each adapter implements sql.Scanner and driver.Valuer, so it can be passed
+to Scan and used as a query argument:
+
+	var cfg pb.Config
+	var created *tspb.Timestamp
+	row := db.QueryRow("SELECT config, created FROM t")
+	err := row.Scan(sqlpb.Binary{Message: &cfg}, sqlpb.Timestamp(&created))
+
+Messages are stored in binary form with Binary, such as in a bytea column,
+or in the proto3 JSON form with JSON, such as in a jsonb column.
+Timestamp, Duration and the wrapper types are stored as native column
+values, with a nil message stored as NULL. Their adapters take a pointer to
+the field holding the message; if that pointer is nil, Value and Scan
+return an error.
+*/
+package sqlpb
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+// Column is implemented by the adapters in this package.
+type Column interface {
+	sql.Scanner
+	driver.Valuer
+}
+
+// isNil reports whether pb is nil or a nil pointer.
+func isNil(pb proto.Message) bool {
+	if pb == nil {
+		return true
+	}
+	v := reflect.ValueOf(pb)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// srcBytes returns the contents of a []byte or string column value.
+func srcBytes(src interface{}) ([]byte, error) {
+	switch src := src.(type) {
+	case []byte:
+		return src, nil
+	case string:
+		return []byte(src), nil
+	}
+	return nil, fmt.Errorf("sqlpb: cannot scan %T into a message", src)
+}
+
+// errNilMessage is returned when scanning into a nil message.
+var errNilMessage = errors.New("sqlpb: cannot scan into a nil message")
+
+// errNilPointer is returned by the adapters of well-known types given a
+// nil pointer.
+var errNilPointer = errors.New("sqlpb: nil pointer to a well-known type")
+
+// Binary stores Message in its binary wire format, such as in a bytea or
+// blob column. A nil Message is stored as NULL. Scanning NULL resets
+// Message, and scanning into a nil Message is an error.
+type Binary struct {
+	Message proto.Message
+}
+
+// Value implements driver.Valuer.
+func (b Binary) Value() (driver.Value, error) {
+	if isNil(b.Message) {
+		return nil, nil
+	}
+	data, err := proto.Marshal(b.Message)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		// An empty message is not NULL.
+		data = []byte{}
+	}
+	return data, nil
+}
+
+// Scan implements sql.Scanner.
+func (b Binary) Scan(src interface{}) error {
+	if isNil(b.Message) {
+		return errNilMessage
+	}
+	if src == nil {
+		b.Message.Reset()
+		return nil
+	}
+	data, err := srcBytes(src)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(data, b.Message)
+}
+
+// JSON stores Message in the proto3 JSON format, such as in a json or
+// jsonb column. A nil Message is stored as NULL. Scanning NULL resets
+// Message, and scanning into a nil Message is an error.
+type JSON struct {
+	Message proto.Message
+
+	// The options used to marshal and unmarshal Message.
+	Marshaler   jsonpb.Marshaler
+	Unmarshaler jsonpb.Unmarshaler
+}
+
+// Value implements driver.Valuer.
+func (j JSON) Value() (driver.Value, error) {
+	if isNil(j.Message) {
+		return nil, nil
+	}
+	return j.Marshaler.MarshalToString(j.Message)
+}
+
+// Scan implements sql.Scanner.
+func (j JSON) Scan(src interface{}) error {
+	if isNil(j.Message) {
+		return errNilMessage
+	}
+	if src == nil {
+		j.Message.Reset()
+		return nil
+	}
+	data, err := srcBytes(src)
+	if err != nil {
+		return err
+	}
+	j.Message.Reset()
+	return j.Unmarshaler.Unmarshal(bytes.NewReader(data), j.Message)
+}
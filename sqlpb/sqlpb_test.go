@@ -0,0 +1,419 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package sqlpb
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"math"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	"github.com/golang/protobuf/proto"
+	durpb "github.com/golang/protobuf/ptypes/duration"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// memDriver is an in-memory database/sql driver with a single table per
+// data source name. "INSERT" appends its arguments as a row, and "SELECT"
+// returns all rows.
+type memDriver struct {
+	mu     sync.Mutex
+	tables map[string][][]driver.Value
+}
+
+var mem = &memDriver{tables: make(map[string][][]driver.Value)}
+
+func init() {
+	sql.Register("sqlpbmem", mem)
+}
+
+func (d *memDriver) Open(name string) (driver.Conn, error) {
+	return &memConn{d: d, name: name}, nil
+}
+
+type memConn struct {
+	d    *memDriver
+	name string
+}
+
+func (c *memConn) Prepare(query string) (driver.Stmt, error) {
+	return &memStmt{c: c, query: query}, nil
+}
+func (c *memConn) Close() error              { return nil }
+func (c *memConn) Begin() (driver.Tx, error) { return nil, errors.New("transactions not supported") }
+
+type memStmt struct {
+	c     *memConn
+	query string
+}
+
+func (s *memStmt) Close() error  { return nil }
+func (s *memStmt) NumInput() int { return -1 }
+
+func (s *memStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.query != "INSERT" {
+		return nil, errors.New("unsupported statement " + s.query)
+	}
+	s.c.d.mu.Lock()
+	defer s.c.d.mu.Unlock()
+	s.c.d.tables[s.c.name] = append(s.c.d.tables[s.c.name], args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *memStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.query != "SELECT" {
+		return nil, errors.New("unsupported query " + s.query)
+	}
+	s.c.d.mu.Lock()
+	defer s.c.d.mu.Unlock()
+	return &memRows{rows: s.c.d.tables[s.c.name]}, nil
+}
+
+type memRows struct {
+	rows [][]driver.Value
+}
+
+func (r *memRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	cols := make([]string, len(r.rows[0]))
+	for i := range cols {
+		cols[i] = string(rune('a' + i))
+	}
+	return cols
+}
+
+func (r *memRows) Close() error { return nil }
+
+func (r *memRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+// roundTrip stores args in a fresh table and scans the row back into dest.
+func roundTrip(t *testing.T, args []interface{}, dest []interface{}) {
+	t.Helper()
+	db, err := sql.Open("sqlpbmem", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("INSERT", args...); err != nil {
+		t.Fatalf("INSERT: %v", err)
+	}
+	if err := db.QueryRow("SELECT").Scan(dest...); err != nil {
+		t.Fatalf("SELECT: %v", err)
+	}
+}
+
+func TestMessages(t *testing.T) {
+	in := &pb.Simple{OString: proto.String("hi"), OInt64: proto.Int64(-3)}
+	bin, js := new(pb.Simple), &pb.Simple{OBool: proto.Bool(true)}
+	nullBin, nullJS := &pb.Simple{OBool: proto.Bool(true)}, &pb.Simple{OBool: proto.Bool(true)}
+	roundTrip(t,
+		[]interface{}{Binary{in}, JSON{Message: in}, Binary{(*pb.Simple)(nil)}, JSON{}},
+		[]interface{}{Binary{bin}, JSON{Message: js}, Binary{nullBin}, JSON{Message: nullJS}})
+	if !proto.Equal(bin, in) {
+		t.Errorf("Binary round trip = %v, want %v", bin, in)
+	}
+	if !proto.Equal(js, in) {
+		t.Errorf("JSON round trip = %v, want %v", js, in)
+	}
+	if !proto.Equal(nullBin, &pb.Simple{}) || !proto.Equal(nullJS, &pb.Simple{}) {
+		t.Errorf("scanning NULL gave %v and %v, want empty messages", nullBin, nullJS)
+	}
+
+	v, err := JSON{Message: in, Marshaler: jsonpb.Marshaler{OrigName: true}}.Value()
+	if want := `{"o_int64":"-3","o_string":"hi"}`; err != nil || v != want {
+		t.Errorf("JSON.Value with OrigName = %v, %v; want %s", v, err, want)
+	}
+	v, err = Binary{&pb.Simple{}}.Value()
+	if b, ok := v.([]byte); err != nil || !ok || b == nil || len(b) != 0 {
+		t.Errorf("Binary.Value of an empty message = %#v, %v; want non-NULL empty bytes", v, err)
+	}
+	if err := (Binary{new(pb.Simple)}).Scan(int64(1)); err == nil {
+		t.Errorf("Binary.Scan(int64) succeeded")
+	}
+	for _, c := range []Column{Binary{}, Binary{(*pb.Simple)(nil)}, JSON{}, JSON{Message: (*pb.Simple)(nil)}} {
+		for _, src := range []interface{}{nil, []byte("{}")} {
+			if err := c.Scan(src); err != errNilMessage {
+				t.Errorf("%T with nil Message: Scan(%v) = %v, want %v", c, src, err, errNilMessage)
+			}
+		}
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	in := &tspb.Timestamp{Seconds: 1500000000, Nanos: 123456789}
+	var out, null *tspb.Timestamp
+	null = &tspb.Timestamp{}
+	var nilTs *tspb.Timestamp
+	roundTrip(t, []interface{}{Timestamp(&in), Timestamp(&nilTs)}, []interface{}{Timestamp(&out), Timestamp(&null)})
+	if !proto.Equal(out, in) {
+		t.Errorf("round trip = %v, want %v", out, in)
+	}
+	if null != nil {
+		t.Errorf("scanning NULL = %v, want nil", null)
+	}
+
+	v, err := Timestamp(&in).Value()
+	if tm, ok := v.(time.Time); err != nil || !ok || !tm.Equal(time.Unix(1500000000, 123456789)) || tm.Location() != time.UTC {
+		t.Errorf("Value = %v, %v", v, err)
+	}
+	bad := &tspb.Timestamp{Nanos: -1}
+	if _, err := Timestamp(&bad).Value(); err == nil {
+		t.Errorf("Value of an invalid Timestamp succeeded")
+	}
+
+	for _, test := range []struct {
+		src  interface{}
+		want *tspb.Timestamp
+	}{
+		{"2017-07-14T02:40:00.5Z", &tspb.Timestamp{Seconds: 1500000000, Nanos: 5e8}},
+		{[]byte("2017-07-14 04:40:00+02"), &tspb.Timestamp{Seconds: 1500000000}},
+		{"2017-07-14 02:40:00.000001", &tspb.Timestamp{Seconds: 1500000000, Nanos: 1000}},
+		{time.Unix(1500000000, 0).In(time.FixedZone("x", 3600)), &tspb.Timestamp{Seconds: 1500000000}},
+	} {
+		var got *tspb.Timestamp
+		if err := Timestamp(&got).Scan(test.src); err != nil || !proto.Equal(got, test.want) {
+			t.Errorf("Scan(%v) = %v, %v; want %v", test.src, got, err, test.want)
+		}
+	}
+	for _, src := range []interface{}{"yesterday", int64(0), "0000-01-01T00:00:00Z"} {
+		var got *tspb.Timestamp
+		if err := Timestamp(&got).Scan(src); err == nil {
+			t.Errorf("Scan(%v) = %v, want error", src, got)
+		}
+	}
+}
+
+func TestDuration(t *testing.T) {
+	in := &durpb.Duration{Seconds: -90061, Nanos: -5e8}
+	var out *durpb.Duration
+	roundTrip(t, []interface{}{Duration(&in)}, []interface{}{Duration(&out)})
+	if !proto.Equal(out, in) {
+		t.Errorf("round trip = %v, want %v", out, in)
+	}
+	if v, err := Duration(&in).Value(); err != nil || v != "-90061.500 seconds" {
+		t.Errorf("Value = %v, %v", v, err)
+	}
+
+	for _, test := range []struct {
+		src  interface{}
+		want *durpb.Duration
+	}{
+		{"1.5s", &durpb.Duration{Seconds: 1, Nanos: 5e8}},
+		{"1.5 seconds", &durpb.Duration{Seconds: 1, Nanos: 5e8}},
+		// postgres
+		{"1 day 02:03:04.5", &durpb.Duration{Seconds: 93784, Nanos: 5e8}},
+		{"-1 days +02:00:00", &durpb.Duration{Seconds: -79200}},
+		{[]byte("-00:00:01.5"), &durpb.Duration{Seconds: -1, Nanos: -5e8}},
+		{"3 days", &durpb.Duration{Seconds: 259200}},
+		// postgres_verbose
+		{"@ 1 day 2 hours 3 mins 4.5 secs ago", &durpb.Duration{Seconds: -93784, Nanos: -5e8}},
+		// sql_standard
+		{"1 2:03:04", &durpb.Duration{Seconds: 93784}},
+		{"-1 -2:03:04", &durpb.Duration{Seconds: -93784}},
+		{"2:03:04.25", &durpb.Duration{Seconds: 7384, Nanos: 25e7}},
+		// iso_8601
+		{"P1DT2H3M4.5S", &durpb.Duration{Seconds: 93784, Nanos: 5e8}},
+		{"PT-1H-30M", &durpb.Duration{Seconds: -5400}},
+		{"-PT1S", &durpb.Duration{Seconds: -1}},
+		{"P1W", &durpb.Duration{Seconds: 604800}},
+		// EXTRACT(EPOCH FROM interval)
+		{int64(60), &durpb.Duration{Seconds: 60}},
+		{float64(-0.25), &durpb.Duration{Nanos: -25e7}},
+		// Years and months have no fixed length.
+		{"1 year", nil},
+		{"2 mons", nil},
+		{"P1M", nil},
+		{"P1Y", nil},
+		{"PT1D", nil},
+		{"P", nil},
+		{"1e3 seconds", nil},
+		{"1:2:3:4", nil},
+		{"1 2", nil},
+		{"", nil},
+		{true, nil},
+		{math.Inf(1), nil},
+		{"400000000000 seconds", nil},
+	} {
+		var got *durpb.Duration
+		err := Duration(&got).Scan(test.src)
+		if test.want == nil {
+			if err == nil {
+				t.Errorf("Scan(%#v) = %v, want error", test.src, got)
+			}
+			continue
+		}
+		if err != nil || !proto.Equal(got, test.want) {
+			t.Errorf("Scan(%#v) = %v, %v; want %v", test.src, got, err, test.want)
+		}
+	}
+}
+
+func TestWrappers(t *testing.T) {
+	var (
+		d   = wrappers.Double(1.5)
+		f   = wrappers.Float(2.5)
+		i64 = wrappers.Int64(-64)
+		u64 = wrappers.UInt64(64)
+		i32 = wrappers.Int32(-32)
+		u32 = wrappers.UInt32(32)
+		b   = wrappers.Bool(true)
+		s   = wrappers.String("s")
+		bs  = wrappers.Bytes([]byte("bs"))
+	)
+	args := []interface{}{Double(&d), Float(&f), Int64(&i64), UInt64(&u64), Int32(&i32), UInt32(&u32), Bool(&b), String(&s), Bytes(&bs)}
+	var (
+		d2   *wrappers.DoubleValue
+		f2   *wrappers.FloatValue
+		i642 *wrappers.Int64Value
+		u642 *wrappers.UInt64Value
+		i322 *wrappers.Int32Value
+		u322 *wrappers.UInt32Value
+		b2   *wrappers.BoolValue
+		s2   *wrappers.StringValue
+		bs2  *wrappers.BytesValue
+	)
+	dest := []interface{}{Double(&d2), Float(&f2), Int64(&i642), UInt64(&u642), Int32(&i322), UInt32(&u322), Bool(&b2), String(&s2), Bytes(&bs2)}
+	roundTrip(t, args, dest)
+	for i, pair := range [][2]proto.Message{{d, d2}, {f, f2}, {i64, i642}, {u64, u642}, {i32, i322}, {u32, u322}, {b, b2}, {s, s2}, {bs, bs2}} {
+		if !proto.Equal(pair[0], pair[1]) {
+			t.Errorf("column %d: round trip = %v, want %v", i, pair[1], pair[0])
+		}
+	}
+
+	// nil wrappers are stored as NULL, and NULL is scanned as nil.
+	d, s, bs = nil, nil, nil
+	d2, s2, bs2 = wrappers.Double(1), wrappers.String("x"), wrappers.Bytes(nil)
+	t.Run("null", func(t *testing.T) {
+		roundTrip(t, []interface{}{Double(&d), String(&s), Bytes(&bs)}, []interface{}{Double(&d2), String(&s2), Bytes(&bs2)})
+	})
+	if d2 != nil || s2 != nil || bs2 != nil {
+		t.Errorf("scanning NULL = %v, %v, %v; want nil", d2, s2, bs2)
+	}
+
+	u64 = wrappers.UInt64(math.MaxUint64)
+	if _, err := UInt64(&u64).Value(); err == nil {
+		t.Errorf("Value of UInt64Value above MaxInt64 succeeded")
+	}
+	if err := UInt32(&u322).Scan(int64(-1)); err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Errorf("Scan of -1 into UInt32Value: %v", err)
+	}
+	if err := Int32(&i322).Scan(int64(math.MaxInt32 + 1)); err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Errorf("Scan of MaxInt32+1 into Int32Value: %v", err)
+	}
+	if err := Bool(&b2).Scan("maybe"); err == nil {
+		t.Errorf("Scan of \"maybe\" into BoolValue succeeded")
+	}
+}
+
+func TestNilPointer(t *testing.T) {
+	for _, c := range []Column{Timestamp(nil), Duration(nil), Double(nil), Int32(nil), String(nil), Bytes(nil)} {
+		if v, err := c.Value(); err != errNilPointer {
+			t.Errorf("%T with nil pointer: Value() = %v, %v; want %v", c, v, err, errNilPointer)
+		}
+		for _, src := range []interface{}{nil, "1"} {
+			if err := c.Scan(src); err != errNilPointer {
+				t.Errorf("%T with nil pointer: Scan(%v) = %v, want %v", c, src, err, errNilPointer)
+			}
+		}
+	}
+}
+
+func TestNull(t *testing.T) {
+	if n := Int64ToNull(wrappers.Int64(3)); n != (sql.NullInt64{Int64: 3, Valid: true}) {
+		t.Errorf("Int64ToNull(3) = %v", n)
+	}
+	if n := Int64ToNull(nil); n.Valid {
+		t.Errorf("Int64ToNull(nil) = %v, want invalid", n)
+	}
+	if w := StringFromNull(sql.NullString{String: "s", Valid: true}); !proto.Equal(w, wrappers.String("s")) {
+		t.Errorf("StringFromNull(s) = %v", w)
+	}
+	if w := StringFromNull(sql.NullString{String: "s"}); w != nil {
+		t.Errorf("StringFromNull(invalid) = %v, want nil", w)
+	}
+	if n := FloatToNull(wrappers.Float(1.5)); n != (sql.NullFloat64{Float64: 1.5, Valid: true}) {
+		t.Errorf("FloatToNull(1.5) = %v", n)
+	}
+	if n := Int32ToNull(wrappers.Int32(-1)); n != (sql.NullInt64{Int64: -1, Valid: true}) {
+		t.Errorf("Int32ToNull(-1) = %v", n)
+	}
+	if n := BoolToNull(wrappers.Bool(false)); n != (sql.NullBool{Valid: true}) {
+		t.Errorf("BoolToNull(false) = %v", n)
+	}
+}
+
+func TestNullRange(t *testing.T) {
+	if w, err := Int32FromNull(sql.NullInt64{Int64: math.MinInt32, Valid: true}); err != nil || w.GetValue() != math.MinInt32 {
+		t.Errorf("Int32FromNull(MinInt32) = %v, %v", w, err)
+	}
+	if w, err := Int32FromNull(sql.NullInt64{Int64: math.MaxInt32 + 1, Valid: true}); err == nil {
+		t.Errorf("Int32FromNull(MaxInt32+1) = %v, want error", w)
+	}
+	if w, err := UInt32FromNull(sql.NullInt64{Int64: math.MaxUint32, Valid: true}); err != nil || w.GetValue() != math.MaxUint32 {
+		t.Errorf("UInt32FromNull(MaxUint32) = %v, %v", w, err)
+	}
+	if w, err := UInt32FromNull(sql.NullInt64{Int64: math.MaxUint32 + 1, Valid: true}); err == nil {
+		t.Errorf("UInt32FromNull(MaxUint32+1) = %v, want error", w)
+	}
+	if w, err := UInt32FromNull(sql.NullInt64{}); w != nil || err != nil {
+		t.Errorf("UInt32FromNull(invalid) = %v, %v; want nil, nil", w, err)
+	}
+	if w, err := UInt64FromNull(sql.NullInt64{Int64: -1, Valid: true}); err == nil {
+		t.Errorf("UInt64FromNull(-1) = %v, want error", w)
+	}
+	if n, err := UInt64ToNull(wrappers.UInt64(math.MaxInt64)); err != nil || n.Int64 != math.MaxInt64 {
+		t.Errorf("UInt64ToNull(MaxInt64) = %v, %v", n, err)
+	}
+	if n, err := UInt64ToNull(wrappers.UInt64(math.MaxInt64 + 1)); err == nil {
+		t.Errorf("UInt64ToNull(MaxInt64+1) = %v, want error", n)
+	}
+	if n, err := UInt64ToNull(nil); n.Valid || err != nil {
+		t.Errorf("UInt64ToNull(nil) = %v, %v; want invalid", n, err)
+	}
+}
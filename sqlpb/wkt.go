@@ -0,0 +1,504 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package sqlpb
+
+// This file implements the adapters for well-known types.
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	durpb "github.com/golang/protobuf/ptypes/duration"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// Timestamp adapts *ts to a TIMESTAMP column. The value is stored as a
+// time.Time in UTC, and NULL is scanned as nil. Besides time.Time, Scan
+// accepts the text forms of timestamps, in RFC 3339 or SQL format; those
+// without a time zone are taken to be in UTC.
+func Timestamp(ts **tspb.Timestamp) Column {
+	return timestampColumn{ts}
+}
+
+type timestampColumn struct {
+	p **tspb.Timestamp
+}
+
+func (c timestampColumn) Value() (driver.Value, error) {
+	if c.p == nil {
+		return nil, errNilPointer
+	}
+	if *c.p == nil {
+		return nil, nil
+	}
+	t, err := ptypes.Timestamp(*c.p)
+	if err != nil {
+		return nil, err
+	}
+	return t.UTC(), nil
+}
+
+// timestampLayouts are the text forms of timestamps accepted by Scan.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+}
+
+func (c timestampColumn) Scan(src interface{}) error {
+	if c.p == nil {
+		return errNilPointer
+	}
+	var t time.Time
+	switch src := src.(type) {
+	case nil:
+		*c.p = nil
+		return nil
+	case time.Time:
+		t = src
+	case []byte, string:
+		s := fmt.Sprintf("%s", src)
+		var err error
+		for _, layout := range timestampLayouts {
+			if t, err = time.Parse(layout, s); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("sqlpb: cannot parse timestamp %q", s)
+		}
+	default:
+		return fmt.Errorf("sqlpb: cannot scan %T into a Timestamp", src)
+	}
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return err
+	}
+	*c.p = ts
+	return nil
+}
+
+// Duration adapts *d to an INTERVAL column. The value is stored as a
+// string such as "1.5 seconds", which PostgreSQL accepts as an interval,
+// and NULL is scanned as nil. Scan accepts the PostgreSQL interval output
+// styles, with days taken as 24 hours and years and months rejected, the
+// proto3 JSON form such as "1.5s", and numbers of seconds, such as those
+// returned by EXTRACT(EPOCH FROM interval).
+func Duration(d **durpb.Duration) Column {
+	return durationColumn{d}
+}
+
+type durationColumn struct {
+	p **durpb.Duration
+}
+
+func (c durationColumn) Value() (driver.Value, error) {
+	if c.p == nil {
+		return nil, errNilPointer
+	}
+	if *c.p == nil {
+		return nil, nil
+	}
+	s, err := ptypes.FormatDuration(*c.p)
+	if err != nil {
+		return nil, err
+	}
+	return strings.TrimSuffix(s, "s") + " seconds", nil
+}
+
+func (c durationColumn) Scan(src interface{}) error {
+	if c.p == nil {
+		return errNilPointer
+	}
+	var secs *big.Rat
+	switch src := src.(type) {
+	case nil:
+		*c.p = nil
+		return nil
+	case int64:
+		secs = new(big.Rat).SetInt64(src)
+	case float64:
+		secs = new(big.Rat)
+		if secs.SetFloat64(src) == nil {
+			return fmt.Errorf("sqlpb: invalid duration %v", src)
+		}
+	case []byte, string:
+		s := fmt.Sprintf("%s", src)
+		if d, err := ptypes.ParseDuration(s); err == nil {
+			*c.p = d
+			return nil
+		}
+		var err error
+		if secs, err = parseInterval(s); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("sqlpb: cannot scan %T into a Duration", src)
+	}
+	d, err := ratDuration(secs)
+	if err != nil {
+		return err
+	}
+	*c.p = d
+	return nil
+}
+
+// ratDuration converts a number of seconds to a Duration, rounding it to
+// the nearest nanosecond toward zero.
+func ratDuration(secs *big.Rat) (*durpb.Duration, error) {
+	n := new(big.Int).Mul(secs.Num(), big.NewInt(1e9))
+	n.Quo(n, secs.Denom())
+	s, ns := new(big.Int).QuoRem(n, big.NewInt(1e9), new(big.Int))
+	if !s.IsInt64() {
+		return nil, fmt.Errorf("sqlpb: duration of %s seconds out of range", secs.FloatString(9))
+	}
+	d := &durpb.Duration{Seconds: s.Int64(), Nanos: int32(ns.Int64())}
+	if err := ptypes.ValidateDuration(d); err != nil {
+		return nil, fmt.Errorf("sqlpb: %v", err)
+	}
+	return d, nil
+}
+
+// intervalUnits are the lengths in seconds of the units of the PostgreSQL
+// "postgres" and "postgres_verbose" interval styles.
+var intervalUnits = map[string]int64{
+	"week": 7 * 86400, "weeks": 7 * 86400,
+	"day": 86400, "days": 86400,
+	"hour": 3600, "hours": 3600,
+	"min": 60, "mins": 60, "minute": 60, "minutes": 60,
+	"sec": 1, "secs": 1, "second": 1, "seconds": 1,
+}
+
+// parseInterval parses a PostgreSQL interval in the "postgres",
+// "postgres_verbose", "sql_standard" or "iso_8601" output style and
+// returns its length in seconds.
+func parseInterval(s string) (*big.Rat, error) {
+	bad := func() (*big.Rat, error) {
+		return nil, fmt.Errorf("sqlpb: cannot parse interval %q", s)
+	}
+	if strings.HasPrefix(s, "P") || strings.HasPrefix(s, "-P") {
+		if secs := parseISOInterval(s); secs != nil {
+			return secs, nil
+		}
+		return bad()
+	}
+	total := new(big.Rat)
+	fields := strings.Fields(s)
+	if len(fields) > 0 && fields[0] == "@" {
+		fields = fields[1:]
+	}
+	ago := len(fields) > 0 && fields[len(fields)-1] == "ago"
+	if ago {
+		fields = fields[:len(fields)-1]
+	}
+	if len(fields) == 0 {
+		return bad()
+	}
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		if strings.Contains(f, ":") {
+			secs := parseClock(f)
+			if secs == nil {
+				return bad()
+			}
+			total.Add(total, secs)
+			continue
+		}
+		x, ok := parseDecimal(f)
+		if !ok || i+1 == len(fields) {
+			return bad()
+		}
+		unit := int64(86400) // days, in the "sql_standard" style
+		if !strings.Contains(fields[i+1], ":") {
+			i++
+			if unit, ok = intervalUnits[fields[i]]; !ok {
+				return bad()
+			}
+		}
+		total.Add(total, x.Mul(x, new(big.Rat).SetInt64(unit)))
+	}
+	if ago {
+		total.Neg(total)
+	}
+	return total, nil
+}
+
+// parseDecimal parses a decimal number such as "-1.5".
+func parseDecimal(s string) (*big.Rat, bool) {
+	if s == "" || strings.ContainsAny(s, "eE/+") {
+		return nil, false
+	}
+	return new(big.Rat).SetString(s)
+}
+
+// parseClock parses a time of day such as "-01:02:03.5" or "01:02" as a
+// number of seconds, or returns nil.
+func parseClock(s string) *big.Rat {
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil
+	}
+	total := new(big.Rat)
+	for i, p := range parts {
+		if strings.HasPrefix(p, "-") || (i < len(parts)-1 && strings.Contains(p, ".")) {
+			return nil
+		}
+		x, ok := parseDecimal(p)
+		if !ok {
+			return nil
+		}
+		total.Mul(total, big.NewRat(60, 1)).Add(total, x)
+	}
+	if len(parts) == 2 {
+		// hh:mm
+		total.Mul(total, big.NewRat(60, 1))
+	}
+	if neg {
+		total.Neg(total)
+	}
+	return total
+}
+
+// parseISOInterval parses an ISO 8601 duration such as "P1DT2H3M4.5S",
+// whose components may be negative, as a number of seconds, or returns nil.
+// Years and months are rejected.
+func parseISOInterval(s string) *big.Rat {
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "P")
+	total := new(big.Rat)
+	inTime, empty := false, true
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime {
+				return nil
+			}
+			inTime = true
+			s = s[1:]
+			continue
+		}
+		i := strings.IndexAny(s, "WDHMS")
+		if i <= 0 {
+			return nil
+		}
+		x, ok := parseDecimal(s[:i])
+		if !ok {
+			return nil
+		}
+		var unit int64
+		switch {
+		case !inTime && s[i] == 'W':
+			unit = 7 * 86400
+		case !inTime && s[i] == 'D':
+			unit = 86400
+		case inTime && s[i] == 'H':
+			unit = 3600
+		case inTime && s[i] == 'M':
+			unit = 60
+		case inTime && s[i] == 'S':
+			unit = 1
+		default:
+			return nil
+		}
+		total.Add(total, x.Mul(x, new(big.Rat).SetInt64(unit)))
+		s = s[i+1:]
+		empty = false
+	}
+	if empty {
+		return nil
+	}
+	if neg {
+		total.Neg(total)
+	}
+	return total
+}
+
+// Double adapts *w to a nullable floating-point column.
+func Double(w **wrappers.DoubleValue) Column { return wrapperColumn{w} }
+
+// Float adapts *w to a nullable floating-point column.
+func Float(w **wrappers.FloatValue) Column { return wrapperColumn{w} }
+
+// Int64 adapts *w to a nullable integer column.
+func Int64(w **wrappers.Int64Value) Column { return wrapperColumn{w} }
+
+// UInt64 adapts *w to a nullable integer column. Values above
+// math.MaxInt64 can't be stored.
+func UInt64(w **wrappers.UInt64Value) Column { return wrapperColumn{w} }
+
+// Int32 adapts *w to a nullable integer column.
+func Int32(w **wrappers.Int32Value) Column { return wrapperColumn{w} }
+
+// UInt32 adapts *w to a nullable integer column.
+func UInt32(w **wrappers.UInt32Value) Column { return wrapperColumn{w} }
+
+// Bool adapts *w to a nullable boolean column.
+func Bool(w **wrappers.BoolValue) Column { return wrapperColumn{w} }
+
+// String adapts *w to a nullable text column.
+func String(w **wrappers.StringValue) Column { return wrapperColumn{w} }
+
+// Bytes adapts *w to a nullable binary column.
+func Bytes(w **wrappers.BytesValue) Column { return wrapperColumn{w} }
+
+// wrapperColumn adapts a pointer to a pointer to a wrapper message, which
+// is stored as NULL when nil.
+type wrapperColumn struct {
+	p interface{}
+}
+
+func (c wrapperColumn) Value() (driver.Value, error) {
+	if reflect.ValueOf(c.p).IsNil() {
+		return nil, errNilPointer
+	}
+	switch p := c.p.(type) {
+	case **wrappers.DoubleValue:
+		return DoubleToNull(*p).Value()
+	case **wrappers.FloatValue:
+		return FloatToNull(*p).Value()
+	case **wrappers.Int64Value:
+		return Int64ToNull(*p).Value()
+	case **wrappers.UInt64Value:
+		n, err := UInt64ToNull(*p)
+		if err != nil {
+			return nil, err
+		}
+		return n.Value()
+	case **wrappers.Int32Value:
+		return Int32ToNull(*p).Value()
+	case **wrappers.UInt32Value:
+		return UInt32ToNull(*p).Value()
+	case **wrappers.BoolValue:
+		return BoolToNull(*p).Value()
+	case **wrappers.StringValue:
+		return StringToNull(*p).Value()
+	case **wrappers.BytesValue:
+		if *p == nil {
+			return nil, nil
+		}
+		if (*p).Value == nil {
+			return []byte{}, nil
+		}
+		return (*p).Value, nil
+	}
+	panic(fmt.Sprintf("sqlpb: unexpected wrapper %T", c.p))
+}
+
+func (c wrapperColumn) Scan(src interface{}) error {
+	if reflect.ValueOf(c.p).IsNil() {
+		return errNilPointer
+	}
+	switch p := c.p.(type) {
+	case **wrappers.DoubleValue:
+		var n sql.NullFloat64
+		if err := n.Scan(src); err != nil {
+			return err
+		}
+		*p = DoubleFromNull(n)
+	case **wrappers.FloatValue:
+		var n sql.NullFloat64
+		if err := n.Scan(src); err != nil {
+			return err
+		}
+		*p = FloatFromNull(n)
+	case **wrappers.Int64Value:
+		var n sql.NullInt64
+		if err := n.Scan(src); err != nil {
+			return err
+		}
+		*p = Int64FromNull(n)
+	case **wrappers.UInt64Value:
+		var n sql.NullInt64
+		if err := n.Scan(src); err != nil {
+			return err
+		}
+		w, err := UInt64FromNull(n)
+		if err != nil {
+			return err
+		}
+		*p = w
+	case **wrappers.Int32Value:
+		var n sql.NullInt64
+		if err := n.Scan(src); err != nil {
+			return err
+		}
+		w, err := Int32FromNull(n)
+		if err != nil {
+			return err
+		}
+		*p = w
+	case **wrappers.UInt32Value:
+		var n sql.NullInt64
+		if err := n.Scan(src); err != nil {
+			return err
+		}
+		w, err := UInt32FromNull(n)
+		if err != nil {
+			return err
+		}
+		*p = w
+	case **wrappers.BoolValue:
+		var n sql.NullBool
+		if err := n.Scan(src); err != nil {
+			return err
+		}
+		*p = BoolFromNull(n)
+	case **wrappers.StringValue:
+		var n sql.NullString
+		if err := n.Scan(src); err != nil {
+			return err
+		}
+		*p = StringFromNull(n)
+	case **wrappers.BytesValue:
+		switch src := src.(type) {
+		case nil:
+			*p = nil
+		case []byte:
+			*p = wrappers.Bytes(append([]byte{}, src...))
+		case string:
+			*p = wrappers.Bytes([]byte(src))
+		default:
+			return fmt.Errorf("sqlpb: cannot scan %T into a BytesValue", src)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package sqlpb
+
+// This file implements conversions between the wrapper messages and the
+// database/sql Null types.
+//
+// A nil wrapper corresponds to a Null value with Valid set to false.
+// BytesValue has no conversion, since database/sql represents a NULL
+// []byte as nil. Int32Value is converted to and from sql.NullInt64, like
+// the unsigned wrappers.
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// DoubleFromNull returns a new DoubleValue holding n.Float64, or nil if n is not valid.
+func DoubleFromNull(n sql.NullFloat64) *wrappers.DoubleValue {
+	if !n.Valid {
+		return nil
+	}
+	return wrappers.Double(n.Float64)
+}
+
+// DoubleToNull returns the value wrapped by m as a sql.NullFloat64, which is not valid if m is nil.
+func DoubleToNull(m *wrappers.DoubleValue) sql.NullFloat64 {
+	if m == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: m.Value, Valid: true}
+}
+
+// FloatFromNull returns a new FloatValue holding n.Float64, or nil if n is not valid.
+func FloatFromNull(n sql.NullFloat64) *wrappers.FloatValue {
+	if !n.Valid {
+		return nil
+	}
+	return wrappers.Float(float32(n.Float64))
+}
+
+// FloatToNull returns the value wrapped by m as a sql.NullFloat64, which is not valid if m is nil.
+func FloatToNull(m *wrappers.FloatValue) sql.NullFloat64 {
+	if m == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: float64(m.Value), Valid: true}
+}
+
+// Int64FromNull returns a new Int64Value holding n.Int64, or nil if n is not valid.
+func Int64FromNull(n sql.NullInt64) *wrappers.Int64Value {
+	if !n.Valid {
+		return nil
+	}
+	return wrappers.Int64(n.Int64)
+}
+
+// Int64ToNull returns the value wrapped by m as a sql.NullInt64, which is not valid if m is nil.
+func Int64ToNull(m *wrappers.Int64Value) sql.NullInt64 {
+	if m == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: m.Value, Valid: true}
+}
+
+// UInt64FromNull returns a new UInt64Value holding n.Int64, or nil if n is not valid.
+// It returns an error if n.Int64 is negative.
+func UInt64FromNull(n sql.NullInt64) (*wrappers.UInt64Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	if n.Int64 < 0 {
+		return nil, fmt.Errorf("sqlpb: %d out of range for UInt64Value", n.Int64)
+	}
+	return wrappers.UInt64(uint64(n.Int64)), nil
+}
+
+// UInt64ToNull returns the value wrapped by m as a sql.NullInt64, which is not valid if m is nil.
+// It returns an error if the value is out of range for an int64.
+func UInt64ToNull(m *wrappers.UInt64Value) (sql.NullInt64, error) {
+	if m == nil {
+		return sql.NullInt64{}, nil
+	}
+	if m.Value > math.MaxInt64 {
+		return sql.NullInt64{}, fmt.Errorf("sqlpb: %d out of range for sql.NullInt64", m.Value)
+	}
+	return sql.NullInt64{Int64: int64(m.Value), Valid: true}, nil
+}
+
+// Int32FromNull returns a new Int32Value holding n.Int64, or nil if n is not valid.
+// It returns an error if n.Int64 is out of range for an int32.
+func Int32FromNull(n sql.NullInt64) (*wrappers.Int32Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	if n.Int64 < math.MinInt32 || n.Int64 > math.MaxInt32 {
+		return nil, fmt.Errorf("sqlpb: %d out of range for Int32Value", n.Int64)
+	}
+	return wrappers.Int32(int32(n.Int64)), nil
+}
+
+// Int32ToNull returns the value wrapped by m as a sql.NullInt64, which is not valid if m is nil.
+func Int32ToNull(m *wrappers.Int32Value) sql.NullInt64 {
+	if m == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(m.Value), Valid: true}
+}
+
+// UInt32FromNull returns a new UInt32Value holding n.Int64, or nil if n is not valid.
+// It returns an error if n.Int64 is out of range for a uint32.
+func UInt32FromNull(n sql.NullInt64) (*wrappers.UInt32Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	if n.Int64 < 0 || n.Int64 > math.MaxUint32 {
+		return nil, fmt.Errorf("sqlpb: %d out of range for UInt32Value", n.Int64)
+	}
+	return wrappers.UInt32(uint32(n.Int64)), nil
+}
+
+// UInt32ToNull returns the value wrapped by m as a sql.NullInt64, which is not valid if m is nil.
+func UInt32ToNull(m *wrappers.UInt32Value) sql.NullInt64 {
+	if m == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(m.Value), Valid: true}
+}
+
+// BoolFromNull returns a new BoolValue holding n.Bool, or nil if n is not valid.
+func BoolFromNull(n sql.NullBool) *wrappers.BoolValue {
+	if !n.Valid {
+		return nil
+	}
+	return wrappers.Bool(n.Bool)
+}
+
+// BoolToNull returns the value wrapped by m as a sql.NullBool, which is not valid if m is nil.
+func BoolToNull(m *wrappers.BoolValue) sql.NullBool {
+	if m == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: m.Value, Valid: true}
+}
+
+// StringFromNull returns a new StringValue holding n.String, or nil if n is not valid.
+func StringFromNull(n sql.NullString) *wrappers.StringValue {
+	if !n.Valid {
+		return nil
+	}
+	return wrappers.String(n.String)
+}
+
+// StringToNull returns the value wrapped by m as a sql.NullString, which is not valid if m is nil.
+func StringToNull(m *wrappers.StringValue) sql.NullString {
+	if m == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: m.Value, Valid: true}
+}
@@ -0,0 +1,362 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package validate
+
+// This file implements validation by reflection, from the rules read from
+// the descriptors of messages.
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/descriptor/registry"
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/validate/rules"
+)
+
+// A fieldRules holds the rules of a field, and where its value is found in
+// the Go struct of its message.
+type fieldRules struct {
+	name  string
+	rules *rules.FieldRules
+
+	index int          // index of the Go struct field holding the value
+	oneof reflect.Type // type of the oneof wrapper holding the value, if any
+
+	typ      descpb.FieldDescriptorProto_Type // type of the elements of a repeated or map field
+	repeated bool
+	isMap    bool
+	scalar   bool // a proto3 scalar outside a oneof, present unless zero
+
+	pattern    *regexp.Regexp
+	enumValues map[int32]bool // the values of the enum, if defined_only is set
+}
+
+// A messageRules holds the rules of the fields of a message type, or the
+// error that made them unusable.
+type messageRules struct {
+	fields []*fieldRules
+	err    error
+}
+
+var (
+	rulesMu sync.RWMutex
+	rulesOf = make(map[reflect.Type]*messageRules)
+)
+
+// validateReflect checks msg against the rules in its descriptor. It
+// returns an Errors listing the violations, or another error if the rules
+// themselves are invalid.
+func validateReflect(msg proto.Message) error {
+	dm, ok := msg.(descriptor.Message)
+	if !ok {
+		return nil
+	}
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	mr := rulesFor(dm)
+	if mr.err != nil {
+		return mr.err
+	}
+	var errs Errors
+	for _, f := range mr.fields {
+		errs = f.check(errs, v.Elem())
+	}
+	return errs.Err()
+}
+
+// rulesFor returns the rules of the fields of msg, which are computed
+// once per message type.
+func rulesFor(msg descriptor.Message) *messageRules {
+	t := reflect.TypeOf(msg)
+	rulesMu.RLock()
+	mr, ok := rulesOf[t]
+	rulesMu.RUnlock()
+	if ok {
+		return mr
+	}
+
+	mr = new(messageRules)
+	mr.fields, mr.err = newMessageRules(msg)
+	rulesMu.Lock()
+	rulesOf[t] = mr
+	rulesMu.Unlock()
+	return mr
+}
+
+func newMessageRules(msg descriptor.Message) ([]*fieldRules, error) {
+	fd, md := descriptor.ForMessage(msg)
+	sprops := proto.GetProperties(reflect.TypeOf(msg).Elem())
+	var fields []*fieldRules
+	for _, f := range md.Field {
+		fr := &fieldRules{
+			name:     f.GetName(),
+			typ:      f.GetType(),
+			repeated: f.GetLabel() == descpb.FieldDescriptorProto_LABEL_REPEATED,
+		}
+		if f.Options != nil && proto.HasExtension(f.Options, rules.E_Rules) {
+			x, err := proto.GetExtension(f.Options, rules.E_Rules)
+			if err != nil {
+				return nil, fmt.Errorf("validate: invalid rules on %s.%s: %v", md.GetName(), f.GetName(), err)
+			}
+			fr.rules = x.(*rules.FieldRules)
+		}
+		if fr.rules == nil {
+			if !isMessage(fr.typ) {
+				continue
+			}
+			// The messages the field holds may have rules of their own.
+			fr.rules = new(rules.FieldRules)
+		}
+
+		if oop, ok := sprops.OneofTypes[f.GetName()]; ok {
+			fr.index, fr.oneof = oop.Field, oop.Type
+		} else {
+			fr.index = -1
+			for i, p := range sprops.Prop {
+				if p.Tag == int(f.GetNumber()) {
+					fr.index = i
+				}
+			}
+			if fr.index < 0 {
+				continue
+			}
+		}
+
+		if fr.repeated && isMessage(fr.typ) {
+			if entry := nestedType(md, f.GetTypeName()); entry.GetOptions().GetMapEntry() {
+				fr.isMap = true
+				f = entry.Field[1]
+				fr.typ = f.GetType()
+			}
+		}
+		fr.scalar = fd.GetSyntax() == "proto3" && f.OneofIndex == nil && !fr.repeated && !isMessage(fr.typ)
+
+		if fr.rules.Pattern != nil && fr.typ == descpb.FieldDescriptorProto_TYPE_STRING {
+			re, err := regexp.Compile(fr.rules.GetPattern())
+			if err != nil {
+				return nil, fmt.Errorf("validate: invalid pattern on %s.%s: %v", md.GetName(), fr.name, err)
+			}
+			fr.pattern = re
+		}
+		if fr.rules.GetDefinedOnly() && fr.typ == descpb.FieldDescriptorProto_TYPE_ENUM {
+			_, ed, err := registry.FindEnum(strings.TrimPrefix(f.GetTypeName(), "."))
+			if err != nil {
+				return nil, fmt.Errorf("validate: %s.%s: %v", md.GetName(), fr.name, err)
+			}
+			fr.enumValues = make(map[int32]bool)
+			for _, vd := range ed.Value {
+				fr.enumValues[vd.GetNumber()] = true
+			}
+		}
+		fields = append(fields, fr)
+	}
+	return fields, nil
+}
+
+func isMessage(t descpb.FieldDescriptorProto_Type) bool {
+	return t == descpb.FieldDescriptorProto_TYPE_MESSAGE || t == descpb.FieldDescriptorProto_TYPE_GROUP
+}
+
+// nestedType returns the message type nested in md with the given full
+// name, or nil if there is none. Map entry types are always nested in the
+// message holding the map field.
+func nestedType(md *descpb.DescriptorProto, name string) *descpb.DescriptorProto {
+	name = name[strings.LastIndex(name, ".")+1:]
+	for _, nd := range md.NestedType {
+		if nd.GetName() == name {
+			return nd
+		}
+	}
+	return nil
+}
+
+// check appends to errs the violations of the rules of f in sv, the
+// struct of a message.
+func (f *fieldRules) check(errs Errors, sv reflect.Value) Errors {
+	v := sv.Field(f.index)
+	switch {
+	case f.oneof != nil:
+		if v.IsNil() || v.Elem().Type() != f.oneof {
+			if f.rules.GetRequired() {
+				errs = append(errs, RequiredError(f.name))
+			}
+			return errs
+		}
+		return f.checkValue(errs, f.name, v.Elem().Elem().Field(0), true)
+
+	case f.isMap:
+		if f.rules.GetRequired() && v.Len() == 0 {
+			errs = append(errs, RequiredError(f.name))
+		}
+		errs = f.checkLen(errs, f.name, v.Len(), "elements")
+		keys := v.MapKeys()
+		sortKeys(keys)
+		for _, k := range keys {
+			errs = f.checkValue(errs, Key(f.name, k.Interface()), v.MapIndex(k), false)
+		}
+		return errs
+
+	case f.repeated:
+		if f.rules.GetRequired() && v.Len() == 0 {
+			errs = append(errs, RequiredError(f.name))
+		}
+		errs = f.checkLen(errs, f.name, v.Len(), "elements")
+		for i := 0; i < v.Len(); i++ {
+			errs = f.checkValue(errs, Index(f.name, i), v.Index(i), false)
+		}
+		return errs
+
+	case f.scalar:
+		if f.rules.GetRequired() && isZero(v) {
+			errs = append(errs, RequiredError(f.name))
+		}
+		return f.checkValue(errs, f.name, v, true)
+	}
+
+	// A proto2 scalar, a bytes field or a message field, present if set.
+	if v.IsNil() {
+		if f.rules.GetRequired() {
+			errs = append(errs, RequiredError(f.name))
+		}
+		return errs
+	}
+	if v.Kind() == reflect.Ptr && !isMessage(f.typ) {
+		v = v.Elem()
+	}
+	return f.checkValue(errs, f.name, v, true)
+}
+
+// checkValue appends to errs the violations of the rules of f in v, the
+// value of the field at path or one of its elements. Length rules apply
+// to the value only if it is the whole field.
+func (f *fieldRules) checkValue(errs Errors, path string, v reflect.Value, whole bool) Errors {
+	switch f.typ {
+	case descpb.FieldDescriptorProto_TYPE_MESSAGE, descpb.FieldDescriptorProto_TYPE_GROUP:
+		if v.IsNil() {
+			return errs
+		}
+		return AppendNested(errs, path, v.Interface().(proto.Message))
+	case descpb.FieldDescriptorProto_TYPE_STRING:
+		s := v.String()
+		if f.pattern != nil && !f.pattern.MatchString(s) {
+			errs = append(errs, PatternError(path, f.rules.GetPattern()))
+		}
+		if whole {
+			errs = f.checkLen(errs, path, utf8.RuneCountInString(s), "characters")
+		}
+	case descpb.FieldDescriptorProto_TYPE_BYTES:
+		if whole {
+			errs = f.checkLen(errs, path, v.Len(), "bytes")
+		}
+	case descpb.FieldDescriptorProto_TYPE_BOOL:
+	case descpb.FieldDescriptorProto_TYPE_ENUM:
+		n := int32(v.Int())
+		errs = f.checkRange(errs, path, float64(n))
+		if f.enumValues != nil && !f.enumValues[n] {
+			errs = append(errs, UndefinedError(path, n))
+		}
+	default:
+		var x float64
+		switch v.Kind() {
+		case reflect.Int32, reflect.Int64:
+			x = float64(v.Int())
+		case reflect.Uint32, reflect.Uint64:
+			x = float64(v.Uint())
+		default:
+			x = v.Float()
+		}
+		errs = f.checkRange(errs, path, x)
+	}
+	return errs
+}
+
+func (f *fieldRules) checkRange(errs Errors, path string, x float64) Errors {
+	if f.rules.Min != nil && x < f.rules.GetMin() {
+		errs = append(errs, MinError(path, f.rules.GetMin()))
+	}
+	if f.rules.Max != nil && x > f.rules.GetMax() {
+		errs = append(errs, MaxError(path, f.rules.GetMax()))
+	}
+	return errs
+}
+
+func (f *fieldRules) checkLen(errs Errors, path string, n int, units string) Errors {
+	if f.rules.MinLen != nil && uint64(n) < f.rules.GetMinLen() {
+		errs = append(errs, MinLenError(path, f.rules.GetMinLen(), units))
+	}
+	if f.rules.MaxLen != nil && uint64(n) > f.rules.GetMaxLen() {
+		errs = append(errs, MaxLenError(path, f.rules.GetMaxLen(), units))
+	}
+	return errs
+}
+
+// isZero reports whether v, a proto3 scalar, holds its zero value.
+func isZero(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint32, reflect.Uint64:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	}
+	return v.Len() == 0
+}
+
+// sortKeys sorts the keys of a map field, so that violations are reported
+// in a stable order.
+func sortKeys(keys []reflect.Value) {
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		switch a.Kind() {
+		case reflect.String:
+			return a.String() < b.String()
+		case reflect.Bool:
+			return !a.Bool() && b.Bool()
+		case reflect.Int32, reflect.Int64:
+			return a.Int() < b.Int()
+		}
+		return a.Uint() < b.Uint()
+	})
+}
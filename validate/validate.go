@@ -0,0 +1,189 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package validate checks messages against the constraints that the
+// (golang.protobuf.validate.rules) option, defined in the
+// github.com/golang/protobuf/validate/rules package, sets on their fields:
+//
+//	import "github.com/golang/protobuf/validate/rules/rules.proto";
+//
+//	message Person {
+//	  string email = 1 [(golang.protobuf.validate.rules) = { pattern: "^[^@]+@[^@]+$" }];
+//	  uint32 age = 2 [(golang.protobuf.validate.rules) = { max: 150 }];
+//	  string name = 3 [(golang.protobuf.validate.rules) = { min_len: 1, max_len: 64 }];
+//	  repeated Person friends = 4 [(golang.protobuf.validate.rules) = { max_len: 100 }];
+//	}
+//
+// Validate reads the rules from the descriptor of a message and reports
+// every violation in it and in the messages it holds:
+//
+//	if err := validate.Validate(person); err != nil {
+//		// err is an Errors, with one Error per violation, such as
+//		// "friends[1].name: must have at least 1 characters".
+//	}
+//
+// Rules that do not apply to the type of a field, such as pattern on an
+// integer field, are ignored.
+//
+// protoc-gen-go generates a Validate method performing the same checks
+// without reflection when it is run with the validate plugin enabled:
+//
+//	protoc --go_out=plugins=validate:. person.proto
+//
+// Validate calls that method if a message has one.
+package validate
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// A Validator is a message with a method checking its own rules, such as
+// the Validate method generated by the validate plugin of protoc-gen-go.
+type Validator interface {
+	Validate() error
+}
+
+// Validate checks msg and the messages it holds against the rules set on
+// their fields. It returns nil if they satisfy them, or an Errors listing
+// every violation otherwise. A nil message satisfies any rules. If the
+// rules of a message cannot be used, such as a pattern that is not a valid
+// regular expression, Validate returns an error describing them instead.
+func Validate(msg proto.Message) error {
+	if v, ok := msg.(Validator); ok {
+		return v.Validate()
+	}
+	return validateReflect(msg)
+}
+
+// An Error is a violation of a rule.
+type Error struct {
+	// Field is the path of the field from the message validated, such as
+	// "items[2].name" or `labels["color"]`.
+	Field string
+	Msg   string
+}
+
+func (e *Error) Error() string {
+	return e.Field + ": " + e.Msg
+}
+
+// Errors lists the violations found in a message, in field order.
+type Errors []*Error
+
+func (errs Errors) Error() string {
+	switch len(errs) {
+	case 0:
+		return "no errors"
+	case 1:
+		return errs[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", errs[0], len(errs)-1)
+}
+
+// Err returns errs, or nil if it is empty.
+func (errs Errors) Err() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// AppendNested validates msg, the value of the field at path, and appends
+// the violations found to errs, with their paths prefixed by path.
+func AppendNested(errs Errors, path string, msg proto.Message) Errors {
+	switch err := Validate(msg).(type) {
+	case nil:
+	case Errors:
+		for _, e := range err {
+			errs = append(errs, &Error{Field: path + "." + e.Field, Msg: e.Msg})
+		}
+	default:
+		errs = append(errs, &Error{Field: path, Msg: err.Error()})
+	}
+	return errs
+}
+
+// Index returns the path of the element at index i of the repeated field
+// at path.
+func Index(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+// Key returns the path of the value for key in the map field at path.
+func Key(path string, key interface{}) string {
+	if s, ok := key.(string); ok {
+		return fmt.Sprintf("%s[%q]", path, s)
+	}
+	return fmt.Sprintf("%s[%v]", path, key)
+}
+
+// The functions below describe the violations of each rule. The code
+// generated by protoc-gen-go calls them, so that its errors are those
+// reported by reflection.
+
+// RequiredError reports that the field at path is not set.
+func RequiredError(path string) *Error {
+	return &Error{Field: path, Msg: "is required"}
+}
+
+// MinError reports that the field at path is smaller than min.
+func MinError(path string, min float64) *Error {
+	return &Error{Field: path, Msg: fmt.Sprintf("must be at least %v", min)}
+}
+
+// MaxError reports that the field at path is larger than max.
+func MaxError(path string, max float64) *Error {
+	return &Error{Field: path, Msg: fmt.Sprintf("must be at most %v", max)}
+}
+
+// PatternError reports that the field at path does not match pattern.
+func PatternError(path, pattern string) *Error {
+	return &Error{Field: path, Msg: fmt.Sprintf("must match %q", pattern)}
+}
+
+// MinLenError reports that the field at path is shorter than n units,
+// such as "characters", "bytes" or "elements".
+func MinLenError(path string, n uint64, units string) *Error {
+	return &Error{Field: path, Msg: fmt.Sprintf("must have at least %d %s", n, units)}
+}
+
+// MaxLenError reports that the field at path is longer than n units.
+func MaxLenError(path string, n uint64, units string) *Error {
+	return &Error{Field: path, Msg: fmt.Sprintf("must have at most %d %s", n, units)}
+}
+
+// UndefinedError reports that the enum field at path holds v, which its
+// enum does not define.
+func UndefinedError(path string, v int32) *Error {
+	return &Error{Field: path, Msg: fmt.Sprintf("has undefined value %d", v)}
+}
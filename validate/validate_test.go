@@ -0,0 +1,281 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package validate_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	descpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/validate"
+	"github.com/golang/protobuf/validate/rules"
+	pb "github.com/golang/protobuf/validate/validate_test_proto"
+)
+
+func validAccount() *pb.Account {
+	return &pb.Account{
+		User:    "bob",
+		Key:     []byte{1},
+		Status:  pb.Status_ACTIVE,
+		Contact: &pb.Account_Mobile{Mobile: &pb.Account_Phone{Number: "12345"}},
+	}
+}
+
+var validateTests = []struct {
+	desc string
+	msg  proto.Message
+	want []string
+}{
+	{
+		desc: "valid proto2 message",
+		msg: &pb.Order{
+			Id:       proto.String("ABC-1"),
+			Customer: &pb.Customer{Name: proto.String("Ann")},
+			Items:    []*pb.Item{{Sku: proto.Uint32(1)}},
+			Payment:  &pb.Order_Account{Account: 7},
+		},
+	},
+	{
+		desc: "empty proto2 message",
+		msg:  &pb.Order{},
+		want: []string{
+			"id: is required",
+			"customer: is required",
+			"items: must have at least 1 elements",
+			"account: is required",
+		},
+	},
+	{
+		desc: "invalid proto2 message",
+		msg: &pb.Order{
+			Id:        proto.String("abc"),
+			Quantity:  proto.Int32(0),
+			Discount:  proto.Float64(0.75),
+			Signature: []byte{1},
+			Color:     pb.Color(5).Enum(),
+			Customer:  &pb.Customer{Name: proto.String(""), Favorites: []pb.Color{pb.Color_GREEN, pb.Color_RED, 3}},
+			Items:     []*pb.Item{{}, {Sku: proto.Uint32(1), Price: proto.Float32(-1)}},
+			Tags:      []string{"ok", "Not", "x"},
+			Counts:    map[string]int64{"b": 11, "a": 12, "c": 1},
+			BySku:     map[int32]*pb.Item{2: {}, 1: {Sku: proto.Uint32(10000)}},
+			Payment:   &pb.Order_Card{Card: "1234"},
+			Note:      &pb.Order_Note{Text: proto.String("too long")},
+		},
+		want: []string{
+			`id: must match "^[A-Z]{3}-[0-9]+$"`,
+			"quantity: must be at least 1",
+			"discount: must be at most 0.5",
+			"signature: must have at least 4 bytes",
+			"color: has undefined value 5",
+			"customer.name: must have at least 1 characters",
+			"customer.favorites[1]: must be at least 1",
+			"customer.favorites[2]: has undefined value 3",
+			"items[0].sku: is required",
+			"items[1].price: must be at least 0.01",
+			"tags: must have at most 2 elements",
+			`tags[1]: must match "^[a-z]+$"`,
+			`counts["a"]: must be at most 10`,
+			`counts["b"]: must be at most 10`,
+			"by_sku[1].sku: must be at most 9999",
+			"by_sku[2].sku: is required",
+			"card: must have at least 12 characters",
+			"account: is required",
+			"note.text: must have at most 5 characters",
+		},
+	},
+	{
+		desc: "valid proto3 message",
+		msg:  validAccount(),
+	},
+	{
+		desc: "empty proto3 message",
+		msg:  &pb.Account{},
+		want: []string{
+			"user: is required",
+			`user: must match "^[a-z]+$"`,
+			"user: must have at least 3 characters",
+			"key: is required",
+			"status: is required",
+			"mobile: is required",
+		},
+	},
+	{
+		desc: "invalid proto3 message",
+		msg: &pb.Account{
+			User:    "Bob",
+			Balance: -101,
+			Status:  7,
+			Parent:  &pb.Account{User: "x"},
+			Flags:   map[string]pb.Status{"y": pb.Status_ACTIVE, "x": 9},
+			Prefs:   map[bool]string{true: "on", false: "maybe"},
+			Scores:  []float64{0.5, 2},
+			Contact: &pb.Account_Phone_{Phone: "12a"},
+		},
+		want: []string{
+			`user: must match "^[a-z]+$"`,
+			"balance: must be at least -100",
+			"key: is required",
+			"status: has undefined value 7",
+			"parent.user: must have at least 3 characters",
+			"parent.key: is required",
+			"parent.status: is required",
+			"parent.mobile: is required",
+			`flags["x"]: has undefined value 9`,
+			"prefs: must have at most 1 elements",
+			`prefs[false]: must match "^(on|off)$"`,
+			"scores[1]: must be at most 1",
+			`phone: must match "^[0-9]+$"`,
+			"mobile: is required",
+		},
+	},
+	{
+		desc: "oneof message",
+		msg: func() proto.Message {
+			a := validAccount()
+			a.Contact = &pb.Account_Mobile{Mobile: &pb.Account_Phone{Number: "1"}}
+			return a
+		}(),
+		want: []string{"mobile.number: must have at least 5 characters"},
+	},
+	{
+		desc: "message without a Validate method",
+		msg:  &pb.Unchecked{Account: &pb.Account{User: "bob", Status: pb.Status_CLOSED}},
+		want: []string{
+			"validate: must have at least 1 characters",
+			"account.key: is required",
+			"account.mobile: is required",
+		},
+	},
+	{
+		desc: "nil message",
+		msg:  (*pb.Order)(nil),
+	},
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range validateTests {
+		if got := violations(t, validate.Validate(tt.msg)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Validate reported\n%q\nwant\n%q", tt.desc, got, tt.want)
+		}
+		if got := violations(t, validate.ValidateReflect(tt.msg)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: validation by reflection reported\n%q\nwant\n%q", tt.desc, got, tt.want)
+		}
+	}
+}
+
+// violations returns the violations listed by err, which must be nil or
+// an Errors.
+func violations(t *testing.T, err error) []string {
+	if err == nil {
+		return nil
+	}
+	errs, ok := err.(validate.Errors)
+	if !ok {
+		t.Fatalf("got error %v of type %T, want validate.Errors", err, err)
+	}
+	var msgs []string
+	for _, e := range errs {
+		msgs = append(msgs, e.Error())
+	}
+	return msgs
+}
+
+func TestErrors(t *testing.T) {
+	err := validate.Validate(&pb.Item{Price: proto.Float32(-1)})
+	if got, want := err.Error(), "sku: is required (and 1 more errors)"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if err := (validate.Errors{}).Err(); err != nil {
+		t.Errorf("Err() of no errors = %v, want nil", err)
+	}
+}
+
+// badRules is a message whose only field has a pattern that is not a
+// valid regular expression.
+type badRules struct {
+	S string `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *badRules) Reset()                    { *m = badRules{} }
+func (m *badRules) String() string            { return proto.CompactTextString(m) }
+func (*badRules) ProtoMessage()               {}
+func (*badRules) Descriptor() ([]byte, []int) { return badRulesDescriptor(), []int{0} }
+
+func badRulesDescriptor() []byte {
+	opts := new(descpb.FieldOptions)
+	if err := proto.SetExtension(opts, rules.E_Rules, &rules.FieldRules{Pattern: proto.String("(")}); err != nil {
+		panic(err)
+	}
+	fd := &descpb.FileDescriptorProto{
+		Name:    proto.String("bad_rules.proto"),
+		Package: proto.String("validate_test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descpb.DescriptorProto{{
+			Name: proto.String("BadRules"),
+			Field: []*descpb.FieldDescriptorProto{{
+				Name:    proto.String("s"),
+				Number:  proto.Int32(1),
+				Label:   descpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:    descpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				Options: opts,
+			}},
+		}},
+	}
+	b, err := proto.Marshal(fd)
+	if err != nil {
+		panic(err)
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write(b)
+	w.Close()
+	return buf.Bytes()
+}
+
+func TestInvalidRules(t *testing.T) {
+	for i := 0; i < 2; i++ {
+		err := validate.Validate(&badRules{S: "x"})
+		if err == nil || !strings.Contains(err.Error(), "invalid pattern on BadRules.s") {
+			t.Errorf("Validate of a message with an invalid pattern = %v, want an invalid pattern error", err)
+		}
+	}
+	// The error is reported at the path of a field holding the message.
+	var errs validate.Errors
+	errs = validate.AppendNested(errs, "bad", &badRules{})
+	if len(errs) != 1 || errs[0].Field != "bad" {
+		t.Errorf("AppendNested of a message with an invalid pattern = %v, want one error on bad", errs)
+	}
+}
@@ -0,0 +1,504 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: validate/validate_test_proto/proto3.proto
+
+package validate_test_proto
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	validate "github.com/golang/protobuf/validate"
+	_ "github.com/golang/protobuf/validate/rules"
+	math "math"
+	regexp "regexp"
+	sort "sort"
+	utf8 "unicode/utf8"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Status int32
+
+const (
+	Status_UNKNOWN Status = 0
+	Status_ACTIVE  Status = 1
+	Status_CLOSED  Status = 2
+)
+
+var Status_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "ACTIVE",
+	2: "CLOSED",
+}
+
+var Status_value = map[string]int32{
+	"UNKNOWN": 0,
+	"ACTIVE":  1,
+	"CLOSED":  2,
+}
+
+func (x Status) String() string {
+	return proto.EnumName(Status_name, int32(x))
+}
+
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5fc4746fd8623865, []int{0}
+}
+
+type Account struct {
+	User    string            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Balance int64             `protobuf:"zigzag64,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Key     []byte            `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Status  Status            `protobuf:"varint,4,opt,name=status,proto3,enum=validate_test.Status" json:"status,omitempty"`
+	Parent  *Account          `protobuf:"bytes,5,opt,name=parent,proto3" json:"parent,omitempty"`
+	Flags   map[string]Status `protobuf:"bytes,6,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=validate_test.Status"`
+	Prefs   map[bool]string   `protobuf:"bytes,7,rep,name=prefs,proto3" json:"prefs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Scores  []float64         `protobuf:"fixed64,8,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	// Types that are valid to be assigned to Contact:
+	//	*Account_Phone_
+	//	*Account_Mobile
+	Contact              isAccount_Contact `protobuf_oneof:"contact"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fc4746fd8623865, []int{0}
+}
+
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account.Unmarshal(m, b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return xxx_messageInfo_Account.Size(m)
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *Account) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *Account) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *Account) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (m *Account) GetParent() *Account {
+	if m != nil {
+		return m.Parent
+	}
+	return nil
+}
+
+func (m *Account) GetFlags() map[string]Status {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+func (m *Account) GetPrefs() map[bool]string {
+	if m != nil {
+		return m.Prefs
+	}
+	return nil
+}
+
+func (m *Account) GetScores() []float64 {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+type isAccount_Contact interface {
+	isAccount_Contact()
+}
+
+type Account_Phone_ struct {
+	Phone string `protobuf:"bytes,9,opt,name=phone,proto3,oneof"`
+}
+
+type Account_Mobile struct {
+	Mobile *Account_Phone `protobuf:"bytes,10,opt,name=mobile,proto3,oneof"`
+}
+
+func (*Account_Phone_) isAccount_Contact() {}
+
+func (*Account_Mobile) isAccount_Contact() {}
+
+func (m *Account) GetContact() isAccount_Contact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (m *Account) GetPhone() string {
+	if x, ok := m.GetContact().(*Account_Phone_); ok {
+		return x.Phone
+	}
+	return ""
+}
+
+func (m *Account) GetMobile() *Account_Phone {
+	if x, ok := m.GetContact().(*Account_Mobile); ok {
+		return x.Mobile
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Account) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Account_OneofMarshaler, _Account_OneofUnmarshaler, _Account_OneofSizer, []interface{}{
+		(*Account_Phone_)(nil),
+		(*Account_Mobile)(nil),
+	}
+}
+
+func _Account_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Account)
+	// contact
+	switch x := m.Contact.(type) {
+	case *Account_Phone_:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Phone)
+	case *Account_Mobile:
+		b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Mobile); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("Account.Contact has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Account_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Account)
+	switch tag {
+	case 9: // contact.phone
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Contact = &Account_Phone_{x}
+		return true, err
+	case 10: // contact.mobile
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Account_Phone)
+		err := b.DecodeMessage(msg)
+		m.Contact = &Account_Mobile{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Account_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Account)
+	// contact
+	switch x := m.Contact.(type) {
+	case *Account_Phone_:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Phone)))
+		n += len(x.Phone)
+	case *Account_Mobile:
+		s := proto.Size(x.Mobile)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// The wrapper type of phone is Account_Phone_, as the nested message
+// takes the name Account_Phone.
+type Account_Phone struct {
+	Number               string   `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account_Phone) Reset()         { *m = Account_Phone{} }
+func (m *Account_Phone) String() string { return proto.CompactTextString(m) }
+func (*Account_Phone) ProtoMessage()    {}
+func (*Account_Phone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fc4746fd8623865, []int{0, 2}
+}
+
+func (m *Account_Phone) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_Phone.Unmarshal(m, b)
+}
+func (m *Account_Phone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_Phone.Marshal(b, m, deterministic)
+}
+func (m *Account_Phone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_Phone.Merge(m, src)
+}
+func (m *Account_Phone) XXX_Size() int {
+	return xxx_messageInfo_Account_Phone.Size(m)
+}
+func (m *Account_Phone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_Phone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_Phone proto.InternalMessageInfo
+
+func (m *Account_Phone) GetNumber() string {
+	if m != nil {
+		return m.Number
+	}
+	return ""
+}
+
+// Unchecked has no Validate method, since its Go struct has a field named
+// Validate. The validate package checks it by reflection.
+type Unchecked struct {
+	Validate             string   `protobuf:"bytes,1,opt,name=validate,proto3" json:"validate,omitempty"`
+	Account              *Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Unchecked) Reset()         { *m = Unchecked{} }
+func (m *Unchecked) String() string { return proto.CompactTextString(m) }
+func (*Unchecked) ProtoMessage()    {}
+func (*Unchecked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fc4746fd8623865, []int{1}
+}
+
+func (m *Unchecked) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Unchecked.Unmarshal(m, b)
+}
+func (m *Unchecked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Unchecked.Marshal(b, m, deterministic)
+}
+func (m *Unchecked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Unchecked.Merge(m, src)
+}
+func (m *Unchecked) XXX_Size() int {
+	return xxx_messageInfo_Unchecked.Size(m)
+}
+func (m *Unchecked) XXX_DiscardUnknown() {
+	xxx_messageInfo_Unchecked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Unchecked proto.InternalMessageInfo
+
+func (m *Unchecked) GetValidate() string {
+	if m != nil {
+		return m.Validate
+	}
+	return ""
+}
+
+func (m *Unchecked) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("validate_test.Status", Status_name, Status_value)
+	proto.RegisterType((*Account)(nil), "validate_test.Account")
+	proto.RegisterMapType((map[string]Status)(nil), "validate_test.Account.FlagsEntry")
+	proto.RegisterMapType((map[bool]string)(nil), "validate_test.Account.PrefsEntry")
+	proto.RegisterType((*Account_Phone)(nil), "validate_test.Account.Phone")
+	proto.RegisterType((*Unchecked)(nil), "validate_test.Unchecked")
+}
+
+func init() {
+	proto.RegisterFile("validate/validate_test_proto/proto3.proto", fileDescriptor_5fc4746fd8623865)
+}
+
+var fileDescriptor_5fc4746fd8623865 = []byte{
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xed, 0x4e, 0xd4, 0x4c,
+	0x14, 0xc7, 0x3b, 0xbb, 0xb4, 0xdd, 0x1e, 0x5e, 0xb2, 0xcc, 0xf3, 0xa0, 0x4d, 0xa3, 0x49, 0x21,
+	0x88, 0x45, 0xb2, 0xdd, 0x0d, 0x26, 0x66, 0xd5, 0xa8, 0xa1, 0x88, 0xa1, 0xa2, 0x60, 0x06, 0xd1,
+	0xa8, 0x01, 0x9c, 0x96, 0xd9, 0x97, 0xd0, 0x6d, 0x37, 0xed, 0x94, 0x04, 0xe3, 0x95, 0xf9, 0xc9,
+	0x6b, 0xe9, 0x15, 0x78, 0x09, 0xa6, 0x9d, 0xee, 0xb2, 0x18, 0xd8, 0x7e, 0xe8, 0x4c, 0xce, 0xfc,
+	0xfe, 0x27, 0x67, 0xce, 0x7f, 0x0e, 0xac, 0x5f, 0xd0, 0xa0, 0x7f, 0x46, 0x39, 0x6b, 0x8e, 0x36,
+	0xa7, 0x9c, 0x25, 0xfc, 0x74, 0x18, 0x47, 0x3c, 0x6a, 0x16, 0xff, 0xc7, 0x76, 0xb1, 0xe0, 0xf9,
+	0x6b, 0x84, 0x61, 0x8c, 0x95, 0x71, 0x1a, 0xb0, 0x44, 0xfc, 0x05, 0xba, 0xf2, 0x4b, 0x06, 0x75,
+	0xcb, 0xf7, 0xa3, 0x34, 0xe4, 0xf8, 0x21, 0xcc, 0xa4, 0x09, 0x8b, 0x75, 0x64, 0x22, 0x4b, 0x73,
+	0xfe, 0xcb, 0xdc, 0xba, 0x51, 0x3b, 0xf9, 0x46, 0x1b, 0x3f, 0x8e, 0x37, 0x56, 0x4d, 0x64, 0x55,
+	0x5b, 0x35, 0x52, 0x00, 0x78, 0x0d, 0x54, 0x8f, 0x06, 0x34, 0xf4, 0x99, 0x5e, 0x31, 0x91, 0x85,
+	0x9d, 0xb9, 0xcc, 0xd5, 0x34, 0xa9, 0xf8, 0xbe, 0xfc, 0x26, 0xa3, 0x43, 0x7c, 0x17, 0xaa, 0xe7,
+	0xec, 0x52, 0xaf, 0x9a, 0xc8, 0x9a, 0x73, 0xe4, 0xcc, 0xad, 0x98, 0x88, 0xe4, 0x11, 0xfc, 0x04,
+	0x94, 0x84, 0x53, 0x9e, 0x26, 0xfa, 0x8c, 0x89, 0xac, 0x85, 0xcd, 0x25, 0xfb, 0x5a, 0xc5, 0xf6,
+	0x61, 0x71, 0xe8, 0xa8, 0x99, 0x3b, 0x63, 0xa2, 0x36, 0x22, 0x25, 0x8d, 0x6d, 0x50, 0x86, 0x34,
+	0x66, 0x21, 0xd7, 0x65, 0x13, 0x59, 0xb3, 0x9b, 0x77, 0xfe, 0xd1, 0x95, 0x37, 0x21, 0x25, 0x85,
+	0x5f, 0x82, 0xdc, 0x09, 0x68, 0x37, 0xd1, 0x15, 0xb3, 0x6a, 0xcd, 0x6e, 0x2e, 0xdf, 0x8c, 0xdb,
+	0x6f, 0x72, 0x66, 0x27, 0xe4, 0xf1, 0x65, 0x51, 0x65, 0x1b, 0x11, 0x21, 0xc3, 0x6f, 0x41, 0x1e,
+	0xc6, 0xac, 0x93, 0xe8, 0xea, 0x54, 0xfd, 0x87, 0x9c, 0x11, 0xfa, 0xc5, 0xcc, 0x5d, 0x30, 0xe0,
+	0xc4, 0x8a, 0xc2, 0x9f, 0x51, 0xa7, 0xb3, 0xbe, 0xda, 0x42, 0x44, 0xa4, 0xc0, 0x0d, 0x50, 0x12,
+	0x3f, 0x8a, 0x59, 0xa2, 0xd7, 0xcc, 0xaa, 0x85, 0x9c, 0xa5, 0xcc, 0xc5, 0x65, 0xcf, 0x24, 0x69,
+	0x51, 0x2c, 0x7f, 0x5e, 0x91, 0x12, 0xc2, 0x0f, 0x40, 0x1e, 0xf6, 0xa2, 0x90, 0xe9, 0x5a, 0xe1,
+	0xc6, 0x7c, 0xe6, 0x42, 0xee, 0x46, 0xab, 0xf1, 0xf4, 0x78, 0x63, 0x75, 0x57, 0x22, 0xe2, 0x14,
+	0xbf, 0x00, 0x65, 0x10, 0x79, 0xfd, 0x80, 0xe9, 0x50, 0x74, 0xe4, 0xde, 0x6d, 0x25, 0xe6, 0x74,
+	0xe9, 0xc1, 0xae, 0x44, 0x4a, 0x91, 0x71, 0x00, 0x70, 0x75, 0x79, 0x5c, 0x17, 0x7e, 0x15, 0xfe,
+	0x0b, 0xa3, 0x36, 0x40, 0xbe, 0xa0, 0x41, 0x2a, 0x7c, 0xbe, 0xcd, 0x27, 0x22, 0x98, 0x67, 0x95,
+	0x36, 0x32, 0xda, 0x00, 0x57, 0xdd, 0x98, 0x4c, 0x58, 0x13, 0x09, 0xff, 0x9f, 0x4c, 0xa8, 0x4d,
+	0x2a, 0xd7, 0x40, 0x2e, 0x8a, 0xc4, 0xf7, 0x41, 0x09, 0xd3, 0x81, 0x37, 0x7e, 0x88, 0x79, 0xd1,
+	0x96, 0x4c, 0xca, 0xa0, 0xa3, 0x81, 0xea, 0x47, 0x21, 0xa7, 0x3e, 0x5f, 0xf9, 0x0e, 0xda, 0x51,
+	0xe8, 0xf7, 0x98, 0x7f, 0xce, 0xce, 0xf0, 0x32, 0xd4, 0x46, 0xc5, 0x4d, 0x0a, 0x11, 0x19, 0x87,
+	0x71, 0x0b, 0x54, 0x2a, 0xfa, 0xa1, 0x57, 0xa6, 0xbe, 0x9f, 0x11, 0xf6, 0xa8, 0x01, 0x8a, 0xb8,
+	0x23, 0x9e, 0x05, 0xf5, 0x68, 0x7f, 0x6f, 0xff, 0xe0, 0xf3, 0x7e, 0x5d, 0xc2, 0x00, 0xca, 0xd6,
+	0xf6, 0x47, 0xf7, 0xd3, 0x4e, 0x1d, 0xe5, 0xfb, 0xed, 0x77, 0x07, 0x87, 0x3b, 0xaf, 0xeb, 0x15,
+	0xe7, 0xfd, 0xd7, 0xbd, 0x6e, 0x9f, 0xf7, 0x52, 0xcf, 0xf6, 0xa3, 0x41, 0xb3, 0x1b, 0x05, 0x34,
+	0xec, 0x8a, 0xd1, 0xf4, 0xd2, 0x4e, 0x73, 0xda, 0x00, 0x3f, 0xbf, 0x21, 0xe6, 0x29, 0x62, 0xaa,
+	0xff, 0x0e, 0x00, 0xd4, 0x34, 0x85, 0x14, 0xfb, 0x03, 0x00, 0x00,
+}
+
+// Validate checks m against the rules set on its fields by the (golang.protobuf.validate.rules) option.
+func (m *Account) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var errs validate.Errors
+	if m.User == "" {
+		errs = append(errs, validate.RequiredError("user"))
+	}
+	if !validatePattern_Account_User.MatchString(m.User) {
+		errs = append(errs, validate.PatternError("user", "^[a-z]+$"))
+	}
+	if uint64(utf8.RuneCountInString(m.User)) < 3 {
+		errs = append(errs, validate.MinLenError("user", 3, "characters"))
+	}
+	if uint64(utf8.RuneCountInString(m.User)) > 8 {
+		errs = append(errs, validate.MaxLenError("user", 8, "characters"))
+	}
+	if float64(m.Balance) < -100 {
+		errs = append(errs, validate.MinError("balance", -100))
+	}
+	if len(m.Key) == 0 {
+		errs = append(errs, validate.RequiredError("key"))
+	}
+	if m.Status == 0 {
+		errs = append(errs, validate.RequiredError("status"))
+	}
+	if _, ok := Status_name[int32(m.Status)]; !ok {
+		errs = append(errs, validate.UndefinedError("status", int32(m.Status)))
+	}
+	if m.Parent != nil {
+		errs = validate.AppendNested(errs, "parent", m.Parent)
+	}
+	if len(m.Flags) > 0 {
+		keys := make([]string, 0, len(m.Flags))
+		for k := range m.Flags {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			v := m.Flags[k]
+			if _, ok := Status_name[int32(v)]; !ok {
+				errs = append(errs, validate.UndefinedError(validate.Key("flags", k), int32(v)))
+			}
+		}
+	}
+	if uint64(len(m.Prefs)) > 1 {
+		errs = append(errs, validate.MaxLenError("prefs", 1, "elements"))
+	}
+	if len(m.Prefs) > 0 {
+		keys := make([]bool, 0, len(m.Prefs))
+		for k := range m.Prefs {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return !keys[i] && keys[j] })
+		for _, k := range keys {
+			v := m.Prefs[k]
+			if !validatePattern_Account_Prefs.MatchString(v) {
+				errs = append(errs, validate.PatternError(validate.Key("prefs", k), "^(on|off)$"))
+			}
+		}
+	}
+	for i, v := range m.Scores {
+		if float64(v) < 0 {
+			errs = append(errs, validate.MinError(validate.Index("scores", i), 0))
+		}
+		if float64(v) > 1 {
+			errs = append(errs, validate.MaxError(validate.Index("scores", i), 1))
+		}
+	}
+	if x, ok := m.Contact.(*Account_Phone_); ok {
+		if !validatePattern_Account_Phone.MatchString(x.Phone) {
+			errs = append(errs, validate.PatternError("phone", "^[0-9]+$"))
+		}
+	}
+	if x, ok := m.Contact.(*Account_Mobile); ok {
+		errs = validate.AppendNested(errs, "mobile", x.Mobile)
+	} else {
+		errs = append(errs, validate.RequiredError("mobile"))
+	}
+	return errs.Err()
+}
+
+var validatePattern_Account_User = regexp.MustCompile("^[a-z]+$")
+
+var validatePattern_Account_Prefs = regexp.MustCompile("^(on|off)$")
+
+var validatePattern_Account_Phone = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks m against the rules set on its fields by the (golang.protobuf.validate.rules) option.
+func (m *Account_Phone) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var errs validate.Errors
+	if uint64(utf8.RuneCountInString(m.Number)) < 5 {
+		errs = append(errs, validate.MinLenError("number", 5, "characters"))
+	}
+	return errs.Err()
+}
@@ -0,0 +1,666 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: validate/validate_test_proto/test.proto
+
+package validate_test_proto
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	validate "github.com/golang/protobuf/validate"
+	_ "github.com/golang/protobuf/validate/rules"
+	math "math"
+	regexp "regexp"
+	sort "sort"
+	utf8 "unicode/utf8"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Color int32
+
+const (
+	Color_RED   Color = 0
+	Color_GREEN Color = 1
+	Color_BLUE  Color = 2
+)
+
+var Color_name = map[int32]string{
+	0: "RED",
+	1: "GREEN",
+	2: "BLUE",
+}
+
+var Color_value = map[string]int32{
+	"RED":   0,
+	"GREEN": 1,
+	"BLUE":  2,
+}
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	return proto.EnumName(Color_name, int32(x))
+}
+
+func (x *Color) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Color_value, data, "Color")
+	if err != nil {
+		return err
+	}
+	*x = Color(value)
+	return nil
+}
+
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d6773fa029456904, []int{0}
+}
+
+type Order struct {
+	Id        *string          `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Quantity  *int32           `protobuf:"varint,2,opt,name=quantity" json:"quantity,omitempty"`
+	Discount  *float64         `protobuf:"fixed64,3,opt,name=discount" json:"discount,omitempty"`
+	Signature []byte           `protobuf:"bytes,4,opt,name=signature" json:"signature,omitempty"`
+	Color     *Color           `protobuf:"varint,5,opt,name=color,enum=validate_test.Color" json:"color,omitempty"`
+	Customer  *Customer        `protobuf:"bytes,6,opt,name=customer" json:"customer,omitempty"`
+	Items     []*Item          `protobuf:"bytes,7,rep,name=items" json:"items,omitempty"`
+	Tags      []string         `protobuf:"bytes,8,rep,name=tags" json:"tags,omitempty"`
+	Counts    map[string]int64 `protobuf:"bytes,9,rep,name=counts" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	BySku     map[int32]*Item  `protobuf:"bytes,10,rep,name=by_sku,json=bySku" json:"by_sku,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Payment:
+	//	*Order_Card
+	//	*Order_Account
+	Payment              isOrder_Payment `protobuf_oneof:"payment"`
+	Note                 *Order_Note     `protobuf:"group,13,opt,name=Note,json=note" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Order) Reset()         { *m = Order{} }
+func (m *Order) String() string { return proto.CompactTextString(m) }
+func (*Order) ProtoMessage()    {}
+func (*Order) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6773fa029456904, []int{0}
+}
+
+func (m *Order) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Order.Unmarshal(m, b)
+}
+func (m *Order) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Order.Marshal(b, m, deterministic)
+}
+func (m *Order) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order.Merge(m, src)
+}
+func (m *Order) XXX_Size() int {
+	return xxx_messageInfo_Order.Size(m)
+}
+func (m *Order) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Order proto.InternalMessageInfo
+
+func (m *Order) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
+}
+
+func (m *Order) GetQuantity() int32 {
+	if m != nil && m.Quantity != nil {
+		return *m.Quantity
+	}
+	return 0
+}
+
+func (m *Order) GetDiscount() float64 {
+	if m != nil && m.Discount != nil {
+		return *m.Discount
+	}
+	return 0
+}
+
+func (m *Order) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *Order) GetColor() Color {
+	if m != nil && m.Color != nil {
+		return *m.Color
+	}
+	return Color_RED
+}
+
+func (m *Order) GetCustomer() *Customer {
+	if m != nil {
+		return m.Customer
+	}
+	return nil
+}
+
+func (m *Order) GetItems() []*Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Order) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Order) GetCounts() map[string]int64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *Order) GetBySku() map[int32]*Item {
+	if m != nil {
+		return m.BySku
+	}
+	return nil
+}
+
+type isOrder_Payment interface {
+	isOrder_Payment()
+}
+
+type Order_Card struct {
+	Card string `protobuf:"bytes,11,opt,name=card,oneof"`
+}
+
+type Order_Account struct {
+	Account uint64 `protobuf:"varint,12,opt,name=account,oneof"`
+}
+
+func (*Order_Card) isOrder_Payment() {}
+
+func (*Order_Account) isOrder_Payment() {}
+
+func (m *Order) GetPayment() isOrder_Payment {
+	if m != nil {
+		return m.Payment
+	}
+	return nil
+}
+
+func (m *Order) GetCard() string {
+	if x, ok := m.GetPayment().(*Order_Card); ok {
+		return x.Card
+	}
+	return ""
+}
+
+func (m *Order) GetAccount() uint64 {
+	if x, ok := m.GetPayment().(*Order_Account); ok {
+		return x.Account
+	}
+	return 0
+}
+
+func (m *Order) GetNote() *Order_Note {
+	if m != nil {
+		return m.Note
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Order) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Order_OneofMarshaler, _Order_OneofUnmarshaler, _Order_OneofSizer, []interface{}{
+		(*Order_Card)(nil),
+		(*Order_Account)(nil),
+	}
+}
+
+func _Order_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Order)
+	// payment
+	switch x := m.Payment.(type) {
+	case *Order_Card:
+		b.EncodeVarint(11<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Card)
+	case *Order_Account:
+		b.EncodeVarint(12<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.Account))
+	case nil:
+	default:
+		return fmt.Errorf("Order.Payment has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Order_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Order)
+	switch tag {
+	case 11: // payment.card
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Payment = &Order_Card{x}
+		return true, err
+	case 12: // payment.account
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Payment = &Order_Account{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Order_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Order)
+	// payment
+	switch x := m.Payment.(type) {
+	case *Order_Card:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Card)))
+		n += len(x.Card)
+	case *Order_Account:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.Account))
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Order_Note struct {
+	Text                 *string  `protobuf:"bytes,14,opt,name=text" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Order_Note) Reset()         { *m = Order_Note{} }
+func (m *Order_Note) String() string { return proto.CompactTextString(m) }
+func (*Order_Note) ProtoMessage()    {}
+func (*Order_Note) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6773fa029456904, []int{0, 2}
+}
+
+func (m *Order_Note) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Order_Note.Unmarshal(m, b)
+}
+func (m *Order_Note) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Order_Note.Marshal(b, m, deterministic)
+}
+func (m *Order_Note) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order_Note.Merge(m, src)
+}
+func (m *Order_Note) XXX_Size() int {
+	return xxx_messageInfo_Order_Note.Size(m)
+}
+func (m *Order_Note) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order_Note.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Order_Note proto.InternalMessageInfo
+
+func (m *Order_Note) GetText() string {
+	if m != nil && m.Text != nil {
+		return *m.Text
+	}
+	return ""
+}
+
+type Customer struct {
+	Name                 *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Favorites            []Color  `protobuf:"varint,2,rep,name=favorites,enum=validate_test.Color" json:"favorites,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Customer) Reset()         { *m = Customer{} }
+func (m *Customer) String() string { return proto.CompactTextString(m) }
+func (*Customer) ProtoMessage()    {}
+func (*Customer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6773fa029456904, []int{1}
+}
+
+func (m *Customer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Customer.Unmarshal(m, b)
+}
+func (m *Customer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Customer.Marshal(b, m, deterministic)
+}
+func (m *Customer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Customer.Merge(m, src)
+}
+func (m *Customer) XXX_Size() int {
+	return xxx_messageInfo_Customer.Size(m)
+}
+func (m *Customer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Customer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Customer proto.InternalMessageInfo
+
+func (m *Customer) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *Customer) GetFavorites() []Color {
+	if m != nil {
+		return m.Favorites
+	}
+	return nil
+}
+
+type Item struct {
+	Sku                  *uint32  `protobuf:"varint,1,opt,name=sku" json:"sku,omitempty"`
+	Price                *float32 `protobuf:"fixed32,2,opt,name=price" json:"price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Item) Reset()         { *m = Item{} }
+func (m *Item) String() string { return proto.CompactTextString(m) }
+func (*Item) ProtoMessage()    {}
+func (*Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6773fa029456904, []int{2}
+}
+
+func (m *Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Item.Unmarshal(m, b)
+}
+func (m *Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Item.Marshal(b, m, deterministic)
+}
+func (m *Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Item.Merge(m, src)
+}
+func (m *Item) XXX_Size() int {
+	return xxx_messageInfo_Item.Size(m)
+}
+func (m *Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Item proto.InternalMessageInfo
+
+func (m *Item) GetSku() uint32 {
+	if m != nil && m.Sku != nil {
+		return *m.Sku
+	}
+	return 0
+}
+
+func (m *Item) GetPrice() float32 {
+	if m != nil && m.Price != nil {
+		return *m.Price
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("validate_test.Color", Color_name, Color_value)
+	proto.RegisterType((*Order)(nil), "validate_test.Order")
+	proto.RegisterMapType((map[int32]*Item)(nil), "validate_test.Order.BySkuEntry")
+	proto.RegisterMapType((map[string]int64)(nil), "validate_test.Order.CountsEntry")
+	proto.RegisterType((*Order_Note)(nil), "validate_test.Order.Note")
+	proto.RegisterType((*Customer)(nil), "validate_test.Customer")
+	proto.RegisterType((*Item)(nil), "validate_test.Item")
+}
+
+func init() {
+	proto.RegisterFile("validate/validate_test_proto/test.proto", fileDescriptor_d6773fa029456904)
+}
+
+var fileDescriptor_d6773fa029456904 = []byte{
+	// 710 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x4e, 0xdb, 0x4a,
+	0x10, 0xce, 0xfa, 0x27, 0x89, 0x27, 0x21, 0x27, 0x2c, 0xa0, 0x63, 0x22, 0x1d, 0x9d, 0x25, 0x05,
+	0xe1, 0x16, 0xe5, 0x87, 0x54, 0xaa, 0x80, 0x5e, 0x40, 0x4d, 0x53, 0xb0, 0x0a, 0x54, 0xda, 0xaa,
+	0x17, 0x05, 0x01, 0x72, 0x92, 0x25, 0xb5, 0x48, 0x6c, 0x6a, 0xaf, 0x51, 0x03, 0xaa, 0xd4, 0x8b,
+	0x4a, 0x7d, 0x9b, 0xde, 0xf7, 0xba, 0x2f, 0xd2, 0xfa, 0x09, 0xfa, 0x08, 0x95, 0xd7, 0x21, 0x04,
+	0x94, 0x36, 0x17, 0xd1, 0x78, 0xbe, 0xef, 0x1b, 0xcf, 0xec, 0x7c, 0x5e, 0x58, 0xbe, 0xb4, 0x7b,
+	0x4e, 0xc7, 0xe6, 0xac, 0x76, 0x13, 0x9c, 0x72, 0x16, 0xf0, 0xd3, 0x0b, 0xdf, 0xe3, 0x5e, 0x2d,
+	0x0e, 0xab, 0x22, 0xc4, 0x53, 0x77, 0xf0, 0x52, 0x69, 0xa4, 0xf3, 0xc3, 0x1e, 0x0b, 0x92, 0xff,
+	0x84, 0x5a, 0xfe, 0x96, 0x06, 0xf5, 0x95, 0xdf, 0x61, 0x3e, 0x36, 0x40, 0x72, 0x3a, 0x3a, 0x22,
+	0xc8, 0xd0, 0x4c, 0x3d, 0xb2, 0xe6, 0x4a, 0xd3, 0x27, 0x47, 0xcf, 0x2a, 0x87, 0xc7, 0xd7, 0x8f,
+	0x3f, 0x56, 0x8e, 0xea, 0x95, 0xf5, 0xe3, 0x95, 0x45, 0x82, 0xa8, 0xe4, 0x74, 0xf0, 0x2a, 0x64,
+	0xdf, 0x87, 0xb6, 0xcb, 0x1d, 0x3e, 0xd0, 0x25, 0x82, 0x0c, 0xd5, 0x9c, 0x8b, 0x2c, 0xac, 0xa5,
+	0xc4, 0xef, 0xd7, 0xe6, 0x74, 0x12, 0xbc, 0xdd, 0xa2, 0x23, 0x5a, 0x2c, 0xe9, 0x38, 0x41, 0xdb,
+	0x0b, 0x5d, 0xae, 0xcb, 0x04, 0x19, 0x68, 0x5c, 0x92, 0x4a, 0x0d, 0x25, 0x3f, 0x36, 0xe9, 0x88,
+	0x86, 0x97, 0x40, 0x0b, 0x9c, 0xae, 0x6b, 0xf3, 0xd0, 0x67, 0xba, 0x42, 0x90, 0x91, 0x37, 0x33,
+	0x91, 0xa5, 0x18, 0x4a, 0x3d, 0x4b, 0x6f, 0x11, 0xbc, 0x0a, 0x6a, 0xdb, 0xeb, 0x79, 0xbe, 0xae,
+	0x12, 0x64, 0x14, 0x1a, 0xb3, 0xd5, 0x3b, 0xb3, 0x57, 0xb7, 0x63, 0xcc, 0x54, 0x23, 0x4b, 0x5a,
+	0x43, 0x34, 0x61, 0xe2, 0x0d, 0xc8, 0xb6, 0xc3, 0x80, 0x7b, 0x7d, 0xe6, 0xeb, 0x69, 0x82, 0x8c,
+	0x5c, 0xe3, 0xdf, 0xfb, 0xaa, 0x21, 0x2c, 0x84, 0x04, 0xd1, 0x11, 0x1f, 0x37, 0x40, 0x75, 0x38,
+	0xeb, 0x07, 0x7a, 0x86, 0xc8, 0x46, 0xae, 0x31, 0x73, 0x4f, 0x68, 0x71, 0xd6, 0x4f, 0xda, 0x44,
+	0x75, 0x99, 0x26, 0x54, 0xfc, 0x00, 0x14, 0x6e, 0x77, 0x03, 0x3d, 0x4b, 0x64, 0x43, 0x33, 0xff,
+	0x89, 0xac, 0x7c, 0x29, 0x7b, 0x72, 0x64, 0x57, 0xae, 0x8e, 0x57, 0x16, 0xeb, 0x12, 0x15, 0x20,
+	0x7e, 0x01, 0x69, 0x31, 0x77, 0xa0, 0x6b, 0xa2, 0x32, 0xb9, 0x57, 0x59, 0x2c, 0xa9, 0xba, 0x2d,
+	0x28, 0x4d, 0x97, 0xfb, 0x03, 0x33, 0x1f, 0x59, 0xda, 0xf0, 0xe0, 0x16, 0xb7, 0xe8, 0x50, 0x8d,
+	0x9f, 0x40, 0xba, 0x35, 0x38, 0x0d, 0xce, 0x43, 0x1d, 0x44, 0x9d, 0xff, 0x27, 0xd6, 0x31, 0x07,
+	0xaf, 0xcf, 0x43, 0x51, 0x86, 0xaa, 0xad, 0x38, 0xc6, 0xcb, 0xa0, 0xb4, 0x6d, 0xbf, 0xa3, 0xe7,
+	0x84, 0x01, 0xa6, 0x23, 0xab, 0x10, 0x37, 0x99, 0xec, 0xdd, 0xc8, 0xd7, 0x67, 0x76, 0x53, 0x54,
+	0x10, 0xf0, 0x02, 0x64, 0xec, 0x76, 0xb2, 0xc9, 0x3c, 0x41, 0x86, 0x32, 0x3c, 0xa3, 0xdd, 0x14,
+	0xbd, 0xc9, 0xe3, 0x0a, 0x28, 0xae, 0xc7, 0x99, 0x3e, 0x45, 0x90, 0x01, 0x8d, 0xf9, 0x89, 0x1d,
+	0x1c, 0x78, 0x9c, 0x51, 0x41, 0x2b, 0xad, 0x43, 0x6e, 0x6c, 0x2e, 0x5c, 0x04, 0xf9, 0x9c, 0x0d,
+	0x12, 0x27, 0xd2, 0x38, 0xc4, 0xb3, 0xa0, 0x5e, 0xda, 0xbd, 0x90, 0x09, 0xb7, 0xc9, 0x34, 0x79,
+	0xd8, 0x90, 0xd6, 0x50, 0x69, 0x1f, 0xe0, 0x76, 0x94, 0x71, 0xa5, 0x9a, 0x28, 0x1f, 0x8e, 0x2b,
+	0x27, 0xaf, 0x6b, 0xbc, 0xdc, 0x02, 0x28, 0x71, 0x5f, 0x78, 0x1e, 0x14, 0xce, 0x3e, 0x70, 0xbd,
+	0x20, 0x0e, 0x23, 0x1e, 0xb0, 0xae, 0x52, 0x91, 0x32, 0x35, 0xc8, 0x5c, 0xd8, 0x83, 0x3e, 0x73,
+	0x79, 0xb9, 0x0f, 0xd9, 0x1b, 0xa3, 0xe0, 0xff, 0x40, 0x71, 0xed, 0x3e, 0x1b, 0x7e, 0x3f, 0x5a,
+	0x64, 0xc5, 0xc6, 0x42, 0x75, 0xa0, 0x22, 0x8d, 0x4d, 0xd0, 0xce, 0xec, 0x4b, 0xcf, 0x77, 0x38,
+	0x0b, 0x74, 0x89, 0xc8, 0x7f, 0x74, 0x6a, 0x21, 0xb2, 0x72, 0xa3, 0x2f, 0x69, 0x0d, 0xd1, 0x5b,
+	0x59, 0x79, 0x0f, 0x94, 0xb8, 0x5f, 0x4c, 0x40, 0x8e, 0xd7, 0x1b, 0xbf, 0x69, 0x4a, 0xf0, 0x85,
+	0x09, 0x3e, 0x7d, 0xf9, 0xbe, 0x45, 0x10, 0x8d, 0x21, 0x5c, 0x06, 0xf5, 0xc2, 0x77, 0xda, 0xc9,
+	0xd4, 0x92, 0x30, 0x8a, 0x76, 0x3d, 0xfb, 0x75, 0xe7, 0xe7, 0xd5, 0xe7, 0x4d, 0x9a, 0x40, 0x8f,
+	0x96, 0x40, 0x15, 0x6f, 0xc4, 0x19, 0x90, 0x69, 0xf3, 0x79, 0x31, 0x85, 0x35, 0x50, 0x77, 0x68,
+	0xb3, 0x79, 0x50, 0x44, 0x38, 0x0b, 0x8a, 0xb9, 0xf7, 0xa6, 0x59, 0x94, 0xcc, 0xfd, 0xc3, 0x97,
+	0x5d, 0x87, 0xbf, 0x0b, 0x5b, 0xd5, 0xb6, 0xd7, 0xaf, 0x75, 0xbd, 0x9e, 0xed, 0x76, 0x6b, 0xe2,
+	0xea, 0x68, 0x85, 0x67, 0xb5, 0xbf, 0x5d, 0x48, 0x4f, 0x27, 0xe4, 0x7e, 0x0f, 0x00, 0x08, 0x25,
+	0xfa, 0xd0, 0xc3, 0x04, 0x00, 0x00,
+}
+
+// Validate checks m against the rules set on its fields by the (golang.protobuf.validate.rules) option.
+func (m *Order) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var errs validate.Errors
+	if m.Id == nil {
+		errs = append(errs, validate.RequiredError("id"))
+	} else {
+		if !validatePattern_Order_Id.MatchString(*m.Id) {
+			errs = append(errs, validate.PatternError("id", "^[A-Z]{3}-[0-9]+$"))
+		}
+	}
+	if m.Quantity != nil {
+		if float64(*m.Quantity) < 1 {
+			errs = append(errs, validate.MinError("quantity", 1))
+		}
+		if float64(*m.Quantity) > 100 {
+			errs = append(errs, validate.MaxError("quantity", 100))
+		}
+	}
+	if m.Discount != nil {
+		if float64(*m.Discount) < 0 {
+			errs = append(errs, validate.MinError("discount", 0))
+		}
+		if float64(*m.Discount) > 0.5 {
+			errs = append(errs, validate.MaxError("discount", 0.5))
+		}
+	}
+	if m.Signature != nil {
+		if uint64(len(m.Signature)) < 4 {
+			errs = append(errs, validate.MinLenError("signature", 4, "bytes"))
+		}
+		if uint64(len(m.Signature)) > 8 {
+			errs = append(errs, validate.MaxLenError("signature", 8, "bytes"))
+		}
+	}
+	if m.Color != nil {
+		if _, ok := Color_name[int32(*m.Color)]; !ok {
+			errs = append(errs, validate.UndefinedError("color", int32(*m.Color)))
+		}
+	}
+	if m.Customer == nil {
+		errs = append(errs, validate.RequiredError("customer"))
+	} else {
+		errs = validate.AppendNested(errs, "customer", m.Customer)
+	}
+	if uint64(len(m.Items)) < 1 {
+		errs = append(errs, validate.MinLenError("items", 1, "elements"))
+	}
+	if uint64(len(m.Items)) > 3 {
+		errs = append(errs, validate.MaxLenError("items", 3, "elements"))
+	}
+	for i, v := range m.Items {
+		errs = validate.AppendNested(errs, validate.Index("items", i), v)
+	}
+	if uint64(len(m.Tags)) > 2 {
+		errs = append(errs, validate.MaxLenError("tags", 2, "elements"))
+	}
+	for i, v := range m.Tags {
+		if !validatePattern_Order_Tags.MatchString(v) {
+			errs = append(errs, validate.PatternError(validate.Index("tags", i), "^[a-z]+$"))
+		}
+	}
+	if len(m.Counts) > 0 {
+		keys := make([]string, 0, len(m.Counts))
+		for k := range m.Counts {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			v := m.Counts[k]
+			if float64(v) > 10 {
+				errs = append(errs, validate.MaxError(validate.Key("counts", k), 10))
+			}
+		}
+	}
+	if len(m.BySku) > 0 {
+		keys := make([]int32, 0, len(m.BySku))
+		for k := range m.BySku {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			v := m.BySku[k]
+			errs = validate.AppendNested(errs, validate.Key("by_sku", k), v)
+		}
+	}
+	if x, ok := m.Payment.(*Order_Card); ok {
+		if !validatePattern_Order_Card.MatchString(x.Card) {
+			errs = append(errs, validate.PatternError("card", "^[0-9]+$"))
+		}
+		if uint64(utf8.RuneCountInString(x.Card)) < 12 {
+			errs = append(errs, validate.MinLenError("card", 12, "characters"))
+		}
+		if uint64(utf8.RuneCountInString(x.Card)) > 19 {
+			errs = append(errs, validate.MaxLenError("card", 19, "characters"))
+		}
+	}
+	if _, ok := m.Payment.(*Order_Account); !ok {
+		errs = append(errs, validate.RequiredError("account"))
+	}
+	if m.Note != nil {
+		errs = validate.AppendNested(errs, "note", m.Note)
+	}
+	return errs.Err()
+}
+
+var validatePattern_Order_Id = regexp.MustCompile("^[A-Z]{3}-[0-9]+$")
+
+var validatePattern_Order_Tags = regexp.MustCompile("^[a-z]+$")
+
+var validatePattern_Order_Card = regexp.MustCompile("^[0-9]+$")
+
+// Validate checks m against the rules set on its fields by the (golang.protobuf.validate.rules) option.
+func (m *Order_Note) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var errs validate.Errors
+	if m.Text != nil {
+		if uint64(utf8.RuneCountInString(*m.Text)) > 5 {
+			errs = append(errs, validate.MaxLenError("text", 5, "characters"))
+		}
+	}
+	return errs.Err()
+}
+
+// Validate checks m against the rules set on its fields by the (golang.protobuf.validate.rules) option.
+func (m *Customer) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var errs validate.Errors
+	if m.Name == nil {
+		errs = append(errs, validate.RequiredError("name"))
+	} else {
+		if uint64(utf8.RuneCountInString(*m.Name)) < 1 {
+			errs = append(errs, validate.MinLenError("name", 1, "characters"))
+		}
+		if uint64(utf8.RuneCountInString(*m.Name)) > 10 {
+			errs = append(errs, validate.MaxLenError("name", 10, "characters"))
+		}
+	}
+	for i, v := range m.Favorites {
+		if float64(v) < 1 {
+			errs = append(errs, validate.MinError(validate.Index("favorites", i), 1))
+		}
+		if _, ok := Color_name[int32(v)]; !ok {
+			errs = append(errs, validate.UndefinedError(validate.Index("favorites", i), int32(v)))
+		}
+	}
+	return errs.Err()
+}
+
+// Validate checks m against the rules set on its fields by the (golang.protobuf.validate.rules) option.
+func (m *Item) Validate() error {
+	if m == nil {
+		return nil
+	}
+	var errs validate.Errors
+	if m.Sku == nil {
+		errs = append(errs, validate.RequiredError("sku"))
+	} else {
+		if float64(*m.Sku) > 9999 {
+			errs = append(errs, validate.MaxError("sku", 9999))
+		}
+	}
+	if m.Price != nil {
+		if float64(*m.Price) < 0.01 {
+			errs = append(errs, validate.MinError("price", 0.01))
+		}
+	}
+	return errs.Err()
+}
@@ -0,0 +1,350 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+/*
+Package yamlpb provides marshaling and unmarshaling between protocol buffers and YAML.
+
+It follows the same mapping as package jsonpb, which implements the specification at
+https://developers.google.com/protocol-buffers/docs/proto3#json: a message is
+represented by the YAML document whose JSON form jsonpb would produce or accept.
+Errors in the input are reported with the YAML line and column of the offending value.
+*/
+package yamlpb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// Marshaler is a configurable object for converting protocol buffer
+// objects to a YAML representation for them.
+type Marshaler struct {
+	// The JSON mapping options: field naming, enum and default value
+	// rendering and Any resolution. JSONPB.Indent is ignored.
+	JSONPB jsonpb.Marshaler
+
+	// The number of spaces to indent each level by. If zero, two spaces
+	// are used.
+	Indent int
+}
+
+// Marshal marshals a protocol buffer into YAML.
+func (m *Marshaler) Marshal(out io.Writer, pb proto.Message) error {
+	jm := m.JSONPB
+	jm.Indent = ""
+	s, err := jm.MarshalToString(pb)
+	if err != nil {
+		return err
+	}
+	// JSON is a subset of YAML, so parsing it yields a node tree that keeps
+	// the field order of the JSON form.
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(s), &doc); err != nil {
+		return err
+	}
+	resetStyle(&doc)
+
+	enc := yaml.NewEncoder(out)
+	indent := m.Indent
+	if indent == 0 {
+		indent = 2
+	}
+	enc.SetIndent(indent)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// MarshalToString converts a protocol buffer object to YAML string.
+func (m *Marshaler) MarshalToString(pb proto.Message) (string, error) {
+	var buf bytes.Buffer
+	if err := m.Marshal(&buf, pb); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// resetStyle switches n and its descendants from the flow and quoted styles
+// of JSON to the default block style. Scalar tags are kept, so the encoder
+// still quotes strings such as "1" or "true" that would otherwise be read
+// back as another type.
+func resetStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		resetStyle(c)
+	}
+}
+
+// Marshal marshals a protocol buffer into YAML using the default options.
+func Marshal(out io.Writer, pb proto.Message) error {
+	return new(Marshaler).Marshal(out, pb)
+}
+
+// MarshalToString converts a protocol buffer object to YAML string using
+// the default options.
+func MarshalToString(pb proto.Message) (string, error) {
+	return new(Marshaler).MarshalToString(pb)
+}
+
+// Unmarshaler is a configurable object for converting from a YAML
+// representation to a protocol buffer object.
+type Unmarshaler struct {
+	// The JSON mapping options: unknown field handling, field naming and
+	// Any resolution.
+	JSONPB jsonpb.Unmarshaler
+}
+
+// An Error reports a YAML value that could not be converted to a
+// protocol buffer.
+type Error struct {
+	Line, Column int
+	Err          error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+// UnmarshalNext unmarshals the next protocol buffer from a YAML document
+// stream. It returns io.EOF at the end of the stream.
+func (u *Unmarshaler) UnmarshalNext(dec *yaml.Decoder, pb proto.Message) error {
+	var doc yaml.Node
+	if err := dec.Decode(&doc); err != nil {
+		return err
+	}
+	root := &doc
+	if doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
+		root = doc.Content[0]
+	}
+	v, err := nodeToInterface(root)
+	if err != nil {
+		return err
+	}
+	if err := u.JSONPB.UnmarshalInterface(v, pb); err != nil {
+		n := root
+		if ve, ok := err.(*jsonpb.ValueError); ok {
+			n = locate(root, ve.Path)
+		}
+		return &Error{Line: n.Line, Column: n.Column, Err: err}
+	}
+	return nil
+}
+
+// Unmarshal unmarshals a YAML document into a protocol buffer.
+func (u *Unmarshaler) Unmarshal(r io.Reader, pb proto.Message) error {
+	return u.UnmarshalNext(yaml.NewDecoder(r), pb)
+}
+
+// UnmarshalNext unmarshals the next protocol buffer from a YAML document
+// stream using the default options.
+func UnmarshalNext(dec *yaml.Decoder, pb proto.Message) error {
+	return new(Unmarshaler).UnmarshalNext(dec, pb)
+}
+
+// Unmarshal unmarshals a YAML document into a protocol buffer using the
+// default options.
+func Unmarshal(r io.Reader, pb proto.Message) error {
+	return new(Unmarshaler).Unmarshal(r, pb)
+}
+
+// UnmarshalString will populate the fields of a protocol buffer based
+// on a YAML string using the default options.
+func UnmarshalString(str string, pb proto.Message) error {
+	return new(Unmarshaler).Unmarshal(strings.NewReader(str), pb)
+}
+
+// nodeToInterface converts a YAML node to the generic Go value
+// jsonpb.UnmarshalInterface accepts.
+func nodeToInterface(n *yaml.Node) (interface{}, error) {
+	switch n.Kind {
+	case yaml.DocumentNode:
+		if len(n.Content) == 0 {
+			return nil, nil
+		}
+		return nodeToInterface(n.Content[0])
+	case yaml.AliasNode:
+		return nodeToInterface(n.Alias)
+	case yaml.SequenceNode:
+		list := make([]interface{}, len(n.Content))
+		for i, c := range n.Content {
+			v, err := nodeToInterface(c)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = v
+		}
+		return list, nil
+	case yaml.MappingNode:
+		obj := make(map[string]interface{}, len(n.Content)/2)
+		if err := addEntries(obj, n, false); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	case yaml.ScalarNode:
+		switch n.ShortTag() {
+		case "!!null":
+			return nil, nil
+		case "!!str", "!!timestamp", "!!binary":
+			// Timestamps and base64 bytes are strings in the JSON mapping.
+			return n.Value, nil
+		case "!!bool", "!!int", "!!float":
+			var v interface{}
+			if err := n.Decode(&v); err != nil {
+				return nil, &Error{Line: n.Line, Column: n.Column, Err: err}
+			}
+			return v, nil
+		}
+		return nil, &Error{Line: n.Line, Column: n.Column, Err: fmt.Errorf("unsupported tag %s", n.Tag)}
+	}
+	return nil, &Error{Line: n.Line, Column: n.Column, Err: fmt.Errorf("unexpected node kind %v", n.Kind)}
+}
+
+// addEntries adds the entries of the mapping node n to obj. Entries from
+// merge keys ("<<") never replace explicit ones; if merged is set, n is
+// itself being merged and its entries only fill in missing keys.
+func addEntries(obj map[string]interface{}, n *yaml.Node, merged bool) error {
+	var merges []*yaml.Node
+	explicit := make(map[string]bool, len(n.Content)/2)
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, v := n.Content[i], n.Content[i+1]
+		if k.Kind != yaml.ScalarNode {
+			return &Error{Line: k.Line, Column: k.Column, Err: fmt.Errorf("non-scalar mapping key")}
+		}
+		if k.ShortTag() == "!!merge" {
+			merges = append(merges, v)
+			continue
+		}
+		if explicit[k.Value] {
+			return &Error{Line: k.Line, Column: k.Column, Err: fmt.Errorf("duplicate key %q", k.Value)}
+		}
+		explicit[k.Value] = true
+		if _, ok := obj[k.Value]; ok && merged {
+			continue
+		}
+		x, err := nodeToInterface(v)
+		if err != nil {
+			return err
+		}
+		obj[k.Value] = x
+	}
+	for _, m := range merges {
+		for _, src := range mergeSources(m) {
+			if src.Kind != yaml.MappingNode {
+				return &Error{Line: m.Line, Column: m.Column, Err: fmt.Errorf("merge value is not a mapping")}
+			}
+			if err := addEntries(obj, src, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mergeSources returns the mappings referred to by the value of a merge key,
+// which is either a mapping or a sequence of them, in order of precedence.
+func mergeSources(n *yaml.Node) []*yaml.Node {
+	for n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	if n.Kind != yaml.SequenceNode {
+		return []*yaml.Node{n}
+	}
+	var srcs []*yaml.Node
+	for _, c := range n.Content {
+		srcs = append(srcs, mergeSources(c)...)
+	}
+	return srcs
+}
+
+// locate returns the node within root at path, as reported by a
+// jsonpb.ValueError. It returns the deepest node it can find. For an object
+// key leading to a mapping or sequence, it returns the key node, so the
+// error points at the field rather than at its first nested entry.
+func locate(root *yaml.Node, path []interface{}) *yaml.Node {
+	n := root
+	for i, step := range path {
+		for n.Kind == yaml.AliasNode {
+			n = n.Alias
+		}
+		var next *yaml.Node
+		switch step := step.(type) {
+		case string:
+			k, v := lookup(n, step)
+			if k == nil {
+				return n
+			}
+			next = v
+			if i == len(path)-1 && v.Kind != yaml.ScalarNode {
+				next = k
+			}
+		case int:
+			if n.Kind != yaml.SequenceNode || step >= len(n.Content) {
+				return n
+			}
+			next = n.Content[step]
+		}
+		n = next
+	}
+	return n
+}
+
+// lookup returns the key and value nodes for key in the mapping node n,
+// following merge keys.
+func lookup(n *yaml.Node, key string) (k, v *yaml.Node) {
+	if n.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	var merges []*yaml.Node
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].ShortTag() == "!!merge" {
+			merges = append(merges, n.Content[i+1])
+			continue
+		}
+		if n.Content[i].Value == key {
+			return n.Content[i], n.Content[i+1]
+		}
+	}
+	for _, m := range merges {
+		for _, src := range mergeSources(m) {
+			if k, v := lookup(src, key); k != nil {
+				return k, v
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,306 @@
+// Go support for Protocol Buffers - Google's data interchange format
+//
+// Copyright 2018 The Go Authors.  All rights reserved.
+// https://github.com/golang/protobuf
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//     * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//     * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//     * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package yamlpb
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"gopkg.in/yaml.v3"
+
+	pb "github.com/golang/protobuf/jsonpb/jsonpb_test_proto"
+	anypb "github.com/golang/protobuf/ptypes/any"
+	durpb "github.com/golang/protobuf/ptypes/duration"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	wpb "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+var marshalingTests = []struct {
+	desc      string
+	marshaler Marshaler
+	pb        proto.Message
+	yaml      string
+}{
+	{"simple", Marshaler{}, &pb.Simple{
+		OBool:   proto.Bool(true),
+		OInt32:  proto.Int32(-32),
+		OInt64:  proto.Int64(64),
+		OString: proto.String("hello"),
+		OBytes:  []byte("world"),
+	}, `oBool: true
+oInt32: -32
+oInt64: "64"
+oString: hello
+oBytes: d29ybGQ=
+`},
+	{"strings that look like other types", Marshaler{}, &pb.Repeats{
+		RString: []string{"1", "true", "null", "", "a: b", "multi\nline"},
+	}, `rString:
+  - "1"
+  - "true"
+  - "null"
+  - ""
+  - 'a: b'
+  - |-
+    multi
+    line
+`},
+	{"nested", Marshaler{Indent: 4}, &pb.Widget{
+		Color:   pb.Widget_BLUE.Enum(),
+		RSimple: []*pb.Simple{{OBool: proto.Bool(true)}, {OInt32: proto.Int32(1)}},
+	}, `color: BLUE
+rSimple:
+    - oBool: true
+    - oInt32: 1
+`},
+	{"jsonpb options", Marshaler{JSONPB: jsonpb.Marshaler{OrigName: true, EnumsAsInts: true}}, &pb.Widget{
+		Color:  pb.Widget_BLUE.Enum(),
+		Simple: &pb.Simple{OInt32: proto.Int32(1)},
+	}, `color: 2
+simple:
+  o_int32: 1
+`},
+	{"well-known types", Marshaler{}, &pb.KnownTypes{
+		Dur: &durpb.Duration{Seconds: 3},
+		I32: &wpb.Int32Value{Value: 5},
+	}, `dur: 3s
+i32: 5
+`},
+	{"empty", Marshaler{}, &pb.Simple{}, "{}\n"},
+}
+
+func TestMarshaling(t *testing.T) {
+	for _, tt := range marshalingTests {
+		s, err := tt.marshaler.MarshalToString(tt.pb)
+		if err != nil {
+			t.Errorf("%s: %v", tt.desc, err)
+			continue
+		}
+		if s != tt.yaml {
+			t.Errorf("%s:\ngot:\n%s\nwant:\n%s", tt.desc, s, tt.yaml)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, tt := range marshalingTests {
+		if tt.marshaler.JSONPB.EnumsAsInts || tt.marshaler.JSONPB.OrigName {
+			continue
+		}
+		got := proto.Clone(tt.pb)
+		got.Reset()
+		if err := UnmarshalString(tt.yaml, got); err != nil {
+			t.Errorf("%s: %v", tt.desc, err)
+			continue
+		}
+		if !proto.Equal(got, tt.pb) {
+			t.Errorf("%s: got %v, want %v", tt.desc, got, tt.pb)
+		}
+	}
+}
+
+var unmarshalingTests = []struct {
+	desc        string
+	unmarshaler Unmarshaler
+	yaml        string
+	pb          proto.Message
+}{
+	{"YAML numbers", Unmarshaler{}, `
+oInt32: 0x10
+oInt64: 64
+oUint64: 18446744073709551615
+oFloat: 1.5
+oDouble: .inf
+oString: "123"
+`, &pb.Simple{
+		OInt32:  proto.Int32(16),
+		OInt64:  proto.Int64(64),
+		OUint64: proto.Uint64(18446744073709551615),
+		OFloat:  proto.Float32(1.5),
+		ODouble: proto.Float64(math.Inf(1)),
+		OString: proto.String("123"),
+	}},
+	{"flow style and orig names", Unmarshaler{}, `{o_bool: true, o_string: "x"}`, &pb.Simple{
+		OBool:   proto.Bool(true),
+		OString: proto.String("x"),
+	}},
+	{"anchors and merge keys", Unmarshaler{}, `
+simple: &base
+  oBool: true
+  oInt32: 1
+rSimple:
+  - *base
+  - <<: *base
+    oInt32: 2
+`, &pb.Widget{
+		Simple: &pb.Simple{OBool: proto.Bool(true), OInt32: proto.Int32(1)},
+		RSimple: []*pb.Simple{
+			{OBool: proto.Bool(true), OInt32: proto.Int32(1)},
+			{OBool: proto.Bool(true), OInt32: proto.Int32(2)},
+		},
+	}},
+	{"timestamp", Unmarshaler{}, `ts: 2014-05-13T16:53:20Z`, &pb.KnownTypes{
+		Ts: &tspb.Timestamp{Seconds: 1400000000},
+	}},
+	{"Any", Unmarshaler{}, `
+an:
+  "@type": type.googleapis.com/google.protobuf.Duration
+  value: 1.5s
+`, &pb.KnownTypes{An: mustMarshalAny(&durpb.Duration{Seconds: 1, Nanos: 500000000})}},
+	{"Any with resolver", Unmarshaler{JSONPB: jsonpb.Unmarshaler{AnyResolver: resolver{"example.com/Dur": &durpb.Duration{}}}}, `
+an:
+  "@type": example.com/Dur
+  value: 2s
+`, &pb.KnownTypes{An: &anypb.Any{
+		TypeUrl: "example.com/Dur",
+		Value:   mustMarshal(&durpb.Duration{Seconds: 2}),
+	}}},
+	{"null", Unmarshaler{}, `simple: ~`, &pb.Widget{}},
+	{"unknown field", Unmarshaler{JSONPB: jsonpb.Unmarshaler{AllowUnknownFields: true}}, `unknown: 1`, &pb.Simple{}},
+}
+
+func TestUnmarshaling(t *testing.T) {
+	for _, tt := range unmarshalingTests {
+		got := proto.Clone(tt.pb)
+		got.Reset()
+		if err := tt.unmarshaler.Unmarshal(strings.NewReader(tt.yaml), got); err != nil {
+			t.Errorf("%s: %v", tt.desc, err)
+			continue
+		}
+		if !proto.Equal(got, tt.pb) {
+			t.Errorf("%s: got %v, want %v", tt.desc, got, tt.pb)
+		}
+	}
+}
+
+var errorTests = []struct {
+	desc         string
+	yaml         string
+	pb           proto.Message
+	line, column int
+}{
+	{"bad scalar", "oBool: true\noInt32: abc\n", &pb.Simple{}, 2, 9},
+	{"unknown field", "oBool: true\nunknown: 1\n", &pb.Simple{}, 2, 10},
+	{"nested", `
+color: RED
+rSimple:
+  - oBool: true
+  - oBool: true
+    oInt32: 1.5
+`, &pb.Widget{}, 6, 13},
+	{"bad nested message", "color: RED\nsimple:\n  - 1\n", &pb.Widget{}, 2, 1},
+	{"Any", `
+an:
+  "@type": type.googleapis.com/google.protobuf.Duration
+  value: 1x
+`, &pb.KnownTypes{}, 4, 10},
+	{"unresolvable Any", `
+an:
+  "@type": example.com/Unknown
+`, &pb.KnownTypes{}, 3, 12},
+	{"unsupported tag", `
+st:
+  a:
+    b: [1, 2, !custom x]
+`, &pb.KnownTypes{}, 4, 15},
+	{"merged value", `
+simple: &base
+  oInt32: 1
+rSimple:
+  - <<: *base
+    oBool: 2
+`, &pb.Widget{}, 6, 12},
+	{"duplicate key", "oBool: true\noBool: false\n", &pb.Simple{}, 2, 1},
+	{"missing required field", "{}", &pb.MsgWithRequired{}, 1, 1},
+}
+
+func TestUnmarshalingErrors(t *testing.T) {
+	for _, tt := range errorTests {
+		err := UnmarshalString(tt.yaml, tt.pb)
+		ye, ok := err.(*Error)
+		if !ok {
+			t.Errorf("%s: got error %v, want *Error", tt.desc, err)
+			continue
+		}
+		if ye.Line != tt.line || ye.Column != tt.column {
+			t.Errorf("%s: got error at %d:%d (%v), want %d:%d", tt.desc, ye.Line, ye.Column, err, tt.line, tt.column)
+		}
+	}
+}
+
+func TestUnmarshalNext(t *testing.T) {
+	dec := yaml.NewDecoder(strings.NewReader("oInt32: 1\n---\noInt32: 2\n"))
+	for _, want := range []int32{1, 2} {
+		var got pb.Simple
+		if err := UnmarshalNext(dec, &got); err != nil {
+			t.Fatal(err)
+		}
+		if got.GetOInt32() != want {
+			t.Errorf("got oInt32 %d, want %d", got.GetOInt32(), want)
+		}
+	}
+	if err := UnmarshalNext(dec, &pb.Simple{}); err != io.EOF {
+		t.Errorf("got %v at end of stream, want io.EOF", err)
+	}
+}
+
+type resolver map[string]proto.Message
+
+func (r resolver) Resolve(turl string) (proto.Message, error) {
+	m, ok := r[turl]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %q", turl)
+	}
+	return proto.Clone(m), nil
+}
+
+func mustMarshal(m proto.Message) []byte {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func mustMarshalAny(m proto.Message) *anypb.Any {
+	a, err := ptypes.MarshalAny(m)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}